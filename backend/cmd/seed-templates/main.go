@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"fitness-trainer/internal/db"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/repository"
+	routine_templates_service "fitness-trainer/internal/service/routine_templates"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+)
+
+func init() {
+	logger.Init()
+	godotenv.Load()
+}
+
+func loadPostgresURL() string {
+	return fmt.Sprintf(
+		"postgres://%s:%s@%s:%s/%s?sslmode=%s",
+		os.Getenv("POSTGRES_USER"),
+		os.Getenv("POSTGRES_PASSWORD"),
+		os.Getenv("POSTGRES_HOST"),
+		os.Getenv("POSTGRES_PORT"),
+		os.Getenv("POSTGRES_DB"),
+		os.Getenv("POSTGRES_SSL_MODE"),
+	)
+}
+
+// Loads the routine template library from the seed files and prints the report.
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := pgxpool.New(ctx, loadPostgresURL())
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	defer pool.Close()
+
+	contextManager := db.NewContextManager(pool)
+	Repo := repository.NewPGXRepository(contextManager)
+	RoutineTemplates := routine_templates_service.New(Repo, contextManager)
+
+	report, err := RoutineTemplates.Seed(ctx)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		logger.Fatal(err.Error())
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	s3_client "fitness-trainer/internal/clients/s3"
+	"fitness-trainer/internal/db"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/repository"
+	storage_gc_service "fitness-trainer/internal/service/storage_gc"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+)
+
+func init() {
+	logger.Init()
+	godotenv.Load()
+}
+
+func loadPostgresURL() string {
+	return fmt.Sprintf(
+		"postgres://%s:%s@%s:%s/%s?sslmode=%s",
+		os.Getenv("POSTGRES_USER"),
+		os.Getenv("POSTGRES_PASSWORD"),
+		os.Getenv("POSTGRES_HOST"),
+		os.Getenv("POSTGRES_PORT"),
+		os.Getenv("POSTGRES_DB"),
+		os.Getenv("POSTGRES_SSL_MODE"),
+	)
+}
+
+// Deletes orphaned objects once and prints the report.
+func main() {
+	dryRun := flag.Bool("dry-run", false, "only report orphaned objects")
+	gracePeriod := flag.Duration("grace-period", 48*time.Hour, "keep objects younger than this")
+	flag.Parse()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := pgxpool.New(ctx, loadPostgresURL())
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	defer pool.Close()
+
+	awsConfig, err := config.LoadDefaultConfig(
+		ctx,
+		config.WithRegion(os.Getenv("AWS_REGION")),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			os.Getenv("AWS_ACCESS_KEY_ID"),
+			os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			"",
+		)),
+	)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+
+	s3Client := s3.NewFromConfig(awsConfig, func(o *s3.Options) {
+		o.BaseEndpoint = aws.String(os.Getenv("AWS_ENDPOINT"))
+		o.UsePathStyle = os.Getenv("AWS_S3_USE_PATH_STYLE") == "true"
+	})
+
+	Repo := repository.NewPGXRepository(db.NewContextManager(pool))
+	StorageGC := storage_gc_service.New(s3_client.New(s3Client, os.Getenv("AWS_S3_BUCKET")), Repo, *gracePeriod)
+
+	report, err := StorageGC.Collect(ctx, *dryRun)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		logger.Fatal(err.Error())
+	}
+}
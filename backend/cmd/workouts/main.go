@@ -13,6 +13,7 @@ import (
 
 	"fitness-trainer/internal/app"
 	genai_client "fitness-trainer/internal/clients/gemini"
+	"fitness-trainer/internal/clients/imaging"
 	"fitness-trainer/internal/clients/ratelimiter"
 	s3_client "fitness-trainer/internal/clients/s3"
 	"fitness-trainer/internal/db"
@@ -20,6 +21,7 @@ import (
 	"fitness-trainer/internal/logger"
 	"fitness-trainer/internal/repository"
 	"fitness-trainer/internal/service"
+	storage_gc_service "fitness-trainer/internal/service/storage_gc"
 	workout_generator_service "fitness-trainer/internal/service/workout_generator"
 	"fitness-trainer/internal/tracer"
 
@@ -55,6 +57,22 @@ func loadPostgresURL() string {
 	)
 }
 
+// loadDuration reads a positive duration from the environment variable, or returns the
+// fallback if it is not set.
+func loadDuration(name string, fallback time.Duration) (time.Duration, error) {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return fallback, nil
+	}
+
+	duration, err := time.ParseDuration(raw)
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("invalid %s %q", name, raw)
+	}
+
+	return duration, nil
+}
+
 func Run() error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -83,9 +101,18 @@ func Run() error {
 	awsConfig := getAWSConfig(ctx)
 	s3Client := s3.NewFromConfig(awsConfig, func(o *s3.Options) {
 		o.BaseEndpoint = aws.String(endpoint)
+		// S3-compatible stand-ins such as MinIO usually don't serve virtual-hosted buckets
+		o.UsePathStyle = os.Getenv("AWS_S3_USE_PATH_STYLE") == "true"
 	})
 	s3ClientWrapper := s3_client.New(s3Client, bucket)
 
+	presignGetTTL, err := loadDuration("AWS_S3_PRESIGN_GET_TTL", time.Hour)
+	if err != nil {
+		return err
+	}
+
+	URLSigner := s3_client.NewURLSigner(s3ClientWrapper, presignGetTTL)
+
 	ContextManager := db.NewContextManager(pool)
 
 	Repo := repository.NewPGXRepository(ContextManager)
@@ -131,12 +158,20 @@ func Run() error {
 
 	rateLimiterWrapper := ratelimiter.New(rateLimiter)
 
+	amendWindow, err := loadDuration("WORKOUT_AMEND_WINDOW", 24*time.Hour)
+	if err != nil {
+		return err
+	}
+
 	Service := service.New(
 		ContextManager,
 		JWTProvider,
 		s3ClientWrapper,
 		WorkoutGenerator,
 		rateLimiterWrapper,
+		imaging.New(),
+		URLSigner,
+		amendWindow,
 		Repo, // Auth
 		Repo, // User
 		Repo, // Exercise
@@ -149,8 +184,43 @@ func Run() error {
 		Repo, // Set
 		Repo, // ExpectedSet
 		Repo, // Generation Settings
+		Repo, // Analytics
+		Repo, // ExerciseMedia
+		Repo, // File
+		Repo, // ImageProcessingJob
+		Repo, // Measurement
+		Repo, // ProgressPhoto
+		Repo, // UserPreferences
+		Repo, // GymProfile
+		Repo, // DataExport
+		Repo, // WorkoutImport
 	)
 
+	go Service.RunImageProcessing(ctx, 5*time.Second)
+	go Service.RunDataExports(ctx, 10*time.Second)
+	go Service.RunWorkoutImports(ctx, 10*time.Second)
+
+	storageGCInterval, err := loadDuration("STORAGE_GC_INTERVAL", 24*time.Hour)
+	if err != nil {
+		return err
+	}
+
+	storageGCGracePeriod, err := loadDuration("STORAGE_GC_GRACE_PERIOD", 48*time.Hour)
+	if err != nil {
+		return err
+	}
+
+	StorageGC := storage_gc_service.New(s3ClientWrapper, Repo, storageGCGracePeriod)
+	go StorageGC.Run(ctx, storageGCInterval, os.Getenv("STORAGE_GC_DRY_RUN") == "true")
+
+	appOptions := []app.OptionsFunc{
+		app.WithHTTPPathPrefix("/api"),
+	}
+	// Progress photos are signed regardless of the mode
+	if os.Getenv("AWS_S3_PRIVATE_BUCKET") == "true" {
+		appOptions = append(appOptions, app.WithMediaURLSigner(URLSigner))
+	}
+
 	App := app.New(
 		Service,
 		Service,
@@ -158,7 +228,7 @@ func Run() error {
 		Service,
 		Service,
 		Service,
-		app.WithHTTPPathPrefix("/api"),
+		appOptions...,
 	)
 
 	if err := App.Run(ctx); err != nil {
@@ -54,6 +54,7 @@ type Options struct {
 	enableReflection bool
 	swaggerFile      []byte
 	bypassCors       bool
+	mediaURLSigner   interceptors.URLSigner
 }
 
 var defaultOptions = &Options{
@@ -108,6 +109,13 @@ func WithBypassCors(bypassCors bool) OptionsFunc {
 	}
 }
 
+// WithMediaURLSigner makes responses carry signed media URLs, for buckets without public read access.
+func WithMediaURLSigner(signer interceptors.URLSigner) OptionsFunc {
+	return func(o *Options) {
+		o.mediaURLSigner = signer
+	}
+}
+
 type App struct {
 	authService     auth.Service
 	userService     user.Service
@@ -147,20 +155,29 @@ func (a *App) Run(ctx context.Context) error {
 	grpcEndpoint := fmt.Sprintf(":%d", a.options.grpcPort)
 	httpEndpoint := fmt.Sprintf(":%d", a.options.gatewayPort)
 
-	srv := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			interceptors.TracingInterceptor,
-			interceptors.RecovertInterceptor,
-			interceptors.NewAuth(
-				a.authService,
-				map[string]struct{}{
-					"/fitness_trainer.api.workout.AuthService/Login":      {},
-					"/fitness_trainer.api.workout.AuthService/Refresh":    {},
-					"/fitness_trainer.api.workout.UserService/CreateUser": {},
-				},
-			),
-			interceptors.ErrCodesInterceptor,
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		interceptors.TracingInterceptor,
+		interceptors.RecovertInterceptor,
+		interceptors.NewAuth(
+			a.authService,
+			map[string]struct{}{
+				"/fitness_trainer.api.workout.AuthService/Login":      {},
+				"/fitness_trainer.api.workout.AuthService/Refresh":    {},
+				"/fitness_trainer.api.workout.UserService/CreateUser": {},
+				// Shared routines are viewed by link without an account
+				"/fitness_trainer.api.workout.RoutineService/GetSharedRoutine": {},
+			},
 		),
+		// Preferences come before the error codes, so errors are localized
+		interceptors.NewPreferences(a.userService),
+		interceptors.ErrCodesInterceptor,
+	}
+	if a.options.mediaURLSigner != nil {
+		unaryInterceptors = append(unaryInterceptors, interceptors.NewMediaURLs(a.options.mediaURLSigner))
+	}
+
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 		grpc.ChainStreamInterceptor(
 			recovery.StreamServerInterceptor(),
 		),
@@ -0,0 +1,48 @@
+package exercise
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) ConfirmExerciseMedia(ctx context.Context, in *desc.ConfirmExerciseMediaRequest) (*desc.ExerciseMediaResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.exercise.ConfirmExerciseMedia")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	exerciseID, err := domain.ParseID(in.GetExerciseId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	mediaID, err := domain.ParseID(in.GetMediaId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	media, err := i.service.ConfirmExerciseMedia(ctx, userID, exerciseID, mediaID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ExerciseMediaResponse{
+		Media: mappers.ExerciseMediaToProto(media),
+	}, nil
+}
@@ -2,6 +2,7 @@ package exercise
 
 import (
 	"context"
+	"fitness-trainer/internal/app/interceptors"
 	"fitness-trainer/internal/app/mappers"
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/domain/dto"
@@ -21,9 +22,20 @@ func (i *Implementation) CreateExercise(ctx context.Context, in *desc.CreateExer
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
 	}
 
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
 	var exerciseDTO dto.CreateExerciseDTO
 	{
+		exerciseDTO.OwnerID = userID
 		exerciseDTO.Name = in.Name
+
+		if in.Visibility != nil {
+			exerciseDTO.Visibility = mappers.ExerciseVisibilityFromProto(in.GetVisibility())
+		}
 		
 		if in.Description != nil {
 			exerciseDTO.Description = utils.NewNullable(in.GetDescription(), true)
@@ -33,6 +45,12 @@ func (i *Implementation) CreateExercise(ctx context.Context, in *desc.CreateExer
 			exerciseDTO.VideoURL = utils.NewNullable(in.GetVideoUrl(), true)
 		}
 
+		if in.Equipment != nil {
+			exerciseDTO.Equipment = mappers.EquipmentFromProto(in.GetEquipment())
+		}
+
+		exerciseDTO.Aliases = mappers.ExerciseAliasesFromProto(in.GetAliases())
+
 		for _, muscleGroupID := range in.TargetMuscleGroupIds {
 			id, err := domain.ParseID(muscleGroupID)
 			if err != nil {
@@ -41,6 +59,24 @@ func (i *Implementation) CreateExercise(ctx context.Context, in *desc.CreateExer
 
 			exerciseDTO.TargetMuscleGroups = append(exerciseDTO.TargetMuscleGroups, id)
 		}
+
+		for _, muscleGroup := range in.MuscleGroups {
+			id, err := domain.ParseID(muscleGroup.GetMuscleGroupId())
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+			}
+
+			muscleGroupDTO := dto.ExerciseMuscleGroupDTO{
+				MuscleGroupID: id,
+				Role:          mappers.MuscleGroupRoleFromProto(muscleGroup.GetRole()),
+			}
+
+			if muscleGroup.Activation != nil {
+				muscleGroupDTO.Activation = utils.NewNullable(muscleGroup.GetActivation(), true)
+			}
+
+			exerciseDTO.MuscleGroups = append(exerciseDTO.MuscleGroups, muscleGroupDTO)
+		}
 	}
 
 	exercise, err := i.service.CreateExercise(
@@ -0,0 +1,44 @@
+package exercise
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) CreateExerciseMediaUpload(ctx context.Context, in *desc.CreateExerciseMediaUploadRequest) (*desc.CreateExerciseMediaUploadResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.exercise.CreateExerciseMediaUpload")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	exerciseID, err := domain.ParseID(in.GetExerciseId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	media, uploadURL, err := i.service.CreateExerciseMediaUpload(ctx, userID, exerciseID, in.GetContentType(), in.GetSizeBytes(), in.GetCaption())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.CreateExerciseMediaUploadResponse{
+		Media:     mappers.ExerciseMediaToProto(media),
+		UploadUrl: uploadURL,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package exercise
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) DeleteExerciseMedia(ctx context.Context, in *desc.DeleteExerciseMediaRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.exercise.DeleteExerciseMedia")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	exerciseID, err := domain.ParseID(in.GetExerciseId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	mediaID, err := domain.ParseID(in.GetMediaId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	if err := i.service.DeleteExerciseMedia(ctx, userID, exerciseID, mediaID); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"fitness-trainer/internal/app/interceptors"
 	"fitness-trainer/internal/app/mappers"
 	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
 	desc "fitness-trainer/pkg/workouts"
 
 	"github.com/opentracing/opentracing-go"
@@ -19,12 +21,18 @@ func (i *Implementation) GetExerciseAlternatives(ctx context.Context, in *desc.G
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
 	}
 
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
 	id, err := domain.ParseID(in.GetExerciseId())
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
 	}
 
-	exercises, err := i.service.GetExerciseAlternatives(ctx, id)
+	exercises, err := i.service.GetExerciseAlternatives(ctx, userID, id)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,56 @@
+package exercise
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) GetExerciseAuditLog(ctx context.Context, in *desc.GetExerciseAuditLogRequest) (*desc.GetExerciseAuditLogResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.exercise.GetExerciseAuditLog")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	exerciseID, err := domain.ParseID(in.GetExerciseId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	var limit, offset int
+	{
+		if in.Limit <= 0 {
+			limit = 20
+		} else {
+			limit = int(in.GetLimit())
+		}
+
+		if in.Offset > 0 {
+			offset = int(in.GetOffset())
+		}
+	}
+
+	entries, err := i.service.GetExerciseAuditLog(ctx, userID, exerciseID, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetExerciseAuditLogResponse{
+		Entries: mappers.ExerciseAuditEntriesToProto(entries),
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"fitness-trainer/internal/app/interceptors"
 	"fitness-trainer/internal/app/mappers"
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/logger"
@@ -20,12 +21,18 @@ func (i *Implementation) GetExerciseDetail(ctx context.Context, in *desc.GetExer
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
 	}
 
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
 	id, err := domain.ParseID(in.GetExerciseId())
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
 	}
 
-	exercise, err := i.service.GetExerciseByID(ctx, id)
+	exercise, err := i.service.GetExerciseByID(ctx, userID, id)
 	if err != nil {
 		logger.Errorf("error getting exercise detail: %v", err)
 		return nil, err
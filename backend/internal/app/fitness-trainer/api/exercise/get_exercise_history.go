@@ -52,7 +52,12 @@ func (i *Implementation) GetExerciseHistory(ctx context.Context, in *desc.GetExe
 		return nil, err
 	}
 
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &desc.ExerciseHistoryResponse{
-		ExerciseLogs: mappers.ExerciseLogDTOsToProto(logs),
+		ExerciseLogs: mappers.ExerciseLogDTOsToProto(logs, mappers.NewUnits(preferences)),
 	}, nil
 }
@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"fitness-trainer/internal/app/interceptors"
 	"fitness-trainer/internal/app/mappers"
 	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
 	desc "fitness-trainer/pkg/workouts"
 
 	"github.com/opentracing/opentracing-go"
@@ -19,6 +23,12 @@ func (i *Implementation) GetExercises(ctx context.Context, in *desc.GetExercises
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
 	}
 
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
 	muscleGroupIDs := make([]domain.ID, 0, len(in.GetMuscleGroupIds()))
 	for _, mg := range in.GetMuscleGroupIds() {
 		muscleGroupID, err := domain.ParseID(mg)
@@ -37,12 +47,49 @@ func (i *Implementation) GetExercises(ctx context.Context, in *desc.GetExercises
 		excludedExerciseIDs = append(excludedExerciseIDs, id)
 	}
 
-	exercises, err := i.service.GetExercises(ctx, muscleGroupIDs, excludedExerciseIDs)
+	muscleGroupRoles := make([]domain.MuscleGroupRole, 0, len(in.GetMuscleGroupRoles()))
+	for _, role := range in.GetMuscleGroupRoles() {
+		muscleGroupRoles = append(muscleGroupRoles, mappers.MuscleGroupRoleFromProto(role))
+	}
+
+	equipment := make([]domain.Equipment, 0, len(in.GetEquipment()))
+	for _, e := range in.GetEquipment() {
+		equipment = append(equipment, mappers.EquipmentFromProto(e))
+	}
+
+	filter := dto.GetExercisesDTO{
+		UserID:            userID,
+		MuscleGroups:      muscleGroupIDs,
+		MuscleGroupRoles:  muscleGroupRoles,
+		ExcludedExercises: excludedExerciseIDs,
+		Query:             in.GetQuery(),
+		Equipment:         equipment,
+		RecentlyUsed:      in.GetRecentlyUsed(),
+		Limit:             int(in.GetLimit()),
+	}
+	if filter.Limit == 0 {
+		filter.Limit = 50
+	}
+
+	if in.GetCursor() != "" {
+		cursor, err := mappers.ExercisesCursorFromProto(in.GetCursor())
+		if err != nil {
+			return nil, err
+		}
+		filter.Cursor = utils.NewNullable(cursor, true)
+	}
+
+	page, err := i.service.GetExercises(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	return &desc.GetExercisesResponse{
-		Exercises: mappers.ExercisesToProto(exercises),
-	}, nil
+	response := &desc.GetExercisesResponse{
+		Exercises: mappers.ExercisesToProto(page.Exercises),
+	}
+	if page.NextCursor.IsValid {
+		response.NextCursor = mappers.ExercisesCursorToProto(page.NextCursor.V)
+	}
+
+	return response, nil
 }
@@ -0,0 +1,34 @@
+package exercise
+
+import (
+	"context"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) GetExercisesForModeration(ctx context.Context, _ *emptypb.Empty) (*desc.GetExercisesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.exercise.GetExercisesForModeration")
+	defer span.Finish()
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	exercises, err := i.service.GetExercisesForModeration(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetExercisesResponse{
+		Exercises: mappers.ExercisesToProto(exercises),
+	}, nil
+}
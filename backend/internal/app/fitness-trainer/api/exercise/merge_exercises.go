@@ -0,0 +1,48 @@
+package exercise
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) MergeExercises(ctx context.Context, in *desc.MergeExercisesRequest) (*desc.ExerciseResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.exercise.MergeExercises")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	canonicalID, err := domain.ParseID(in.GetCanonicalExerciseId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	duplicateID, err := domain.ParseID(in.GetDuplicateExerciseId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	exercise, err := i.service.MergeExercises(ctx, userID, canonicalID, duplicateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ExerciseResponse{
+		Exercise: mappers.ExerciseToProto(exercise),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package exercise
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) ModerateExercise(ctx context.Context, in *desc.ModerateExerciseRequest) (*desc.ExerciseResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.exercise.ModerateExercise")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	exerciseID, err := domain.ParseID(in.GetExerciseId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	exercise, err := i.service.ModerateExercise(ctx, userID, exerciseID, in.GetApprove(), in.GetComment())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ExerciseResponse{
+		Exercise: mappers.ExerciseToProto(exercise),
+	}, nil
+}
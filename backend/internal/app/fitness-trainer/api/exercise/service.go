@@ -10,9 +10,27 @@ import (
 
 type Service interface {
 	CreateExercise(ctx context.Context, exercise dto.CreateExerciseDTO) (domain.Exercise, error)
-	GetExercises(ctx context.Context, muscleGroups, excludedExercises []domain.ID) ([]domain.Exercise, error)
-	GetExerciseByID(ctx context.Context, id domain.ID) (domain.Exercise, error)
-	GetExerciseAlternatives(ctx context.Context, id domain.ID) ([]domain.Exercise, error)
+	GetExercises(ctx context.Context, filter dto.GetExercisesDTO) (dto.ExercisesPageDTO, error)
+	GetExerciseByID(ctx context.Context, userID, id domain.ID) (domain.Exercise, error)
+	GetExerciseAlternatives(ctx context.Context, userID, id domain.ID) ([]domain.Exercise, error)
+
+	SetExerciseVisibility(ctx context.Context, userID, exerciseID domain.ID, visibility domain.ExerciseVisibility) (domain.Exercise, error)
+	AddSharedExercise(ctx context.Context, userID domain.ID, token string) (domain.Exercise, error)
+	RequestExercisePromotion(ctx context.Context, userID, exerciseID domain.ID) (domain.Exercise, error)
+	GetExercisesForModeration(ctx context.Context, userID domain.ID) ([]domain.Exercise, error)
+	ModerateExercise(ctx context.Context, userID, exerciseID domain.ID, approve bool, comment string) (domain.Exercise, error)
+
+	UpdateExercise(ctx context.Context, userID, exerciseID domain.ID, exercise dto.UpdateExerciseDTO) (domain.Exercise, error)
+	ArchiveExercise(ctx context.Context, userID, exerciseID domain.ID) (domain.Exercise, error)
+	RestoreExercise(ctx context.Context, userID, exerciseID domain.ID) (domain.Exercise, error)
+	MergeExercises(ctx context.Context, userID, canonicalID, duplicateID domain.ID) (domain.Exercise, error)
+	GetExerciseAuditLog(ctx context.Context, userID, exerciseID domain.ID, offset, limit int) ([]domain.ExerciseAuditEntry, error)
+
+	CreateExerciseMediaUpload(ctx context.Context, userID, exerciseID domain.ID, contentType string, size int64, caption string) (domain.ExerciseMedia, string, error)
+	ConfirmExerciseMedia(ctx context.Context, userID, exerciseID, mediaID domain.ID) (domain.ExerciseMedia, error)
+	UpdateExerciseMediaCaption(ctx context.Context, userID, exerciseID, mediaID domain.ID, caption string) (domain.ExerciseMedia, error)
+	DeleteExerciseMedia(ctx context.Context, userID, exerciseID, mediaID domain.ID) error
+	SetExerciseMediaOrder(ctx context.Context, userID, exerciseID domain.ID, mediaIDs []domain.ID) (domain.Exercise, error)
 
 	GetMuscleGroups(ctx context.Context) ([]dto.MuscleGroupDTO, error)
 
@@ -0,0 +1,52 @@
+package exercise
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) SetExerciseMediaOrder(ctx context.Context, in *desc.SetExerciseMediaOrderRequest) (*desc.ExerciseResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.exercise.SetExerciseMediaOrder")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	exerciseID, err := domain.ParseID(in.GetExerciseId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	mediaIDs := make([]domain.ID, 0, len(in.GetMediaIds()))
+	for _, id := range in.GetMediaIds() {
+		mediaID, err := domain.ParseID(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+		}
+		mediaIDs = append(mediaIDs, mediaID)
+	}
+
+	exercise, err := i.service.SetExerciseMediaOrder(ctx, userID, exerciseID, mediaIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ExerciseResponse{
+		Exercise: mappers.ExerciseToProto(exercise),
+	}, nil
+}
@@ -0,0 +1,93 @@
+package exercise
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) UpdateExercise(ctx context.Context, in *desc.UpdateExerciseRequest) (*desc.ExerciseResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.exercise.UpdateExercise")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	exerciseID, err := domain.ParseID(in.GetExerciseId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	var exerciseDTO dto.UpdateExerciseDTO
+	{
+		if in.Name != nil {
+			exerciseDTO.Name = utils.NewNullable(in.GetName(), true)
+		}
+
+		if in.Description != nil {
+			exerciseDTO.Description = utils.NewNullable(in.GetDescription(), true)
+		}
+
+		if in.VideoUrl != nil {
+			exerciseDTO.VideoURL = utils.NewNullable(in.GetVideoUrl(), true)
+		}
+
+		if in.Equipment != nil {
+			exerciseDTO.Equipment = mappers.EquipmentFromProto(in.GetEquipment())
+		}
+
+		exerciseDTO.Aliases = mappers.ExerciseAliasesFromProto(in.GetAliases())
+
+		for _, muscleGroupID := range in.TargetMuscleGroupIds {
+			id, err := domain.ParseID(muscleGroupID)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+			}
+
+			exerciseDTO.TargetMuscleGroups = append(exerciseDTO.TargetMuscleGroups, id)
+		}
+
+		for _, muscleGroup := range in.MuscleGroups {
+			id, err := domain.ParseID(muscleGroup.GetMuscleGroupId())
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+			}
+
+			muscleGroupDTO := dto.ExerciseMuscleGroupDTO{
+				MuscleGroupID: id,
+				Role:          mappers.MuscleGroupRoleFromProto(muscleGroup.GetRole()),
+			}
+
+			if muscleGroup.Activation != nil {
+				muscleGroupDTO.Activation = utils.NewNullable(muscleGroup.GetActivation(), true)
+			}
+
+			exerciseDTO.MuscleGroups = append(exerciseDTO.MuscleGroups, muscleGroupDTO)
+		}
+	}
+
+	exercise, err := i.service.UpdateExercise(ctx, userID, exerciseID, exerciseDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ExerciseResponse{
+		Exercise: mappers.ExerciseToProto(exercise),
+	}, nil
+}
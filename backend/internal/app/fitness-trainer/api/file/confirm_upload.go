@@ -0,0 +1,44 @@
+package file
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) ConfirmUpload(ctx context.Context, in *desc.ConfirmUploadRequest) (*desc.FileResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.file.ConfirmUpload")
+	defer span.Finish()
+
+	if err := in.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	fileID, err := domain.ParseID(in.GetFileId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	file, err := i.service.ConfirmUpload(ctx, userID, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.FileResponse{
+		File: mappers.FileToProto(file),
+	}, nil
+}
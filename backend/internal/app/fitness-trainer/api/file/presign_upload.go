@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
 	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
 	"fitness-trainer/internal/logger"
 
 	desc "fitness-trainer/pkg/workouts"
@@ -20,10 +23,23 @@ func (i *Implementation) PresignUpload(ctx context.Context, in *desc.PresignUplo
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
 	}
 
-	uploadURL, getURL, err := i.service.GenerateUploadURL(
-		ctx,
-		in.Filename,
-	)
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	purpose := mappers.FilePurposeFromProto(in.GetPurpose())
+	if purpose == domain.FilePurposeUnknown {
+		purpose = domain.FilePurposeProfilePicture
+	}
+
+	file, uploadURL, err := i.service.PresignUpload(ctx, userID, dto.PresignUploadDTO{
+		Purpose:     purpose,
+		Filename:    in.GetFilename(),
+		ContentType: in.GetContentType(),
+		Size:        in.GetSizeBytes(),
+	})
 	if err != nil {
 		logger.Errorf("error generating presigned URL: %v", err)
 		return nil, err
@@ -31,6 +47,7 @@ func (i *Implementation) PresignUpload(ctx context.Context, in *desc.PresignUplo
 
 	return &desc.PresignUploadResponse{
 		UploadUrl: uploadURL,
-		GetUrl:    getURL,
+		GetUrl:    file.URL,
+		FileId:    file.ID.String(),
 	}, nil
 }
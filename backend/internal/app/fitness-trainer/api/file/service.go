@@ -2,11 +2,15 @@ package file
 
 import (
 	"context"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
 	desc "fitness-trainer/pkg/workouts"
 )
 
 type Service interface {
-	GenerateUploadURL(ctx context.Context, key string) (uploadURL string, getURL string, err error)
+	PresignUpload(ctx context.Context, userID domain.ID, input dto.PresignUploadDTO) (file domain.File, uploadURL string, err error)
+	ConfirmUpload(ctx context.Context, userID, fileID domain.ID) (domain.File, error)
 }
 
 type Implementation struct {
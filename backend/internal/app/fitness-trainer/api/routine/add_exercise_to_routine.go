@@ -2,7 +2,9 @@ package routine
 
 import (
 	"context"
+	"fitness-trainer/internal/app/interceptors"
 	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
 	desc "fitness-trainer/pkg/workouts"
 	"fmt"
 
@@ -18,6 +20,12 @@ func (i *Implementation) AddExerciseToRoutine(ctx context.Context, in *desc.AddE
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
 	}
 
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
 	routineID, err := domain.ParseID(in.GetRoutineId())
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
@@ -28,7 +36,7 @@ func (i *Implementation) AddExerciseToRoutine(ctx context.Context, in *desc.AddE
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
 	}
 
-	exerciseInstance, err := i.service.AddExerciseToRoutine(ctx, routineID, exerciseID)
+	exerciseInstance, err := i.service.AddExerciseToRoutine(ctx, userID, routineID, exerciseID)
 	if err != nil {
 		return nil, err
 	}
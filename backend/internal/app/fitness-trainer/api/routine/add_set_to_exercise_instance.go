@@ -38,6 +38,13 @@ func (i *Implementation) AddSetToExerciseInstance(ctx context.Context, in *desc.
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
 	}
 
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	units := mappers.NewUnits(preferences)
+
 	var createSetDTO dto.CreateSetDTO
 	{
 		createSetDTO.ExerciseInstanceID = exerciseInstanceID
@@ -48,8 +55,10 @@ func (i *Implementation) AddSetToExerciseInstance(ctx context.Context, in *desc.
 		}
 
 		createSetDTO.Reps = utils.NewNullable(int(in.GetReps()), in.GetReps() != 0)
-		createSetDTO.Weight = utils.NewNullable(float32(in.GetWeight()), in.GetWeight() != 0)
+		createSetDTO.Weight = utils.NewNullable(units.WeightFromProto(float32(in.GetWeight())), in.GetWeight() != 0)
 		createSetDTO.Time = utils.NewNullable(in.GetTime().AsDuration(), in.GetTime().AsDuration() != 0)
+		createSetDTO.Rest = utils.NewNullable(in.GetRest().AsDuration(), in.GetRest().AsDuration() != 0)
+		createSetDTO.Position = utils.NewNullable(int(in.GetPosition()), in.Position != nil)
 	}
 
 	set, err := i.service.AddSetToExerciseInstance(ctx, userID, routineID, exerciseInstanceID, createSetDTO)
@@ -58,6 +67,6 @@ func (i *Implementation) AddSetToExerciseInstance(ctx context.Context, in *desc.
 	}
 
 	return &desc.SetResponse{
-		Set: mappers.SetToProto(set),
+		Set: mappers.SetToProto(set, units),
 	}, nil
 }
@@ -0,0 +1,44 @@
+package routine
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) CloneRoutineTemplate(ctx context.Context, in *desc.CloneRoutineTemplateRequest) (*desc.RoutineResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.routine.CloneRoutineTemplate")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	templateID, err := domain.ParseID(in.GetTemplateId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	routine, err := i.service.CloneRoutineTemplate(ctx, userID, templateID, utils.NewNullable(in.GetName(), in.Name != nil))
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.RoutineResponse{
+		Routine: mappers.RoutineToProto(routine),
+	}, nil
+}
@@ -34,6 +34,7 @@ func (i *Implementation) CreateRoutine(ctx context.Context, in *desc.CreateRouti
 
 		dto.Name = in.GetName()
 		dto.Description = in.GetDescription()
+		dto.WarmupSteps = mappers.WarmupSchemeFromProto(in.GetWarmupScheme())
 
 		if in.WorkoutId != nil {
 			parsedID, err := domain.ParseID(*in.WorkoutId)
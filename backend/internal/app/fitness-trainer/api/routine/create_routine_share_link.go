@@ -0,0 +1,49 @@
+package routine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) CreateRoutineShareLink(ctx context.Context, in *desc.CreateRoutineShareLinkRequest) (*desc.RoutineShareLinkResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.routine.CreateRoutineShareLink")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	routineID, err := domain.ParseID(in.GetRoutineId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	var expiresAt time.Time
+	if in.GetExpiresAt() != nil {
+		expiresAt = in.GetExpiresAt().AsTime()
+	}
+
+	link, err := i.service.CreateRoutineShareLink(ctx, userID, routineID, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.RoutineShareLinkResponse{
+		ShareLink: mappers.RoutineShareLinkToProto(link),
+	}, nil
+}
@@ -0,0 +1,45 @@
+package routine
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) DeleteRoutineShareLink(ctx context.Context, in *desc.DeleteRoutineShareLinkRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.routine.DeleteRoutineShareLink")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	routineID, err := domain.ParseID(in.GetRoutineId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	shareLinkID, err := domain.ParseID(in.GetShareLinkId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	if err := i.service.DeleteRoutineShareLink(ctx, userID, routineID, shareLinkID); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,48 @@
+package routine
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) DiffRoutineVersions(ctx context.Context, in *desc.DiffRoutineVersionsRequest) (*desc.DiffRoutineVersionsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.routine.DiffRoutineVersions")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	routineID, err := domain.ParseID(in.GetRoutineId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	diff, err := i.service.DiffRoutineVersions(ctx, userID, routineID, int(in.GetFromVersion()), int(in.GetToVersion()))
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	units := mappers.NewUnits(preferences)
+
+	return mappers.RoutineDiffToProto(diff, units), nil
+}
@@ -0,0 +1,50 @@
+package routine
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) DuplicateRoutine(ctx context.Context, in *desc.DuplicateRoutineRequest) (*desc.RoutineResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.routine.DuplicateRoutine")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	routineID, err := domain.ParseID(in.GetRoutineId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	input := dto.DuplicateRoutineDTO{
+		Name:    utils.NewNullable(in.GetName(), in.Name != nil),
+		Version: utils.NewNullable(int(in.GetVersion()), in.Version != nil),
+	}
+
+	routine, err := i.service.DuplicateRoutine(ctx, userID, routineID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.RoutineResponse{
+		Routine: mappers.RoutineToProto(routine),
+	}, nil
+}
@@ -42,7 +42,12 @@ func (i *Implementation) GetExerciseInstanceDetails(ctx context.Context, in *des
 		return nil, err
 	}
 
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &desc.GetExerciseInstanceDetailsResponse{
-		ExerciseInstanceDetails: mappers.ExerciseInstanceDetailToProto(exerciseInstance),
+		ExerciseInstanceDetails: mappers.ExerciseInstanceDetailToProto(exerciseInstance, mappers.NewUnits(preferences)),
 	}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"fitness-trainer/internal/app/interceptors"
 	"fitness-trainer/internal/app/mappers"
 	"fitness-trainer/internal/domain"
 	desc "fitness-trainer/pkg/workouts"
@@ -29,5 +30,10 @@ func (i *Implementation) GetRoutineDetail(ctx context.Context, in *desc.GetRouti
 		return nil, err
 	}
 
-	return mappers.RoutineDetailsDTOToProto(routine), nil
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.RoutineDetailsDTOToProto(routine, mappers.NewUnits(preferences)), nil
 }
@@ -0,0 +1,43 @@
+package routine
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) GetRoutineShareLinks(ctx context.Context, in *desc.GetRoutineShareLinksRequest) (*desc.GetRoutineShareLinksResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.routine.GetRoutineShareLinks")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	routineID, err := domain.ParseID(in.GetRoutineId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	links, err := i.service.GetRoutineShareLinks(ctx, userID, routineID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetRoutineShareLinksResponse{
+		ShareLinks: mappers.RoutineShareLinksToProto(links),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package routine
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) GetRoutineTemplates(ctx context.Context, in *desc.GetRoutineTemplatesRequest) (*desc.GetRoutineTemplatesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.routine.GetRoutineTemplates")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	templates, err := i.service.GetRoutineTemplates(ctx, dto.RoutineTemplatesFilterDTO{
+		Query:   in.GetQuery(),
+		Program: in.GetProgram(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetRoutineTemplatesResponse{
+		Templates: mappers.RoutineTemplatesToProto(templates, preferences.Locale),
+	}, nil
+}
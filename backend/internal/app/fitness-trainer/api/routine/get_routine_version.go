@@ -0,0 +1,48 @@
+package routine
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) GetRoutineVersion(ctx context.Context, in *desc.GetRoutineVersionRequest) (*desc.GetRoutineVersionResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.routine.GetRoutineVersion")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	versionID, err := domain.ParseID(in.GetVersionId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	version, err := i.service.GetRoutineVersion(ctx, userID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	units := mappers.NewUnits(preferences)
+
+	return mappers.RoutineVersionSnapshotToProto(version, units), nil
+}
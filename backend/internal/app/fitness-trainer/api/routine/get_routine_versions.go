@@ -0,0 +1,43 @@
+package routine
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) GetRoutineVersions(ctx context.Context, in *desc.GetRoutineVersionsRequest) (*desc.GetRoutineVersionsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.routine.GetRoutineVersions")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	routineID, err := domain.ParseID(in.GetRoutineId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	versions, err := i.service.GetRoutineVersions(ctx, userID, routineID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetRoutineVersionsResponse{
+		Versions: mappers.RoutineVersionsToProto(versions),
+	}, nil
+}
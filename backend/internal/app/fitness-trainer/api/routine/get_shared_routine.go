@@ -0,0 +1,39 @@
+package routine
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// GetSharedRoutine is available without authentication.
+func (i *Implementation) GetSharedRoutine(ctx context.Context, in *desc.GetSharedRoutineRequest) (*desc.RoutineDetailResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.routine.GetSharedRoutine")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	routine, err := i.service.GetSharedRoutine(ctx, in.GetShareToken())
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := mappers.RoutineDetailsDTOToProto(routine, mappers.NewUnits(preferences))
+	// The author of a shared routine is not disclosed
+	response.Routine.UserId = ""
+
+	return response, nil
+}
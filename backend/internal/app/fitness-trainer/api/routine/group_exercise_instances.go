@@ -0,0 +1,58 @@
+package routine
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) GroupExerciseInstances(ctx context.Context, in *desc.GroupExerciseInstancesRequest) (*desc.GroupExerciseInstancesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.routine.GroupExerciseInstances")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	routineID, err := domain.ParseID(in.GetRoutineId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	ids := make([]domain.ID, 0, len(in.GetExerciseInstanceIds()))
+	for _, id := range in.GetExerciseInstanceIds() {
+		parsedID, err := domain.ParseID(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+		}
+
+		ids = append(ids, parsedID)
+	}
+
+	exerciseInstances, err := i.service.GroupExerciseInstances(ctx, userID, routineID, dto.GroupExercisesDTO{
+		IDs:    ids,
+		Type:   mappers.ExerciseGroupTypeFromProto(in.GetType()),
+		Rounds: int(in.GetRounds()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GroupExerciseInstancesResponse{
+		ExerciseInstances: mappers.ExerciseInstancesToProto(exerciseInstances),
+	}, nil
+}
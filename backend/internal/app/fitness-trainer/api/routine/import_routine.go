@@ -0,0 +1,38 @@
+package routine
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) ImportRoutine(ctx context.Context, in *desc.ImportRoutineRequest) (*desc.RoutineResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.routine.ImportRoutine")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	routine, err := i.service.ImportRoutine(ctx, userID, in.GetShareToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.RoutineResponse{
+		Routine: mappers.RoutineToProto(routine),
+	}, nil
+}
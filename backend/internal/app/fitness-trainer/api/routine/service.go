@@ -2,9 +2,11 @@ package routine
 
 import (
 	"context"
+	"time"
 
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/utils"
 	desc "fitness-trainer/pkg/workouts"
 )
 
@@ -15,13 +17,29 @@ type Service interface {
 	UpdateRoutine(ctx context.Context, id domain.ID, dto dto.UpdateRoutineDTO) (domain.Routine, error)
 	DeleteRoutine(ctx context.Context, id domain.ID) error
 
-	AddExerciseToRoutine(ctx context.Context, routineID domain.ID, exerciseID domain.ID) (domain.ExerciseInstance, error)
+	AddExerciseToRoutine(ctx context.Context, userID, routineID, exerciseID domain.ID) (domain.ExerciseInstance, error)
 	GetExerciseInstance(ctx context.Context, userID, routineID, exerciseInstanceID domain.ID) (dto.ExerciseInstanceDetailsDTO, error)
 	RemoveExerciseInstanceFromRoutine(ctx context.Context, userID, routineID, exerciseInstanceID domain.ID) error
 	SetExerciseOrder(ctx context.Context, userID, routineID domain.ID, exerciseInstanceIDs []domain.ID) error
+	GroupExerciseInstances(ctx context.Context, userID, routineID domain.ID, input dto.GroupExercisesDTO) ([]domain.ExerciseInstance, error)
+	UngroupExerciseInstances(ctx context.Context, userID, routineID, groupID domain.ID) error
+	DuplicateRoutine(ctx context.Context, userID, routineID domain.ID, input dto.DuplicateRoutineDTO) (domain.Routine, error)
+	GetRoutineVersions(ctx context.Context, userID, routineID domain.ID) ([]domain.RoutineVersion, error)
+	GetRoutineVersion(ctx context.Context, userID, versionID domain.ID) (domain.RoutineVersion, error)
+	DiffRoutineVersions(ctx context.Context, userID, routineID domain.ID, fromVersion, toVersion int) (domain.RoutineDiff, error)
+
+	CreateRoutineShareLink(ctx context.Context, userID, routineID domain.ID, expiresAt time.Time) (domain.RoutineShareLink, error)
+	GetRoutineShareLinks(ctx context.Context, userID, routineID domain.ID) ([]domain.RoutineShareLink, error)
+	DeleteRoutineShareLink(ctx context.Context, userID, routineID, shareLinkID domain.ID) error
+	GetSharedRoutine(ctx context.Context, token string) (dto.RoutineDetailsDTO, error)
+	ImportRoutine(ctx context.Context, userID domain.ID, token string) (domain.Routine, error)
+
+	GetRoutineTemplates(ctx context.Context, filter dto.RoutineTemplatesFilterDTO) ([]domain.Routine, error)
+	CloneRoutineTemplate(ctx context.Context, userID, templateID domain.ID, name utils.Nullable[string]) (domain.Routine, error)
 
 	AddSetToExerciseInstance(ctx context.Context, userID, routineID, exerciseInstanceID domain.ID, dto dto.CreateSetDTO) (domain.Set, error)
 	RemoveSetFromExerciseInstance(ctx context.Context, userID, routineID, exerciseInstanceID, setID domain.ID) error
+	SetSetOrder(ctx context.Context, userID, routineID, exerciseInstanceID domain.ID, setIDs []domain.ID) error
 	UpdateSetInExerciseInstance(ctx context.Context, userID, routineID, exerciseInstanceID, setID domain.ID, dto dto.UpdateSetDTO) (domain.Set, error)
 }
 
@@ -30,6 +30,7 @@ func (i *Implementation) UpdateRoutine(ctx context.Context, in *desc.UpdateRouti
 	{
 		updateDTO.Name = utils.NewNullable(in.GetName(), in.Name != nil)
 		updateDTO.Description = utils.NewNullable(in.GetDescription(), in.Description != nil)
+		updateDTO.WarmupSteps = utils.NewNullable(mappers.WarmupSchemeFromProto(in.GetWarmupScheme()), in.WarmupScheme != nil)
 	}
 
 	routine, err := i.service.UpdateRoutine(ctx, routineID, updateDTO)
@@ -43,11 +43,19 @@ func (i *Implementation) UpdateSetInExerciseInstance(ctx context.Context, in *de
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
 	}
 
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	units := mappers.NewUnits(preferences)
+
 	var updateDTO dto.UpdateSetDTO
 	{
 		updateDTO.Reps = utils.NewNullable(int(in.GetReps()), in.Reps != nil)
-		updateDTO.Weight = utils.NewNullable(in.GetWeight(), in.Weight != nil)
+		updateDTO.Weight = utils.NewNullable(units.WeightFromProto(in.GetWeight()), in.Weight != nil)
 		updateDTO.Time = utils.NewNullable(in.GetTime().AsDuration(), in.Time != nil)
+		updateDTO.Rest = utils.NewNullable(in.GetRest().AsDuration(), in.Rest != nil)
 	}
 
 	set, err := i.service.UpdateSetInExerciseInstance(ctx, userID, routineID, exerciseInstanceID, setID, updateDTO)
@@ -56,6 +64,6 @@ func (i *Implementation) UpdateSetInExerciseInstance(ctx context.Context, in *de
 	}
 
 	return &desc.SetResponse{
-		Set: mappers.SetToProto(set),
+		Set: mappers.SetToProto(set, units),
 	}, nil
 }
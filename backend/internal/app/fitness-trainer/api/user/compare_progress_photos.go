@@ -0,0 +1,38 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) CompareProgressPhotos(ctx context.Context, in *desc.CompareProgressPhotosRequest) (*desc.CompareProgressPhotosResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.user.CompareProgressPhotos")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	comparisons, err := i.service.CompareProgressPhotos(ctx, userID, in.GetBefore().AsTime(), in.GetAfter().AsTime(), in.GetIncludePrivate())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.CompareProgressPhotosResponse{
+		Comparisons: mappers.ProgressPhotoComparisonsToProto(comparisons),
+	}, nil
+}
@@ -47,6 +47,6 @@ func (i *Implementation) CreateUser(ctx context.Context, in *desc.CreateUserRequ
 	}
 
 	return &desc.UserResponse{
-		User: mappers.UserToProto(user),
+		User: mappers.UserToProto(user, mappers.MetricUnits),
 	}, nil
 }
@@ -0,0 +1,54 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) CreateGymProfile(ctx context.Context, in *desc.CreateGymProfileRequest) (*desc.GymProfileResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.user.CreateGymProfile")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	units := mappers.NewUnits(preferences)
+
+	var input dto.CreateGymProfileDTO
+	{
+		input.Name = in.GetName()
+		input.BarWeight = units.WeightFromProto(in.GetBarWeight())
+		input.Plates = mappers.PlateInventoriesFromProto(in.GetPlates(), units)
+		input.IsDefault = in.GetIsDefault()
+	}
+
+	profile, err := i.service.CreateGymProfile(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GymProfileResponse{
+		GymProfile: mappers.GymProfileToProto(profile, units),
+	}, nil
+}
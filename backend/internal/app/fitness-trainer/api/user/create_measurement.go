@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) CreateMeasurement(ctx context.Context, in *desc.CreateMeasurementRequest) (*desc.MeasurementResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.user.CreateMeasurement")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	input := dto.CreateMeasurementDTO{
+		Kind:  mappers.MeasurementKindFromProto(in.GetKind()),
+		Value: in.GetValue(),
+		Unit:  mappers.MeasurementUnitFromProto(in.GetUnit()),
+		Note:  in.GetNote(),
+	}
+	if in.MeasuredAt != nil {
+		input.MeasuredAt = in.GetMeasuredAt().AsTime()
+	}
+
+	measurement, err := i.service.CreateMeasurement(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.MeasurementResponse{
+		Measurement: mappers.MeasurementToProto(measurement),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) CreateProgressPhoto(ctx context.Context, in *desc.CreateProgressPhotoRequest) (*desc.ProgressPhotoResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.user.CreateProgressPhoto")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	fileID, err := domain.ParseID(in.GetFileId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	input := dto.CreateProgressPhotoDTO{
+		FileID: fileID,
+		Pose:   mappers.ProgressPhotoPoseFromProto(in.GetPose()),
+		// Photos are private unless the user decides otherwise
+		IsPrivate: in.IsPrivate == nil || in.GetIsPrivate(),
+		Note:      in.GetNote(),
+	}
+	{
+		if in.TakenAt != nil {
+			input.TakenAt = in.GetTakenAt().AsTime()
+		}
+
+		if in.MeasurementId != nil {
+			measurementID, err := domain.ParseID(in.GetMeasurementId())
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+			}
+			input.MeasurementID = utils.NewNullable(measurementID, true)
+		}
+	}
+
+	photo, err := i.service.CreateProgressPhoto(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ProgressPhotoResponse{
+		Photo: mappers.ProgressPhotoToProto(photo),
+	}, nil
+}
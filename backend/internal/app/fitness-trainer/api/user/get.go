@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"fitness-trainer/internal/app/interceptors"
 	"fitness-trainer/internal/app/mappers"
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/logger"
@@ -35,7 +36,12 @@ func (i *Implementation) GetUser(ctx context.Context, in *desc.GetUserRequest) (
 		return nil, err
 	}
 
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &desc.UserResponse{
-		User: mappers.UserToProto(user),
+		User: mappers.UserToProto(user, mappers.NewUnits(preferences)),
 	}, nil
 }
@@ -0,0 +1,43 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) GetDataExport(ctx context.Context, in *desc.GetDataExportRequest) (*desc.DataExportResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.user.GetDataExport")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	exportID, err := domain.ParseID(in.GetExportId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	export, err := i.service.GetDataExport(ctx, userID, exportID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.DataExportResponse{
+		Export: mappers.DataExportToProto(export),
+	}, nil
+}
@@ -0,0 +1,39 @@
+package user
+
+import (
+	"context"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) GetGymProfiles(ctx context.Context, _ *emptypb.Empty) (*desc.GetGymProfilesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.user.GetGymProfiles")
+	defer span.Finish()
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	profiles, err := i.service.GetGymProfiles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetGymProfilesResponse{
+		GymProfiles: mappers.GymProfilesToProto(profiles, mappers.NewUnits(preferences)),
+	}, nil
+}
@@ -0,0 +1,60 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) GetMeasurementTrend(ctx context.Context, in *desc.GetMeasurementTrendRequest) (*desc.GetMeasurementTrendResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.user.GetMeasurementTrend")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	var from, to time.Time
+	{
+		if in.From != nil {
+			from = in.GetFrom().AsTime()
+		}
+
+		if in.To != nil {
+			to = in.GetTo().AsTime()
+		}
+	}
+
+	trend, err := i.service.GetMeasurementTrend(
+		ctx,
+		userID,
+		mappers.MeasurementKindFromProto(in.GetKind()),
+		from,
+		to,
+		int(in.GetWindowDays()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.MeasurementTrendToProto(trend, mappers.NewUnits(preferences)), nil
+}
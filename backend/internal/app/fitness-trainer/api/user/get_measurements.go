@@ -0,0 +1,62 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) GetMeasurements(ctx context.Context, in *desc.GetMeasurementsRequest) (*desc.GetMeasurementsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.user.GetMeasurements")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	filter := dto.GetMeasurementsDTO{
+		UserID: userID,
+		Kind:   mappers.MeasurementKindFromProto(in.GetKind()),
+		Limit:  100,
+	}
+	{
+		if in.From != nil {
+			filter.From = in.GetFrom().AsTime()
+		}
+
+		if in.To != nil {
+			filter.To = in.GetTo().AsTime()
+		}
+
+		if in.Limit > 0 {
+			filter.Limit = int(in.GetLimit())
+		}
+
+		if in.Offset > 0 {
+			filter.Offset = int(in.GetOffset())
+		}
+	}
+
+	measurements, err := i.service.GetMeasurements(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetMeasurementsResponse{
+		Measurements: mappers.MeasurementsToProto(measurements),
+	}, nil
+}
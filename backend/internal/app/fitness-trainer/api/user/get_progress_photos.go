@@ -0,0 +1,62 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) GetProgressPhotos(ctx context.Context, in *desc.GetProgressPhotosRequest) (*desc.GetProgressPhotosResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.user.GetProgressPhotos")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	filter := dto.GetProgressPhotosDTO{
+		UserID: userID,
+		Pose:   mappers.ProgressPhotoPoseFromProto(in.GetPose()),
+		Limit:  20,
+	}
+	{
+		if in.From != nil {
+			filter.From = in.GetFrom().AsTime()
+		}
+
+		if in.To != nil {
+			filter.To = in.GetTo().AsTime()
+		}
+
+		if in.Limit > 0 {
+			filter.Limit = int(in.GetLimit())
+		}
+
+		if in.Offset > 0 {
+			filter.Offset = int(in.GetOffset())
+		}
+	}
+
+	photos, err := i.service.GetProgressPhotos(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetProgressPhotosResponse{
+		Photos: mappers.ProgressPhotosToProto(photos),
+	}, nil
+}
@@ -0,0 +1,34 @@
+package user
+
+import (
+	"context"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) GetUserPreferences(ctx context.Context, _ *emptypb.Empty) (*desc.UserPreferencesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.user.GetUserPreferences")
+	defer span.Finish()
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	preferences, err := i.service.GetUserPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.UserPreferencesResponse{
+		Preferences: mappers.UserPreferencesToProto(preferences),
+	}, nil
+}
@@ -26,7 +26,12 @@ func (i *Implementation) GetMe(ctx context.Context, _ *emptypb.Empty) (*desc.Use
 		return nil, err
 	}
 
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &desc.UserResponse{
-		User: mappers.UserToProto(user),
+		User: mappers.UserToProto(user, mappers.NewUnits(preferences)),
 	}, nil
 }
@@ -2,6 +2,8 @@ package user
 
 import (
 	"context"
+	"time"
+
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/domain/dto"
 	desc "fitness-trainer/pkg/workouts"
@@ -14,6 +16,30 @@ type Service interface {
 
 	GetGenerationSettings(ctx context.Context, userID domain.ID) (domain.GenerationSettings, error)
 	SaveGenerationSettings(ctx context.Context, userID domain.ID, createDTO dto.CreateGenerationSettings) (domain.GenerationSettings, error)
+
+	CreateMeasurement(ctx context.Context, userID domain.ID, input dto.CreateMeasurementDTO) (domain.Measurement, error)
+	GetMeasurements(ctx context.Context, filter dto.GetMeasurementsDTO) ([]domain.Measurement, error)
+	UpdateMeasurement(ctx context.Context, userID, measurementID domain.ID, input dto.UpdateMeasurementDTO) (domain.Measurement, error)
+	DeleteMeasurement(ctx context.Context, userID, measurementID domain.ID) error
+	GetMeasurementTrend(ctx context.Context, userID domain.ID, kind domain.MeasurementKind, from, to time.Time, windowDays int) (dto.MeasurementTrendDTO, error)
+
+	CreateProgressPhoto(ctx context.Context, userID domain.ID, input dto.CreateProgressPhotoDTO) (domain.ProgressPhoto, error)
+	GetProgressPhotos(ctx context.Context, filter dto.GetProgressPhotosDTO) ([]domain.ProgressPhoto, error)
+	UpdateProgressPhoto(ctx context.Context, userID, photoID domain.ID, input dto.UpdateProgressPhotoDTO) (domain.ProgressPhoto, error)
+	DeleteProgressPhoto(ctx context.Context, userID, photoID domain.ID) error
+	CompareProgressPhotos(ctx context.Context, userID domain.ID, before, after time.Time, includePrivate bool) ([]dto.ProgressPhotoComparisonDTO, error)
+
+	CreateGymProfile(ctx context.Context, userID domain.ID, input dto.CreateGymProfileDTO) (domain.GymProfile, error)
+	GetGymProfiles(ctx context.Context, userID domain.ID) ([]domain.GymProfile, error)
+	UpdateGymProfile(ctx context.Context, userID, profileID domain.ID, input dto.UpdateGymProfileDTO) (domain.GymProfile, error)
+	DeleteGymProfile(ctx context.Context, userID, profileID domain.ID) error
+
+	GetUserPreferences(ctx context.Context, userID domain.ID) (domain.UserPreferences, error)
+	UpdateUserPreferences(ctx context.Context, userID domain.ID, input dto.UpdateUserPreferencesDTO) (domain.UserPreferences, error)
+
+	RequestDataExport(ctx context.Context, userID domain.ID) (dto.DataExportDTO, error)
+	GetDataExports(ctx context.Context, userID domain.ID) ([]dto.DataExportDTO, error)
+	GetDataExport(ctx context.Context, userID, exportID domain.ID) (dto.DataExportDTO, error)
 }
 
 type Implementation struct {
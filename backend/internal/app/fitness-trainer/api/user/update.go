@@ -30,6 +30,13 @@ func (i *Implementation) UpdateUser(ctx context.Context, in *desc.UpdateUserRequ
 		return nil, domain.ErrUnauthorized
 	}
 
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	units := mappers.NewUnits(preferences)
+
 	var input dto.UpdateUserDTO
 	{
 		input.FirstName = utils.NewNullable(in.GetFirstName(), in.GetFirstName() != "")
@@ -37,10 +44,18 @@ func (i *Implementation) UpdateUser(ctx context.Context, in *desc.UpdateUserRequ
 
 		input.DateOfBirth = in.GetDateOfBirth().AsTime()
 
-		input.Height = utils.NewNullable(in.GetHeight(), in.GetHeight() != 0)
-		input.Weight = utils.NewNullable(in.GetWeight(), in.GetWeight() != 0)
+		input.Height = utils.NewNullable(units.LengthFromProto(in.GetHeight()), in.GetHeight() != 0)
+		input.Weight = utils.NewNullable(units.WeightFromProto(in.GetWeight()), in.GetWeight() != 0)
 
 		input.ProfilePicURL = utils.NewNullable(in.GetProfilePictureUrl(), in.ProfilePictureUrl != nil)
+
+		if in.ProfilePictureFileId != nil {
+			fileID, err := domain.ParseID(in.GetProfilePictureFileId())
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+			}
+			input.ProfilePictureFileID = utils.NewNullable(fileID, true)
+		}
 	}
 
 	user, err := i.service.UpdateUser(ctx, id, input)
@@ -49,6 +64,6 @@ func (i *Implementation) UpdateUser(ctx context.Context, in *desc.UpdateUserRequ
 	}
 
 	return &desc.UserResponse{
-		User: mappers.UserToProto(user),
+		User: mappers.UserToProto(user, units),
 	}, nil
 }
@@ -0,0 +1,60 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) UpdateGymProfile(ctx context.Context, in *desc.UpdateGymProfileRequest) (*desc.GymProfileResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.user.UpdateGymProfile")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	profileID, err := domain.ParseID(in.GetGymProfileId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	units := mappers.NewUnits(preferences)
+
+	var input dto.UpdateGymProfileDTO
+	{
+		input.Name = utils.NewNullable(in.GetName(), in.Name != nil)
+		input.BarWeight = utils.NewNullable(units.WeightFromProto(in.GetBarWeight()), in.BarWeight != nil)
+		input.Plates = utils.NewNullable(mappers.PlateInventoriesFromProto(in.GetPlates(), units), in.GetUpdatePlates())
+		input.IsDefault = in.GetIsDefault()
+	}
+
+	profile, err := i.service.UpdateGymProfile(ctx, userID, profileID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GymProfileResponse{
+		GymProfile: mappers.GymProfileToProto(profile, units),
+	}, nil
+}
@@ -0,0 +1,53 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) UpdateMeasurement(ctx context.Context, in *desc.UpdateMeasurementRequest) (*desc.MeasurementResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.user.UpdateMeasurement")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	measurementID, err := domain.ParseID(in.GetMeasurementId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	var input dto.UpdateMeasurementDTO
+	{
+		input.Value = utils.NewNullable(in.GetValue(), in.Value != nil)
+		input.Unit = utils.NewNullable(mappers.MeasurementUnitFromProto(in.GetUnit()), in.Unit != nil)
+		input.MeasuredAt = utils.NewNullable(in.GetMeasuredAt().AsTime(), in.MeasuredAt != nil)
+		input.Note = utils.NewNullable(in.GetNote(), in.Note != nil)
+	}
+
+	measurement, err := i.service.UpdateMeasurement(ctx, userID, measurementID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.MeasurementResponse{
+		Measurement: mappers.MeasurementToProto(measurement),
+	}, nil
+}
@@ -0,0 +1,64 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) UpdateProgressPhoto(ctx context.Context, in *desc.UpdateProgressPhotoRequest) (*desc.ProgressPhotoResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.user.UpdateProgressPhoto")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	photoID, err := domain.ParseID(in.GetPhotoId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	var input dto.UpdateProgressPhotoDTO
+	{
+		input.Pose = utils.NewNullable(mappers.ProgressPhotoPoseFromProto(in.GetPose()), in.Pose != nil)
+		input.TakenAt = utils.NewNullable(in.GetTakenAt().AsTime(), in.TakenAt != nil)
+		input.IsPrivate = utils.NewNullable(in.GetIsPrivate(), in.IsPrivate != nil)
+		input.Note = utils.NewNullable(in.GetNote(), in.Note != nil)
+
+		if in.MeasurementId != nil {
+			var measurementID domain.ID
+			if in.GetMeasurementId() != "" {
+				measurementID, err = domain.ParseID(in.GetMeasurementId())
+				if err != nil {
+					return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+				}
+			}
+			input.MeasurementID = utils.NewNullable(measurementID, true)
+		}
+	}
+
+	photo, err := i.service.UpdateProgressPhoto(ctx, userID, photoID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ProgressPhotoResponse{
+		Photo: mappers.ProgressPhotoToProto(photo),
+	}, nil
+}
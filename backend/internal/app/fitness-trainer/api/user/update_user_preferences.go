@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) UpdateUserPreferences(ctx context.Context, in *desc.UpdateUserPreferencesRequest) (*desc.UserPreferencesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.user.UpdateUserPreferences")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	var input dto.UpdateUserPreferencesDTO
+	{
+		input.WeightUnit = utils.NewNullable(mappers.MeasurementUnitFromProto(in.GetWeightUnit()), in.WeightUnit != nil)
+		input.LengthUnit = utils.NewNullable(mappers.MeasurementUnitFromProto(in.GetLengthUnit()), in.LengthUnit != nil)
+		input.Locale = utils.NewNullable(in.GetLocale(), in.Locale != nil)
+		input.FirstDayOfWeek = utils.NewNullable(mappers.DayOfWeekFromProto(in.GetFirstDayOfWeek()), in.FirstDayOfWeek != nil)
+		input.Timezone = utils.NewNullable(in.GetTimezone(), in.Timezone != nil)
+	}
+
+	preferences, err := i.service.UpdateUserPreferences(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.UserPreferencesResponse{
+		Preferences: mappers.UserPreferencesToProto(preferences),
+	}, nil
+}
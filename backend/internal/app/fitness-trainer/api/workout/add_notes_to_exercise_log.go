@@ -38,7 +38,7 @@ func (i *Implementation) AddNotesToExerciseLog(ctx context.Context, in *desc.Add
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
 	}
 
-	err = i.service.AddNotesToExerciseLog(ctx, userID, workoutID, exerciseLogID, in.GetNotes())
+	err = i.service.AddNotesToExerciseLog(ctx, userID, workoutID, exerciseLogID, in.GetNotes(), in.GetAmend())
 	if err != nil {
 		return nil, err
 	}
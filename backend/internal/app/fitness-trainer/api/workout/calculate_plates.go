@@ -0,0 +1,59 @@
+package workout
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) CalculatePlates(ctx context.Context, in *desc.CalculatePlatesRequest) (*desc.PlateLoadout, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.workout.CalculatePlates")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	units := mappers.NewUnits(preferences)
+
+	var input dto.CalculatePlatesDTO
+	{
+		input.TargetWeight = units.WeightFromProto(in.GetTargetWeight())
+		input.BarWeight = utils.NewNullable(units.WeightFromProto(in.GetBarWeight()), in.BarWeight != nil)
+
+		if in.GymProfileId != nil {
+			profileID, err := domain.ParseID(in.GetGymProfileId())
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+			}
+			input.GymProfileID = utils.NewNullable(profileID, true)
+		}
+	}
+
+	loadout, err := i.service.CalculatePlates(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.PlateLoadoutToProto(loadout, units), nil
+}
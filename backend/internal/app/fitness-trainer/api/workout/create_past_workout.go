@@ -0,0 +1,78 @@
+package workout
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) CreatePastWorkout(ctx context.Context, in *desc.CreatePastWorkoutRequest) (*desc.GetWorkoutResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.workout.CreatePastWorkout")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	units := mappers.NewUnits(preferences)
+
+	input := dto.CreatePastWorkoutDTO{
+		StartedAt:  in.GetStartedAt().AsTime(),
+		FinishedAt: in.GetFinishedAt().AsTime(),
+		Notes:      in.GetNotes(),
+		Rating:     int(in.GetRating()),
+		Exercises:  make([]dto.PastExerciseLogDTO, 0, len(in.GetExercises())),
+	}
+	for _, exercise := range in.GetExercises() {
+		exerciseID, err := domain.ParseID(exercise.GetExerciseId())
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+		}
+
+		exerciseLog := dto.PastExerciseLogDTO{
+			ExerciseID: exerciseID,
+			Notes:      exercise.GetNotes(),
+			Sets:       make([]dto.PastSetLogDTO, 0, len(exercise.GetSets())),
+		}
+		for _, set := range exercise.GetSets() {
+			exerciseLog.Sets = append(exerciseLog.Sets, dto.PastSetLogDTO{
+				Reps:        int(set.GetReps()),
+				Weight:      units.WeightFromProto(set.GetWeight()),
+				Time:        set.GetTime().AsDuration(),
+				CompletedAt: utils.NewNullable(set.GetCompletedAt().AsTime(), set.CompletedAt != nil),
+			})
+		}
+
+		input.Exercises = append(input.Exercises, exerciseLog)
+	}
+
+	workout, err := i.service.CreatePastWorkout(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetWorkoutResponse{
+		Workout:      mappers.WorkoutToProto(workout.Workout),
+		ExerciseLogs: mappers.ExerciseLogDTOsToProto(workout.ExerciseLogs, units),
+	}, nil
+}
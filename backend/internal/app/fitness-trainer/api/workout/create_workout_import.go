@@ -0,0 +1,46 @@
+package workout
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) CreateWorkoutImport(ctx context.Context, in *desc.CreateWorkoutImportRequest) (*desc.WorkoutImportResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.workout.CreateWorkoutImport")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	fileID, err := domain.ParseID(in.GetFileId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	weightUnit := utils.NewNullable(mappers.MeasurementUnitFromProto(in.GetWeightUnit()), in.WeightUnit != nil)
+
+	workoutImport, err := i.service.CreateWorkoutImport(ctx, userID, mappers.WorkoutImportSourceFromProto(in.GetSource()), fileID, weightUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.WorkoutImportResponse{
+		Import: mappers.WorkoutImportToProto(workoutImport),
+	}, nil
+}
@@ -42,7 +42,7 @@ func (i *Implementation) DeleteSetLog(ctx context.Context, in *desc.DeleteSetLog
 		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
 	}
 
-	if err := i.service.DeleteSetLog(ctx, userID, workoutID, exerciseLogID, setLogID); err != nil {
+	if err := i.service.DeleteSetLog(ctx, userID, workoutID, exerciseLogID, setLogID, in.GetAmend()); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,12 @@ func (s *Implementation) GetExerciseLogDetails(ctx context.Context, in *desc.Get
 		return nil, err
 	}
 
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &desc.ExerciseLogResponse{
-		ExerciseLogDetails: mappers.ExerciseLogDTOToProto(exerciseLog),
+		ExerciseLogDetails: mappers.ExerciseLogDTOToProto(exerciseLog, mappers.NewUnits(preferences)),
 	}, nil
 }
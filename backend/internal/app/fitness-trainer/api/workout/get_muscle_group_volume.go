@@ -0,0 +1,54 @@
+package workout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+const defaultMuscleGroupVolumePeriod = 7 * 24 * time.Hour
+
+func (i *Implementation) GetMuscleGroupVolume(ctx context.Context, in *desc.GetMuscleGroupVolumeRequest) (*desc.GetMuscleGroupVolumeResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.workout.GetMuscleGroupVolume")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	var from, to time.Time
+	{
+		to = time.Now()
+		if in.To != nil {
+			to = in.GetTo().AsTime()
+		}
+
+		from = to.Add(-defaultMuscleGroupVolumePeriod)
+		if in.From != nil {
+			from = in.GetFrom().AsTime()
+		}
+	}
+
+	volume, err := i.service.GetMuscleGroupVolume(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetMuscleGroupVolumeResponse{
+		MuscleGroups: mappers.MuscleGroupVolumeDTOsToProto(volume),
+	}, nil
+}
@@ -37,8 +37,13 @@ func (i *Implementation) GetWorkout(ctx context.Context, in *desc.GetWorkoutRequ
 		return nil, err
 	}
 
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &desc.GetWorkoutResponse{
 		Workout:      mappers.WorkoutToProto(workouDTO.Workout),
-		ExerciseLogs: mappers.ExerciseLogDTOsToProto(workouDTO.ExerciseLogs),
+		ExerciseLogs: mappers.ExerciseLogDTOsToProto(workouDTO.ExerciseLogs, mappers.NewUnits(preferences)),
 	}, nil
 }
@@ -0,0 +1,60 @@
+package workout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) GetWorkoutCalendar(ctx context.Context, in *desc.GetWorkoutCalendarRequest) (*desc.GetWorkoutCalendarResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.workout.GetWorkoutCalendar")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var from, to time.Time
+	{
+		// The current month of the user by default
+		now := time.Now().In(preferences.Location())
+		from = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		if in.From != nil {
+			from = in.GetFrom().AsTime()
+		}
+
+		to = from.AddDate(0, 1, 0)
+		if in.To != nil {
+			to = in.GetTo().AsTime()
+		}
+	}
+
+	days, err := i.service.GetWorkoutCalendar(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetWorkoutCalendarResponse{
+		Days:     mappers.WorkoutCalendarDaysToProto(days),
+		Timezone: preferences.Timezone,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package workout
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) GetWorkoutImport(ctx context.Context, in *desc.GetWorkoutImportRequest) (*desc.WorkoutImportResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.workout.GetWorkoutImport")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	importID, err := domain.ParseID(in.GetImportId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	workoutImport, err := i.service.GetWorkoutImport(ctx, userID, importID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.WorkoutImportResponse{
+		Import: mappers.WorkoutImportToProto(workoutImport),
+	}, nil
+}
@@ -0,0 +1,34 @@
+package workout
+
+import (
+	"context"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) GetWorkoutImports(ctx context.Context, _ *emptypb.Empty) (*desc.GetWorkoutImportsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.workout.GetWorkoutImports")
+	defer span.Finish()
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	imports, err := i.service.GetWorkoutImports(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetWorkoutImportsResponse{
+		Imports: mappers.WorkoutImportsToProto(imports),
+	}, nil
+}
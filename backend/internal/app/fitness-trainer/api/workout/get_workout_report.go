@@ -2,21 +2,45 @@ package workout
 
 import (
 	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
 	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
 	desc "fitness-trainer/pkg/workouts"
-	"fmt"
 
 	"github.com/opentracing/opentracing-go"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func (i *Implementation) GetWorkoutReport(ctx context.Context, in *desc.GetWorkoutReportRequest) (*desc.WorkoutReportResponse, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "api.workout.GetWorkoutReport")
 	defer span.Finish()
-	
+
 	if err := in.Validate(); err != nil {
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
 	}
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	workoutID, err := domain.ParseID(in.GetWorkoutId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	report, err := i.service.GetWorkoutReport(ctx, userID, workoutID)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.WorkoutReportToProto(report, mappers.NewUnits(preferences)), nil
 }
@@ -0,0 +1,41 @@
+package workout
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) GetWorkoutRestAnalytics(ctx context.Context, in *desc.GetWorkoutRestAnalyticsRequest) (*desc.GetWorkoutRestAnalyticsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.workout.GetWorkoutRestAnalytics")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	workoutID, err := domain.ParseID(in.GetWorkoutId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	analytics, err := i.service.GetWorkoutRestAnalytics(ctx, userID, workoutID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.RestAnalyticsToProto(analytics), nil
+}
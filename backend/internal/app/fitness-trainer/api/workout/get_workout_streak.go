@@ -0,0 +1,32 @@
+package workout
+
+import (
+	"context"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) GetWorkoutStreak(ctx context.Context, _ *emptypb.Empty) (*desc.GetWorkoutStreakResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.workout.GetWorkoutStreak")
+	defer span.Finish()
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	streak, err := i.service.GetWorkoutStreak(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.WorkoutStreakToProto(streak), nil
+}
@@ -0,0 +1,58 @@
+package workout
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) GroupExerciseLogs(ctx context.Context, in *desc.GroupExerciseLogsRequest) (*desc.GroupExerciseLogsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.workout.GroupExerciseLogs")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	workoutID, err := domain.ParseID(in.GetWorkoutId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	ids := make([]domain.ID, 0, len(in.GetExerciseLogIds()))
+	for _, id := range in.GetExerciseLogIds() {
+		parsedID, err := domain.ParseID(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+		}
+
+		ids = append(ids, parsedID)
+	}
+
+	exerciseLogs, err := i.service.GroupExerciseLogs(ctx, userID, workoutID, dto.GroupExercisesDTO{
+		IDs:    ids,
+		Type:   mappers.ExerciseGroupTypeFromProto(in.GetType()),
+		Rounds: int(in.GetRounds()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GroupExerciseLogsResponse{
+		ExerciseLogs: mappers.ExerciseLogsToProto(exerciseLogs),
+	}, nil
+}
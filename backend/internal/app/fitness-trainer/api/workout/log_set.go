@@ -7,6 +7,7 @@ import (
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/domain/dto"
 	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
 	desc "fitness-trainer/pkg/workouts"
 	"fmt"
 
@@ -37,10 +38,20 @@ func (i *Implementation) LogSet(ctx context.Context, in *desc.LogSetRequest) (*d
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
 	}
 
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	units := mappers.NewUnits(preferences)
+
 	var setLogDTO dto.CreateSetLogDTO
 	{
 		setLogDTO.Reps = int(in.Reps)
-		setLogDTO.Weight = in.Weight
+		setLogDTO.Weight = units.WeightFromProto(in.Weight)
+		setLogDTO.StartedAt = utils.NewNullable(in.GetStartedAt().AsTime(), in.StartedAt != nil)
+		setLogDTO.Position = utils.NewNullable(int(in.GetPosition()), in.Position != nil)
+		setLogDTO.Amend = in.GetAmend()
 	}
 
 	setLog, err := i.service.LogSet(ctx, userID, workoutID, exerciseLogID, setLogDTO)
@@ -49,6 +60,6 @@ func (i *Implementation) LogSet(ctx context.Context, in *desc.LogSetRequest) (*d
 	}
 
 	return &desc.SetLogResponse{
-		SetLog: mappers.SetLogToProto(setLog),
+		SetLog: mappers.SetLogToProto(setLog, units),
 	}, nil
 }
@@ -0,0 +1,58 @@
+package workout
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/app/interceptors"
+	"fitness-trainer/internal/app/mappers"
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+	desc "fitness-trainer/pkg/workouts"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (i *Implementation) ResolveWorkoutImport(ctx context.Context, in *desc.ResolveWorkoutImportRequest) (*desc.WorkoutImportResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.workout.ResolveWorkoutImport")
+	defer span.Finish()
+
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	userID, ok := interceptors.GetUserID(ctx)
+	if !ok {
+		logger.Errorf("user id not found in context")
+		return nil, domain.ErrInternal
+	}
+
+	importID, err := domain.ParseID(in.GetImportId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	mappings := make([]domain.ImportExerciseMapping, 0, len(in.GetMappings()))
+	for _, mapping := range in.GetMappings() {
+		item := domain.ImportExerciseMapping{Name: mapping.GetName(), Skip: mapping.GetSkip()}
+		if mapping.ExerciseId != nil {
+			exerciseID, err := domain.ParseID(mapping.GetExerciseId())
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+			}
+			item.ExerciseID = utils.NewNullable(exerciseID, true)
+		}
+
+		mappings = append(mappings, item)
+	}
+
+	workoutImport, err := i.service.ResolveWorkoutImport(ctx, userID, importID, mappings, in.GetConfirm())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.WorkoutImportResponse{
+		Import: mappers.WorkoutImportToProto(workoutImport),
+	}, nil
+}
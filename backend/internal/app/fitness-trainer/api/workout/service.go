@@ -2,9 +2,11 @@ package workout
 
 import (
 	"context"
+	"time"
 
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/utils"
 
 	desc "fitness-trainer/pkg/workouts"
 )
@@ -12,21 +14,38 @@ import (
 type Service interface {
 	GetWorkouts(ctx context.Context, userID domain.ID, limit, offset int) ([]dto.WorkoutDTO, error)
 	StartWorkout(ctx context.Context, userID domain.ID, opts domain.StartWorkoutOpts) (domain.Workout, error)
+	CreatePastWorkout(ctx context.Context, userID domain.ID, input dto.CreatePastWorkoutDTO) (dto.WorkoutDetailsDTO, error)
 	GetWorkout(ctx context.Context, userID, workoutID domain.ID) (dto.WorkoutDetailsDTO, error)
 	DeleteWorkout(ctx context.Context, userID, workoutID domain.ID) error
 	GetActiveWorkouts(ctx context.Context, userID domain.ID) ([]domain.Workout, error)
 	CompleteWorkout(ctx context.Context, userID, workoutID domain.ID) error
 	RateWorkout(ctx context.Context, userID, workoutID domain.ID, rating int) (domain.Workout, error)
 	AddCommentToWorkout(ctx context.Context, userID, workoutID domain.ID, comment string) (domain.Workout, error)
+	GetWorkoutAmendments(ctx context.Context, userID, workoutID domain.ID) ([]domain.WorkoutAmendment, error)
+	GetMuscleGroupVolume(ctx context.Context, userID domain.ID, from, to time.Time) ([]dto.MuscleGroupVolumeDTO, error)
+	GetWorkoutCalendar(ctx context.Context, userID domain.ID, from, to time.Time) ([]dto.WorkoutCalendarDayDTO, error)
+	GetWorkoutReport(ctx context.Context, userID, workoutID domain.ID) (dto.WorkoutReportDTO, error)
+	GetWorkoutRestAnalytics(ctx context.Context, userID, workoutID domain.ID) (dto.RestAnalyticsDTO, error)
+	GetWorkoutStreak(ctx context.Context, userID domain.ID) (dto.WorkoutStreakDTO, error)
+	CalculatePlates(ctx context.Context, userID domain.ID, input dto.CalculatePlatesDTO) (domain.PlateLoadout, error)
+
+	CreateWorkoutImport(ctx context.Context, userID domain.ID, source domain.WorkoutImportSource, fileID domain.ID, weightUnit utils.Nullable[domain.MeasurementUnit]) (domain.WorkoutImport, error)
+	GetWorkoutImports(ctx context.Context, userID domain.ID) ([]domain.WorkoutImport, error)
+	GetWorkoutImport(ctx context.Context, userID, importID domain.ID) (domain.WorkoutImport, error)
+	ResolveWorkoutImport(ctx context.Context, userID, importID domain.ID, mappings []domain.ImportExerciseMapping, confirm bool) (domain.WorkoutImport, error)
 
 	LogExercise(ctx context.Context, userID, workoutID, exerciseID domain.ID) (domain.ExerciseLog, error)
 	GetExerciseLog(ctx context.Context, userID, exerciseLogID domain.ID) (dto.ExerciseLogDTO, error)
 	DeleteExerciseLog(ctx context.Context, userID, workoutID, exerciseLogID domain.ID) error
-	AddNotesToExerciseLog(ctx context.Context, userID, workoutID, exerciseLogID domain.ID, notes string) error
+	AddNotesToExerciseLog(ctx context.Context, userID, workoutID, exerciseLogID domain.ID, notes string, amend bool) error
 	AddPowerRatingToExerciseLog(ctx context.Context, userID, workoutID, exerciseLogID domain.ID, powerRating int) error
+	SetExerciseLogOrder(ctx context.Context, userID, workoutID domain.ID, exerciseLogIDs []domain.ID) error
+	GroupExerciseLogs(ctx context.Context, userID, workoutID domain.ID, input dto.GroupExercisesDTO) ([]domain.ExerciseLog, error)
+	UngroupExerciseLogs(ctx context.Context, userID, workoutID, groupID domain.ID) error
 
 	LogSet(ctx context.Context, userID, workoutID, exerciseLogID domain.ID, setlogDTO dto.CreateSetLogDTO) (domain.ExerciseSetLog, error)
-	DeleteSetLog(ctx context.Context, userID, workoutID, exerciseLogID domain.ID, setLogID domain.ID) error
+	DeleteSetLog(ctx context.Context, userID, workoutID, exerciseLogID domain.ID, setLogID domain.ID, amend bool) error
+	SetSetLogOrder(ctx context.Context, userID, workoutID, exerciseLogID domain.ID, setLogIDs []domain.ID) error
 	UpdateSetLog(ctx context.Context, userID, workoutID, exerciseLogID, setLogID domain.ID, setlogDTO dto.UpdateSetLogDTO) (domain.ExerciseSetLog, error)
 }
 
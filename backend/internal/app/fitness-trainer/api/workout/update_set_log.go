@@ -44,14 +44,22 @@ func (i *Implementation) UpdateSetLog(ctx context.Context, in *desc.UpdateSetLog
 		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
 	}
 
+	preferences, err := interceptors.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	units := mappers.NewUnits(preferences)
+
 	var updateSetLogDTO dto.UpdateSetLogDTO
 	{
 		if in.Reps != nil {
 			updateSetLogDTO.Reps = utils.NewNullable(int(*in.Reps), in.Reps != nil)
 		}
 		if in.Weight != nil {
-			updateSetLogDTO.Weight = utils.NewNullable(float32(*in.Weight), in.Weight != nil)
+			updateSetLogDTO.Weight = utils.NewNullable(units.WeightFromProto(float32(*in.Weight)), in.Weight != nil)
 		}
+		updateSetLogDTO.Amend = in.GetAmend()
 	}
 
 	setLog, err := i.service.UpdateSetLog(ctx, userID, workoutID, exerciseLogID, setLogID, updateSetLogDTO)
@@ -60,6 +68,6 @@ func (i *Implementation) UpdateSetLog(ctx context.Context, in *desc.UpdateSetLog
 	}
 
 	return &desc.SetLogResponse{
-		SetLog: mappers.SetLogToProto(setLog),
+		SetLog: mappers.SetLogToProto(setLog, units),
 	}, nil
 }
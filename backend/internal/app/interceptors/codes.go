@@ -6,36 +6,92 @@ import (
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/logger"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// errorMessages are shown to the user in the language of their preferences, the message of
+// the status stays the error itself.
+var errorMessages = map[codes.Code]map[string]string{
+	codes.NotFound: {
+		domain.LocaleRussian: "Не найдено",
+		domain.LocaleEnglish: "Not found",
+	},
+	codes.AlreadyExists: {
+		domain.LocaleRussian: "Такая запись уже существует",
+		domain.LocaleEnglish: "Already exists",
+	},
+	codes.InvalidArgument: {
+		domain.LocaleRussian: "Некорректные данные",
+		domain.LocaleEnglish: "Invalid data",
+	},
+	codes.Unauthenticated: {
+		domain.LocaleRussian: "Требуется авторизация",
+		domain.LocaleEnglish: "Authentication required",
+	},
+	codes.PermissionDenied: {
+		domain.LocaleRussian: "Доступ запрещён",
+		domain.LocaleEnglish: "Access denied",
+	},
+	codes.ResourceExhausted: {
+		domain.LocaleRussian: "Слишком много запросов, попробуйте позже",
+		domain.LocaleEnglish: "Too many requests, try again later",
+	},
+	codes.Internal: {
+		domain.LocaleRussian: "Внутренняя ошибка сервера",
+		domain.LocaleEnglish: "Internal server error",
+	},
+}
+
 func ErrCodesInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	resp, err := handler(ctx, req)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			return nil, status.Errorf(codes.NotFound, "%s", err.Error())
+			return nil, localizedStatus(ctx, codes.NotFound, err.Error())
 		}
 		if errors.Is(err, domain.ErrAlreadyExists) {
-			return nil, status.Errorf(codes.AlreadyExists, "%s", err.Error())
+			return nil, localizedStatus(ctx, codes.AlreadyExists, err.Error())
 		}
 		if errors.Is(err, domain.ErrInvalidArgument) {
-			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+			return nil, localizedStatus(ctx, codes.InvalidArgument, err.Error())
 		}
 		if errors.Is(err, domain.ErrUnauthorized) {
-			return nil, status.Errorf(codes.Unauthenticated, "%s", err.Error())
+			return nil, localizedStatus(ctx, codes.Unauthenticated, err.Error())
 		}
 		if errors.Is(err, domain.ErrForbidden) {
-			return nil, status.Errorf(codes.PermissionDenied, "%s", err.Error())
+			return nil, localizedStatus(ctx, codes.PermissionDenied, err.Error())
 		}
 		if errors.Is(err, domain.ErrTooManyRequests) {
-			return nil, status.Errorf(codes.ResourceExhausted, "%s", err.Error())
+			return nil, localizedStatus(ctx, codes.ResourceExhausted, err.Error())
 		}
 
 		logger.Errorf("[interceptor.Error] method: %s; error: %s", info.FullMethod, err.Error())
-		return nil, status.Error(codes.Internal, "internal server error")
+		return nil, localizedStatus(ctx, codes.Internal, "internal server error")
 	}
 
 	return resp, err
 }
+
+// localizedStatus attaches the message of the code in the locale of the user to the status.
+func localizedStatus(ctx context.Context, code codes.Code, message string) error {
+	st := status.New(code, message)
+
+	locale := domain.DefaultUserPreferences(domain.ID{}).Locale
+	if preferences, err := GetPreferences(ctx); err == nil {
+		locale = preferences.Locale
+	}
+
+	localized, ok := errorMessages[code][locale]
+	if !ok {
+		return st.Err()
+	}
+
+	withDetails, err := st.WithDetails(&errdetails.LocalizedMessage{Locale: locale, Message: localized})
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
@@ -0,0 +1,84 @@
+package interceptors
+
+import (
+	"context"
+
+	"fitness-trainer/internal/logger"
+
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type URLSigner interface {
+	SignURL(ctx context.Context, rawURL string) (string, error)
+}
+
+// mediaURLFields are the response fields which point to uploaded media
+var mediaURLFields = map[protoreflect.FullName]struct{}{
+	"fitness_trainer.api.workout.User.profile_picture_url": {},
+	"fitness_trainer.api.workout.ImageVariant.url":         {},
+	"fitness_trainer.api.workout.ExerciseMedia.url":        {},
+	"fitness_trainer.api.workout.Exercise.video_url":       {},
+	"fitness_trainer.api.workout.File.url":                 {},
+}
+
+// NewMediaURLs replaces media URLs in responses with short-lived signed URLs, which lets
+// the bucket stay private.
+func NewMediaURLs(
+	signer URLSigner,
+) func(context.Context, interface{}, *grpc.UnaryServerInfo, grpc.UnaryHandler) (interface{}, error) {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+
+		message, ok := resp.(proto.Message)
+		if !ok {
+			return resp, nil
+		}
+
+		span, ctx := opentracing.StartSpanFromContext(ctx, "interceptors.MediaURLs")
+		defer span.Finish()
+
+		signMediaURLs(ctx, signer, message.ProtoReflect())
+
+		return resp, nil
+	}
+}
+
+func signMediaURLs(ctx context.Context, signer URLSigner, message protoreflect.Message) {
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsMap():
+			if field.MapValue().Message() != nil {
+				value.Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+					signMediaURLs(ctx, signer, value.Message())
+					return true
+				})
+			}
+		case field.Message() != nil && field.IsList():
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				signMediaURLs(ctx, signer, list.Get(i).Message())
+			}
+		case field.Message() != nil:
+			signMediaURLs(ctx, signer, value.Message())
+		case field.Kind() == protoreflect.StringKind && !field.IsList():
+			if _, ok := mediaURLFields[field.FullName()]; !ok {
+				return true
+			}
+
+			signed, err := signer.SignURL(ctx, value.String())
+			if err != nil {
+				logger.Errorf("failed to sign url of %s: %v", field.FullName(), err)
+				return true
+			}
+			message.Set(field, protoreflect.ValueOfString(signed))
+		}
+
+		return true
+	})
+}
@@ -0,0 +1,53 @@
+package interceptors
+
+import (
+	"context"
+	"sync"
+
+	"fitness-trainer/internal/domain"
+
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc"
+)
+
+const preferencesKey contextKey = "preferences-interceptor.preferences"
+
+type PreferencesProvider interface {
+	GetUserPreferences(ctx context.Context, userID domain.ID) (domain.UserPreferences, error)
+}
+
+type preferencesLoader func() (domain.UserPreferences, error)
+
+// NewPreferences lets handlers get preferences of the authenticated user to convert values
+// to the units the user prefers. They are loaded on the first use, so methods that do not
+// convert anything do not query them.
+func NewPreferences(
+	provider PreferencesProvider,
+) func(context.Context, interface{}, *grpc.UnaryServerInfo, grpc.UnaryHandler) (interface{}, error) {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		userID, ok := GetUserID(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		loader := preferencesLoader(sync.OnceValues(func() (domain.UserPreferences, error) {
+			span, spanCtx := opentracing.StartSpanFromContext(ctx, "interceptors.Preferences")
+			defer span.Finish()
+
+			return provider.GetUserPreferences(spanCtx, userID)
+		}))
+
+		return handler(context.WithValue(ctx, preferencesKey, loader), req)
+	}
+}
+
+// GetPreferences returns preferences of the authenticated user, the default ones for
+// unprotected methods.
+func GetPreferences(ctx context.Context) (domain.UserPreferences, error) {
+	if loader, ok := ctx.Value(preferencesKey).(preferencesLoader); ok {
+		return loader()
+	}
+
+	userID, _ := GetUserID(ctx)
+	return domain.DefaultUserPreferences(userID), nil
+}
@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	desc "fitness-trainer/pkg/workouts"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func DataExportStatusToProto(status domain.DataExportStatus) desc.DataExportStatus {
+	switch status {
+	case domain.DataExportStatusPending:
+		return desc.DataExportStatus_DATA_EXPORT_STATUS_PENDING
+	case domain.DataExportStatusRunning:
+		return desc.DataExportStatus_DATA_EXPORT_STATUS_RUNNING
+	case domain.DataExportStatusDone:
+		return desc.DataExportStatus_DATA_EXPORT_STATUS_DONE
+	case domain.DataExportStatusFailed:
+		return desc.DataExportStatus_DATA_EXPORT_STATUS_FAILED
+	default:
+		return desc.DataExportStatus_DATA_EXPORT_STATUS_UNSPECIFIED
+	}
+}
+
+func DataExportToProto(export dto.DataExportDTO) *desc.DataExport {
+	exportProto := &desc.DataExport{
+		Id:          export.Export.ID.String(),
+		Status:      DataExportStatusToProto(export.Export.Status),
+		Size:        export.Export.Size,
+		DownloadUrl: export.DownloadURL,
+		CreatedAt:   timestamppb.New(export.Export.CreatedAt),
+	}
+	if !export.Export.ExpiresAt.IsZero() {
+		exportProto.ExpiresAt = timestamppb.New(export.Export.ExpiresAt)
+	}
+
+	return exportProto
+}
+
+func DataExportsToProto(exports []dto.DataExportDTO) []*desc.DataExport {
+	result := make([]*desc.DataExport, 0, len(exports))
+	for _, export := range exports {
+		result = append(result, DataExportToProto(export))
+	}
+
+	return result
+}
@@ -0,0 +1,52 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	desc "fitness-trainer/pkg/workouts"
+)
+
+func EquipmentToProto(equipment domain.Equipment) desc.Equipment {
+	switch equipment {
+	case domain.EquipmentBarbell:
+		return desc.Equipment_EQUIPMENT_BARBELL
+	case domain.EquipmentDumbbell:
+		return desc.Equipment_EQUIPMENT_DUMBBELL
+	case domain.EquipmentKettlebell:
+		return desc.Equipment_EQUIPMENT_KETTLEBELL
+	case domain.EquipmentMachine:
+		return desc.Equipment_EQUIPMENT_MACHINE
+	case domain.EquipmentCable:
+		return desc.Equipment_EQUIPMENT_CABLE
+	case domain.EquipmentBodyweight:
+		return desc.Equipment_EQUIPMENT_BODYWEIGHT
+	case domain.EquipmentBand:
+		return desc.Equipment_EQUIPMENT_BAND
+	case domain.EquipmentOther:
+		return desc.Equipment_EQUIPMENT_OTHER
+	default:
+		return desc.Equipment_EQUIPMENT_UNSPECIFIED
+	}
+}
+
+func EquipmentFromProto(equipment desc.Equipment) domain.Equipment {
+	switch equipment {
+	case desc.Equipment_EQUIPMENT_BARBELL:
+		return domain.EquipmentBarbell
+	case desc.Equipment_EQUIPMENT_DUMBBELL:
+		return domain.EquipmentDumbbell
+	case desc.Equipment_EQUIPMENT_KETTLEBELL:
+		return domain.EquipmentKettlebell
+	case desc.Equipment_EQUIPMENT_MACHINE:
+		return domain.EquipmentMachine
+	case desc.Equipment_EQUIPMENT_CABLE:
+		return domain.EquipmentCable
+	case desc.Equipment_EQUIPMENT_BODYWEIGHT:
+		return domain.EquipmentBodyweight
+	case desc.Equipment_EQUIPMENT_BAND:
+		return domain.EquipmentBand
+	case desc.Equipment_EQUIPMENT_OTHER:
+		return domain.EquipmentOther
+	default:
+		return domain.EquipmentUnknown
+	}
+}
@@ -1,6 +1,11 @@
 package mappers
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/domain/dto"
 	desc "fitness-trainer/pkg/workouts"
@@ -14,14 +19,113 @@ func ExerciseToProto(exercise domain.Exercise) *desc.Exercise {
 		muscleGroups = append(muscleGroups, muscleGroup.String())
 	}
 
-	return &desc.Exercise{
+	exerciseProto := &desc.Exercise{
 		Id:                 exercise.ID.String(),
 		Name:               exercise.Name,
 		Description:        exercise.Description,
 		TargetMuscleGroups: muscleGroups,
+		MuscleGroups:       ExerciseMuscleGroupsToProto(exercise.MuscleGroups),
+		Equipment:          EquipmentToProto(exercise.Equipment),
+		Aliases:            ExerciseAliasesToProto(exercise.Aliases),
+		Media:              ExerciseMediaListToProto(exercise.Media),
+		Visibility:         ExerciseVisibilityToProto(exercise.Visibility),
+		ShareToken:         exercise.ShareToken,
+		ModerationStatus:   ModerationStatusToProto(exercise.ModerationStatus),
+		ModerationComment:  exercise.ModerationComment,
 		CreatedAt:          timestamppb.New(exercise.CreatedAt),
 		UpdatedAt:          timestamppb.New(exercise.UpdatedAt),
 	}
+	if exercise.OwnerID.IsValid {
+		ownerID := exercise.OwnerID.V.String()
+		exerciseProto.OwnerId = &ownerID
+	}
+	if exercise.IsArchived() {
+		exerciseProto.ArchivedAt = timestamppb.New(exercise.ArchivedAt)
+	}
+	if exercise.MergedIntoID.IsValid {
+		mergedIntoID := exercise.MergedIntoID.V.String()
+		exerciseProto.MergedIntoId = &mergedIntoID
+	}
+
+	return exerciseProto
+}
+
+func ExerciseMuscleGroupsToProto(muscleGroups []domain.ExerciseMuscleGroup) []*desc.ExerciseMuscleGroup {
+	result := make([]*desc.ExerciseMuscleGroup, 0, len(muscleGroups))
+	for _, muscleGroup := range muscleGroups {
+		result = append(result, &desc.ExerciseMuscleGroup{
+			MuscleGroupId: muscleGroup.MuscleGroupID.String(),
+			Name:          muscleGroup.MuscleGroup.String(),
+			Role:          MuscleGroupRoleToProto(muscleGroup.Role),
+			Activation:    muscleGroup.Activation,
+		})
+	}
+
+	return result
+}
+
+func ExerciseAliasesToProto(aliases []domain.ExerciseAlias) []*desc.ExerciseAlias {
+	result := make([]*desc.ExerciseAlias, 0, len(aliases))
+	for _, alias := range aliases {
+		result = append(result, &desc.ExerciseAlias{
+			Language: alias.Language,
+			Alias:    alias.Alias,
+		})
+	}
+
+	return result
+}
+
+func ExerciseAliasesFromProto(aliases []*desc.ExerciseAlias) []domain.ExerciseAlias {
+	result := make([]domain.ExerciseAlias, 0, len(aliases))
+	for _, alias := range aliases {
+		result = append(result, domain.ExerciseAlias{
+			Language: alias.GetLanguage(),
+			Alias:    alias.GetAlias(),
+		})
+	}
+
+	return result
+}
+
+type exercisesCursor struct {
+	Rank      float64   `json:"r"`
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+// ExercisesCursorToProto encodes the cursor into an opaque page token.
+func ExercisesCursorToProto(cursor dto.ExercisesCursor) string {
+	data, _ := json.Marshal(exercisesCursor{
+		Rank:      cursor.Rank,
+		CreatedAt: cursor.CreatedAt,
+		ID:        cursor.ID.String(),
+	})
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func ExercisesCursorFromProto(token string) (dto.ExercisesCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return dto.ExercisesCursor{}, fmt.Errorf("%w: malformed cursor", domain.ErrInvalidArgument)
+	}
+
+	var cursor exercisesCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return dto.ExercisesCursor{}, fmt.Errorf("%w: malformed cursor", domain.ErrInvalidArgument)
+	}
+
+	id, err := domain.ParseID(cursor.ID)
+	if err != nil {
+		return dto.ExercisesCursor{}, fmt.Errorf("%w: malformed cursor", domain.ErrInvalidArgument)
+	}
+
+	return dto.ExercisesCursor{
+		Rank:      cursor.Rank,
+		CreatedAt: cursor.CreatedAt,
+		ID:        id,
+	}, nil
 }
 
 func ExercisesToProto(exercises []domain.Exercise) []*desc.Exercise {
@@ -48,3 +152,51 @@ func MuscleGroupDTOsToProto(muscleGroups []dto.MuscleGroupDTO) []*desc.MuscleGro
 
 	return result
 }
+
+func MuscleGroupVolumeDTOsToProto(volume []dto.MuscleGroupVolumeDTO) []*desc.MuscleGroupVolume {
+	result := make([]*desc.MuscleGroupVolume, 0, len(volume))
+	for _, v := range volume {
+		result = append(result, &desc.MuscleGroupVolume{
+			MuscleGroupId:  v.MuscleGroupID.String(),
+			Name:           v.Name,
+			DirectSets:     int32(v.DirectSets),
+			FractionalSets: v.FractionalSets,
+		})
+	}
+
+	return result
+}
+
+func ExerciseAuditEntryToProto(entry domain.ExerciseAuditEntry) *desc.ExerciseAuditEntry {
+	changes := make(map[string]*desc.FieldChange, len(entry.Changes))
+	for field, change := range entry.Changes {
+		changes[field] = &desc.FieldChange{
+			From: change.From,
+			To:   change.To,
+		}
+	}
+
+	entryProto := &desc.ExerciseAuditEntry{
+		Id:         entry.ID.String(),
+		ExerciseId: entry.ExerciseID.String(),
+		Action:     entry.Action.String(),
+		Changes:    changes,
+		CreatedAt:  timestamppb.New(entry.CreatedAt),
+	}
+	// The author is unset once their account is deleted
+	if entry.UserID != (domain.ID{}) {
+		userID := entry.UserID.String()
+		entryProto.UserId = &userID
+	}
+
+	return entryProto
+}
+
+func ExerciseAuditEntriesToProto(entries []domain.ExerciseAuditEntry) []*desc.ExerciseAuditEntry {
+	result := make([]*desc.ExerciseAuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, ExerciseAuditEntryToProto(entry))
+	}
+
+	return result
+}
@@ -0,0 +1,76 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/utils"
+	desc "fitness-trainer/pkg/workouts"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func ExerciseGroupTypeToProto(groupType domain.ExerciseGroupType) desc.ExerciseGroupType {
+	switch groupType {
+	case domain.ExerciseGroupTypeSuperset:
+		return desc.ExerciseGroupType_EXERCISE_GROUP_TYPE_SUPERSET
+	case domain.ExerciseGroupTypeGiantSet:
+		return desc.ExerciseGroupType_EXERCISE_GROUP_TYPE_GIANT_SET
+	case domain.ExerciseGroupTypeCircuit:
+		return desc.ExerciseGroupType_EXERCISE_GROUP_TYPE_CIRCUIT
+	default:
+		return desc.ExerciseGroupType_EXERCISE_GROUP_TYPE_UNSPECIFIED
+	}
+}
+
+func ExerciseGroupTypeFromProto(groupType desc.ExerciseGroupType) domain.ExerciseGroupType {
+	switch groupType {
+	case desc.ExerciseGroupType_EXERCISE_GROUP_TYPE_SUPERSET:
+		return domain.ExerciseGroupTypeSuperset
+	case desc.ExerciseGroupType_EXERCISE_GROUP_TYPE_GIANT_SET:
+		return domain.ExerciseGroupTypeGiantSet
+	case desc.ExerciseGroupType_EXERCISE_GROUP_TYPE_CIRCUIT:
+		return domain.ExerciseGroupTypeCircuit
+	default:
+		return domain.ExerciseGroupTypeUnknown
+	}
+}
+
+func ExerciseGroupToProto(group utils.Nullable[domain.ExerciseGroup]) *desc.ExerciseGroup {
+	if !group.IsValid {
+		return nil
+	}
+
+	return &desc.ExerciseGroup{
+		Id:     group.V.ID.String(),
+		Type:   ExerciseGroupTypeToProto(group.V.Type),
+		Rounds: int32(group.V.Rounds),
+	}
+}
+
+func WorkoutReportToProto(report dto.WorkoutReportDTO, units Units) *desc.WorkoutReportResponse {
+	groups := make([]*desc.WorkoutReportResponse_GroupSummary, 0, len(report.Groups))
+	for _, group := range report.Groups {
+		ids := make([]string, 0, len(group.ExerciseLogIDs))
+		for _, id := range group.ExerciseLogIDs {
+			ids = append(ids, id.String())
+		}
+
+		groups = append(groups, &desc.WorkoutReportResponse_GroupSummary{
+			Group:           ExerciseGroupToProto(utils.NewNullable(group.Group, true)),
+			ExerciseLogIds:  ids,
+			CompletedRounds: int32(group.CompletedRounds),
+		})
+	}
+
+	return &desc.WorkoutReportResponse{
+		Workout:      WorkoutToProto(report.Workout),
+		ExerciseLogs: ExerciseLogsToProto(report.ExerciseLogs),
+		AdditionalInfo: &desc.WorkoutReportResponse_AdditionalInfo{
+			TotalSets:   int32(report.TotalSets),
+			TotalReps:   int32(report.TotalReps),
+			TotalWeight: units.WeightToProto(report.TotalWeight),
+			TotalTime:   durationpb.New(report.TotalTime),
+		},
+		Groups: groups,
+	}
+}
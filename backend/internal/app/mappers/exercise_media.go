@@ -0,0 +1,42 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	desc "fitness-trainer/pkg/workouts"
+)
+
+func ExerciseMediaKindToProto(kind domain.ExerciseMediaKind) desc.ExerciseMediaKind {
+	switch kind {
+	case domain.ExerciseMediaKindImage:
+		return desc.ExerciseMediaKind_EXERCISE_MEDIA_KIND_IMAGE
+	case domain.ExerciseMediaKindGIF:
+		return desc.ExerciseMediaKind_EXERCISE_MEDIA_KIND_GIF
+	case domain.ExerciseMediaKindVideo:
+		return desc.ExerciseMediaKind_EXERCISE_MEDIA_KIND_VIDEO
+	default:
+		return desc.ExerciseMediaKind_EXERCISE_MEDIA_KIND_UNSPECIFIED
+	}
+}
+
+func ExerciseMediaToProto(media domain.ExerciseMedia) *desc.ExerciseMedia {
+	return &desc.ExerciseMedia{
+		Id:          media.ID.String(),
+		Kind:        ExerciseMediaKindToProto(media.Kind),
+		Url:         media.URL,
+		ContentType: media.ContentType,
+		SizeBytes:   media.Size,
+		Caption:     media.Caption,
+		Position:    int32(media.Position),
+		Ready:       media.Status == domain.ExerciseMediaStatusReady,
+		Variants:    ImageVariantsToProto(media.Variants),
+	}
+}
+
+func ExerciseMediaListToProto(media []domain.ExerciseMedia) []*desc.ExerciseMedia {
+	result := make([]*desc.ExerciseMedia, 0, len(media))
+	for _, m := range media {
+		result = append(result, ExerciseMediaToProto(m))
+	}
+
+	return result
+}
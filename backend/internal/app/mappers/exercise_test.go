@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+)
+
+func TestExercisesCursorRoundTrip(t *testing.T) {
+	cursor := dto.ExercisesCursor{
+		Rank:      0.0759909,
+		CreatedAt: time.Date(2024, 5, 1, 18, 30, 15, 123456000, time.UTC),
+		ID:        domain.NewID(),
+	}
+
+	got, err := ExercisesCursorFromProto(ExercisesCursorToProto(cursor))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Keyset pagination compares all three fields, none of them may lose precision
+	if got.Rank != cursor.Rank || !got.CreatedAt.Equal(cursor.CreatedAt) || got.ID != cursor.ID {
+		t.Errorf("cursor = %+v, want %+v", got, cursor)
+	}
+}
+
+func TestExercisesCursorFromProtoMalformed(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "not base64", token: "***"},
+		{name: "not json", token: base64.RawURLEncoding.EncodeToString([]byte("cursor"))},
+		{name: "bad id", token: base64.RawURLEncoding.EncodeToString([]byte(`{"r":1,"c":"2024-05-01T18:30:15Z","i":"42"}`))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ExercisesCursorFromProto(tt.token); !errors.Is(err, domain.ErrInvalidArgument) {
+				t.Errorf("error = %v, want %v", err, domain.ErrInvalidArgument)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	desc "fitness-trainer/pkg/workouts"
+)
+
+func ExerciseVisibilityToProto(visibility domain.ExerciseVisibility) desc.ExerciseVisibility {
+	switch visibility {
+	case domain.ExerciseVisibilityGlobal:
+		return desc.ExerciseVisibility_EXERCISE_VISIBILITY_GLOBAL
+	case domain.ExerciseVisibilityPrivate:
+		return desc.ExerciseVisibility_EXERCISE_VISIBILITY_PRIVATE
+	case domain.ExerciseVisibilityShared:
+		return desc.ExerciseVisibility_EXERCISE_VISIBILITY_SHARED
+	default:
+		return desc.ExerciseVisibility_EXERCISE_VISIBILITY_UNSPECIFIED
+	}
+}
+
+func ExerciseVisibilityFromProto(visibility desc.ExerciseVisibility) domain.ExerciseVisibility {
+	switch visibility {
+	case desc.ExerciseVisibility_EXERCISE_VISIBILITY_GLOBAL:
+		return domain.ExerciseVisibilityGlobal
+	case desc.ExerciseVisibility_EXERCISE_VISIBILITY_PRIVATE:
+		return domain.ExerciseVisibilityPrivate
+	case desc.ExerciseVisibility_EXERCISE_VISIBILITY_SHARED:
+		return domain.ExerciseVisibilityShared
+	default:
+		return domain.ExerciseVisibilityUnknown
+	}
+}
+
+func ModerationStatusToProto(status domain.ModerationStatus) desc.ModerationStatus {
+	switch status {
+	case domain.ModerationStatusNone:
+		return desc.ModerationStatus_MODERATION_STATUS_NONE
+	case domain.ModerationStatusPending:
+		return desc.ModerationStatus_MODERATION_STATUS_PENDING
+	case domain.ModerationStatusApproved:
+		return desc.ModerationStatus_MODERATION_STATUS_APPROVED
+	case domain.ModerationStatusRejected:
+		return desc.ModerationStatus_MODERATION_STATUS_REJECTED
+	default:
+		return desc.ModerationStatus_MODERATION_STATUS_UNSPECIFIED
+	}
+}
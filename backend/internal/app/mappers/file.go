@@ -0,0 +1,47 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	desc "fitness-trainer/pkg/workouts"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func FilePurposeToProto(purpose domain.FilePurpose) desc.FilePurpose {
+	switch purpose {
+	case domain.FilePurposeProfilePicture:
+		return desc.FilePurpose_FILE_PURPOSE_PROFILE_PICTURE
+	case domain.FilePurposeProgressPhoto:
+		return desc.FilePurpose_FILE_PURPOSE_PROGRESS_PHOTO
+	case domain.FilePurposeWorkoutImport:
+		return desc.FilePurpose_FILE_PURPOSE_WORKOUT_IMPORT
+	default:
+		return desc.FilePurpose_FILE_PURPOSE_UNSPECIFIED
+	}
+}
+
+func FilePurposeFromProto(purpose desc.FilePurpose) domain.FilePurpose {
+	switch purpose {
+	case desc.FilePurpose_FILE_PURPOSE_PROFILE_PICTURE:
+		return domain.FilePurposeProfilePicture
+	case desc.FilePurpose_FILE_PURPOSE_PROGRESS_PHOTO:
+		return domain.FilePurposeProgressPhoto
+	case desc.FilePurpose_FILE_PURPOSE_WORKOUT_IMPORT:
+		return domain.FilePurposeWorkoutImport
+	default:
+		return domain.FilePurposeUnknown
+	}
+}
+
+func FileToProto(file domain.File) *desc.File {
+	return &desc.File{
+		Id:          file.ID.String(),
+		Purpose:     FilePurposeToProto(file.Purpose),
+		Url:         file.URL,
+		ContentType: file.ContentType,
+		SizeBytes:   file.Size,
+		Confirmed:   file.IsConfirmed(),
+		CreatedAt:   timestamppb.New(file.CreatedAt),
+		Variants:    ImageVariantsToProto(file.Variants),
+	}
+}
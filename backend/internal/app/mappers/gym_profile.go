@@ -0,0 +1,66 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	desc "fitness-trainer/pkg/workouts"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func PlateInventoriesToProto(plates []domain.PlateInventory, units Units) []*desc.PlateInventory {
+	result := make([]*desc.PlateInventory, 0, len(plates))
+	for _, plate := range plates {
+		result = append(result, &desc.PlateInventory{
+			Weight: units.WeightToProto(plate.Weight),
+			Count:  int32(plate.Count),
+		})
+	}
+
+	return result
+}
+
+func PlateInventoriesFromProto(plates []*desc.PlateInventory, units Units) []domain.PlateInventory {
+	result := make([]domain.PlateInventory, 0, len(plates))
+	for _, plate := range plates {
+		result = append(result, domain.PlateInventory{
+			Weight: units.WeightFromProto(plate.GetWeight()),
+			Count:  int(plate.GetCount()),
+		})
+	}
+
+	return result
+}
+
+func GymProfileToProto(profile domain.GymProfile, units Units) *desc.GymProfile {
+	return &desc.GymProfile{
+		Id:        profile.ID.String(),
+		Name:      profile.Name,
+		BarWeight: units.WeightToProto(profile.BarWeight),
+		Plates:    PlateInventoriesToProto(profile.Plates, units),
+		IsDefault: profile.IsDefault,
+		CreatedAt: timestamppb.New(profile.CreatedAt),
+		UpdatedAt: timestamppb.New(profile.UpdatedAt),
+	}
+}
+
+func GymProfilesToProto(profiles []domain.GymProfile, units Units) []*desc.GymProfile {
+	result := make([]*desc.GymProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		result = append(result, GymProfileToProto(profile, units))
+	}
+
+	return result
+}
+
+func PlateLoadoutToProto(loadout domain.PlateLoadout, units Units) *desc.PlateLoadout {
+	plates := make([]float32, 0, len(loadout.PlatesPerSide))
+	for _, plate := range loadout.PlatesPerSide {
+		plates = append(plates, units.WeightToProto(plate))
+	}
+
+	return &desc.PlateLoadout{
+		PlatesPerSide: plates,
+		BarWeight:     units.WeightToProto(loadout.BarWeight),
+		Weight:        units.WeightToProto(loadout.Weight),
+	}
+}
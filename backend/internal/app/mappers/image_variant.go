@@ -0,0 +1,18 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	desc "fitness-trainer/pkg/workouts"
+)
+
+func ImageVariantsToProto(variants []domain.ImageVariant) []*desc.ImageVariant {
+	result := make([]*desc.ImageVariant, 0, len(variants))
+	for _, variant := range variants {
+		result = append(result, &desc.ImageVariant{
+			Size: int32(variant.Size),
+			Url:  variant.URL,
+		})
+	}
+
+	return result
+}
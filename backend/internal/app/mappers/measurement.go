@@ -0,0 +1,144 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	desc "fitness-trainer/pkg/workouts"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MeasurementKindToProto(kind domain.MeasurementKind) desc.MeasurementKind {
+	switch kind {
+	case domain.MeasurementKindBodyweight:
+		return desc.MeasurementKind_MEASUREMENT_KIND_BODYWEIGHT
+	case domain.MeasurementKindBodyFat:
+		return desc.MeasurementKind_MEASUREMENT_KIND_BODY_FAT
+	case domain.MeasurementKindNeck:
+		return desc.MeasurementKind_MEASUREMENT_KIND_NECK
+	case domain.MeasurementKindShoulders:
+		return desc.MeasurementKind_MEASUREMENT_KIND_SHOULDERS
+	case domain.MeasurementKindChest:
+		return desc.MeasurementKind_MEASUREMENT_KIND_CHEST
+	case domain.MeasurementKindWaist:
+		return desc.MeasurementKind_MEASUREMENT_KIND_WAIST
+	case domain.MeasurementKindHips:
+		return desc.MeasurementKind_MEASUREMENT_KIND_HIPS
+	case domain.MeasurementKindArm:
+		return desc.MeasurementKind_MEASUREMENT_KIND_ARM
+	case domain.MeasurementKindForearm:
+		return desc.MeasurementKind_MEASUREMENT_KIND_FOREARM
+	case domain.MeasurementKindThigh:
+		return desc.MeasurementKind_MEASUREMENT_KIND_THIGH
+	case domain.MeasurementKindCalf:
+		return desc.MeasurementKind_MEASUREMENT_KIND_CALF
+	default:
+		return desc.MeasurementKind_MEASUREMENT_KIND_UNSPECIFIED
+	}
+}
+
+func MeasurementKindFromProto(kind desc.MeasurementKind) domain.MeasurementKind {
+	switch kind {
+	case desc.MeasurementKind_MEASUREMENT_KIND_BODYWEIGHT:
+		return domain.MeasurementKindBodyweight
+	case desc.MeasurementKind_MEASUREMENT_KIND_BODY_FAT:
+		return domain.MeasurementKindBodyFat
+	case desc.MeasurementKind_MEASUREMENT_KIND_NECK:
+		return domain.MeasurementKindNeck
+	case desc.MeasurementKind_MEASUREMENT_KIND_SHOULDERS:
+		return domain.MeasurementKindShoulders
+	case desc.MeasurementKind_MEASUREMENT_KIND_CHEST:
+		return domain.MeasurementKindChest
+	case desc.MeasurementKind_MEASUREMENT_KIND_WAIST:
+		return domain.MeasurementKindWaist
+	case desc.MeasurementKind_MEASUREMENT_KIND_HIPS:
+		return domain.MeasurementKindHips
+	case desc.MeasurementKind_MEASUREMENT_KIND_ARM:
+		return domain.MeasurementKindArm
+	case desc.MeasurementKind_MEASUREMENT_KIND_FOREARM:
+		return domain.MeasurementKindForearm
+	case desc.MeasurementKind_MEASUREMENT_KIND_THIGH:
+		return domain.MeasurementKindThigh
+	case desc.MeasurementKind_MEASUREMENT_KIND_CALF:
+		return domain.MeasurementKindCalf
+	default:
+		return domain.MeasurementKindUnknown
+	}
+}
+
+func MeasurementUnitToProto(unit domain.MeasurementUnit) desc.MeasurementUnit {
+	switch unit {
+	case domain.MeasurementUnitKilogram:
+		return desc.MeasurementUnit_MEASUREMENT_UNIT_KG
+	case domain.MeasurementUnitPound:
+		return desc.MeasurementUnit_MEASUREMENT_UNIT_LB
+	case domain.MeasurementUnitPercent:
+		return desc.MeasurementUnit_MEASUREMENT_UNIT_PERCENT
+	case domain.MeasurementUnitCentimeter:
+		return desc.MeasurementUnit_MEASUREMENT_UNIT_CM
+	case domain.MeasurementUnitInch:
+		return desc.MeasurementUnit_MEASUREMENT_UNIT_IN
+	default:
+		return desc.MeasurementUnit_MEASUREMENT_UNIT_UNSPECIFIED
+	}
+}
+
+func MeasurementUnitFromProto(unit desc.MeasurementUnit) domain.MeasurementUnit {
+	switch unit {
+	case desc.MeasurementUnit_MEASUREMENT_UNIT_KG:
+		return domain.MeasurementUnitKilogram
+	case desc.MeasurementUnit_MEASUREMENT_UNIT_LB:
+		return domain.MeasurementUnitPound
+	case desc.MeasurementUnit_MEASUREMENT_UNIT_PERCENT:
+		return domain.MeasurementUnitPercent
+	case desc.MeasurementUnit_MEASUREMENT_UNIT_CM:
+		return domain.MeasurementUnitCentimeter
+	case desc.MeasurementUnit_MEASUREMENT_UNIT_IN:
+		return domain.MeasurementUnitInch
+	default:
+		return domain.MeasurementUnitUnknown
+	}
+}
+
+func MeasurementToProto(measurement domain.Measurement) *desc.Measurement {
+	return &desc.Measurement{
+		Id:         measurement.ID.String(),
+		Kind:       MeasurementKindToProto(measurement.Kind),
+		Value:      measurement.Value,
+		Unit:       MeasurementUnitToProto(measurement.Unit),
+		MeasuredAt: timestamppb.New(measurement.MeasuredAt),
+		Note:       measurement.Note,
+		CreatedAt:  timestamppb.New(measurement.CreatedAt),
+	}
+}
+
+func MeasurementsToProto(measurements []domain.Measurement) []*desc.Measurement {
+	result := make([]*desc.Measurement, 0, len(measurements))
+	for _, measurement := range measurements {
+		result = append(result, MeasurementToProto(measurement))
+	}
+
+	return result
+}
+
+// MeasurementTrendToProto converts values of the trend from the base unit of the kind to the
+// unit the user prefers.
+func MeasurementTrendToProto(trend dto.MeasurementTrendDTO, units Units) *desc.GetMeasurementTrendResponse {
+	unit := units.unitFor(trend.Unit)
+
+	points := make([]*desc.MeasurementTrendPoint, 0, len(trend.Points))
+	for _, point := range trend.Points {
+		points = append(points, &desc.MeasurementTrendPoint{
+			Date:          timestamppb.New(point.Date),
+			Value:         unit.FromBase(point.Value),
+			MovingAverage: unit.FromBase(point.MovingAverage),
+		})
+	}
+
+	return &desc.GetMeasurementTrendResponse{
+		Kind:   MeasurementKindToProto(trend.Kind),
+		Unit:   MeasurementUnitToProto(unit),
+		Points: points,
+		Change: unit.FromBase(trend.Change),
+	}
+}
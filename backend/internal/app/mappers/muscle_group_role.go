@@ -0,0 +1,32 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	desc "fitness-trainer/pkg/workouts"
+)
+
+func MuscleGroupRoleToProto(role domain.MuscleGroupRole) desc.MuscleGroupRole {
+	switch role {
+	case domain.MuscleGroupRolePrimary:
+		return desc.MuscleGroupRole_MUSCLE_GROUP_ROLE_PRIMARY
+	case domain.MuscleGroupRoleSecondary:
+		return desc.MuscleGroupRole_MUSCLE_GROUP_ROLE_SECONDARY
+	case domain.MuscleGroupRoleStabilizer:
+		return desc.MuscleGroupRole_MUSCLE_GROUP_ROLE_STABILIZER
+	default:
+		return desc.MuscleGroupRole_MUSCLE_GROUP_ROLE_UNSPECIFIED
+	}
+}
+
+func MuscleGroupRoleFromProto(role desc.MuscleGroupRole) domain.MuscleGroupRole {
+	switch role {
+	case desc.MuscleGroupRole_MUSCLE_GROUP_ROLE_PRIMARY:
+		return domain.MuscleGroupRolePrimary
+	case desc.MuscleGroupRole_MUSCLE_GROUP_ROLE_SECONDARY:
+		return domain.MuscleGroupRoleSecondary
+	case desc.MuscleGroupRole_MUSCLE_GROUP_ROLE_STABILIZER:
+		return domain.MuscleGroupRoleStabilizer
+	default:
+		return domain.MuscleGroupRoleUnknown
+	}
+}
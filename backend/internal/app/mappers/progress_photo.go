@@ -0,0 +1,85 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	desc "fitness-trainer/pkg/workouts"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ProgressPhotoPoseToProto(pose domain.ProgressPhotoPose) desc.ProgressPhotoPose {
+	switch pose {
+	case domain.ProgressPhotoPoseFront:
+		return desc.ProgressPhotoPose_PROGRESS_PHOTO_POSE_FRONT
+	case domain.ProgressPhotoPoseSide:
+		return desc.ProgressPhotoPose_PROGRESS_PHOTO_POSE_SIDE
+	case domain.ProgressPhotoPoseBack:
+		return desc.ProgressPhotoPose_PROGRESS_PHOTO_POSE_BACK
+	default:
+		return desc.ProgressPhotoPose_PROGRESS_PHOTO_POSE_UNSPECIFIED
+	}
+}
+
+func ProgressPhotoPoseFromProto(pose desc.ProgressPhotoPose) domain.ProgressPhotoPose {
+	switch pose {
+	case desc.ProgressPhotoPose_PROGRESS_PHOTO_POSE_FRONT:
+		return domain.ProgressPhotoPoseFront
+	case desc.ProgressPhotoPose_PROGRESS_PHOTO_POSE_SIDE:
+		return domain.ProgressPhotoPoseSide
+	case desc.ProgressPhotoPose_PROGRESS_PHOTO_POSE_BACK:
+		return domain.ProgressPhotoPoseBack
+	default:
+		return domain.ProgressPhotoPoseUnknown
+	}
+}
+
+func ProgressPhotoToProto(photo domain.ProgressPhoto) *desc.ProgressPhoto {
+	photoProto := &desc.ProgressPhoto{
+		Id:        photo.ID.String(),
+		Pose:      ProgressPhotoPoseToProto(photo.Pose),
+		TakenAt:   timestamppb.New(photo.TakenAt),
+		Url:       photo.URL,
+		Variants:  ImageVariantsToProto(photo.Variants),
+		IsPrivate: photo.IsPrivate,
+		Note:      photo.Note,
+		CreatedAt: timestamppb.New(photo.CreatedAt),
+	}
+
+	if photo.MeasurementID.IsValid {
+		measurementID := photo.MeasurementID.V.String()
+		photoProto.MeasurementId = &measurementID
+	}
+
+	return photoProto
+}
+
+func ProgressPhotosToProto(photos []domain.ProgressPhoto) []*desc.ProgressPhoto {
+	result := make([]*desc.ProgressPhoto, 0, len(photos))
+	for _, photo := range photos {
+		result = append(result, ProgressPhotoToProto(photo))
+	}
+
+	return result
+}
+
+func ProgressPhotoComparisonsToProto(comparisons []dto.ProgressPhotoComparisonDTO) []*desc.ProgressPhotoComparison {
+	result := make([]*desc.ProgressPhotoComparison, 0, len(comparisons))
+	for _, comparison := range comparisons {
+		comparisonProto := &desc.ProgressPhotoComparison{
+			Pose: ProgressPhotoPoseToProto(comparison.Pose),
+		}
+
+		if comparison.Before.IsValid {
+			comparisonProto.Before = ProgressPhotoToProto(comparison.Before.V)
+		}
+
+		if comparison.After.IsValid {
+			comparisonProto.After = ProgressPhotoToProto(comparison.After.V)
+		}
+
+		result = append(result, comparisonProto)
+	}
+
+	return result
+}
@@ -16,9 +16,40 @@ func RoutineToProto(routine domain.Routine) *desc.Routine {
 		Description: routine.Description,
 		CreatedAt:   timestamppb.New(routine.CreatedAt),
 		UpdatedAt:   timestamppb.New(routine.UpdatedAt),
+		WarmupSteps: WarmupStepsToProto(routine.WarmupSteps),
 	}
 }
 
+func WarmupStepsToProto(steps []domain.WarmupStep) []*desc.WarmupStep {
+	result := make([]*desc.WarmupStep, 0, len(steps))
+	for _, step := range steps {
+		result = append(result, &desc.WarmupStep{
+			Percent: int32(step.Percent),
+			Reps:    int32(step.Reps),
+		})
+	}
+
+	return result
+}
+
+// WarmupSchemeFromProto returns the warm-up steps of the scheme, the default ones if the
+// scheme asks for them.
+func WarmupSchemeFromProto(scheme *desc.WarmupScheme) []domain.WarmupStep {
+	if scheme.GetUseDefaultSteps() {
+		return domain.DefaultWarmupSteps()
+	}
+
+	steps := make([]domain.WarmupStep, 0, len(scheme.GetSteps()))
+	for _, step := range scheme.GetSteps() {
+		steps = append(steps, domain.WarmupStep{
+			Percent: int(step.GetPercent()),
+			Reps:    int(step.GetReps()),
+		})
+	}
+
+	return steps
+}
+
 func RoutinesToProto(routines []domain.Routine) []*desc.Routine {
 	result := make([]*desc.Routine, 0, len(routines))
 	for _, routine := range routines {
@@ -35,6 +66,7 @@ func ExerciseInstanceToProto(instance domain.ExerciseInstance) *desc.ExerciseIns
 		ExerciseId: instance.ExerciseID.String(),
 		CreatedAt:  timestamppb.New(instance.CreatedAt),
 		UpdatedAt:  timestamppb.New(instance.UpdatedAt),
+		Group:      ExerciseGroupToProto(instance.Group),
 	}
 }
 
@@ -47,7 +79,7 @@ func ExerciseInstancesToProto(instances []domain.ExerciseInstance) []*desc.Exerc
 	return result
 }
 
-func ExerciseInstanceDetailToProto(instance dto.ExerciseInstanceDetailsDTO) *desc.ExerciseInstanceDetails {
+func ExerciseInstanceDetailToProto(instance dto.ExerciseInstanceDetailsDTO, units Units) *desc.ExerciseInstanceDetails {
 	return &desc.ExerciseInstanceDetails{
 		ExerciseInstance: &desc.ExerciseInstance{
 			Id:         instance.ID.String(),
@@ -55,23 +87,24 @@ func ExerciseInstanceDetailToProto(instance dto.ExerciseInstanceDetailsDTO) *des
 			ExerciseId: instance.ExerciseID.String(),
 			CreatedAt:  timestamppb.New(instance.CreatedAt),
 			UpdatedAt:  timestamppb.New(instance.UpdatedAt),
+			Group:      ExerciseGroupToProto(instance.Group),
 		},
 		Exercise: ExerciseToProto(instance.Exercise),
-		Sets:    SetsToProto(instance.Sets),
+		Sets:    SetsToProto(instance.Sets, units),
 	}
 }
 
-func ExerciseInstanceDetailsToProto(instances []dto.ExerciseInstanceDetailsDTO) []*desc.ExerciseInstanceDetails {
+func ExerciseInstanceDetailsToProto(instances []dto.ExerciseInstanceDetailsDTO, units Units) []*desc.ExerciseInstanceDetails {
 	result := make([]*desc.ExerciseInstanceDetails, 0, len(instances))
 	for _, instance := range instances {
-		result = append(result, ExerciseInstanceDetailToProto(instance))
+		result = append(result, ExerciseInstanceDetailToProto(instance, units))
 	}
 
 	return result
 }
 
-func RoutineDetailsDTOToProto(instance dto.RoutineDetailsDTO) *desc.RoutineDetailResponse {
-	exerciseInstances := ExerciseInstanceDetailsToProto(instance.ExerciseInstances)
+func RoutineDetailsDTOToProto(instance dto.RoutineDetailsDTO, units Units) *desc.RoutineDetailResponse {
+	exerciseInstances := ExerciseInstanceDetailsToProto(instance.ExerciseInstances, units)
 
 	return &desc.RoutineDetailResponse{
 		Routine: &desc.Routine{
@@ -81,6 +114,7 @@ func RoutineDetailsDTOToProto(instance dto.RoutineDetailsDTO) *desc.RoutineDetai
 			Description: instance.Description,
 			CreatedAt:   timestamppb.New(instance.CreatedAt),
 			UpdatedAt:   timestamppb.New(instance.UpdatedAt),
+			WarmupSteps: WarmupStepsToProto(instance.WarmupSteps),
 		},
 		ExerciseInstances: exerciseInstances,
 	}
@@ -0,0 +1,31 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	desc "fitness-trainer/pkg/workouts"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func RoutineShareLinkToProto(link domain.RoutineShareLink) *desc.RoutineShareLink {
+	linkProto := &desc.RoutineShareLink{
+		Id:         link.ID.String(),
+		RoutineId:  link.RoutineID.String(),
+		ShareToken: link.Token,
+		CreatedAt:  timestamppb.New(link.CreatedAt),
+	}
+	if !link.ExpiresAt.IsZero() {
+		linkProto.ExpiresAt = timestamppb.New(link.ExpiresAt)
+	}
+
+	return linkProto
+}
+
+func RoutineShareLinksToProto(links []domain.RoutineShareLink) []*desc.RoutineShareLink {
+	result := make([]*desc.RoutineShareLink, 0, len(links))
+	for _, link := range links {
+		result = append(result, RoutineShareLinkToProto(link))
+	}
+
+	return result
+}
@@ -0,0 +1,26 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	desc "fitness-trainer/pkg/workouts"
+)
+
+func RoutineTemplateToProto(template domain.Routine, locale string) *desc.RoutineTemplate {
+	routine := RoutineToProto(template.Localized(locale))
+	// templates have no owner
+	routine.UserId = ""
+
+	return &desc.RoutineTemplate{
+		Routine: routine,
+		Program: template.Program,
+	}
+}
+
+func RoutineTemplatesToProto(templates []domain.Routine, locale string) []*desc.RoutineTemplate {
+	result := make([]*desc.RoutineTemplate, 0, len(templates))
+	for _, template := range templates {
+		result = append(result, RoutineTemplateToProto(template, locale))
+	}
+
+	return result
+}
@@ -0,0 +1,110 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	desc "fitness-trainer/pkg/workouts"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func RoutineVersionToProto(version domain.RoutineVersion) *desc.RoutineVersion {
+	var routineID *string
+	if version.RoutineID.IsValid {
+		routineIDValue := version.RoutineID.V.String()
+		routineID = &routineIDValue
+	}
+	return &desc.RoutineVersion{
+		Id:            version.ID.String(),
+		RoutineId:     routineID,
+		Version:       int32(version.Version),
+		Name:          version.Snapshot.Name,
+		ExerciseCount: int32(len(version.Snapshot.Exercises)),
+		CreatedAt:     timestamppb.New(version.CreatedAt),
+	}
+}
+
+func RoutineVersionsToProto(versions []domain.RoutineVersion) []*desc.RoutineVersion {
+	result := make([]*desc.RoutineVersion, 0, len(versions))
+	for _, version := range versions {
+		result = append(result, RoutineVersionToProto(version))
+	}
+
+	return result
+}
+
+func RoutineVersionSnapshotToProto(version domain.RoutineVersion, units Units) *desc.GetRoutineVersionResponse {
+	exercises := make([]*desc.RoutineVersionExercise, 0, len(version.Snapshot.Exercises))
+	for _, exercise := range version.Snapshot.Exercises {
+		exercises = append(exercises, &desc.RoutineVersionExercise{
+			ExerciseInstanceId: exercise.ExerciseInstanceID.String(),
+			ExerciseId:         exercise.ExerciseID.String(),
+			Group:              ExerciseGroupToProto(exercise.Group),
+			Sets:               SetsToProto(exercise.Sets, units),
+		})
+	}
+
+	return &desc.GetRoutineVersionResponse{
+		Version:     RoutineVersionToProto(version),
+		Description: version.Snapshot.Description,
+		WarmupSteps: WarmupStepsToProto(version.Snapshot.WarmupSteps),
+		Exercises:   exercises,
+	}
+}
+
+func RoutineChangeActionToProto(action domain.RoutineChangeAction) desc.RoutineChangeAction {
+	switch action {
+	case domain.RoutineChangeActionAdded:
+		return desc.RoutineChangeAction_ROUTINE_CHANGE_ACTION_ADDED
+	case domain.RoutineChangeActionRemoved:
+		return desc.RoutineChangeAction_ROUTINE_CHANGE_ACTION_REMOVED
+	case domain.RoutineChangeActionModified:
+		return desc.RoutineChangeAction_ROUTINE_CHANGE_ACTION_MODIFIED
+	default:
+		return desc.RoutineChangeAction_ROUTINE_CHANGE_ACTION_UNSPECIFIED
+	}
+}
+
+func FieldChangesToProto(changes map[string]domain.FieldChange) map[string]*desc.FieldChange {
+	result := make(map[string]*desc.FieldChange, len(changes))
+	for field, change := range changes {
+		result[field] = &desc.FieldChange{
+			From: change.From,
+			To:   change.To,
+		}
+	}
+
+	return result
+}
+
+func RoutineDiffToProto(diff domain.RoutineDiff, units Units) *desc.DiffRoutineVersionsResponse {
+	exercises := make([]*desc.ExerciseInstanceChange, 0, len(diff.Exercises))
+	for _, exercise := range diff.Exercises {
+		sets := make([]*desc.SetChange, 0, len(exercise.Sets))
+		for _, set := range exercise.Sets {
+			change := &desc.SetChange{
+				SetId:  set.SetID.String(),
+				Action: RoutineChangeActionToProto(set.Action),
+			}
+			if set.Before.IsValid {
+				change.Before = SetToProto(set.Before.V, units)
+			}
+			if set.After.IsValid {
+				change.After = SetToProto(set.After.V, units)
+			}
+			sets = append(sets, change)
+		}
+
+		exercises = append(exercises, &desc.ExerciseInstanceChange{
+			ExerciseInstanceId: exercise.ExerciseInstanceID.String(),
+			ExerciseId:         exercise.ExerciseID.String(),
+			Action:             RoutineChangeActionToProto(exercise.Action),
+			Changes:            FieldChangesToProto(exercise.Changes),
+			Sets:               sets,
+		})
+	}
+
+	return &desc.DiffRoutineVersionsResponse{
+		Changes:   FieldChangesToProto(diff.Changes),
+		Exercises: exercises,
+	}
+}
@@ -8,23 +8,25 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func SetToProto(set domain.Set) *desc.Set {
+func SetToProto(set domain.Set, units Units) *desc.Set {
 	return &desc.Set{
 		Id:                 set.ID.String(),
 		ExerciseInstanceId: set.ExerciseInstanceID.String(),
 		SetType:            SetTypeToProto(set.SetType),
 		Reps:               int32(set.Reps),
-		Weight:             set.Weight,
+		Weight:             units.WeightToProto(set.Weight),
 		Time:               durationpb.New(set.Time),
+		Rest:               durationpb.New(set.Rest),
+		Position:           int32(set.Position),
 		CreatedAt:          timestamppb.New(set.CreatedAt),
 		UpdatedAt:          timestamppb.New(set.UpdatedAt),
 	}
 }
 
-func SetsToProto(sets []domain.Set) []*desc.Set {
+func SetsToProto(sets []domain.Set, units Units) []*desc.Set {
 	result := make([]*desc.Set, 0, len(sets))
 	for _, set := range sets {
-		result = append(result, SetToProto(set))
+		result = append(result, SetToProto(set, units))
 	}
 
 	return result
@@ -0,0 +1,82 @@
+package mappers
+
+import (
+	"time"
+
+	"fitness-trainer/internal/domain"
+	desc "fitness-trainer/pkg/workouts"
+)
+
+// Units converts weights and lengths between the metric units they are stored in and the
+// units the user prefers.
+type Units struct {
+	Weight domain.MeasurementUnit
+	Length domain.MeasurementUnit
+}
+
+// MetricUnits leave values as they are stored
+var MetricUnits = Units{
+	Weight: domain.MeasurementUnitKilogram,
+	Length: domain.MeasurementUnitCentimeter,
+}
+
+func NewUnits(preferences domain.UserPreferences) Units {
+	return Units{
+		Weight: preferences.WeightUnit,
+		Length: preferences.LengthUnit,
+	}
+}
+
+func (u Units) WeightToProto(kilograms float32) float32 {
+	return float32(u.Weight.FromBase(float64(kilograms)))
+}
+
+func (u Units) WeightFromProto(weight float32) float32 {
+	return float32(u.Weight.ToBase(float64(weight)))
+}
+
+func (u Units) LengthToProto(centimeters float32) float32 {
+	return float32(u.Length.FromBase(float64(centimeters)))
+}
+
+func (u Units) LengthFromProto(length float32) float32 {
+	return float32(u.Length.ToBase(float64(length)))
+}
+
+// unitFor returns the preferred unit of the same dimension as the base unit
+func (u Units) unitFor(base domain.MeasurementUnit) domain.MeasurementUnit {
+	switch base {
+	case domain.MeasurementUnitKilogram:
+		return u.Weight
+	case domain.MeasurementUnitCentimeter:
+		return u.Length
+	default:
+		return base
+	}
+}
+
+func DayOfWeekToProto(day time.Weekday) desc.DayOfWeek {
+	if day == time.Sunday {
+		return desc.DayOfWeek_DAY_OF_WEEK_SUNDAY
+	}
+
+	return desc.DayOfWeek(day)
+}
+
+func DayOfWeekFromProto(day desc.DayOfWeek) time.Weekday {
+	if day == desc.DayOfWeek_DAY_OF_WEEK_SUNDAY {
+		return time.Sunday
+	}
+
+	return time.Weekday(day)
+}
+
+func UserPreferencesToProto(preferences domain.UserPreferences) *desc.UserPreferences {
+	return &desc.UserPreferences{
+		WeightUnit:     MeasurementUnitToProto(preferences.WeightUnit),
+		LengthUnit:     MeasurementUnitToProto(preferences.LengthUnit),
+		Locale:         preferences.Locale,
+		FirstDayOfWeek: DayOfWeekToProto(preferences.FirstDayOfWeek),
+		Timezone:       preferences.Timezone,
+	}
+}
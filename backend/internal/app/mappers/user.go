@@ -7,17 +7,19 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func UserToProto(user domain.User) *desc.User {
+func UserToProto(user domain.User, units Units) *desc.User {
 	userProto := &desc.User{
-		Id:                user.ID.String(),
-		Email:             user.Email,
-		FirstName:         user.FirstName,
-		LastName:          user.LastName,
-		Weight:            user.Weight,
-		Height:            user.Height,
-		ProfilePictureUrl: user.ProfilePicURL,
-		CreatedAt:         timestamppb.New(user.CreatedAt),
-		UpdatedAt:         timestamppb.New(user.UpdatedAt),
+		Id:                     user.ID.String(),
+		Email:                  user.Email,
+		FirstName:              user.FirstName,
+		LastName:               user.LastName,
+		Weight:                 units.WeightToProto(user.Weight),
+		Height:                 units.LengthToProto(user.Height),
+		ProfilePictureUrl:      user.ProfilePicURL,
+		IsAdmin:                user.Role == domain.UserRoleAdmin,
+		ProfilePictureVariants: ImageVariantsToProto(user.ProfilePictureVariants),
+		CreatedAt:              timestamppb.New(user.CreatedAt),
+		UpdatedAt:              timestamppb.New(user.UpdatedAt),
 	}
 	if !user.DateOfBirth.IsZero() {
 		userProto.DateOfBirth = timestamppb.New(user.DateOfBirth)
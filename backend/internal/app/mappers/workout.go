@@ -26,28 +26,41 @@ func WorkoutToProto(workout domain.Workout) *desc.Workout {
 		routineIDValue := workout.RoutineID.V.String()
 		routineID = &routineIDValue
 	}
+	var routineVersionID *string
+	if workout.RoutineVersionID.IsValid {
+		routineVersionIDValue := workout.RoutineVersionID.V.String()
+		routineVersionID = &routineVersionIDValue
+	}
 	return &desc.Workout{
-		Id:            workout.ID.String(),
-		RoutineId:     routineID,
-		UserId:        workout.UserID.String(),
-		CreatedAt:     timestamppb.New(workout.CreatedAt),
-		Notes:         workout.Notes,
-		Rating:        int32(workout.Rating),
-		FinishedAt:    timestamppb.New(workout.FinishedAt),
-		UpdatedAt:     timestamppb.New(workout.UpdatedAt),
-		Reasoning:     workout.Reasoning,
-		IsAiGenerated: workout.IsAIGenerated,
+		Id:               workout.ID.String(),
+		RoutineId:        routineID,
+		RoutineVersionId: routineVersionID,
+		UserId:           workout.UserID.String(),
+		CreatedAt:        timestamppb.New(workout.CreatedAt),
+		Notes:            workout.Notes,
+		Rating:           int32(workout.Rating),
+		FinishedAt:       timestamppb.New(workout.FinishedAt),
+		UpdatedAt:        timestamppb.New(workout.UpdatedAt),
+		Reasoning:        workout.Reasoning,
+		IsAiGenerated:    workout.IsAIGenerated,
 	}
 }
 
-func SetLogToProto(setLog domain.ExerciseSetLog) *desc.SetLog {
-	return &desc.SetLog{
-		Id:        setLog.ID.String(),
-		Reps:      int32(setLog.Reps),
-		Weight:    setLog.Weight,
-		CreatedAt: timestamppb.New(setLog.CreatedAt),
-		UpdatedAt: timestamppb.New(setLog.UpdatedAt),
+func SetLogToProto(setLog domain.ExerciseSetLog, units Units) *desc.SetLog {
+	setLogProto := &desc.SetLog{
+		Id:          setLog.ID.String(),
+		Reps:        int32(setLog.Reps),
+		Weight:      units.WeightToProto(setLog.Weight),
+		CreatedAt:   timestamppb.New(setLog.CreatedAt),
+		UpdatedAt:   timestamppb.New(setLog.UpdatedAt),
+		CompletedAt: timestamppb.New(setLog.CompletedAt),
+		Position:    int32(setLog.Position),
+	}
+	if !setLog.StartedAt.IsZero() {
+		setLogProto.StartedAt = timestamppb.New(setLog.StartedAt)
 	}
+
+	return setLogProto
 }
 
 func ExerciseLogToProto(exerciseLog domain.ExerciseLog) *desc.ExerciseLog {
@@ -59,50 +72,60 @@ func ExerciseLogToProto(exerciseLog domain.ExerciseLog) *desc.ExerciseLog {
 		Notes:       exerciseLog.Notes,
 		CreatedAt:   timestamppb.New(exerciseLog.CreatedAt),
 		UpdatedAt:   timestamppb.New(exerciseLog.UpdatedAt),
+		Group:       ExerciseGroupToProto(exerciseLog.Group),
+		Position:    int32(exerciseLog.Position),
 	}
 }
 
-func ExerciseLogDTOsToProto(in []dto.ExerciseLogDTO) []*desc.ExerciseLogDetails {
+func ExerciseLogDTOsToProto(in []dto.ExerciseLogDTO, units Units) []*desc.ExerciseLogDetails {
 	out := make([]*desc.ExerciseLogDetails, 0, len(in))
 	for _, ex := range in {
-		out = append(out, ExerciseLogDTOToProto(ex))
+		out = append(out, ExerciseLogDTOToProto(ex, units))
 	}
 	return out
 }
 
-func ExpectedSetsToProto(expectedSets []domain.ExpectedSet) []*desc.ExpectedSet {
+func ExpectedSetsToProto(expectedSets []domain.ExpectedSet, units Units) []*desc.ExpectedSet {
 	out := make([]*desc.ExpectedSet, 0, len(expectedSets))
 	for _, expectedSet := range expectedSets {
-		out = append(out, ExpectedSetToProto(expectedSet))
+		out = append(out, ExpectedSetToProto(expectedSet, units))
 	}
 	return out
 }
 
-func ExpectedSetToProto(expectedSet domain.ExpectedSet) *desc.ExpectedSet {
-	return &desc.ExpectedSet{
+func ExpectedSetToProto(expectedSet domain.ExpectedSet, units Units) *desc.ExpectedSet {
+	expectedSetProto := &desc.ExpectedSet{
 		Id:            expectedSet.ID.String(),
 		ExerciseLogId: expectedSet.ExerciseLogID.String(),
 		Reps:          int32(expectedSet.Reps),
-		Weight:        expectedSet.Weight,
+		Weight:        units.WeightToProto(expectedSet.Weight),
 		Time:          durationpb.New(expectedSet.Time),
+		Rest:          durationpb.New(expectedSet.Rest),
 		CreatedAt:     timestamppb.New(expectedSet.CreatedAt),
 		UpdatedAt:     timestamppb.New(expectedSet.UpdatedAt),
+		IsWarmup:      expectedSet.IsWarmup,
+		Position:      int32(expectedSet.Position),
 	}
+	if expectedSet.Loadout.IsValid {
+		expectedSetProto.Loadout = PlateLoadoutToProto(expectedSet.Loadout.V, units)
+	}
+
+	return expectedSetProto
 }
 
-func ExerciseLogDTOToProto(in dto.ExerciseLogDTO) *desc.ExerciseLogDetails {
+func ExerciseLogDTOToProto(in dto.ExerciseLogDTO, units Units) *desc.ExerciseLogDetails {
 	return &desc.ExerciseLogDetails{
 		ExerciseLog:  ExerciseLogToProto(in.ExerciseLog),
 		Exercise:     ExerciseToProto(in.Exercise),
-		SetLogs:      SetLogsToProto(in.SetLogs),
-		ExpectedSets: ExpectedSetsToProto(in.ExpectedSets),
+		SetLogs:      SetLogsToProto(in.SetLogs, units),
+		ExpectedSets: ExpectedSetsToProto(in.ExpectedSets, units),
 	}
 }
 
-func SetLogsToProto(setLogs []domain.ExerciseSetLog) []*desc.SetLog {
+func SetLogsToProto(setLogs []domain.ExerciseSetLog, units Units) []*desc.SetLog {
 	setLogsList := make([]*desc.SetLog, 0, len(setLogs))
 	for _, setLog := range setLogs {
-		setLogsList = append(setLogsList, SetLogToProto(setLog))
+		setLogsList = append(setLogsList, SetLogToProto(setLog, units))
 	}
 
 	return setLogsList
@@ -132,3 +155,54 @@ func WorkoutsDTOToProto(workoutDTOs []dto.WorkoutDTO) []*desc.GetWorkoutsRespons
 
 	return result
 }
+
+func WorkoutCalendarDaysToProto(days []dto.WorkoutCalendarDayDTO) []*desc.WorkoutCalendarDay {
+	result := make([]*desc.WorkoutCalendarDay, 0, len(days))
+	for _, day := range days {
+		result = append(result, &desc.WorkoutCalendarDay{
+			Date:         timestamppb.New(day.Date),
+			WorkoutCount: int32(day.WorkoutCount),
+		})
+	}
+
+	return result
+}
+
+func WorkoutStreakToProto(streak dto.WorkoutStreakDTO) *desc.GetWorkoutStreakResponse {
+	response := &desc.GetWorkoutStreakResponse{
+		CurrentDays:  int32(streak.CurrentDays),
+		LongestDays:  int32(streak.LongestDays),
+		CurrentWeeks: int32(streak.CurrentWeeks),
+		LongestWeeks: int32(streak.LongestWeeks),
+	}
+	if !streak.LastWorkoutAt.IsZero() {
+		response.LastWorkoutAt = timestamppb.New(streak.LastWorkoutAt)
+	}
+
+	return response
+}
+
+func RestAnalyticsToProto(analytics dto.RestAnalyticsDTO) *desc.GetWorkoutRestAnalyticsResponse {
+	intervals := make([]*desc.RestInterval, 0, len(analytics.Intervals))
+	for _, interval := range analytics.Intervals {
+		intervalProto := &desc.RestInterval{
+			ExerciseLogId:    interval.ExerciseLogID.String(),
+			PreviousSetLogId: interval.PreviousSetLogID.String(),
+			SetLogId:         interval.SetLogID.String(),
+			Actual:           durationpb.New(interval.Actual),
+			IsLong:           interval.IsLong,
+		}
+		if interval.Target.IsValid {
+			intervalProto.Target = durationpb.New(interval.Target.V)
+		}
+		intervals = append(intervals, intervalProto)
+	}
+
+	return &desc.GetWorkoutRestAnalyticsResponse{
+		Intervals:         intervals,
+		TotalRest:         durationpb.New(analytics.TotalRest),
+		AverageRest:       durationpb.New(analytics.AverageRest),
+		AverageTargetRest: durationpb.New(analytics.AverageTargetRest),
+		LongGaps:          int32(analytics.LongGaps),
+	}
+}
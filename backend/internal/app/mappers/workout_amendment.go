@@ -0,0 +1,47 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	desc "fitness-trainer/pkg/workouts"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func WorkoutAmendmentToProto(amendment domain.WorkoutAmendment) *desc.WorkoutAmendment {
+	changes := make(map[string]*desc.FieldChange, len(amendment.Changes))
+	for field, change := range amendment.Changes {
+		changes[field] = &desc.FieldChange{
+			From: change.From,
+			To:   change.To,
+		}
+	}
+
+	amendmentProto := &desc.WorkoutAmendment{
+		Id:            amendment.ID.String(),
+		WorkoutId:     amendment.WorkoutID.String(),
+		ExerciseLogId: amendment.ExerciseLogID.String(),
+		Action:        amendment.Action.String(),
+		Changes:       changes,
+		CreatedAt:     timestamppb.New(amendment.CreatedAt),
+	}
+	// The author is unset once their account is deleted
+	if amendment.UserID != (domain.ID{}) {
+		userID := amendment.UserID.String()
+		amendmentProto.UserId = &userID
+	}
+	if amendment.SetLogID != (domain.ID{}) {
+		setLogID := amendment.SetLogID.String()
+		amendmentProto.SetLogId = &setLogID
+	}
+
+	return amendmentProto
+}
+
+func WorkoutAmendmentsToProto(amendments []domain.WorkoutAmendment) []*desc.WorkoutAmendment {
+	result := make([]*desc.WorkoutAmendment, 0, len(amendments))
+	for _, amendment := range amendments {
+		result = append(result, WorkoutAmendmentToProto(amendment))
+	}
+
+	return result
+}
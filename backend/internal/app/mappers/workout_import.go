@@ -0,0 +1,90 @@
+package mappers
+
+import (
+	"fitness-trainer/internal/domain"
+	desc "fitness-trainer/pkg/workouts"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func WorkoutImportSourceFromProto(source desc.WorkoutImportSource) domain.WorkoutImportSource {
+	switch source {
+	case desc.WorkoutImportSource_WORKOUT_IMPORT_SOURCE_STRONG:
+		return domain.WorkoutImportSourceStrong
+	case desc.WorkoutImportSource_WORKOUT_IMPORT_SOURCE_HEVY:
+		return domain.WorkoutImportSourceHevy
+	case desc.WorkoutImportSource_WORKOUT_IMPORT_SOURCE_FITNOTES:
+		return domain.WorkoutImportSourceFitNotes
+	default:
+		return ""
+	}
+}
+
+func WorkoutImportSourceToProto(source domain.WorkoutImportSource) desc.WorkoutImportSource {
+	switch source {
+	case domain.WorkoutImportSourceStrong:
+		return desc.WorkoutImportSource_WORKOUT_IMPORT_SOURCE_STRONG
+	case domain.WorkoutImportSourceHevy:
+		return desc.WorkoutImportSource_WORKOUT_IMPORT_SOURCE_HEVY
+	case domain.WorkoutImportSourceFitNotes:
+		return desc.WorkoutImportSource_WORKOUT_IMPORT_SOURCE_FITNOTES
+	default:
+		return desc.WorkoutImportSource_WORKOUT_IMPORT_SOURCE_UNSPECIFIED
+	}
+}
+
+func WorkoutImportStatusToProto(status domain.WorkoutImportStatus) desc.WorkoutImportStatus {
+	switch status {
+	case domain.WorkoutImportStatusReview:
+		return desc.WorkoutImportStatus_WORKOUT_IMPORT_STATUS_REVIEW
+	case domain.WorkoutImportStatusPending:
+		return desc.WorkoutImportStatus_WORKOUT_IMPORT_STATUS_PENDING
+	case domain.WorkoutImportStatusRunning:
+		return desc.WorkoutImportStatus_WORKOUT_IMPORT_STATUS_RUNNING
+	case domain.WorkoutImportStatusDone:
+		return desc.WorkoutImportStatus_WORKOUT_IMPORT_STATUS_DONE
+	case domain.WorkoutImportStatusFailed:
+		return desc.WorkoutImportStatus_WORKOUT_IMPORT_STATUS_FAILED
+	default:
+		return desc.WorkoutImportStatus_WORKOUT_IMPORT_STATUS_UNSPECIFIED
+	}
+}
+
+func WorkoutImportToProto(workoutImport domain.WorkoutImport) *desc.WorkoutImport {
+	mappings := make([]*desc.WorkoutImportMapping, 0, len(workoutImport.Mappings))
+	for _, mapping := range workoutImport.Mappings {
+		mappingProto := &desc.WorkoutImportMapping{
+			Name:         mapping.Name,
+			ExerciseName: mapping.ExerciseName,
+			Similarity:   mapping.Similarity,
+			Skip:         mapping.Skip,
+			SetCount:     int32(mapping.SetCount),
+		}
+		if mapping.ExerciseID.IsValid {
+			exerciseID := mapping.ExerciseID.V.String()
+			mappingProto.ExerciseId = &exerciseID
+		}
+
+		mappings = append(mappings, mappingProto)
+	}
+
+	return &desc.WorkoutImport{
+		Id:           workoutImport.ID.String(),
+		Source:       WorkoutImportSourceToProto(workoutImport.Source),
+		Status:       WorkoutImportStatusToProto(workoutImport.Status),
+		Mappings:     mappings,
+		CreatedCount: int32(workoutImport.Created),
+		SkippedCount: int32(workoutImport.Skipped),
+		LastError:    workoutImport.LastError,
+		CreatedAt:    timestamppb.New(workoutImport.CreatedAt),
+	}
+}
+
+func WorkoutImportsToProto(imports []domain.WorkoutImport) []*desc.WorkoutImport {
+	result := make([]*desc.WorkoutImport, 0, len(imports))
+	for _, workoutImport := range imports {
+		result = append(result, WorkoutImportToProto(workoutImport))
+	}
+
+	return result
+}
@@ -0,0 +1,204 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/draw"
+)
+
+// orientationTag is the EXIF tag of the orientation of the camera relative to the scene
+const orientationTag = 0x0112
+
+// jpegOrientation returns the EXIF orientation of a JPEG image, 1 if it has none.
+func jpegOrientation(data []byte) int {
+	if len(data) < 2 || data[0] != 0xff || data[1] != 0xd8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xff {
+			return 1
+		}
+
+		marker := data[i+1]
+		// Metadata segments precede the start of the scan
+		if marker == 0xda || marker == 0xd9 {
+			return 1
+		}
+
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return 1
+		}
+
+		segment := data[i+4 : i+2+length]
+		if marker == 0xe1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return tiffOrientation(segment[6:])
+		}
+
+		i += 2 + length
+	}
+
+	return 1
+}
+
+// webpOrientation returns the EXIF orientation of a WebP image, 1 if it has none.
+func webpOrientation(data []byte) int {
+	orientation := 1
+	_ = walkWebPChunks(data, func(fourCC string, chunk []byte) bool {
+		if fourCC == "EXIF" {
+			orientation = tiffOrientation(bytes.TrimPrefix(chunk, []byte("Exif\x00\x00")))
+		}
+		return true
+	})
+
+	return orientation
+}
+
+// tiffOrientation reads the orientation from the first IFD of EXIF data.
+func tiffOrientation(data []byte) int {
+	if len(data) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(data[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(data[4:]))
+	if offset < 8 || offset+2 > len(data) {
+		return 1
+	}
+
+	count := int(order.Uint16(data[offset:]))
+	for i := range count {
+		entry := offset + 2 + i*12
+		if entry+12 > len(data) {
+			return 1
+		}
+
+		if order.Uint16(data[entry:]) == orientationTag {
+			orientation := int(order.Uint16(data[entry+8:]))
+			if orientation < 1 || orientation > 8 {
+				return 1
+			}
+			return orientation
+		}
+	}
+
+	return 1
+}
+
+// walkWebPChunks calls fn with every chunk of the RIFF container of a WebP image until fn
+// returns false.
+func walkWebPChunks(data []byte, fn func(fourCC string, chunk []byte) bool) error {
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return fmt.Errorf("invalid webp container")
+	}
+
+	for i := 12; i+8 <= len(data); {
+		size := int(binary.LittleEndian.Uint32(data[i+4:]))
+		if i+8+size > len(data) {
+			return fmt.Errorf("invalid webp chunk size")
+		}
+
+		if !fn(string(data[i:i+4]), data[i+8:i+8+size]) {
+			return nil
+		}
+
+		// Chunks are padded to an even size
+		i += 8 + size + size%2
+	}
+
+	return nil
+}
+
+// stripWebPMetadata removes the EXIF and XMP chunks of a WebP image.
+func stripWebPMetadata(data []byte) ([]byte, error) {
+	const (
+		xmpFlag  = 0x04
+		exifFlag = 0x08
+	)
+
+	result := append([]byte{}, data[:12]...)
+	err := walkWebPChunks(data, func(fourCC string, chunk []byte) bool {
+		switch fourCC {
+		case "EXIF", "XMP ":
+			return true
+		case "VP8X":
+			chunk = append([]byte{}, chunk...)
+			if len(chunk) > 0 {
+				chunk[0] &^= xmpFlag | exifFlag
+			}
+		}
+
+		result = append(result, fourCC...)
+		result = binary.LittleEndian.AppendUint32(result, uint32(len(chunk)))
+		result = append(result, chunk...)
+		if len(chunk)%2 == 1 {
+			result = append(result, 0)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	binary.LittleEndian.PutUint32(result[4:], uint32(len(result)-8))
+
+	return result, nil
+}
+
+// orient transforms the image as its EXIF orientation tells, so it's shown upright
+// without the tag.
+func orient(src image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return src
+	}
+
+	bounds := src.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+
+	w, h := bounds.Dx(), bounds.Dy()
+	dw, dh := w, h
+	// Orientations from 5 to 8 swap the sides
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := range dh {
+		for x := range dw {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+
+			copy(dst.Pix[dst.PixOffset(x, y):dst.PixOffset(x, y)+4], rgba.Pix[rgba.PixOffset(sx, sy):rgba.PixOffset(sx, sy)+4])
+		}
+	}
+
+	return dst
+}
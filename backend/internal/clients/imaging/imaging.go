@@ -0,0 +1,115 @@
+package imaging
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+
+	// Decoders of the supported upload formats
+	_ "image/gif"
+
+	_ "golang.org/x/image/webp"
+
+	"fitness-trainer/internal/domain"
+
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/image/draw"
+)
+
+const (
+	jpegQuality = 85
+	// originalJPEGQuality is higher than the one of thumbnails, re-encoded originals are
+	// shown full size
+	originalJPEGQuality = 92
+	// maxPixels protects the worker from decompression bombs
+	maxPixels = 50_000_000
+)
+
+type Processor struct{}
+
+func New() *Processor {
+	return &Processor{}
+}
+
+// Process applies the EXIF orientation of the image, strips its metadata and generates
+// thumbnails. Thumbnails are center-cropped to a square, scaled to each of the sizes and
+// encoded as JPEG. JPEG and PNG originals are re-encoded, WebP ones get their EXIF and XMP
+// chunks removed and GIFs, which carry no EXIF, are kept as is.
+func (p *Processor) Process(ctx context.Context, data []byte, sizes []int) (domain.ProcessedImage, error) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "imaging.Process")
+	defer span.Finish()
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return domain.ProcessedImage{}, fmt.Errorf("failed to decode image config: %w", err)
+	}
+
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxPixels {
+		return domain.ProcessedImage{}, fmt.Errorf("image of %dx%d is not supported", config.Width, config.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return domain.ProcessedImage{}, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	result := domain.ProcessedImage{
+		Original:    data,
+		ContentType: "image/" + format,
+		Thumbnails:  make(map[int][]byte, len(sizes)),
+	}
+
+	switch format {
+	case "jpeg":
+		src = orient(src, jpegOrientation(data))
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, src, &jpeg.Options{Quality: originalJPEGQuality}); err != nil {
+			return domain.ProcessedImage{}, fmt.Errorf("failed to encode image: %w", err)
+		}
+		result.Original = buf.Bytes()
+	case "png":
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, src); err != nil {
+			return domain.ProcessedImage{}, fmt.Errorf("failed to encode image: %w", err)
+		}
+		result.Original = buf.Bytes()
+	case "webp":
+		// There is no WebP encoder, so the pixels are kept and only thumbnails are oriented
+		orientation := webpOrientation(data)
+		if result.Original, err = stripWebPMetadata(data); err != nil {
+			return domain.ProcessedImage{}, err
+		}
+		src = orient(src, orientation)
+	}
+
+	square := centerSquare(src.Bounds())
+
+	for _, size := range sizes {
+		// Thumbnails are never upscaled
+		side := min(size, square.Dx())
+
+		dst := image.NewRGBA(image.Rect(0, 0, side, side))
+		draw.CatmullRom.Scale(dst, dst.Bounds(), src, square, draw.Src, nil)
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return domain.ProcessedImage{}, fmt.Errorf("failed to encode thumbnail: %w", err)
+		}
+
+		result.Thumbnails[size] = buf.Bytes()
+	}
+
+	return result, nil
+}
+
+func centerSquare(bounds image.Rectangle) image.Rectangle {
+	side := min(bounds.Dx(), bounds.Dy())
+	x := bounds.Min.X + (bounds.Dx()-side)/2
+	y := bounds.Min.Y + (bounds.Dy()-side)/2
+
+	return image.Rect(x, y, x+side, y+side)
+}
@@ -0,0 +1,128 @@
+package imaging
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+)
+
+// jpegWithOrientation encodes a landscape image with the EXIF orientation and a GPS tag.
+func jpegWithOrientation(t *testing.T, orientation int) []byte {
+	t.Helper()
+
+	src := image.NewRGBA(image.Rect(0, 0, 40, 20))
+	for y := range 20 {
+		for x := range 40 {
+			src.Set(x, y, color.RGBA{R: 255, A: 255})
+		}
+	}
+
+	var encoded bytes.Buffer
+	if err := jpeg.Encode(&encoded, src, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Big-endian TIFF with the orientation and GPS IFD pointer entries in the first IFD
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08\x00\x02")
+	tiff = append(tiff, 0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x00, byte(orientation), 0x00, 0x00)
+	tiff = append(tiff, 0x88, 0x25, 0x00, 0x04, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00)
+	tiff = append(tiff, 0x00, 0x00, 0x00, 0x00)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := []byte{0xff, 0xe1}
+	app1 = binary.BigEndian.AppendUint16(app1, uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	data := encoded.Bytes()
+	return append(append(append([]byte{}, data[:2]...), app1...), data[2:]...)
+}
+
+func TestProcessOrientsAndStripsJPEG(t *testing.T) {
+	tests := []struct {
+		name        string
+		orientation int
+		width       int
+		height      int
+	}{
+		{name: "upright", orientation: 1, width: 40, height: 20},
+		{name: "upside down", orientation: 3, width: 40, height: 20},
+		{name: "rotated clockwise", orientation: 6, width: 20, height: 40},
+		{name: "rotated counterclockwise", orientation: 8, width: 20, height: 40},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := jpegWithOrientation(t, tt.orientation)
+			if got := jpegOrientation(data); got != tt.orientation {
+				t.Fatalf("jpegOrientation() = %d, want %d", got, tt.orientation)
+			}
+
+			processed, err := New().Process(context.Background(), data, []int{16})
+			if err != nil {
+				t.Fatalf("Process() error = %v", err)
+			}
+
+			if processed.ContentType != "image/jpeg" {
+				t.Errorf("content type = %q, want image/jpeg", processed.ContentType)
+			}
+			if bytes.Contains(processed.Original, []byte("Exif")) {
+				t.Error("original still has EXIF")
+			}
+
+			config, err := jpeg.DecodeConfig(bytes.NewReader(processed.Original))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if config.Width != tt.width || config.Height != tt.height {
+				t.Errorf("original is %dx%d, want %dx%d", config.Width, config.Height, tt.width, tt.height)
+			}
+
+			if _, ok := processed.Thumbnails[16]; !ok {
+				t.Error("thumbnail of 16 pixels is missing")
+			}
+		})
+	}
+}
+
+func TestOrient(t *testing.T) {
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+
+	// A row of a red and a blue pixel
+	src := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	src.Set(0, 0, red)
+	src.Set(1, 0, blue)
+
+	tests := []struct {
+		orientation int
+		pixels      [][]color.RGBA
+	}{
+		{orientation: 1, pixels: [][]color.RGBA{{red, blue}}},
+		{orientation: 2, pixels: [][]color.RGBA{{blue, red}}},
+		{orientation: 3, pixels: [][]color.RGBA{{blue, red}}},
+		{orientation: 4, pixels: [][]color.RGBA{{red, blue}}},
+		{orientation: 5, pixels: [][]color.RGBA{{red}, {blue}}},
+		{orientation: 6, pixels: [][]color.RGBA{{red}, {blue}}},
+		{orientation: 7, pixels: [][]color.RGBA{{blue}, {red}}},
+		{orientation: 8, pixels: [][]color.RGBA{{blue}, {red}}},
+	}
+
+	for _, tt := range tests {
+		dst := orient(src, tt.orientation)
+
+		if dst.Bounds().Dy() != len(tt.pixels) || dst.Bounds().Dx() != len(tt.pixels[0]) {
+			t.Fatalf("orientation %d: got %v bounds", tt.orientation, dst.Bounds())
+		}
+		for y, row := range tt.pixels {
+			for x, want := range row {
+				if got := color.RGBAModel.Convert(dst.At(x, y)); got != want {
+					t.Errorf("orientation %d: pixel %d,%d = %v, want %v", tt.orientation, x, y, got, want)
+				}
+			}
+		}
+	}
+}
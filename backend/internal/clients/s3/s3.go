@@ -1,15 +1,27 @@
 package s3_client
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/logger"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 )
 
+const presignExpires = time.Minute * 15
+
 type Client struct {
 	client *s3.Client
 	bucket string
@@ -22,20 +34,190 @@ func New(client *s3.Client, bucket string) *Client {
 	}
 }
 
-func (c *Client) GeneratePutPresignedURL(ctx context.Context, key string) (string, error) {
+// PresignPutObject generates an upload URL which only accepts objects with the given
+// content type and, if size is positive, of exactly the given size.
+func (c *Client) PresignPutObject(ctx context.Context, key, contentType string, size int64) (string, error) {
 	presignClient := s3.NewPresignClient(c.client)
 
-	req, err := presignClient.PresignPutObject(ctx, &s3.PutObjectInput{
+	input := &s3.PutObjectInput{
+		Bucket:      aws.String(c.bucket),
+		Key:         aws.String(key),
+		ContentType: aws.String(contentType),
+	}
+	if size > 0 {
+		input.ContentLength = aws.Int64(size)
+	}
+
+	req, err := presignClient.PresignPutObject(ctx, input,
+		s3.WithPresignExpires(presignExpires),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate presigned URL: %w", err)
+	}
+
+	logger.Debugf("generated presigned URL for key %s: %s", key, req.URL)
+
+	return req.URL, nil
+}
+
+// PresignGetObject generates a download URL which is valid for ttl.
+func (c *Client) PresignGetObject(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	presignClient := s3.NewPresignClient(c.client)
+
+	req, err := presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(c.bucket),
 		Key:    aws.String(key),
 	},
-		s3.WithPresignExpires(time.Minute*15),
+		s3.WithPresignExpires(ttl),
 	)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate presigned URL: %w", err)
 	}
 
-	logger.Debugf("generated presigned URL for key %s: %s", key, req.URL)
-
 	return req.URL, nil
 }
+
+// HeadObject returns the content type and the size of the object, or domain.ErrNotFound
+// if there is no object with the key.
+func (c *Client) HeadObject(ctx context.Context, key string) (contentType string, size int64, err error) {
+	out, err := c.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return "", 0, domain.ErrNotFound
+		}
+		return "", 0, fmt.Errorf("failed to head object %s: %w", key, err)
+	}
+
+	return aws.ToString(out.ContentType), aws.ToInt64(out.ContentLength), nil
+}
+
+// GetObject reads the whole object, failing if it is larger than maxSize bytes.
+func (c *Client) GetObject(ctx context.Context, key string, maxSize int64) ([]byte, error) {
+	out, err := c.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get object %s: %w", key, err)
+	}
+	defer out.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(out.Body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object %s: %w", key, err)
+	}
+
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("object %s is larger than %d bytes", key, maxSize)
+	}
+
+	return data, nil
+}
+
+func (c *Client) PutObject(ctx context.Context, key, contentType string, data []byte) error {
+	_, err := c.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(c.bucket),
+		Key:         aws.String(key),
+		ContentType: aws.String(contentType),
+		Body:        bytes.NewReader(data),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put object %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteObject(ctx context.Context, key string) error {
+	_, err := c.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete object %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// ListObjects returns every object whose key starts with the prefix.
+func (c *Client) ListObjects(ctx context.Context, prefix string) ([]domain.StorageObject, error) {
+	paginator := s3.NewListObjectsV2Paginator(c.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(prefix),
+	})
+
+	var objects []domain.StorageObject
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects with prefix %s: %w", prefix, err)
+		}
+
+		for _, object := range page.Contents {
+			objects = append(objects, domain.StorageObject{
+				Key:          aws.ToString(object.Key),
+				Size:         aws.ToInt64(object.Size),
+				LastModified: aws.ToTime(object.LastModified),
+			})
+		}
+	}
+
+	return objects, nil
+}
+
+// ObjectURL returns the public URL of the object.
+func (c *Client) ObjectURL(key string) string {
+	options := c.client.Options()
+
+	u, err := url.Parse(aws.ToString(options.BaseEndpoint))
+	if err != nil || u.Host == "" {
+		u = &url.URL{Scheme: "https", Host: fmt.Sprintf("s3.%s.amazonaws.com", options.Region)}
+	}
+
+	if options.UsePathStyle {
+		u.Path = path.Join("/", c.bucket, key)
+	} else {
+		u.Host = c.bucket + "." + u.Host
+		u.Path = path.Join("/", key)
+	}
+
+	return u.String()
+}
+
+// KeyFromURL returns the key of the object if the URL was built by ObjectURL.
+func (c *Client) KeyFromURL(rawURL string) (string, bool) {
+	prefix := strings.TrimSuffix(c.ObjectURL(""), "/") + "/"
+	// URLs with a query are already signed
+	if !strings.HasPrefix(rawURL, prefix) || strings.Contains(rawURL, "?") {
+		return "", false
+	}
+
+	key, err := url.PathUnescape(rawURL[len(prefix):])
+	if err != nil || key == "" {
+		return "", false
+	}
+
+	return key, true
+}
+
+func isNotFound(err error) bool {
+	var notFound *types.NotFound
+	if errors.As(err, &notFound) {
+		return true
+	}
+
+	var noSuchKey *types.NoSuchKey
+	if errors.As(err, &noSuchKey) {
+		return true
+	}
+
+	var responseErr *awshttp.ResponseError
+	return errors.As(err, &responseErr) && responseErr.HTTPStatusCode() == http.StatusNotFound
+}
@@ -0,0 +1,79 @@
+package s3_client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// maxSignedURLs bounds the number of cached signed URLs
+const maxSignedURLs = 65536
+
+type signedURL struct {
+	url       string
+	expiresAt time.Time
+}
+
+// URLSigner replaces object URLs with presigned GET URLs for buckets without public read
+// access. Signed URLs are cached and reused until half of their TTL has passed, so
+// clients get a stable URL for repeated reads and can cache the media themselves.
+type URLSigner struct {
+	client *Client
+	ttl    time.Duration
+
+	mu    sync.Mutex
+	cache map[string]signedURL
+}
+
+func NewURLSigner(client *Client, ttl time.Duration) *URLSigner {
+	return &URLSigner{
+		client: client,
+		ttl:    ttl,
+		cache:  make(map[string]signedURL),
+	}
+}
+
+// SignURL returns a presigned URL for an object URL of the bucket. Other URLs are
+// returned as is.
+func (s *URLSigner) SignURL(ctx context.Context, rawURL string) (string, error) {
+	key, ok := s.client.KeyFromURL(rawURL)
+	if !ok {
+		return rawURL, nil
+	}
+
+	now := time.Now()
+
+	s.mu.Lock()
+	cached, ok := s.cache[key]
+	s.mu.Unlock()
+
+	if ok && cached.expiresAt.Sub(now) > s.ttl/2 {
+		return cached.url, nil
+	}
+
+	signed, err := s.client.PresignGetObject(ctx, key, s.ttl)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.cache) >= maxSignedURLs {
+		s.evictExpired(now)
+	}
+	if len(s.cache) >= maxSignedURLs {
+		s.cache = make(map[string]signedURL)
+	}
+	s.cache[key] = signedURL{url: signed, expiresAt: now.Add(s.ttl)}
+
+	return signed, nil
+}
+
+func (s *URLSigner) evictExpired(now time.Time) {
+	for key, cached := range s.cache {
+		if cached.expiresAt.Sub(now) <= s.ttl/2 {
+			delete(s.cache, key)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package s3_client
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+func newTestClient(usePathStyle bool) *Client {
+	client := s3.New(s3.Options{
+		Region:       "ru-central1",
+		BaseEndpoint: aws.String("https://storage.example.com"),
+		UsePathStyle: usePathStyle,
+		Credentials:  credentials.NewStaticCredentialsProvider("key", "secret", ""),
+	})
+
+	return New(client, "media")
+}
+
+func TestKeyFromURL(t *testing.T) {
+	tests := []struct {
+		name         string
+		usePathStyle bool
+		url          string
+		wantKey      string
+		wantOK       bool
+	}{
+		{
+			name:         "path style object URL",
+			usePathStyle: true,
+			url:          "https://storage.example.com/media/users/1/avatar.png",
+			wantKey:      "users/1/avatar.png",
+			wantOK:       true,
+		},
+		{
+			name:    "virtual hosted object URL",
+			url:     "https://media.storage.example.com/exercises/2/image%20v2.jpg",
+			wantKey: "exercises/2/image v2.jpg",
+			wantOK:  true,
+		},
+		{
+			name:         "already signed URL",
+			usePathStyle: true,
+			url:          "https://storage.example.com/media/users/1/avatar.png?X-Amz-Signature=abc",
+		},
+		{
+			name:         "URL of another bucket",
+			usePathStyle: true,
+			url:          "https://storage.example.com/other/users/1/avatar.png",
+		},
+		{
+			name:         "external URL",
+			usePathStyle: true,
+			url:          "https://example.com/avatar.png",
+		},
+		{
+			name:         "bucket root",
+			usePathStyle: true,
+			url:          "https://storage.example.com/media/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, ok := newTestClient(tt.usePathStyle).KeyFromURL(tt.url)
+			if key != tt.wantKey || ok != tt.wantOK {
+				t.Errorf("KeyFromURL(%q) = %q, %v, want %q, %v", tt.url, key, ok, tt.wantKey, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestKeyFromObjectURL(t *testing.T) {
+	client := newTestClient(true)
+	key := "users/1/avatar thumb.png"
+
+	got, ok := client.KeyFromURL(client.ObjectURL(key))
+	if !ok || got != key {
+		t.Errorf("KeyFromURL(ObjectURL(%q)) = %q, %v", key, got, ok)
+	}
+}
+
+func TestSignURL(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(true)
+	signer := NewURLSigner(client, time.Hour)
+
+	objectURL := client.ObjectURL("users/1/avatar.png")
+
+	signed, err := signer.SignURL(ctx, objectURL)
+	if err != nil {
+		t.Fatalf("SignURL() error = %v", err)
+	}
+	if !strings.HasPrefix(signed, objectURL+"?") || !strings.Contains(signed, "X-Amz-Expires=3600") {
+		t.Errorf("SignURL() = %q, want a presigned URL of %q valid for an hour", signed, objectURL)
+	}
+
+	again, err := signer.SignURL(ctx, objectURL)
+	if err != nil {
+		t.Fatalf("SignURL() error = %v", err)
+	}
+	if again != signed {
+		t.Errorf("SignURL() did not reuse the cached URL: %q, want %q", again, signed)
+	}
+
+	// a cached URL past half of its TTL is signed again
+	signer.cache["users/1/avatar.png"] = signedURL{url: "stale", expiresAt: time.Now().Add(time.Minute)}
+	renewed, err := signer.SignURL(ctx, objectURL)
+	if err != nil {
+		t.Fatalf("SignURL() error = %v", err)
+	}
+	if renewed == "stale" {
+		t.Errorf("SignURL() returned a URL about to expire")
+	}
+
+	external := "https://example.com/avatar.png"
+	if got, err := signer.SignURL(ctx, external); err != nil || got != external {
+		t.Errorf("SignURL(%q) = %q, %v, want it unchanged", external, got, err)
+	}
+}
@@ -3,6 +3,12 @@ package domain
 import (
 	"fitness-trainer/internal/utils"
 	"fmt"
+	"math"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -42,9 +48,21 @@ func NewModel() Model {
 	}
 }
 
+type UserRole string
+
+const (
+	UserRoleUser  UserRole = "user"
+	UserRoleAdmin UserRole = "admin"
+)
+
+func (r UserRole) String() string {
+	return string(r)
+}
+
 type User struct {
 	Model
 
+	Role          UserRole
 	Email         string
 	Password      string
 	FirstName     string
@@ -53,6 +71,10 @@ type User struct {
 	Height        float32
 	Weight        float32
 	ProfilePicURL string
+	// ProfilePictureFileID is the confirmed upload the profile picture is served from
+	ProfilePictureFileID utils.Nullable[ID]
+	// ProfilePictureVariants are square thumbnails of the profile picture
+	ProfilePictureVariants []ImageVariant
 }
 
 func NewUser(
@@ -66,6 +88,7 @@ func NewUser(
 ) User {
 	return User{
 		Model:       NewModel(),
+		Role:        UserRoleUser,
 		Email:       Email,
 		Password:    Password,
 		FirstName:   FirstName,
@@ -76,6 +99,227 @@ func NewUser(
 	}
 }
 
+const (
+	LocaleRussian = "ru"
+	LocaleEnglish = "en"
+)
+
+// IsSupportedLocale reports whether texts can be shown in the locale.
+func IsSupportedLocale(locale string) bool {
+	return locale == LocaleRussian || locale == LocaleEnglish
+}
+
+// UserPreferences are the units and the regional settings values are shown to the user
+// in. Values are always stored in kilograms and centimeters.
+type UserPreferences struct {
+	UserID         ID
+	WeightUnit     MeasurementUnit
+	LengthUnit     MeasurementUnit
+	Locale         string
+	FirstDayOfWeek time.Weekday
+	// Timezone is an IANA time zone name, days of calendars and reports start in it
+	Timezone  string
+	UpdatedAt time.Time
+}
+
+func DefaultUserPreferences(userID ID) UserPreferences {
+	return UserPreferences{
+		UserID:         userID,
+		WeightUnit:     MeasurementUnitKilogram,
+		LengthUnit:     MeasurementUnitCentimeter,
+		Locale:         LocaleRussian,
+		FirstDayOfWeek: time.Monday,
+		Timezone:       "UTC",
+	}
+}
+
+func (p UserPreferences) Validate() error {
+	if p.WeightUnit != MeasurementUnitKilogram && p.WeightUnit != MeasurementUnitPound {
+		return fmt.Errorf("%w: unknown weight unit %q", ErrInvalidArgument, p.WeightUnit)
+	}
+
+	if p.LengthUnit != MeasurementUnitCentimeter && p.LengthUnit != MeasurementUnitInch {
+		return fmt.Errorf("%w: unknown length unit %q", ErrInvalidArgument, p.LengthUnit)
+	}
+
+	if !IsSupportedLocale(p.Locale) {
+		return fmt.Errorf("%w: unsupported locale %q", ErrInvalidArgument, p.Locale)
+	}
+
+	if p.FirstDayOfWeek < time.Sunday || p.FirstDayOfWeek > time.Saturday {
+		return fmt.Errorf("%w: invalid first day of week", ErrInvalidArgument)
+	}
+
+	if _, err := time.LoadLocation(p.Timezone); err != nil || p.Timezone == "" || p.Timezone == "Local" {
+		return fmt.Errorf("%w: unknown timezone %q", ErrInvalidArgument, p.Timezone)
+	}
+
+	return nil
+}
+
+// Location returns the time zone of the user, UTC if it can't be loaded. Every call loads
+// a new location, so it should be loaded once per calculation.
+func (p UserPreferences) Location() *time.Location {
+	location, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+// StartOfDay returns the start of the day t falls on in the location.
+func StartOfDay(t time.Time, location *time.Location) time.Time {
+	t = t.In(location)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, location)
+}
+
+// StartOfWeek returns the start of the week beginning on firstDay that t falls on in the location.
+func StartOfWeek(t time.Time, location *time.Location, firstDay time.Weekday) time.Time {
+	day := StartOfDay(t, location)
+	offset := (int(day.Weekday()) - int(firstDay) + 7) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+type MeasurementKind string
+
+const (
+	MeasurementKindUnknown    MeasurementKind = ""
+	MeasurementKindBodyweight MeasurementKind = "bodyweight"
+	MeasurementKindBodyFat    MeasurementKind = "body_fat"
+	MeasurementKindNeck       MeasurementKind = "neck"
+	MeasurementKindShoulders  MeasurementKind = "shoulders"
+	MeasurementKindChest      MeasurementKind = "chest"
+	MeasurementKindWaist      MeasurementKind = "waist"
+	MeasurementKindHips       MeasurementKind = "hips"
+	MeasurementKindArm        MeasurementKind = "arm"
+	MeasurementKindForearm    MeasurementKind = "forearm"
+	MeasurementKindThigh      MeasurementKind = "thigh"
+	MeasurementKindCalf       MeasurementKind = "calf"
+)
+
+func (k MeasurementKind) String() string {
+	return string(k)
+}
+
+// BaseUnit is the unit trends of the kind are calculated in.
+func (k MeasurementKind) BaseUnit() MeasurementUnit {
+	switch k {
+	case MeasurementKindBodyweight:
+		return MeasurementUnitKilogram
+	case MeasurementKindBodyFat:
+		return MeasurementUnitPercent
+	case MeasurementKindNeck, MeasurementKindShoulders, MeasurementKindChest, MeasurementKindWaist,
+		MeasurementKindHips, MeasurementKindArm, MeasurementKindForearm, MeasurementKindThigh, MeasurementKindCalf:
+		return MeasurementUnitCentimeter
+	default:
+		return MeasurementUnitUnknown
+	}
+}
+
+type MeasurementUnit string
+
+const (
+	MeasurementUnitUnknown    MeasurementUnit = ""
+	MeasurementUnitKilogram   MeasurementUnit = "kg"
+	MeasurementUnitPound      MeasurementUnit = "lb"
+	MeasurementUnitPercent    MeasurementUnit = "percent"
+	MeasurementUnitCentimeter MeasurementUnit = "cm"
+	MeasurementUnitInch       MeasurementUnit = "in"
+)
+
+const (
+	kilogramsInPound  = 0.45359237
+	centimetersInInch = 2.54
+)
+
+func (u MeasurementUnit) String() string {
+	return string(u)
+}
+
+// toBase converts the value to the unit of the same dimension it is derived from.
+func (u MeasurementUnit) toBase(value float64) (float64, MeasurementUnit) {
+	switch u {
+	case MeasurementUnitPound:
+		return value * kilogramsInPound, MeasurementUnitKilogram
+	case MeasurementUnitInch:
+		return value * centimetersInInch, MeasurementUnitCentimeter
+	default:
+		return value, u
+	}
+}
+
+// ToBase converts a value in the unit to kilograms or centimeters.
+func (u MeasurementUnit) ToBase(value float64) float64 {
+	value, _ = u.toBase(value)
+	return value
+}
+
+// FromBase converts a value in kilograms or centimeters to the unit.
+func (u MeasurementUnit) FromBase(value float64) float64 {
+	switch u {
+	case MeasurementUnitPound:
+		return value / kilogramsInPound
+	case MeasurementUnitInch:
+		return value / centimetersInInch
+	default:
+		return value
+	}
+}
+
+// Measurement is a single entry of a body measurement time series
+type Measurement struct {
+	Model
+
+	UserID     ID
+	Kind       MeasurementKind
+	Value      float64
+	Unit       MeasurementUnit
+	MeasuredAt time.Time
+	Note       string
+}
+
+// NewMeasurement validates that the unit suits the kind of the measurement.
+func NewMeasurement(userID ID, kind MeasurementKind, value float64, unit MeasurementUnit, measuredAt time.Time, note string) (Measurement, error) {
+	measurement := Measurement{
+		Model:      NewModel(),
+		UserID:     userID,
+		Kind:       kind,
+		Value:      value,
+		Unit:       unit,
+		MeasuredAt: measuredAt,
+		Note:       note,
+	}
+
+	if err := measurement.Validate(); err != nil {
+		return Measurement{}, err
+	}
+
+	return measurement, nil
+}
+
+func (m Measurement) Validate() error {
+	if m.Kind.BaseUnit() == MeasurementUnitUnknown {
+		return fmt.Errorf("%w: unknown measurement kind", ErrInvalidArgument)
+	}
+
+	if _, base := m.Unit.toBase(m.Value); base != m.Kind.BaseUnit() {
+		return fmt.Errorf("%w: unit %q does not suit %s", ErrInvalidArgument, m.Unit, m.Kind)
+	}
+
+	if m.Value <= 0 || (m.Unit == MeasurementUnitPercent && m.Value >= 100) {
+		return fmt.Errorf("%w: measurement value is out of range", ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+// BaseValue is the value in the base unit of the measurement kind.
+func (m Measurement) BaseValue() float64 {
+	value, _ := m.Unit.toBase(m.Value)
+	return value
+}
+
 type MuscleGroup string
 
 const (
@@ -98,51 +342,928 @@ func (m MuscleGroup) String() string {
 	return string(m)
 }
 
-func NewMuscleGroup(m string) (MuscleGroup, error) {
-	switch m {
-	case "chest":
-		return MuscleGroupChest, nil
-	case "lats":
-		return MuscleGroupBack, nil
-	case "shoulders":
-		return MuscleGroupShoulders, nil
-	case "biceps":
-		return MuscleGroupBiceps, nil
-	case "triceps":
-		return MuscleGroupTriceps, nil
-	case "forearms":
-		return MuscleGroupForearms, nil
-	case "abs":
-		return MuscleGroupAbs, nil
-	case "quads":
-		return MuscleGroupQuads, nil
-	case "hamstrings":
-		return MuscleGroupHamstrings, nil
-	case "calves":
-		return MuscleGroupCalves, nil
-	case "glutes":
-		return MuscleGroupGlutes, nil
-	case "lower back":
-		return MuscleGroupLowerBack, nil
-	case "traps":
-		return MuscleGroupTraps, nil
-	default:
-		return "", fmt.Errorf("unknown muscle group: %w", ErrInvalidArgument)
+func NewMuscleGroup(m string) (MuscleGroup, error) {
+	switch m {
+	case "chest":
+		return MuscleGroupChest, nil
+	case "lats":
+		return MuscleGroupBack, nil
+	case "shoulders":
+		return MuscleGroupShoulders, nil
+	case "biceps":
+		return MuscleGroupBiceps, nil
+	case "triceps":
+		return MuscleGroupTriceps, nil
+	case "forearms":
+		return MuscleGroupForearms, nil
+	case "abs":
+		return MuscleGroupAbs, nil
+	case "quads":
+		return MuscleGroupQuads, nil
+	case "hamstrings":
+		return MuscleGroupHamstrings, nil
+	case "calves":
+		return MuscleGroupCalves, nil
+	case "glutes":
+		return MuscleGroupGlutes, nil
+	case "lower back":
+		return MuscleGroupLowerBack, nil
+	case "traps":
+		return MuscleGroupTraps, nil
+	default:
+		return "", fmt.Errorf("unknown muscle group: %w", ErrInvalidArgument)
+	}
+}
+
+type MuscleGroupRole string
+
+const (
+	MuscleGroupRoleUnknown    MuscleGroupRole = ""
+	MuscleGroupRolePrimary    MuscleGroupRole = "primary"
+	MuscleGroupRoleSecondary  MuscleGroupRole = "secondary"
+	MuscleGroupRoleStabilizer MuscleGroupRole = "stabilizer"
+)
+
+func (r MuscleGroupRole) String() string {
+	return string(r)
+}
+
+// DefaultActivation returns the share of a working set credited to a muscle
+// group with this role when no explicit activation is given.
+func (r MuscleGroupRole) DefaultActivation() float32 {
+	switch r {
+	case MuscleGroupRolePrimary:
+		return 1
+	case MuscleGroupRoleSecondary:
+		return 0.5
+	case MuscleGroupRoleStabilizer:
+		return 0.25
+	default:
+		return 0
+	}
+}
+
+func NewMuscleGroupRole(r string) (MuscleGroupRole, error) {
+	switch r {
+	case "primary":
+		return MuscleGroupRolePrimary, nil
+	case "secondary":
+		return MuscleGroupRoleSecondary, nil
+	case "stabilizer":
+		return MuscleGroupRoleStabilizer, nil
+	default:
+		return "", fmt.Errorf("unknown muscle group role: %w", ErrInvalidArgument)
+	}
+}
+
+// ExerciseMuscleGroup describes how a muscle group is involved in an exercise.
+// Activation is in (0, 1] and is used to count sets fractionally in volume analytics.
+type ExerciseMuscleGroup struct {
+	MuscleGroupID ID
+	MuscleGroup   MuscleGroup
+	Role          MuscleGroupRole
+	Activation    float32
+}
+
+func NewExerciseMuscleGroup(muscleGroupID ID, role MuscleGroupRole, activation float32) ExerciseMuscleGroup {
+	if activation <= 0 {
+		activation = role.DefaultActivation()
+	}
+
+	return ExerciseMuscleGroup{
+		MuscleGroupID: muscleGroupID,
+		Role:          role,
+		Activation:    activation,
+	}
+}
+
+type ExerciseVisibility string
+
+const (
+	ExerciseVisibilityUnknown ExerciseVisibility = ""
+	// ExerciseVisibilityGlobal exercises are a part of the common catalog
+	ExerciseVisibilityGlobal ExerciseVisibility = "global"
+	// ExerciseVisibilityPrivate exercises are visible to their owner only
+	ExerciseVisibilityPrivate ExerciseVisibility = "private"
+	// ExerciseVisibilityShared exercises are visible to their owner and to users who opened the share link
+	ExerciseVisibilityShared ExerciseVisibility = "shared"
+)
+
+func (v ExerciseVisibility) String() string {
+	return string(v)
+}
+
+func NewExerciseVisibility(v string) (ExerciseVisibility, error) {
+	switch v {
+	case "global":
+		return ExerciseVisibilityGlobal, nil
+	case "private":
+		return ExerciseVisibilityPrivate, nil
+	case "shared":
+		return ExerciseVisibilityShared, nil
+	default:
+		return "", fmt.Errorf("unknown exercise visibility: %w", ErrInvalidArgument)
+	}
+}
+
+type Equipment string
+
+const (
+	EquipmentUnknown    Equipment = ""
+	EquipmentBarbell    Equipment = "barbell"
+	EquipmentDumbbell   Equipment = "dumbbell"
+	EquipmentKettlebell Equipment = "kettlebell"
+	EquipmentMachine    Equipment = "machine"
+	EquipmentCable      Equipment = "cable"
+	EquipmentBodyweight Equipment = "bodyweight"
+	EquipmentBand       Equipment = "band"
+	EquipmentOther      Equipment = "other"
+)
+
+func (e Equipment) String() string {
+	return string(e)
+}
+
+func NewEquipment(e string) (Equipment, error) {
+	switch Equipment(e) {
+	case EquipmentBarbell, EquipmentDumbbell, EquipmentKettlebell, EquipmentMachine,
+		EquipmentCable, EquipmentBodyweight, EquipmentBand, EquipmentOther:
+		return Equipment(e), nil
+	default:
+		return "", fmt.Errorf("unknown equipment: %w", ErrInvalidArgument)
+	}
+}
+
+// ExerciseAlias is an alternative name of an exercise used by search, e.g. a name
+// in another language.
+type ExerciseAlias struct {
+	Language string
+	Alias    string
+}
+
+// ThumbnailSizes are the sides in pixels of square thumbnails generated for uploaded images.
+var ThumbnailSizes = []int{64, 128, 256, 512}
+
+// ImageVariant is a processed copy of an uploaded image.
+type ImageVariant struct {
+	Size int
+	Key  string
+	URL  string
+}
+
+// ProcessedImage is an uploaded image prepared to be served.
+type ProcessedImage struct {
+	// Original is the upload in its own format with the orientation applied and metadata
+	// like EXIF and GPS removed
+	Original    []byte
+	ContentType string
+	// Thumbnails are JPEG images by their size
+	Thumbnails map[int][]byte
+}
+
+// ImageVariantKey derives the key of a thumbnail from the key of the source image.
+func ImageVariantKey(sourceKey string, size int) string {
+	base := strings.TrimSuffix(sourceKey, path.Ext(sourceKey))
+	return fmt.Sprintf("%s/thumb_%d.jpg", base, size)
+}
+
+type ImageJobSourceType string
+
+const (
+	ImageJobSourceFile          ImageJobSourceType = "file"
+	ImageJobSourceExerciseMedia ImageJobSourceType = "exercise_media"
+)
+
+func (t ImageJobSourceType) String() string {
+	return string(t)
+}
+
+type ImageJobStatus string
+
+const (
+	ImageJobStatusPending ImageJobStatus = "pending"
+	ImageJobStatusRunning ImageJobStatus = "running"
+	ImageJobStatusDone    ImageJobStatus = "done"
+	ImageJobStatusFailed  ImageJobStatus = "failed"
+)
+
+func (s ImageJobStatus) String() string {
+	return string(s)
+}
+
+// ImageProcessingJob is a request to generate thumbnails of an uploaded image.
+type ImageProcessingJob struct {
+	Model
+
+	SourceType ImageJobSourceType
+	SourceID   ID
+	SourceKey  string
+	Status     ImageJobStatus
+	Attempts   int
+	LastError  string
+	RunAfter   time.Time
+}
+
+func NewImageProcessingJob(sourceType ImageJobSourceType, sourceID ID, sourceKey string) ImageProcessingJob {
+	model := NewModel()
+
+	return ImageProcessingJob{
+		Model:      model,
+		SourceType: sourceType,
+		SourceID:   sourceID,
+		SourceKey:  sourceKey,
+		Status:     ImageJobStatusPending,
+		RunAfter:   model.CreatedAt,
+	}
+}
+
+type DataExportStatus string
+
+const (
+	DataExportStatusPending DataExportStatus = "pending"
+	DataExportStatusRunning DataExportStatus = "running"
+	DataExportStatusDone    DataExportStatus = "done"
+	DataExportStatusFailed  DataExportStatus = "failed"
+)
+
+func (s DataExportStatus) String() string {
+	return string(s)
+}
+
+// DataExport is a request to build an archive with all data of a user.
+type DataExport struct {
+	Model
+
+	UserID    ID
+	Status    DataExportStatus
+	Attempts  int
+	LastError string
+	RunAfter  time.Time
+	// Key and Size describe the archive, they are set once the export is done
+	Key  string
+	Size int64
+	// ExpiresAt is the time the archive stops being downloadable and may be deleted
+	ExpiresAt time.Time
+}
+
+func NewDataExport(userID ID) DataExport {
+	model := NewModel()
+
+	return DataExport{
+		Model:    model,
+		UserID:   userID,
+		Status:   DataExportStatusPending,
+		RunAfter: model.CreatedAt,
+	}
+}
+
+// IsActive reports whether the export is still waiting for or being processed by the worker.
+func (e DataExport) IsActive() bool {
+	return e.Status == DataExportStatusPending || e.Status == DataExportStatusRunning
+}
+
+// IsDownloadable reports whether the archive of the export can be downloaded at the time.
+func (e DataExport) IsDownloadable(at time.Time) bool {
+	return e.Status == DataExportStatusDone && e.Key != "" && at.Before(e.ExpiresAt)
+}
+
+type WorkoutImportSource string
+
+const (
+	WorkoutImportSourceStrong   WorkoutImportSource = "strong"
+	WorkoutImportSourceHevy     WorkoutImportSource = "hevy"
+	WorkoutImportSourceFitNotes WorkoutImportSource = "fitnotes"
+)
+
+func (s WorkoutImportSource) String() string {
+	return string(s)
+}
+
+type WorkoutImportStatus string
+
+const (
+	// WorkoutImportStatusReview imports wait for the user to map or skip exercise names
+	WorkoutImportStatusReview  WorkoutImportStatus = "review"
+	WorkoutImportStatusPending WorkoutImportStatus = "pending"
+	WorkoutImportStatusRunning WorkoutImportStatus = "running"
+	WorkoutImportStatusDone    WorkoutImportStatus = "done"
+	WorkoutImportStatusFailed  WorkoutImportStatus = "failed"
+)
+
+func (s WorkoutImportStatus) String() string {
+	return string(s)
+}
+
+// ImportedWorkout is a workout parsed from the export of another app.
+type ImportedWorkout struct {
+	// ExternalID identifies the workout within the source, so the same history uploaded
+	// again doesn't create duplicates
+	ExternalID string
+	Title      string
+	Notes      string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Exercises  []ImportedExercise
+}
+
+type ImportedExercise struct {
+	// Name is the exercise name used by the source app
+	Name  string
+	Notes string
+	Sets  []ImportedSet
+}
+
+// ImportedSet is a logged set, the weight is converted to kilograms.
+type ImportedSet struct {
+	Reps   int
+	Weight float32
+	Time   time.Duration
+}
+
+// ImportExerciseMapping maps an exercise name of the source to an exercise of the catalog.
+type ImportExerciseMapping struct {
+	Name string
+	// ExerciseID is empty until the name is matched or chosen by the user
+	ExerciseID   utils.Nullable[ID]
+	ExerciseName string
+	// Similarity of the matched exercise, 1 for an exact match and 0 if nothing matched
+	Similarity float64
+	// Skip excludes the sets of the exercise from the import
+	Skip     bool
+	SetCount int
+}
+
+func (m ImportExerciseMapping) IsResolved() bool {
+	return m.Skip || m.ExerciseID.IsValid
+}
+
+// WorkoutImport is a history of workouts uploaded from another app.
+type WorkoutImport struct {
+	Model
+
+	UserID    ID
+	Source    WorkoutImportSource
+	FileID    ID
+	Status    WorkoutImportStatus
+	Attempts  int
+	LastError string
+	RunAfter  time.Time
+	Workouts  []ImportedWorkout
+	Mappings  []ImportExerciseMapping
+	// Created and Skipped are set once the import is done, skipped workouts have been
+	// imported before
+	Created int
+	Skipped int
+}
+
+func NewWorkoutImport(userID ID, source WorkoutImportSource, fileID ID, workouts []ImportedWorkout, mappings []ImportExerciseMapping) WorkoutImport {
+	model := NewModel()
+
+	return WorkoutImport{
+		Model:    model,
+		UserID:   userID,
+		Source:   source,
+		FileID:   fileID,
+		Status:   WorkoutImportStatusReview,
+		RunAfter: model.CreatedAt,
+		Workouts: workouts,
+		Mappings: mappings,
+	}
+}
+
+// IsResolved reports whether every exercise name is mapped or skipped.
+func (i WorkoutImport) IsResolved() bool {
+	for _, mapping := range i.Mappings {
+		if !mapping.IsResolved() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DataExportKey is the key of the archive of an export, private so it is only served
+// with presigned URLs.
+func DataExportKey(userID, exportID ID) string {
+	return fmt.Sprintf("private/exports/%s/%s.zip", userID, exportID)
+}
+
+type FilePurpose string
+
+const (
+	FilePurposeUnknown        FilePurpose = ""
+	FilePurposeProfilePicture FilePurpose = "profile_picture"
+	FilePurposeProgressPhoto  FilePurpose = "progress_photo"
+	FilePurposeWorkoutImport  FilePurpose = "workout_import"
+)
+
+func (p FilePurpose) String() string {
+	return string(p)
+}
+
+// MaxSize returns the maximum allowed size of a file uploaded for the purpose in bytes.
+func (p FilePurpose) MaxSize() int64 {
+	switch p {
+	case FilePurposeProfilePicture:
+		return 5 << 20
+	case FilePurposeProgressPhoto:
+		return 10 << 20
+	case FilePurposeWorkoutImport:
+		return 20 << 20
+	default:
+		return 0
+	}
+}
+
+// FileExtension returns the extension to store a file of the content type with, or an
+// error if files of the content type can't be uploaded for the purpose.
+func (p FilePurpose) FileExtension(contentType string) (string, error) {
+	switch p {
+	case FilePurposeProfilePicture, FilePurposeProgressPhoto:
+		switch contentType {
+		case "image/jpeg":
+			return ".jpg", nil
+		case "image/png":
+			return ".png", nil
+		case "image/webp":
+			return ".webp", nil
+		}
+	case FilePurposeWorkoutImport:
+		switch contentType {
+		case "text/csv":
+			return ".csv", nil
+		}
+	}
+
+	return "", fmt.Errorf("content type %q is not allowed for %s: %w", contentType, p, ErrInvalidArgument)
+}
+
+// IsPrivate reports whether files of the purpose must never be publicly readable. Such
+// files are stored under a separate prefix and are only served through signed URLs.
+func (p FilePurpose) IsPrivate() bool {
+	return p == FilePurposeProgressPhoto || p == FilePurposeWorkoutImport
+}
+
+// IsImage reports whether files of the purpose are images which get thumbnails.
+func (p FilePurpose) IsImage() bool {
+	return p == FilePurposeProfilePicture || p == FilePurposeProgressPhoto
+}
+
+type FileStatus string
+
+const (
+	// FileStatusPending files have a presigned upload URL but the upload is not confirmed yet
+	FileStatusPending   FileStatus = "pending"
+	FileStatusConfirmed FileStatus = "confirmed"
+)
+
+func (s FileStatus) String() string {
+	return string(s)
+}
+
+// File is an object uploaded by a user to the storage.
+type File struct {
+	Model
+
+	UserID       ID
+	Purpose      FilePurpose
+	Status       FileStatus
+	Key          string
+	URL          string
+	OriginalName string
+	ContentType  string
+	Size         int64
+	ConfirmedAt  time.Time
+	Variants     []ImageVariant
+}
+
+func NewFile(userID ID, purpose FilePurpose, key, originalName, contentType string, size int64) File {
+	return File{
+		Model:        NewModel(),
+		UserID:       userID,
+		Purpose:      purpose,
+		Status:       FileStatusPending,
+		Key:          key,
+		OriginalName: originalName,
+		ContentType:  contentType,
+		Size:         size,
+	}
+}
+
+func (f File) IsConfirmed() bool {
+	return f.Status == FileStatusConfirmed
+}
+
+type ProgressPhotoPose string
+
+const (
+	ProgressPhotoPoseUnknown ProgressPhotoPose = ""
+	ProgressPhotoPoseFront   ProgressPhotoPose = "front"
+	ProgressPhotoPoseSide    ProgressPhotoPose = "side"
+	ProgressPhotoPoseBack    ProgressPhotoPose = "back"
+)
+
+func (p ProgressPhotoPose) String() string {
+	return string(p)
+}
+
+// ProgressPhoto is a photo of the user's body taken to track visual progress.
+type ProgressPhoto struct {
+	Model
+
+	UserID  ID
+	FileID  ID
+	Pose    ProgressPhotoPose
+	TakenAt time.Time
+	// MeasurementID links the photo to a measurement taken at the same time
+	MeasurementID utils.Nullable[ID]
+	// IsPrivate photos are left out of comparisons unless they are asked for
+	IsPrivate bool
+	Note      string
+	// URL and Variants are the ones of the file
+	URL      string
+	Variants []ImageVariant
+}
+
+func NewProgressPhoto(userID, fileID ID, pose ProgressPhotoPose, takenAt time.Time, isPrivate bool, note string) ProgressPhoto {
+	return ProgressPhoto{
+		Model:     NewModel(),
+		UserID:    userID,
+		FileID:    fileID,
+		Pose:      pose,
+		TakenAt:   takenAt,
+		IsPrivate: isPrivate,
+		Note:      note,
+	}
+}
+
+const (
+	maxGymProfilePlateSizes = 20
+	maxPlatesOfSize         = 100
+	maxBarWeight            = 100
+)
+
+// PlateInventory is the number of plates of one weight available in a gym.
+type PlateInventory struct {
+	Weight float32
+	// Count is the number of plates of both sides, a plate without a pair is never loaded
+	Count int
+}
+
+// GymProfile describes the equipment of a gym the user trains in. Weights are in kilograms.
+type GymProfile struct {
+	Model
+
+	UserID    ID
+	Name      string
+	BarWeight float32
+	Plates    []PlateInventory
+	// IsDefault profile is used to load expected sets of active workouts
+	IsDefault bool
+}
+
+func NewGymProfile(userID ID, name string, barWeight float32, plates []PlateInventory, isDefault bool) (GymProfile, error) {
+	profile := GymProfile{
+		Model:     NewModel(),
+		UserID:    userID,
+		Name:      name,
+		BarWeight: barWeight,
+		Plates:    plates,
+		IsDefault: isDefault,
+	}
+
+	if err := profile.Validate(); err != nil {
+		return GymProfile{}, err
+	}
+
+	return profile, nil
+}
+
+// DefaultGymProfile is the equipment of a typical commercial gym, it's used when the user
+// has no gym profiles.
+func DefaultGymProfile(userID ID) GymProfile {
+	return GymProfile{
+		UserID:    userID,
+		Name:      "Default",
+		BarWeight: 20,
+		Plates: []PlateInventory{
+			{Weight: 25, Count: 4},
+			{Weight: 20, Count: 4},
+			{Weight: 15, Count: 2},
+			{Weight: 10, Count: 4},
+			{Weight: 5, Count: 4},
+			{Weight: 2.5, Count: 4},
+			{Weight: 1.25, Count: 2},
+		},
+		IsDefault: true,
+	}
+}
+
+func (p GymProfile) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("%w: gym profile name is required", ErrInvalidArgument)
+	}
+
+	if p.BarWeight < 0 || p.BarWeight > maxBarWeight {
+		return fmt.Errorf("%w: bar weight must be between 0 and %d", ErrInvalidArgument, maxBarWeight)
+	}
+
+	if len(p.Plates) > maxGymProfilePlateSizes {
+		return fmt.Errorf("%w: at most %d plate sizes are allowed", ErrInvalidArgument, maxGymProfilePlateSizes)
+	}
+
+	seen := make(map[int]struct{}, len(p.Plates))
+	for _, plate := range p.Plates {
+		if plate.Weight <= 0 || plate.Count <= 0 || plate.Count > maxPlatesOfSize {
+			return fmt.Errorf("%w: plates must have a positive weight and at most %d pieces", ErrInvalidArgument, maxPlatesOfSize)
+		}
+
+		units := weightToPlateUnits(plate.Weight)
+		if units == 0 {
+			return fmt.Errorf("%w: plates must weigh at least 10 grams", ErrInvalidArgument)
+		}
+
+		if _, ok := seen[units]; ok {
+			return fmt.Errorf("%w: plate %v is listed twice", ErrInvalidArgument, plate.Weight)
+		}
+		seen[units] = struct{}{}
+	}
+
+	return nil
+}
+
+// PlateLoadout is a way to load a bar with plates.
+type PlateLoadout struct {
+	// PlatesPerSide are ordered from the heaviest plate
+	PlatesPerSide []float32
+	BarWeight     float32
+	// Weight is the total weight of the loaded bar
+	Weight float32
+}
+
+// weightToPlateUnits converts kilograms to the tens of grams plates are counted in
+func weightToPlateUnits(weight float32) int {
+	return int(math.Round(float64(weight) * 100))
+}
+
+func plateUnitsToWeight(units int) float32 {
+	return float32(float64(units) / 100)
+}
+
+// maxLoadoutWeight is the heaviest weight loadouts are looked for, it bounds the memory the
+// search takes
+const maxLoadoutWeight = 1000
+
+// plateSize is the plates of one weight available for a side of the bar
+type plateSize struct {
+	units int
+	count int
+}
+
+// Loadout returns the plates to load on each side of the bar to get the weight closest to
+// the target with the plates of the profile, using as few plates as possible. Lighter
+// loadouts win ties.
+func (p GymProfile) Loadout(target float32) PlateLoadout {
+	loadout := PlateLoadout{
+		PlatesPerSide: []float32{},
+		BarWeight:     p.BarWeight,
+		Weight:        p.BarWeight,
+	}
+
+	side := (weightToPlateUnits(min(target, maxLoadoutWeight)) - weightToPlateUnits(p.BarWeight)) / 2
+	if side <= 0 {
+		return loadout
+	}
+
+	// Plates that can't fit on a side twice as heavy as the target are left out
+	var sizes []plateSize
+	for _, inventory := range p.Plates {
+		units := weightToPlateUnits(inventory.Weight)
+		if count := min(inventory.Count/2, 2*side/units); count > 0 {
+			sizes = append(sizes, plateSize{units: units, count: count})
+		}
+	}
+	if len(sizes) == 0 {
+		return loadout
+	}
+	slices.SortFunc(sizes, func(a, b plateSize) int {
+		return b.units - a.units
+	})
+
+	// Sides are counted in steps of the greatest common divisor of the plates
+	step := 0
+	for _, size := range sizes {
+		step = gcd(step, size.units)
+	}
+
+	// No side heavier than twice the target is closer to it than an empty one
+	limit := 2 * side / step
+	goal := side / step
+
+	// counts[s] is the fewest plates that sum up to s steps, taken[i][s] is how many plates
+	// of the i-th size are in that combination after the first i+1 sizes are considered
+	counts := make([]int, limit+1)
+	for s := 1; s <= limit; s++ {
+		counts[s] = -1
+	}
+	previous := make([]int, limit+1)
+	taken := make([][]uint8, len(sizes))
+	for i, size := range sizes {
+		copy(previous, counts)
+		taken[i] = make([]uint8, limit+1)
+		units := size.units / step
+		for s := units; s <= limit; s++ {
+			for k := 1; k <= size.count && k*units <= s; k++ {
+				rest := previous[s-k*units]
+				if rest < 0 {
+					continue
+				}
+				if counts[s] < 0 || rest+k < counts[s] {
+					counts[s] = rest + k
+					taken[i][s] = uint8(k)
+				}
+			}
+		}
+	}
+
+	best := 0
+	for s := 1; s <= limit; s++ {
+		if counts[s] < 0 {
+			continue
+		}
+		if distance(s, goal) < distance(best, goal) {
+			best = s
+		}
+	}
+
+	loadout.Weight = plateUnitsToWeight(weightToPlateUnits(p.BarWeight) + 2*best*step)
+	for i := len(sizes) - 1; i >= 0 && best > 0; i-- {
+		for range taken[i][best] {
+			loadout.PlatesPerSide = append(loadout.PlatesPerSide, plateUnitsToWeight(sizes[i].units))
+		}
+		best -= int(taken[i][best]) * sizes[i].units / step
+	}
+	slices.Reverse(loadout.PlatesPerSide)
+
+	return loadout
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+func distance(a, b int) int {
+	if a > b {
+		return a - b
+	}
+
+	return b - a
+}
+
+// StorageObject is an object stored in the bucket
+type StorageObject struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+type ExerciseMediaKind string
+
+const (
+	ExerciseMediaKindUnknown ExerciseMediaKind = ""
+	ExerciseMediaKindImage   ExerciseMediaKind = "image"
+	// ExerciseMediaKindGIF is an animated demonstration of the exercise
+	ExerciseMediaKindGIF   ExerciseMediaKind = "gif"
+	ExerciseMediaKindVideo ExerciseMediaKind = "video"
+)
+
+func (k ExerciseMediaKind) String() string {
+	return string(k)
+}
+
+// MaxSize returns the maximum allowed size of a media file of the kind in bytes.
+func (k ExerciseMediaKind) MaxSize() int64 {
+	switch k {
+	case ExerciseMediaKindImage:
+		return 10 << 20
+	case ExerciseMediaKindGIF:
+		return 20 << 20
+	case ExerciseMediaKindVideo:
+		return 200 << 20
+	default:
+		return 0
+	}
+}
+
+// ExerciseMediaKindFromContentType returns the kind of media with the given content type
+// and the file extension to store it with.
+func ExerciseMediaKindFromContentType(contentType string) (ExerciseMediaKind, string, error) {
+	switch contentType {
+	case "image/jpeg":
+		return ExerciseMediaKindImage, ".jpg", nil
+	case "image/png":
+		return ExerciseMediaKindImage, ".png", nil
+	case "image/webp":
+		return ExerciseMediaKindImage, ".webp", nil
+	case "image/gif":
+		return ExerciseMediaKindGIF, ".gif", nil
+	case "video/mp4":
+		return ExerciseMediaKindVideo, ".mp4", nil
+	case "video/webm":
+		return ExerciseMediaKindVideo, ".webm", nil
+	case "video/quicktime":
+		return ExerciseMediaKindVideo, ".mov", nil
+	default:
+		return "", "", fmt.Errorf("unsupported content type %q: %w", contentType, ErrInvalidArgument)
+	}
+}
+
+type ExerciseMediaStatus string
+
+const (
+	// ExerciseMediaStatusPending media has a presigned upload URL but the upload is not confirmed yet
+	ExerciseMediaStatusPending ExerciseMediaStatus = "pending"
+	ExerciseMediaStatusReady   ExerciseMediaStatus = "ready"
+)
+
+func (s ExerciseMediaStatus) String() string {
+	return string(s)
+}
+
+// ExerciseMedia is an image, an animated demonstration or a video attached to an exercise.
+type ExerciseMedia struct {
+	Model
+
+	ExerciseID  ID
+	UploadedBy  ID
+	Kind        ExerciseMediaKind
+	Status      ExerciseMediaStatus
+	Key         string
+	URL         string
+	ContentType string
+	Size        int64
+	Caption     string
+	Position    int
+	Variants    []ImageVariant
+}
+
+func NewExerciseMedia(exerciseID, uploadedBy ID, kind ExerciseMediaKind, key, contentType string, size int64, caption string) ExerciseMedia {
+	return ExerciseMedia{
+		Model:       NewModel(),
+		ExerciseID:  exerciseID,
+		UploadedBy:  uploadedBy,
+		Kind:        kind,
+		Status:      ExerciseMediaStatusPending,
+		Key:         key,
+		ContentType: contentType,
+		Size:        size,
+		Caption:     caption,
 	}
 }
 
+type ModerationStatus string
+
+const (
+	ModerationStatusNone     ModerationStatus = "none"
+	ModerationStatusPending  ModerationStatus = "pending"
+	ModerationStatusApproved ModerationStatus = "approved"
+	ModerationStatusRejected ModerationStatus = "rejected"
+)
+
+func (s ModerationStatus) String() string {
+	return string(s)
+}
+
 type Exercise struct {
 	Model
 
+	OwnerID            utils.Nullable[ID]
+	Visibility         ExerciseVisibility
+	ShareToken         string
+	ModerationStatus   ModerationStatus
+	ModerationComment  string
+	ArchivedAt         time.Time
+	MergedIntoID       utils.Nullable[ID]
 	Name               string
 	Description        string
 	VideoURL           string
+	Equipment          Equipment
+	Aliases            []ExerciseAlias
+	Media              []ExerciseMedia
 	TargetMuscleGroups []MuscleGroup
+	MuscleGroups       []ExerciseMuscleGroup
 }
 
 func NewExercise(name, description, videoURL string, targetMuscleGroups []MuscleGroup) Exercise {
 	return Exercise{
 		Model:              NewModel(),
+		Visibility:         ExerciseVisibilityGlobal,
+		ModerationStatus:   ModerationStatusNone,
+		Equipment:          EquipmentOther,
 		Name:               name,
 		Description:        description,
 		VideoURL:           videoURL,
@@ -150,12 +1271,231 @@ func NewExercise(name, description, videoURL string, targetMuscleGroups []Muscle
 	}
 }
 
+// IsOwnedBy reports whether the exercise is a custom exercise of the user.
+func (e Exercise) IsOwnedBy(userID ID) bool {
+	return e.OwnerID.IsValid && e.OwnerID.V == userID
+}
+
+// IsArchived reports whether the exercise is hidden from pickers. Archived exercises
+// are kept for workout history.
+func (e Exercise) IsArchived() bool {
+	return !e.ArchivedAt.IsZero()
+}
+
+type ExerciseAuditAction string
+
+const (
+	ExerciseAuditActionCreated            ExerciseAuditAction = "created"
+	ExerciseAuditActionUpdated            ExerciseAuditAction = "updated"
+	ExerciseAuditActionArchived           ExerciseAuditAction = "archived"
+	ExerciseAuditActionRestored           ExerciseAuditAction = "restored"
+	ExerciseAuditActionMerged             ExerciseAuditAction = "merged"
+	ExerciseAuditActionVisibilityChanged  ExerciseAuditAction = "visibility_changed"
+	ExerciseAuditActionPromotionRequested ExerciseAuditAction = "promotion_requested"
+	ExerciseAuditActionModerated          ExerciseAuditAction = "moderated"
+	ExerciseAuditActionMediaAdded         ExerciseAuditAction = "media_added"
+	ExerciseAuditActionMediaUpdated       ExerciseAuditAction = "media_updated"
+	ExerciseAuditActionMediaRemoved       ExerciseAuditAction = "media_removed"
+)
+
+func (a ExerciseAuditAction) String() string {
+	return string(a)
+}
+
+// FieldChange is a change of a single field recorded in an audit trail.
+type FieldChange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ExerciseAuditEntry is a record of a single change of the exercise catalog.
+type ExerciseAuditEntry struct {
+	Model
+
+	ExerciseID ID
+	UserID     ID
+	Action     ExerciseAuditAction
+	Changes    map[string]FieldChange
+}
+
+func NewExerciseAuditEntry(exerciseID, userID ID, action ExerciseAuditAction, changes map[string]FieldChange) ExerciseAuditEntry {
+	if changes == nil {
+		changes = map[string]FieldChange{}
+	}
+
+	return ExerciseAuditEntry{
+		Model:      NewModel(),
+		ExerciseID: exerciseID,
+		UserID:     userID,
+		Action:     action,
+		Changes:    changes,
+	}
+}
+
+// DiffExercises returns fields of the catalog entry which differ between two
+// states of the exercise.
+func DiffExercises(before, after Exercise) map[string]FieldChange {
+	changes := make(map[string]FieldChange)
+
+	add := func(field, from, to string) {
+		if from != to {
+			changes[field] = FieldChange{From: from, To: to}
+		}
+	}
+
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	formatID := func(id utils.Nullable[ID]) string {
+		if !id.IsValid {
+			return ""
+		}
+		return id.V.String()
+	}
+
+	formatMuscleGroups := func(muscleGroups []ExerciseMuscleGroup) string {
+		parts := make([]string, 0, len(muscleGroups))
+		for _, mg := range muscleGroups {
+			parts = append(parts, fmt.Sprintf("%s:%s:%.2f", mg.MuscleGroupID, mg.Role, mg.Activation))
+		}
+		sort.Strings(parts)
+		return strings.Join(parts, ",")
+	}
+
+	formatAliases := func(aliases []ExerciseAlias) string {
+		parts := make([]string, 0, len(aliases))
+		for _, alias := range aliases {
+			parts = append(parts, alias.Language+":"+alias.Alias)
+		}
+		sort.Strings(parts)
+		return strings.Join(parts, ",")
+	}
+
+	add("name", before.Name, after.Name)
+	add("description", before.Description, after.Description)
+	add("video_url", before.VideoURL, after.VideoURL)
+	add("equipment", before.Equipment.String(), after.Equipment.String())
+	add("aliases", formatAliases(before.Aliases), formatAliases(after.Aliases))
+	add("visibility", before.Visibility.String(), after.Visibility.String())
+	add("moderation_status", before.ModerationStatus.String(), after.ModerationStatus.String())
+	add("moderation_comment", before.ModerationComment, after.ModerationComment)
+	add("archived_at", formatTime(before.ArchivedAt), formatTime(after.ArchivedAt))
+	add("merged_into_id", formatID(before.MergedIntoID), formatID(after.MergedIntoID))
+	add("muscle_groups", formatMuscleGroups(before.MuscleGroups), formatMuscleGroups(after.MuscleGroups))
+
+	return changes
+}
+
 type Routine struct {
 	Model
 
 	UserID      ID
 	Name        string
 	Description string
+	// WarmupSteps ramp up to the first working set of every exercise of workouts started
+	// from the routine, the routine has no warm-ups if there are none
+	WarmupSteps []WarmupStep
+	// TemplateKey identifies a template of the library, templates have no owner
+	TemplateKey     string
+	TemplateVersion int
+	// Program is the name of the program the template belongs to
+	Program string
+	// Translations are the name and the description of a template in other locales
+	Translations map[string]RoutineTranslation
+}
+
+// RoutineTranslation is the name and the description of a template in a locale.
+type RoutineTranslation struct {
+	Name        string
+	Description string
+}
+
+// IsTemplate reports whether the routine is a template of the library.
+func (r Routine) IsTemplate() bool {
+	return r.TemplateKey != ""
+}
+
+// Localized returns the routine with the name and the description in the locale, the
+// original ones are kept if there is no translation.
+func (r Routine) Localized(locale string) Routine {
+	translation, ok := r.Translations[locale]
+	if !ok {
+		return r
+	}
+
+	r.Name = translation.Name
+	if translation.Description != "" {
+		r.Description = translation.Description
+	}
+
+	return r
+}
+
+const maxWarmupSteps = 10
+
+// WarmupStep is a warm-up set of a ramp, its weight is a percentage of the working weight.
+type WarmupStep struct {
+	Percent int
+	Reps    int
+}
+
+// DefaultWarmupSteps is the ramp used when a routine opts in to warm-ups without its own.
+func DefaultWarmupSteps() []WarmupStep {
+	return []WarmupStep{
+		{Percent: 40, Reps: 5},
+		{Percent: 60, Reps: 3},
+		{Percent: 80, Reps: 2},
+	}
+}
+
+// ValidateWarmupSteps checks that the steps ramp up to the working weight.
+func ValidateWarmupSteps(steps []WarmupStep) error {
+	if len(steps) > maxWarmupSteps {
+		return fmt.Errorf("%w: at most %d warm-up steps are allowed", ErrInvalidArgument, maxWarmupSteps)
+	}
+
+	for i, step := range steps {
+		if step.Percent <= 0 || step.Percent >= 100 {
+			return fmt.Errorf("%w: warm-up percent must be between 1 and 99", ErrInvalidArgument)
+		}
+
+		if step.Reps <= 0 {
+			return fmt.Errorf("%w: warm-up reps must be positive", ErrInvalidArgument)
+		}
+
+		if i > 0 && step.Percent <= steps[i-1].Percent {
+			return fmt.Errorf("%w: warm-up percents must increase", ErrInvalidArgument)
+		}
+	}
+
+	return nil
+}
+
+// PlanWarmups returns warm-up sets ramping up to the weight of the working set. round turns
+// a weight into one that can be loaded, steps which round to no lighter weight than the
+// previous one or to the working weight are skipped.
+func PlanWarmups(working ExpectedSet, steps []WarmupStep, round func(float32) float32) []ExpectedSet {
+	var (
+		warmups []ExpectedSet
+		last    float32
+	)
+	for _, step := range steps {
+		weight := round(working.Weight * float32(step.Percent) / 100)
+		if weight <= last || weight >= working.Weight {
+			continue
+		}
+		last = weight
+
+		warmup := NewExpectedSet(working.ExerciseLogID, working.SetType, step.Reps, weight, 0)
+		warmup.IsWarmup = true
+		warmups = append(warmups, warmup)
+	}
+
+	return warmups
 }
 
 func NewRoutine(userID ID, name, description string) Routine {
@@ -167,6 +1507,292 @@ func NewRoutine(userID ID, name, description string) Routine {
 	}
 }
 
+// RoutineVersion is an immutable snapshot of a routine, every edit of the routine records
+// a new version.
+type RoutineVersion struct {
+	Model
+
+	// RoutineID is not set once the routine is deleted, its versions are kept for the
+	// workouts started from them
+	RoutineID utils.Nullable[ID]
+	Version   int
+	Snapshot  RoutineSnapshot
+}
+
+func NewRoutineVersion(routineID ID, version int, snapshot RoutineSnapshot) RoutineVersion {
+	return RoutineVersion{
+		Model:     NewModel(),
+		RoutineID: utils.NewNullable(routineID, true),
+		Version:   version,
+		Snapshot:  snapshot,
+	}
+}
+
+// RoutineSnapshot is the content of a routine at some point in time.
+type RoutineSnapshot struct {
+	Name        string
+	Description string
+	WarmupSteps []WarmupStep
+	Exercises   []RoutineSnapshotExercise
+}
+
+// RoutineSnapshotExercise is an exercise instance of a routine snapshot with its sets in order.
+type RoutineSnapshotExercise struct {
+	ExerciseInstanceID ID
+	ExerciseID         ID
+	Group              utils.Nullable[ExerciseGroup]
+	Sets               []Set
+}
+
+type RoutineChangeAction string
+
+const (
+	RoutineChangeActionAdded    RoutineChangeAction = "added"
+	RoutineChangeActionRemoved  RoutineChangeAction = "removed"
+	RoutineChangeActionModified RoutineChangeAction = "modified"
+)
+
+func (a RoutineChangeAction) String() string {
+	return string(a)
+}
+
+// RoutineDiff is the difference between two versions of a routine.
+type RoutineDiff struct {
+	// Changes are changed fields of the routine itself
+	Changes   map[string]FieldChange
+	Exercises []ExerciseInstanceDiff
+}
+
+func (d RoutineDiff) IsEmpty() bool {
+	return len(d.Changes) == 0 && len(d.Exercises) == 0
+}
+
+// ExerciseInstanceDiff is an exercise instance which was added, removed or modified between
+// two versions of a routine.
+type ExerciseInstanceDiff struct {
+	ExerciseInstanceID ID
+	ExerciseID         ID
+	Action             RoutineChangeAction
+	Changes            map[string]FieldChange
+	Sets               []SetDiff
+}
+
+// SetDiff is a set which was added, removed or modified, Before is empty for added sets and
+// After is empty for removed ones.
+type SetDiff struct {
+	SetID  ID
+	Action RoutineChangeAction
+	Before utils.Nullable[Set]
+	After  utils.Nullable[Set]
+}
+
+// DiffRoutineSnapshots returns what changed in the routine between two snapshots. Exercise
+// instances and sets are matched by their IDs.
+func DiffRoutineSnapshots(before, after RoutineSnapshot) RoutineDiff {
+	diff := RoutineDiff{Changes: make(map[string]FieldChange)}
+
+	add := func(changes map[string]FieldChange, field, from, to string) {
+		if from != to {
+			changes[field] = FieldChange{From: from, To: to}
+		}
+	}
+
+	formatWarmupSteps := func(steps []WarmupStep) string {
+		parts := make([]string, 0, len(steps))
+		for _, step := range steps {
+			parts = append(parts, fmt.Sprintf("%d%%x%d", step.Percent, step.Reps))
+		}
+		return strings.Join(parts, ",")
+	}
+
+	formatGroup := func(group utils.Nullable[ExerciseGroup]) string {
+		if !group.IsValid {
+			return ""
+		}
+		return fmt.Sprintf("%s:%s:%d", group.V.ID, group.V.Type, group.V.Rounds)
+	}
+
+	add(diff.Changes, "name", before.Name, after.Name)
+	add(diff.Changes, "description", before.Description, after.Description)
+	add(diff.Changes, "warmup_steps", formatWarmupSteps(before.WarmupSteps), formatWarmupSteps(after.WarmupSteps))
+
+	beforeIndex := make(map[ID]int, len(before.Exercises))
+	beforeIDs := make([]ID, 0, len(before.Exercises))
+	for i, exercise := range before.Exercises {
+		beforeIndex[exercise.ExerciseInstanceID] = i
+		beforeIDs = append(beforeIDs, exercise.ExerciseInstanceID)
+	}
+
+	afterOrder := make([]ID, 0, len(after.Exercises))
+	for _, exercise := range after.Exercises {
+		afterOrder = append(afterOrder, exercise.ExerciseInstanceID)
+	}
+	beforeRank, afterRank := keptOrder(beforeIDs, afterOrder)
+
+	afterIDs := make(map[ID]struct{}, len(after.Exercises))
+	for i, exercise := range after.Exercises {
+		afterIDs[exercise.ExerciseInstanceID] = struct{}{}
+
+		j, ok := beforeIndex[exercise.ExerciseInstanceID]
+		if !ok {
+			diff.Exercises = append(diff.Exercises, ExerciseInstanceDiff{
+				ExerciseInstanceID: exercise.ExerciseInstanceID,
+				ExerciseID:         exercise.ExerciseID,
+				Action:             RoutineChangeActionAdded,
+				Changes:            map[string]FieldChange{},
+				Sets:               diffSets(nil, exercise.Sets),
+			})
+			continue
+		}
+
+		previous := before.Exercises[j]
+		changes := make(map[string]FieldChange)
+		if beforeRank[exercise.ExerciseInstanceID] != afterRank[exercise.ExerciseInstanceID] {
+			add(changes, "position", strconv.Itoa(j), strconv.Itoa(i))
+		}
+		add(changes, "group", formatGroup(previous.Group), formatGroup(exercise.Group))
+
+		sets := diffSets(previous.Sets, exercise.Sets)
+		if len(changes) == 0 && len(sets) == 0 {
+			continue
+		}
+
+		diff.Exercises = append(diff.Exercises, ExerciseInstanceDiff{
+			ExerciseInstanceID: exercise.ExerciseInstanceID,
+			ExerciseID:         exercise.ExerciseID,
+			Action:             RoutineChangeActionModified,
+			Changes:            changes,
+			Sets:               sets,
+		})
+	}
+
+	for _, exercise := range before.Exercises {
+		if _, ok := afterIDs[exercise.ExerciseInstanceID]; ok {
+			continue
+		}
+
+		diff.Exercises = append(diff.Exercises, ExerciseInstanceDiff{
+			ExerciseInstanceID: exercise.ExerciseInstanceID,
+			ExerciseID:         exercise.ExerciseID,
+			Action:             RoutineChangeActionRemoved,
+			Changes:            map[string]FieldChange{},
+			Sets:               diffSets(exercise.Sets, nil),
+		})
+	}
+
+	return diff
+}
+
+func diffSets(before, after []Set) []SetDiff {
+	beforeIndex := make(map[ID]int, len(before))
+	beforeIDs := make([]ID, 0, len(before))
+	for i, set := range before {
+		beforeIndex[set.ID] = i
+		beforeIDs = append(beforeIDs, set.ID)
+	}
+
+	afterOrder := make([]ID, 0, len(after))
+	for _, set := range after {
+		afterOrder = append(afterOrder, set.ID)
+	}
+	beforeRank, afterRank := keptOrder(beforeIDs, afterOrder)
+
+	var diffs []SetDiff
+	afterIDs := make(map[ID]struct{}, len(after))
+	for _, set := range after {
+		afterIDs[set.ID] = struct{}{}
+
+		j, ok := beforeIndex[set.ID]
+		if !ok {
+			diffs = append(diffs, SetDiff{
+				SetID:  set.ID,
+				Action: RoutineChangeActionAdded,
+				After:  utils.NewNullable(set, true),
+			})
+			continue
+		}
+
+		previous := before[j]
+		if beforeRank[set.ID] == afterRank[set.ID] &&
+			previous.SetType == set.SetType &&
+			previous.Reps == set.Reps &&
+			previous.Weight == set.Weight &&
+			previous.Time == set.Time &&
+			previous.Rest == set.Rest {
+			continue
+		}
+
+		diffs = append(diffs, SetDiff{
+			SetID:  set.ID,
+			Action: RoutineChangeActionModified,
+			Before: utils.NewNullable(previous, true),
+			After:  utils.NewNullable(set, true),
+		})
+	}
+
+	for _, set := range before {
+		if _, ok := afterIDs[set.ID]; ok {
+			continue
+		}
+
+		diffs = append(diffs, SetDiff{
+			SetID:  set.ID,
+			Action: RoutineChangeActionRemoved,
+			Before: utils.NewNullable(set, true),
+		})
+	}
+
+	return diffs
+}
+
+// keptOrder ranks the IDs present in both lists among themselves, so adding or removing an
+// item does not count as moving the items after it.
+func keptOrder(before, after []ID) (map[ID]int, map[ID]int) {
+	inAfter := make(map[ID]struct{}, len(after))
+	for _, id := range after {
+		inAfter[id] = struct{}{}
+	}
+
+	beforeRank := make(map[ID]int, len(before))
+	for _, id := range before {
+		if _, ok := inAfter[id]; ok {
+			beforeRank[id] = len(beforeRank)
+		}
+	}
+
+	afterRank := make(map[ID]int, len(after))
+	for _, id := range after {
+		if _, ok := beforeRank[id]; ok {
+			afterRank[id] = len(afterRank)
+		}
+	}
+
+	return beforeRank, afterRank
+}
+
+// RoutineShareLink gives read-only access to a routine to anyone who knows its token.
+type RoutineShareLink struct {
+	Model
+
+	RoutineID ID
+	Token     string
+	// ExpiresAt is zero if the link never expires
+	ExpiresAt time.Time
+}
+
+func NewRoutineShareLink(routineID ID, token string, expiresAt time.Time) RoutineShareLink {
+	return RoutineShareLink{
+		Model:     NewModel(),
+		RoutineID: routineID,
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (l RoutineShareLink) IsExpired(at time.Time) bool {
+	return !l.ExpiresAt.IsZero() && !at.Before(l.ExpiresAt)
+}
+
 type SetType string
 
 const (
@@ -193,11 +1819,83 @@ func NewSetType(s string) (SetType, error) {
 	}
 }
 
+type ExerciseGroupType string
+
+const (
+	ExerciseGroupTypeUnknown  ExerciseGroupType = ""
+	ExerciseGroupTypeSuperset ExerciseGroupType = "superset"
+	ExerciseGroupTypeGiantSet ExerciseGroupType = "giant_set"
+	ExerciseGroupTypeCircuit  ExerciseGroupType = "circuit"
+)
+
+func (t ExerciseGroupType) String() string {
+	return string(t)
+}
+
+func NewExerciseGroupType(s string) (ExerciseGroupType, error) {
+	switch s {
+	case "superset":
+		return ExerciseGroupTypeSuperset, nil
+	case "giant_set":
+		return ExerciseGroupTypeGiantSet, nil
+	case "circuit":
+		return ExerciseGroupTypeCircuit, nil
+	default:
+		return "", fmt.Errorf("unknown exercise group type: %w", ErrInvalidArgument)
+	}
+}
+
+const maxExerciseGroupRounds = 20
+
+// ExerciseGroup joins exercises performed back to back, members of a group share its ID
+// and are done Rounds times in their order.
+type ExerciseGroup struct {
+	ID     ID
+	Type   ExerciseGroupType
+	Rounds int
+}
+
+func NewExerciseGroup(groupType ExerciseGroupType, rounds int) ExerciseGroup {
+	return ExerciseGroup{
+		ID:     NewID(),
+		Type:   groupType,
+		Rounds: rounds,
+	}
+}
+
+// Validate checks the group for the given number of exercises: a superset pairs two
+// exercises, a giant set joins three or more and a circuit at least two.
+func (g ExerciseGroup) Validate(size int) error {
+	if g.Rounds < 1 || g.Rounds > maxExerciseGroupRounds {
+		return fmt.Errorf("%w: rounds must be between 1 and %d", ErrInvalidArgument, maxExerciseGroupRounds)
+	}
+
+	switch g.Type {
+	case ExerciseGroupTypeSuperset:
+		if size != 2 {
+			return fmt.Errorf("%w: superset must have exactly 2 exercises", ErrInvalidArgument)
+		}
+	case ExerciseGroupTypeGiantSet:
+		if size < 3 {
+			return fmt.Errorf("%w: giant set must have at least 3 exercises", ErrInvalidArgument)
+		}
+	case ExerciseGroupTypeCircuit:
+		if size < 2 {
+			return fmt.Errorf("%w: circuit must have at least 2 exercises", ErrInvalidArgument)
+		}
+	default:
+		return fmt.Errorf("%w: unknown exercise group type", ErrInvalidArgument)
+	}
+
+	return nil
+}
+
 type ExerciseInstance struct {
 	Model
 
 	RoutineID  ID
 	ExerciseID ID
+	Group      utils.Nullable[ExerciseGroup]
 }
 
 func NewExerciseInstance(routineID, exerciseID ID) ExerciseInstance {
@@ -216,6 +1914,9 @@ type Set struct {
 	Reps               int
 	Weight             float32
 	Time               time.Duration
+	// Rest is the target rest after the set, zero if not planned
+	Rest     time.Duration
+	Position int
 }
 
 func NewSet(exerciseInstanceID ID, setType SetType, reps int, weight float32, time time.Duration) Set {
@@ -232,13 +1933,18 @@ func NewSet(exerciseInstanceID ID, setType SetType, reps int, weight float32, ti
 type Workout struct {
 	Model
 
-	UserID        ID
-	RoutineID     utils.Nullable[ID]
-	Notes         string
-	Rating        int
-	FinishedAt    time.Time
-	IsAIGenerated bool
-	Reasoning     string
+	UserID    ID
+	RoutineID utils.Nullable[ID]
+	// RoutineVersionID is the version of the routine the workout was started from
+	RoutineVersionID utils.Nullable[ID]
+	Notes            string
+	Rating           int
+	FinishedAt       time.Time
+	IsAIGenerated    bool
+	Reasoning        string
+	// ExternalID identifies a workout imported from another app, empty for workouts
+	// logged in this one
+	ExternalID string
 }
 
 func NewWorkout(userID ID, routineID utils.Nullable[ID], isAIGenerated bool) Workout {
@@ -257,6 +1963,9 @@ type ExerciseLog struct {
 	ExerciseID  ID
 	Notes       string
 	PowerRating int
+	Group       utils.Nullable[ExerciseGroup]
+	// Position is the order of the exercise in the workout
+	Position int
 }
 
 func NewExerciseLog(workoutID, exerciseID ID) ExerciseLog {
@@ -275,6 +1984,11 @@ type ExpectedSet struct {
 	Reps          int
 	Weight        float32
 	Time          time.Duration
+	Rest          time.Duration
+	IsWarmup      bool
+	Position      int
+	// Loadout is only set for barbell sets of active workouts, it isn't stored
+	Loadout utils.Nullable[PlateLoadout]
 }
 
 func NewExpectedSet(exerciseLogID ID, setType SetType, reps int, weight float32, time time.Duration) ExpectedSet {
@@ -295,6 +2009,10 @@ type ExerciseSetLog struct {
 	Reps          int
 	Weight        float32
 	Time          time.Duration
+	// StartedAt is reported by the client and is zero if unknown
+	StartedAt   time.Time
+	CompletedAt time.Time
+	Position    int
 }
 
 func NewExerciseSetLog(exerciseLogID ID, reps int, weight float32, time time.Duration) ExerciseSetLog {
@@ -307,6 +2025,91 @@ func NewExerciseSetLog(exerciseLogID ID, reps int, weight float32, time time.Dur
 	}
 }
 
+type WorkoutAmendmentAction string
+
+const (
+	WorkoutAmendmentActionSetLogged    WorkoutAmendmentAction = "set_logged"
+	WorkoutAmendmentActionSetUpdated   WorkoutAmendmentAction = "set_updated"
+	WorkoutAmendmentActionSetDeleted   WorkoutAmendmentAction = "set_deleted"
+	WorkoutAmendmentActionNotesChanged WorkoutAmendmentAction = "notes_changed"
+)
+
+func (a WorkoutAmendmentAction) String() string {
+	return string(a)
+}
+
+// WorkoutAmendment is a record of a single change of a finished workout.
+type WorkoutAmendment struct {
+	Model
+
+	WorkoutID     ID
+	UserID        ID
+	ExerciseLogID ID
+	// SetLogID is empty for changes of the exercise log itself
+	SetLogID ID
+	Action   WorkoutAmendmentAction
+	Changes  map[string]FieldChange
+}
+
+func NewWorkoutAmendment(workoutID, userID, exerciseLogID, setLogID ID, action WorkoutAmendmentAction, changes map[string]FieldChange) WorkoutAmendment {
+	if changes == nil {
+		changes = map[string]FieldChange{}
+	}
+
+	return WorkoutAmendment{
+		Model:         NewModel(),
+		WorkoutID:     workoutID,
+		UserID:        userID,
+		ExerciseLogID: exerciseLogID,
+		SetLogID:      setLogID,
+		Action:        action,
+		Changes:       changes,
+	}
+}
+
+// DiffSetLogs returns fields which differ between two states of the set log, a zero set
+// log stands for one which doesn't exist.
+func DiffSetLogs(before, after ExerciseSetLog) map[string]FieldChange {
+	changes := make(map[string]FieldChange)
+
+	format := func(setLog ExerciseSetLog, value string) string {
+		if setLog.ID == (ID{}) {
+			return ""
+		}
+		return value
+	}
+
+	add := func(field string, from, to string) {
+		from, to = format(before, from), format(after, to)
+		if from != to {
+			changes[field] = FieldChange{From: from, To: to}
+		}
+	}
+
+	add("reps", strconv.Itoa(before.Reps), strconv.Itoa(after.Reps))
+	add("weight", strconv.FormatFloat(float64(before.Weight), 'f', -1, 32), strconv.FormatFloat(float64(after.Weight), 'f', -1, 32))
+	add("time", before.Time.String(), after.Time.String())
+
+	return changes
+}
+
+const (
+	// longRestFactor is how many times a rest may exceed its target before it's flagged
+	longRestFactor = 2
+	// defaultLongRest flags rests without a target
+	defaultLongRest = 5 * time.Minute
+)
+
+// IsLongRest reports whether the rest is an unusually long gap for its target, target is
+// zero when the rest wasn't planned.
+func IsLongRest(actual, target time.Duration) bool {
+	if target > 0 {
+		return actual > target*longRestFactor
+	}
+
+	return actual > defaultLongRest
+}
+
 type Session struct {
 	Model
 
@@ -0,0 +1,10 @@
+package dto
+
+import "fitness-trainer/internal/domain"
+
+type MuscleGroupVolumeDTO struct {
+	MuscleGroupID  domain.ID
+	Name           string
+	DirectSets     int
+	FractionalSets float32
+}
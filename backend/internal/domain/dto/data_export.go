@@ -0,0 +1,155 @@
+package dto
+
+import (
+	"time"
+
+	"fitness-trainer/internal/domain"
+)
+
+type DataExportDTO struct {
+	Export domain.DataExport
+	// DownloadURL is a presigned link to the archive, empty unless the archive is downloadable
+	DownloadURL string
+}
+
+// DataExportSchemaVersion is bumped on every incompatible change of the export.json schema.
+const DataExportSchemaVersion = 1
+
+// DataExportArchiveDTO is the content of export.json in the export archive. IDs are UUID
+// strings, times are RFC 3339, durations are whole seconds and weights are kilograms.
+type DataExportArchiveDTO struct {
+	SchemaVersion      int                              `json:"schema_version"`
+	ExportedAt         time.Time                        `json:"exported_at"`
+	User               DataExportUserDTO                `json:"user"`
+	Preferences        DataExportPreferencesDTO         `json:"preferences"`
+	GenerationSettings *DataExportGenerationSettingsDTO `json:"generation_settings"`
+	GymProfiles        []DataExportGymProfileDTO        `json:"gym_profiles"`
+	Routines           []DataExportRoutineDTO           `json:"routines"`
+	Workouts           []DataExportWorkoutDTO           `json:"workouts"`
+	Measurements       []DataExportMeasurementDTO       `json:"measurements"`
+}
+
+type DataExportUserDTO struct {
+	ID          string     `json:"id"`
+	Email       string     `json:"email"`
+	FirstName   string     `json:"first_name"`
+	LastName    string     `json:"last_name"`
+	DateOfBirth *time.Time `json:"date_of_birth"`
+	Height      float32    `json:"height_cm"`
+	Weight      float32    `json:"weight_kg"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type DataExportPreferencesDTO struct {
+	WeightUnit     string `json:"weight_unit"`
+	LengthUnit     string `json:"length_unit"`
+	Locale         string `json:"locale"`
+	FirstDayOfWeek string `json:"first_day_of_week"`
+	Timezone       string `json:"timezone"`
+}
+
+type DataExportGenerationSettingsDTO struct {
+	BasePrompt   string `json:"base_prompt"`
+	VarietyLevel int    `json:"variety_level"`
+}
+
+type DataExportGymProfileDTO struct {
+	ID        string               `json:"id"`
+	Name      string               `json:"name"`
+	BarWeight float32              `json:"bar_weight_kg"`
+	Plates    []DataExportPlateDTO `json:"plates"`
+	IsDefault bool                 `json:"is_default"`
+}
+
+type DataExportPlateDTO struct {
+	Weight float32 `json:"weight_kg"`
+	// Count is the number of plates of the weight, not pairs
+	Count int `json:"count"`
+}
+
+type DataExportGroupDTO struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+	Rounds int    `json:"rounds"`
+}
+
+type DataExportWarmupStepDTO struct {
+	Percent int `json:"percent"`
+	Reps    int `json:"reps"`
+}
+
+type DataExportRoutineDTO struct {
+	ID          string                         `json:"id"`
+	Name        string                         `json:"name"`
+	Description string                         `json:"description"`
+	WarmupSteps []DataExportWarmupStepDTO      `json:"warmup_steps"`
+	Exercises   []DataExportRoutineExerciseDTO `json:"exercises"`
+	CreatedAt   time.Time                      `json:"created_at"`
+	UpdatedAt   time.Time                      `json:"updated_at"`
+}
+
+type DataExportRoutineExerciseDTO struct {
+	ID           string              `json:"id"`
+	ExerciseID   string              `json:"exercise_id"`
+	ExerciseName string              `json:"exercise_name"`
+	Group        *DataExportGroupDTO `json:"group"`
+	Sets         []DataExportSetDTO  `json:"sets"`
+}
+
+// DataExportSetDTO is a planned set of a routine or an expected set of a workout.
+type DataExportSetDTO struct {
+	ID       string  `json:"id"`
+	Position int     `json:"position"`
+	Type     string  `json:"type"`
+	Reps     int     `json:"reps"`
+	Weight   float32 `json:"weight_kg"`
+	Time     int64   `json:"time_seconds"`
+	Rest     int64   `json:"rest_seconds"`
+	IsWarmup bool    `json:"is_warmup"`
+}
+
+type DataExportWorkoutDTO struct {
+	ID               string    `json:"id"`
+	RoutineID        string    `json:"routine_id"`
+	RoutineVersionID string    `json:"routine_version_id"`
+	StartedAt        time.Time `json:"started_at"`
+	// FinishedAt is null for workouts which are still in progress
+	FinishedAt    *time.Time                 `json:"finished_at"`
+	Notes         string                     `json:"notes"`
+	Rating        int                        `json:"rating"`
+	IsAIGenerated bool                       `json:"is_ai_generated"`
+	Reasoning     string                     `json:"reasoning"`
+	Exercises     []DataExportExerciseLogDTO `json:"exercises"`
+}
+
+type DataExportExerciseLogDTO struct {
+	ID           string                `json:"id"`
+	ExerciseID   string                `json:"exercise_id"`
+	ExerciseName string                `json:"exercise_name"`
+	Position     int                   `json:"position"`
+	Notes        string                `json:"notes"`
+	PowerRating  int                   `json:"power_rating"`
+	Group        *DataExportGroupDTO   `json:"group"`
+	ExpectedSets []DataExportSetDTO    `json:"expected_sets"`
+	Sets         []DataExportSetLogDTO `json:"sets"`
+}
+
+type DataExportSetLogDTO struct {
+	ID       string  `json:"id"`
+	Position int     `json:"position"`
+	Reps     int     `json:"reps"`
+	Weight   float32 `json:"weight_kg"`
+	Time     int64   `json:"time_seconds"`
+	// StartedAt is null if the client didn't report it
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt time.Time  `json:"completed_at"`
+}
+
+type DataExportMeasurementDTO struct {
+	ID         string    `json:"id"`
+	Kind       string    `json:"kind"`
+	Value      float64   `json:"value"`
+	Unit       string    `json:"unit"`
+	MeasuredAt time.Time `json:"measured_at"`
+	Note       string    `json:"note"`
+}
@@ -1,13 +1,67 @@
 package dto
 
 import (
+	"time"
+
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/utils"
 )
 
 type CreateExerciseDTO struct {
+	OwnerID            domain.ID
+	Visibility         domain.ExerciseVisibility
 	Name               string
 	Description        utils.Nullable[string]
 	VideoURL           utils.Nullable[string]
+	Equipment          domain.Equipment
+	Aliases            []domain.ExerciseAlias
+	TargetMuscleGroups []domain.ID
+	MuscleGroups       []ExerciseMuscleGroupDTO
+}
+
+type UpdateExerciseDTO struct {
+	Name        utils.Nullable[string]
+	Description utils.Nullable[string]
+	VideoURL    utils.Nullable[string]
+	Equipment   domain.Equipment
+	// Aliases are replaced when any of them is given
+	Aliases []domain.ExerciseAlias
+	// Muscle groups are replaced when any of them is given
 	TargetMuscleGroups []domain.ID
+	MuscleGroups       []ExerciseMuscleGroupDTO
+}
+
+type ExerciseMuscleGroupDTO struct {
+	MuscleGroupID domain.ID
+	Role          domain.MuscleGroupRole
+	Activation    utils.Nullable[float32]
+}
+
+type GetExercisesDTO struct {
+	// UserID limits the result to exercises visible to the user
+	UserID            domain.ID
+	MuscleGroups      []domain.ID
+	MuscleGroupRoles  []domain.MuscleGroupRole
+	ExcludedExercises []domain.ID
+	// Query is matched against names, descriptions and aliases
+	Query     string
+	Equipment []domain.Equipment
+	// RecentlyUsed keeps only exercises the user has logged lately
+	RecentlyUsed      bool
+	RecentlyUsedSince time.Time
+	Cursor            utils.Nullable[ExercisesCursor]
+	// Limit of zero means no limit
+	Limit int
+}
+
+// ExercisesCursor points at the last exercise of a page in the order of GetExercises.
+type ExercisesCursor struct {
+	Rank      float64
+	CreatedAt time.Time
+	ID        domain.ID
+}
+
+type ExercisesPageDTO struct {
+	Exercises  []domain.Exercise
+	NextCursor utils.Nullable[ExercisesCursor]
 }
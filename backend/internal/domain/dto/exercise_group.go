@@ -0,0 +1,10 @@
+package dto
+
+import "fitness-trainer/internal/domain"
+
+// GroupExercisesDTO groups exercise instances of a routine or exercise logs of a workout.
+type GroupExercisesDTO struct {
+	IDs    []domain.ID
+	Type   domain.ExerciseGroupType
+	Rounds int
+}
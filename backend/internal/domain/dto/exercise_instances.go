@@ -2,6 +2,7 @@ package dto
 
 import (
 	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/utils"
 	"time"
 )
 
@@ -9,6 +10,7 @@ type ExerciseInstanceDetailsDTO struct {
 	ID         domain.ID
 	RoutineID  domain.ID
 	ExerciseID domain.ID
+	Group      utils.Nullable[domain.ExerciseGroup]
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 	Exercise   domain.Exercise
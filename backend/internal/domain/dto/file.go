@@ -0,0 +1,11 @@
+package dto
+
+import "fitness-trainer/internal/domain"
+
+type PresignUploadDTO struct {
+	Purpose domain.FilePurpose
+	// Filename is the original name of the file, it is not used as the key
+	Filename    string
+	ContentType string
+	Size        int64
+}
@@ -9,4 +9,6 @@ type GenerateWorkoutOptions struct {
 	VarietyLevel   int
 	UserPrompt     string
 	BaseUserPrompt string
+	// Locale is the language of the reasoning
+	Locale string
 }
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/utils"
+)
+
+type CreateGymProfileDTO struct {
+	Name      string
+	BarWeight float32
+	Plates    []domain.PlateInventory
+	IsDefault bool
+}
+
+type UpdateGymProfileDTO struct {
+	Name      utils.Nullable[string]
+	BarWeight utils.Nullable[float32]
+	Plates    utils.Nullable[[]domain.PlateInventory]
+	// IsDefault can only make the profile the default one, another profile has to be made
+	// default instead to unset it
+	IsDefault bool
+}
+
+type CalculatePlatesDTO struct {
+	TargetWeight float32
+	// GymProfileID is empty to use the default profile of the user
+	GymProfileID utils.Nullable[domain.ID]
+	// BarWeight overrides the bar of the profile
+	BarWeight utils.Nullable[float32]
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/utils"
+)
+
+type CreateMeasurementDTO struct {
+	Kind       domain.MeasurementKind
+	Value      float64
+	Unit       domain.MeasurementUnit
+	MeasuredAt time.Time
+	Note       string
+}
+
+type UpdateMeasurementDTO struct {
+	Value      utils.Nullable[float64]
+	Unit       utils.Nullable[domain.MeasurementUnit]
+	MeasuredAt utils.Nullable[time.Time]
+	Note       utils.Nullable[string]
+}
+
+type GetMeasurementsDTO struct {
+	UserID domain.ID
+	// Kind is MeasurementKindUnknown to get measurements of every kind
+	Kind domain.MeasurementKind
+	// From and To bound measured_at, zero values leave the range open
+	From   time.Time
+	To     time.Time
+	Offset int
+	// Limit is 0 to get every measurement
+	Limit int
+}
+
+type MeasurementTrendPointDTO struct {
+	// Date is the start of the day the value was measured on
+	Date time.Time
+	// Value is the mean of the measurements of the day
+	Value float64
+	// MovingAverage is the mean of the daily values within the window ending on the date
+	MovingAverage float64
+}
+
+type MeasurementTrendDTO struct {
+	Kind domain.MeasurementKind
+	// Unit is the base unit of the kind, values measured in other units are converted
+	Unit   domain.MeasurementUnit
+	Points []MeasurementTrendPointDTO
+	// Change is the difference between moving averages of the last and the first points
+	Change float64
+}
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/utils"
+)
+
+// CreatePastWorkoutDTO is a finished workout entered after the fact.
+type CreatePastWorkoutDTO struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Notes      string
+	Rating     int
+	Exercises  []PastExerciseLogDTO
+}
+
+type PastExerciseLogDTO struct {
+	ExerciseID domain.ID
+	Notes      string
+	Sets       []PastSetLogDTO
+}
+
+type PastSetLogDTO struct {
+	Reps   int
+	Weight float32
+	Time   time.Duration
+	// CompletedAt is spread evenly over the workout if not set
+	CompletedAt utils.Nullable[time.Time]
+}
@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/utils"
+)
+
+type CreateProgressPhotoDTO struct {
+	FileID        domain.ID
+	Pose          domain.ProgressPhotoPose
+	TakenAt       time.Time
+	MeasurementID utils.Nullable[domain.ID]
+	IsPrivate     bool
+	Note          string
+}
+
+type UpdateProgressPhotoDTO struct {
+	Pose    utils.Nullable[domain.ProgressPhotoPose]
+	TakenAt utils.Nullable[time.Time]
+	// MeasurementID unlinks the measurement if it is valid but zero
+	MeasurementID utils.Nullable[domain.ID]
+	IsPrivate     utils.Nullable[bool]
+	Note          utils.Nullable[string]
+}
+
+type GetProgressPhotosDTO struct {
+	UserID domain.ID
+	// Pose is ProgressPhotoPoseUnknown to get photos of every pose
+	Pose   domain.ProgressPhotoPose
+	From   time.Time
+	To     time.Time
+	Offset int
+	Limit  int
+}
+
+// ProgressPhotoComparisonDTO holds the photos of a pose taken closest to the compared dates
+type ProgressPhotoComparisonDTO struct {
+	Pose   domain.ProgressPhotoPose
+	Before utils.Nullable[domain.ProgressPhoto]
+	After  utils.Nullable[domain.ProgressPhoto]
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/utils"
+)
+
+// RestIntervalDTO is the rest between two consecutive sets of a workout.
+type RestIntervalDTO struct {
+	// ExerciseLogID is the exercise of the set before the rest
+	ExerciseLogID    domain.ID
+	PreviousSetLogID domain.ID
+	SetLogID         domain.ID
+	Actual           time.Duration
+	Target           utils.Nullable[time.Duration]
+	IsLong           bool
+}
+
+type RestAnalyticsDTO struct {
+	Intervals         []RestIntervalDTO
+	TotalRest         time.Duration
+	AverageRest       time.Duration
+	AverageTargetRest time.Duration
+	LongGaps          int
+}
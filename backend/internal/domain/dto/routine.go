@@ -14,6 +14,7 @@ type RoutineDetailsDTO struct {
 	Description       string
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
+	WarmupSteps       []domain.WarmupStep
 	ExerciseInstances []ExerciseInstanceDetailsDTO
 }
 
@@ -22,9 +23,32 @@ type CreateRoutineDTO struct {
 	WorkoutID   utils.Nullable[domain.ID]
 	Name        string
 	Description string
+	WarmupSteps []domain.WarmupStep
 }
 
 type UpdateRoutineDTO struct {
 	Name        utils.Nullable[string]
 	Description utils.Nullable[string]
+	WarmupSteps utils.Nullable[[]domain.WarmupStep]
+}
+
+type DuplicateRoutineDTO struct {
+	// Name of the copy, the name of the routine is kept if empty
+	Name utils.Nullable[string]
+	// Version to copy, the current state of the routine is copied if empty
+	Version utils.Nullable[int]
+}
+
+type RoutineTemplatesFilterDTO struct {
+	// Query is matched against names, descriptions and programs of templates
+	Query   string
+	Program string
+}
+
+type RoutineTemplatesSeedReportDTO struct {
+	Created   int
+	Updated   int
+	Unchanged int
+	// Failed are errors of templates which could not be loaded by their keys
+	Failed map[string]string
 }
@@ -12,10 +12,14 @@ type CreateSetDTO struct {
 	Reps               utils.Nullable[int]
 	Weight             utils.Nullable[float32]
 	Time               utils.Nullable[time.Duration]
+	Rest               utils.Nullable[time.Duration]
+	// Position inserts the set before the set at this index, the set is appended if not set
+	Position utils.Nullable[int]
 }
 
 type UpdateSetDTO struct {
 	Reps   utils.Nullable[int]
 	Weight utils.Nullable[float32]
 	Time   utils.Nullable[time.Duration]
+	Rest   utils.Nullable[time.Duration]
 }
\ No newline at end of file
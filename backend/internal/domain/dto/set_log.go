@@ -1,13 +1,25 @@
 package dto
 
-import "fitness-trainer/internal/utils"
+import (
+	"time"
+
+	"fitness-trainer/internal/utils"
+)
 
 type CreateSetLogDTO struct {
 	Reps   int
 	Weight float32
+	// StartedAt is when the user began the set as reported by the client
+	StartedAt utils.Nullable[time.Time]
+	// Position inserts the set log before the set log at this index, it's appended if not set
+	Position utils.Nullable[int]
+	// Amend allows logging into a finished workout after the amend window
+	Amend bool
 }
 
 type UpdateSetLogDTO struct {
 	Reps   utils.Nullable[int]
 	Weight utils.Nullable[float32]
-}
\ No newline at end of file
+	// Amend allows changing a finished workout after the amend window
+	Amend bool
+}
@@ -0,0 +1,20 @@
+package dto
+
+import "fitness-trainer/internal/domain"
+
+type ObjectReferencesDTO struct {
+	Keys []string
+	// URLs are references which only keep the URL of the object
+	URLs []string
+}
+
+type StorageGCReportDTO struct {
+	DryRun  bool
+	Scanned int
+	// Recent objects are younger than the grace period and are never deleted
+	Recent     int
+	Referenced int
+	Orphaned   []domain.StorageObject
+	Deleted    int
+	Failed     int
+}
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/utils"
 	"time"
 )
@@ -23,4 +24,6 @@ type UpdateUserDTO struct {
 	Weight        utils.Nullable[float32]
 	ProfilePicURL utils.Nullable[string]
 	DateOfBirth   time.Time
+	// ProfilePictureFileID takes precedence over ProfilePicURL
+	ProfilePictureFileID utils.Nullable[domain.ID]
 }
@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/utils"
+)
+
+type UpdateUserPreferencesDTO struct {
+	WeightUnit     utils.Nullable[domain.MeasurementUnit]
+	LengthUnit     utils.Nullable[domain.MeasurementUnit]
+	Locale         utils.Nullable[string]
+	FirstDayOfWeek utils.Nullable[time.Weekday]
+	Timezone       utils.Nullable[string]
+}
+
+type WorkoutCalendarDayDTO struct {
+	// Date is the start of the day in the user's time zone
+	Date         time.Time
+	WorkoutCount int
+}
+
+type WorkoutStreakDTO struct {
+	// CurrentDays is the number of consecutive days with workouts ending today or yesterday
+	CurrentDays int
+	LongestDays int
+	// CurrentWeeks is the number of consecutive weeks with workouts ending this or the previous week
+	CurrentWeeks  int
+	LongestWeeks  int
+	LastWorkoutAt time.Time
+}
@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"time"
+
+	"fitness-trainer/internal/domain"
+)
+
+type WorkoutReportDTO struct {
+	Workout      domain.Workout
+	ExerciseLogs []domain.ExerciseLog
+	Groups       []ExerciseGroupReportDTO
+	TotalSets    int
+	TotalReps    int
+	// Sum of weight times reps over all logged sets
+	TotalWeight float32
+	TotalTime   time.Duration
+}
+
+type ExerciseGroupReportDTO struct {
+	Group          domain.ExerciseGroup
+	ExerciseLogIDs []domain.ID
+	// Rounds in which every exercise of the group has a logged set
+	CompletedRounds int
+}
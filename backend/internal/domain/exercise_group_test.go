@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestExerciseGroupValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		groupType ExerciseGroupType
+		rounds    int
+		size      int
+		wantErr   bool
+	}{
+		{name: "superset of two", groupType: ExerciseGroupTypeSuperset, rounds: 3, size: 2},
+		{name: "superset of three", groupType: ExerciseGroupTypeSuperset, rounds: 3, size: 3, wantErr: true},
+		{name: "giant set of three", groupType: ExerciseGroupTypeGiantSet, rounds: 3, size: 3},
+		{name: "giant set of two", groupType: ExerciseGroupTypeGiantSet, rounds: 3, size: 2, wantErr: true},
+		{name: "circuit of two", groupType: ExerciseGroupTypeCircuit, rounds: 3, size: 2},
+		{name: "circuit of six", groupType: ExerciseGroupTypeCircuit, rounds: 3, size: 6},
+		{name: "circuit of one", groupType: ExerciseGroupTypeCircuit, rounds: 3, size: 1, wantErr: true},
+		{name: "no rounds", groupType: ExerciseGroupTypeCircuit, rounds: 0, size: 3, wantErr: true},
+		{name: "most rounds", groupType: ExerciseGroupTypeCircuit, rounds: maxExerciseGroupRounds, size: 3},
+		{name: "too many rounds", groupType: ExerciseGroupTypeCircuit, rounds: maxExerciseGroupRounds + 1, size: 3, wantErr: true},
+		{name: "unknown type", groupType: ExerciseGroupTypeUnknown, rounds: 3, size: 2, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewExerciseGroup(tt.groupType, tt.rounds).Validate(tt.size)
+			if tt.wantErr != errors.Is(err, ErrInvalidArgument) {
+				t.Errorf("Validate() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGymProfileLoadout(t *testing.T) {
+	onlyTwentyFives := GymProfile{
+		BarWeight: 20,
+		Plates:    []PlateInventory{{Weight: 25, Count: 2}},
+	}
+	fives := GymProfile{
+		BarWeight: 20,
+		Plates:    []PlateInventory{{Weight: 5, Count: 4}},
+	}
+
+	tests := []struct {
+		name    string
+		profile GymProfile
+		target  float32
+		weight  float32
+		plates  []float32
+	}{
+		{
+			name:    "lighter than the bar",
+			profile: DefaultGymProfile(NewID()),
+			target:  15,
+			weight:  20,
+			plates:  []float32{},
+		},
+		{
+			name:    "exact with one plate",
+			profile: DefaultGymProfile(NewID()),
+			target:  60,
+			weight:  60,
+			plates:  []float32{20},
+		},
+		{
+			name:    "exact with fewest plates",
+			profile: DefaultGymProfile(NewID()),
+			target:  117.5,
+			weight:  117.5,
+			plates:  []float32{25, 20, 2.5, 1.25},
+		},
+		{
+			name:    "rounded to the closest weight",
+			profile: DefaultGymProfile(NewID()),
+			target:  101,
+			weight:  100,
+			plates:  []float32{20, 20},
+		},
+		{
+			name:    "ties are rounded down",
+			profile: fives,
+			target:  25,
+			weight:  20,
+			plates:  []float32{},
+		},
+		{
+			name:    "plates too heavy for the target",
+			profile: onlyTwentyFives,
+			target:  30,
+			weight:  20,
+			plates:  []float32{},
+		},
+		{
+			name:    "not enough plates for the target",
+			profile: onlyTwentyFives,
+			target:  200,
+			weight:  70,
+			plates:  []float32{25},
+		},
+		{
+			name:    "target beyond the heaviest loadout",
+			profile: fives,
+			target:  5000,
+			weight:  40,
+			plates:  []float32{5, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loadout := tt.profile.Loadout(tt.target)
+
+			if loadout.Weight != tt.weight {
+				t.Errorf("weight = %v, want %v", loadout.Weight, tt.weight)
+			}
+			if !slices.Equal(loadout.PlatesPerSide, tt.plates) {
+				t.Errorf("plates per side = %v, want %v", loadout.PlatesPerSide, tt.plates)
+			}
+			if loadout.BarWeight != tt.profile.BarWeight {
+				t.Errorf("bar weight = %v, want %v", loadout.BarWeight, tt.profile.BarWeight)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNewMeasurement(t *testing.T) {
+	tests := []struct {
+		name      string
+		kind      MeasurementKind
+		value     float64
+		unit      MeasurementUnit
+		wantBase  float64
+		wantError bool
+	}{
+		{name: "bodyweight in kilograms", kind: MeasurementKindBodyweight, value: 80, unit: MeasurementUnitKilogram, wantBase: 80},
+		{name: "bodyweight in pounds", kind: MeasurementKindBodyweight, value: 100, unit: MeasurementUnitPound, wantBase: 45.359237},
+		{name: "waist in inches", kind: MeasurementKindWaist, value: 30, unit: MeasurementUnitInch, wantBase: 76.2},
+		{name: "body fat in percent", kind: MeasurementKindBodyFat, value: 15, unit: MeasurementUnitPercent, wantBase: 15},
+		{name: "bodyweight in centimeters", kind: MeasurementKindBodyweight, value: 80, unit: MeasurementUnitCentimeter, wantError: true},
+		{name: "waist in pounds", kind: MeasurementKindWaist, value: 30, unit: MeasurementUnitPound, wantError: true},
+		{name: "unknown kind", kind: "height", value: 180, unit: MeasurementUnitCentimeter, wantError: true},
+		{name: "zero value", kind: MeasurementKindBodyweight, value: 0, unit: MeasurementUnitKilogram, wantError: true},
+		{name: "body fat of 100 percent", kind: MeasurementKindBodyFat, value: 100, unit: MeasurementUnitPercent, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			measurement, err := NewMeasurement(NewID(), tt.kind, tt.value, tt.unit, time.Now(), "")
+			if tt.wantError {
+				if !errors.Is(err, ErrInvalidArgument) {
+					t.Fatalf("error = %v, want %v", err, ErrInvalidArgument)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if base := measurement.BaseValue(); math.Abs(base-tt.wantBase) > 1e-9 {
+				t.Errorf("base value = %f, want %f", base, tt.wantBase)
+			}
+			if back := tt.unit.FromBase(measurement.BaseValue()); math.Abs(back-tt.value) > 1e-9 {
+				t.Errorf("value converted back = %f, want %f", back, tt.value)
+			}
+		})
+	}
+}
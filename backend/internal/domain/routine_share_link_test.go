@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRoutineShareLinkIsExpired(t *testing.T) {
+	expiresAt := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		at        time.Time
+		want      bool
+	}{
+		{name: "permanent link", at: expiresAt.AddDate(10, 0, 0)},
+		{name: "before expiration", expiresAt: expiresAt, at: expiresAt.Add(-time.Second)},
+		{name: "at expiration", expiresAt: expiresAt, at: expiresAt, want: true},
+		{name: "after expiration", expiresAt: expiresAt, at: expiresAt.Add(time.Second), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			link := NewRoutineShareLink(NewID(), "token", tt.expiresAt)
+			if got := link.IsExpired(tt.at); got != tt.want {
+				t.Errorf("IsExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "testing"
+
+func TestRoutineLocalized(t *testing.T) {
+	routine := Routine{
+		Name:        "5x5: Workout A",
+		Description: "Squat, bench press and barbell row",
+		Translations: map[string]RoutineTranslation{
+			"ru": {Name: "5x5: Тренировка A", Description: "Присед, жим лёжа и тяга штанги"},
+			"de": {Name: "5x5: Training A"},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		locale          string
+		wantName        string
+		wantDescription string
+	}{
+		{name: "translated", locale: "ru", wantName: "5x5: Тренировка A", wantDescription: "Присед, жим лёжа и тяга штанги"},
+		{name: "translation without description", locale: "de", wantName: "5x5: Training A", wantDescription: "Squat, bench press and barbell row"},
+		{name: "no translation", locale: "en", wantName: "5x5: Workout A", wantDescription: "Squat, bench press and barbell row"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := routine.Localized(tt.locale)
+			if got.Name != tt.wantName || got.Description != tt.wantDescription {
+				t.Errorf("Localized() = %q, %q, want %q, %q", got.Name, got.Description, tt.wantName, tt.wantDescription)
+			}
+		})
+	}
+
+	if routine.Name != "5x5: Workout A" {
+		t.Errorf("original routine was changed")
+	}
+}
@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"maps"
+	"testing"
+
+	"fitness-trainer/internal/utils"
+)
+
+func TestKeptOrder(t *testing.T) {
+	a, b, c, d := NewID(), NewID(), NewID(), NewID()
+
+	tests := []struct {
+		name       string
+		before     []ID
+		after      []ID
+		beforeRank map[ID]int
+		afterRank  map[ID]int
+	}{
+		{
+			name:       "same order",
+			before:     []ID{a, b, c},
+			after:      []ID{a, b, c},
+			beforeRank: map[ID]int{a: 0, b: 1, c: 2},
+			afterRank:  map[ID]int{a: 0, b: 1, c: 2},
+		},
+		{
+			name:       "added item does not move the others",
+			before:     []ID{a, b},
+			after:      []ID{d, a, b},
+			beforeRank: map[ID]int{a: 0, b: 1},
+			afterRank:  map[ID]int{a: 0, b: 1},
+		},
+		{
+			name:       "removed item does not move the others",
+			before:     []ID{a, b, c},
+			after:      []ID{b, c},
+			beforeRank: map[ID]int{b: 0, c: 1},
+			afterRank:  map[ID]int{b: 0, c: 1},
+		},
+		{
+			name:       "swapped items",
+			before:     []ID{a, b, c},
+			after:      []ID{b, a, c},
+			beforeRank: map[ID]int{a: 0, b: 1, c: 2},
+			afterRank:  map[ID]int{b: 0, a: 1, c: 2},
+		},
+		{
+			name:       "nothing kept",
+			before:     []ID{a},
+			after:      []ID{b},
+			beforeRank: map[ID]int{},
+			afterRank:  map[ID]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeRank, afterRank := keptOrder(tt.before, tt.after)
+
+			if !maps.Equal(beforeRank, tt.beforeRank) {
+				t.Errorf("before rank = %v, want %v", beforeRank, tt.beforeRank)
+			}
+			if !maps.Equal(afterRank, tt.afterRank) {
+				t.Errorf("after rank = %v, want %v", afterRank, tt.afterRank)
+			}
+		})
+	}
+}
+
+func TestDiffRoutineSnapshots(t *testing.T) {
+	squat, bench, row := NewID(), NewID(), NewID()
+	squatSet, benchSet := NewID(), NewID()
+
+	exercise := func(instanceID ID, sets ...Set) RoutineSnapshotExercise {
+		return RoutineSnapshotExercise{ExerciseInstanceID: instanceID, ExerciseID: NewID(), Sets: sets}
+	}
+	set := func(id ID, reps int, weight float32) Set {
+		return Set{Model: Model{ID: id}, SetType: SetTypeReps, Reps: reps, Weight: weight}
+	}
+
+	base := RoutineSnapshot{
+		Name:        "Push",
+		WarmupSteps: []WarmupStep{{Percent: 50, Reps: 5}},
+		Exercises: []RoutineSnapshotExercise{
+			exercise(squat, set(squatSet, 5, 100)),
+			exercise(bench, set(benchSet, 5, 80)),
+		},
+	}
+
+	tests := []struct {
+		name      string
+		after     func(RoutineSnapshot) RoutineSnapshot
+		changes   []string
+		exercises map[ID]RoutineChangeAction
+		sets      map[ID]RoutineChangeAction
+	}{
+		{
+			name:  "nothing changed",
+			after: func(s RoutineSnapshot) RoutineSnapshot { return s },
+		},
+		{
+			name: "routine fields changed",
+			after: func(s RoutineSnapshot) RoutineSnapshot {
+				s.Name = "Push A"
+				s.WarmupSteps = nil
+				return s
+			},
+			changes: []string{"name", "warmup_steps"},
+		},
+		{
+			name: "exercise added",
+			after: func(s RoutineSnapshot) RoutineSnapshot {
+				s.Exercises = append([]RoutineSnapshotExercise{exercise(row)}, s.Exercises...)
+				return s
+			},
+			exercises: map[ID]RoutineChangeAction{row: RoutineChangeActionAdded},
+		},
+		{
+			name: "exercise removed",
+			after: func(s RoutineSnapshot) RoutineSnapshot {
+				s.Exercises = s.Exercises[1:]
+				return s
+			},
+			exercises: map[ID]RoutineChangeAction{squat: RoutineChangeActionRemoved},
+			sets:      map[ID]RoutineChangeAction{squatSet: RoutineChangeActionRemoved},
+		},
+		{
+			name: "exercises swapped",
+			after: func(s RoutineSnapshot) RoutineSnapshot {
+				s.Exercises = []RoutineSnapshotExercise{s.Exercises[1], s.Exercises[0]}
+				return s
+			},
+			exercises: map[ID]RoutineChangeAction{squat: RoutineChangeActionModified, bench: RoutineChangeActionModified},
+		},
+		{
+			name: "exercise grouped",
+			after: func(s RoutineSnapshot) RoutineSnapshot {
+				s.Exercises = []RoutineSnapshotExercise{s.Exercises[0], s.Exercises[1]}
+				s.Exercises[1].Group = utils.NewNullable(ExerciseGroup{ID: NewID(), Type: ExerciseGroupTypeSuperset, Rounds: 3}, true)
+				return s
+			},
+			exercises: map[ID]RoutineChangeAction{bench: RoutineChangeActionModified},
+		},
+		{
+			name: "set changed",
+			after: func(s RoutineSnapshot) RoutineSnapshot {
+				s.Exercises = []RoutineSnapshotExercise{exercise(squat, set(squatSet, 5, 105)), s.Exercises[1]}
+				return s
+			},
+			exercises: map[ID]RoutineChangeAction{squat: RoutineChangeActionModified},
+			sets:      map[ID]RoutineChangeAction{squatSet: RoutineChangeActionModified},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := DiffRoutineSnapshots(base, tt.after(base))
+
+			changes := make(map[string]FieldChange, len(tt.changes))
+			for _, field := range tt.changes {
+				changes[field] = diff.Changes[field]
+			}
+			if !maps.Equal(diff.Changes, changes) {
+				t.Errorf("changes = %v, want fields %v", diff.Changes, tt.changes)
+			}
+
+			exercises := make(map[ID]RoutineChangeAction)
+			sets := make(map[ID]RoutineChangeAction)
+			for _, exercise := range diff.Exercises {
+				exercises[exercise.ExerciseInstanceID] = exercise.Action
+				for _, set := range exercise.Sets {
+					sets[set.SetID] = set.Action
+				}
+			}
+			if !maps.Equal(exercises, tt.exercises) {
+				t.Errorf("exercises = %v, want %v", exercises, tt.exercises)
+			}
+			if !maps.Equal(sets, tt.sets) {
+				t.Errorf("sets = %v, want %v", sets, tt.sets)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStartOfWeek(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone is not available: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		t        time.Time
+		firstDay time.Weekday
+		want     time.Time
+	}{
+		{
+			name:     "week starting on Monday",
+			t:        time.Date(2024, time.May, 8, 15, 0, 0, 0, location),
+			firstDay: time.Monday,
+			want:     time.Date(2024, time.May, 6, 0, 0, 0, 0, location),
+		},
+		{
+			name:     "week starting on Sunday",
+			t:        time.Date(2024, time.May, 8, 15, 0, 0, 0, location),
+			firstDay: time.Sunday,
+			want:     time.Date(2024, time.May, 5, 0, 0, 0, 0, location),
+		},
+		{
+			name:     "first day of the week",
+			t:        time.Date(2024, time.May, 6, 0, 0, 0, 0, location),
+			firstDay: time.Monday,
+			want:     time.Date(2024, time.May, 6, 0, 0, 0, 0, location),
+		},
+		{
+			name: "day is taken in the location",
+			// Monday in UTC, still Sunday in New York
+			t:        time.Date(2024, time.May, 6, 2, 0, 0, 0, time.UTC),
+			firstDay: time.Monday,
+			want:     time.Date(2024, time.April, 29, 0, 0, 0, 0, location),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StartOfWeek(tt.t, location, tt.firstDay); !got.Equal(tt.want) {
+				t.Errorf("StartOfWeek() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserPreferencesValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(p *UserPreferences)
+		wantErr bool
+	}{
+		{name: "defaults", update: func(p *UserPreferences) {}},
+		{name: "pounds and inches", update: func(p *UserPreferences) { p.WeightUnit, p.LengthUnit = MeasurementUnitPound, MeasurementUnitInch }},
+		{name: "length unit as weight unit", update: func(p *UserPreferences) { p.WeightUnit = MeasurementUnitCentimeter }, wantErr: true},
+		{name: "unsupported locale", update: func(p *UserPreferences) { p.Locale = "xx" }, wantErr: true},
+		{name: "invalid first day of week", update: func(p *UserPreferences) { p.FirstDayOfWeek = 7 }, wantErr: true},
+		{name: "unknown timezone", update: func(p *UserPreferences) { p.Timezone = "Mars/Olympus" }, wantErr: true},
+		{name: "local timezone", update: func(p *UserPreferences) { p.Timezone = "Local" }, wantErr: true},
+		{name: "empty timezone", update: func(p *UserPreferences) { p.Timezone = "" }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preferences := DefaultUserPreferences(NewID())
+			tt.update(&preferences)
+
+			if err := preferences.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
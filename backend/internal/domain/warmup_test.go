@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPlanWarmups(t *testing.T) {
+	roundTo := func(step float32) func(float32) float32 {
+		return func(weight float32) float32 {
+			return float32(math.Round(float64(weight/step))) * step
+		}
+	}
+
+	tests := []struct {
+		name    string
+		weight  float32
+		steps   []WarmupStep
+		round   func(float32) float32
+		weights []float32
+		reps    []int
+	}{
+		{
+			name:    "default steps",
+			weight:  100,
+			steps:   DefaultWarmupSteps(),
+			round:   roundTo(2.5),
+			weights: []float32{40, 60, 80},
+			reps:    []int{5, 3, 2},
+		},
+		{
+			name:    "rounded to loadable weights",
+			weight:  105,
+			steps:   DefaultWarmupSteps(),
+			round:   roundTo(5),
+			weights: []float32{40, 65, 85},
+			reps:    []int{5, 3, 2},
+		},
+		{
+			name:    "steps rounding to the same weight are skipped",
+			weight:  10,
+			steps:   []WarmupStep{{Percent: 40, Reps: 5}, {Percent: 50, Reps: 3}, {Percent: 60, Reps: 2}},
+			round:   roundTo(5),
+			weights: []float32{5},
+			reps:    []int{5},
+		},
+		{
+			name:    "steps rounding to the working weight are skipped",
+			weight:  20,
+			steps:   []WarmupStep{{Percent: 50, Reps: 5}, {Percent: 90, Reps: 1}},
+			round:   roundTo(10),
+			weights: []float32{10},
+			reps:    []int{5},
+		},
+		{
+			name:   "no steps",
+			weight: 100,
+			round:  roundTo(2.5),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			working := NewExpectedSet(NewID(), SetTypeReps, 5, tt.weight, 0)
+
+			warmups := PlanWarmups(working, tt.steps, tt.round)
+
+			if len(warmups) != len(tt.weights) {
+				t.Fatalf("got %d warm-ups, want %d", len(warmups), len(tt.weights))
+			}
+			for i, warmup := range warmups {
+				if warmup.Weight != tt.weights[i] || warmup.Reps != tt.reps[i] {
+					t.Errorf("warm-up %d = %v x %d, want %v x %d", i, warmup.Weight, warmup.Reps, tt.weights[i], tt.reps[i])
+				}
+				if !warmup.IsWarmup {
+					t.Errorf("warm-up %d is not marked as a warm-up", i)
+				}
+				if warmup.ExerciseLogID != working.ExerciseLogID || warmup.SetType != working.SetType {
+					t.Errorf("warm-up %d does not belong to the working set", i)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"maps"
+	"testing"
+	"time"
+)
+
+func TestDiffSetLogs(t *testing.T) {
+	setLog := ExerciseSetLog{Model: Model{ID: NewID()}, Reps: 5, Weight: 100}
+
+	tests := []struct {
+		name   string
+		before ExerciseSetLog
+		after  ExerciseSetLog
+		want   map[string]FieldChange
+	}{
+		{
+			name:   "nothing changed",
+			before: setLog,
+			after:  setLog,
+			want:   map[string]FieldChange{},
+		},
+		{
+			name:   "reps and weight changed",
+			before: setLog,
+			after:  ExerciseSetLog{Model: setLog.Model, Reps: 6, Weight: 102.5},
+			want: map[string]FieldChange{
+				"reps":   {From: "5", To: "6"},
+				"weight": {From: "100", To: "102.5"},
+			},
+		},
+		{
+			name:   "time changed",
+			before: ExerciseSetLog{Model: setLog.Model, Time: 30 * time.Second},
+			after:  ExerciseSetLog{Model: setLog.Model, Time: 45 * time.Second},
+			want: map[string]FieldChange{
+				"time": {From: "30s", To: "45s"},
+			},
+		},
+		{
+			name:  "set logged",
+			after: setLog,
+			want: map[string]FieldChange{
+				"reps":   {From: "", To: "5"},
+				"weight": {From: "", To: "100"},
+				"time":   {From: "", To: "0s"},
+			},
+		},
+		{
+			name:   "set deleted",
+			before: setLog,
+			want: map[string]FieldChange{
+				"reps":   {From: "5", To: ""},
+				"weight": {From: "100", To: ""},
+				"time":   {From: "0s", To: ""},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiffSetLogs(tt.before, tt.after); !maps.Equal(got, tt.want) {
+				t.Errorf("DiffSetLogs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
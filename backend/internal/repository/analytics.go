@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+type muscleGroupVolumeEntity struct {
+	MuscleGroupID  pgtype.UUID
+	Name           string
+	DirectSets     int
+	FractionalSets float32
+}
+
+func (e muscleGroupVolumeEntity) toDTO() dto.MuscleGroupVolumeDTO {
+	return dto.MuscleGroupVolumeDTO{
+		MuscleGroupID:  domain.ID(e.MuscleGroupID.Bytes),
+		Name:           e.Name,
+		DirectSets:     e.DirectSets,
+		FractionalSets: e.FractionalSets,
+	}
+}
+
+// GetMuscleGroupVolume counts logged sets per muscle group for workouts started in [from, to).
+// Every set is credited to each muscle group of the exercise with its activation weight,
+// so secondary muscles get fractional sets.
+func (r *PGXRepository) GetMuscleGroupVolume(ctx context.Context, userID domain.ID, from, to time.Time) ([]dto.MuscleGroupVolumeDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetMuscleGroupVolume")
+	defer span.Finish()
+
+	query := `
+		SELECT
+			mg.id AS muscle_group_id,
+			mg.name,
+			COUNT(*) FILTER (WHERE emg.role = 'primary') AS direct_sets,
+			SUM(emg.activation) AS fractional_sets
+		FROM set_logs sl
+		JOIN exercise_logs el ON sl.exercise_log_id = el.id
+		JOIN workouts w ON el.workout_id = w.id
+		JOIN exercise_muscle_groups emg ON emg.exercise_id = el.exercise_id
+		JOIN muscle_groups mg ON emg.muscle_group_id = mg.id
+		WHERE w.user_id = $1 AND w.created_at >= $2 AND w.created_at < $3
+		GROUP BY mg.id, mg.name
+		ORDER BY fractional_sets DESC, mg.name;
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var volume []muscleGroupVolumeEntity
+	if err := pgxscan.Select(ctx, engine, &volume, query, uuidToPgtype(userID), from, to); err != nil {
+		logger.Errorf("failed to get muscle group volume: %v", err)
+		return nil, err
+	}
+
+	result := make([]dto.MuscleGroupVolumeDTO, 0, len(volume))
+	for _, v := range volume {
+		result = append(result, v.toDTO())
+	}
+
+	return result, nil
+}
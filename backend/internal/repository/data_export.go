@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+type dataExportEntity struct {
+	ID        pgtype.UUID
+	UserID    pgtype.UUID
+	Status    string
+	Attempts  int
+	LastError string
+	RunAfter  pgtype.Timestamptz
+	Key       string
+	Size      int64
+	ExpiresAt pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+func (e dataExportEntity) toDomain() domain.DataExport {
+	return domain.DataExport{
+		Model: domain.Model{
+			ID:        domain.ID(e.ID.Bytes),
+			CreatedAt: timeFromPgtype(e.CreatedAt),
+			UpdatedAt: timeFromPgtype(e.UpdatedAt),
+		},
+		UserID:    domain.ID(e.UserID.Bytes),
+		Status:    domain.DataExportStatus(e.Status),
+		Attempts:  e.Attempts,
+		LastError: e.LastError,
+		RunAfter:  timeFromPgtype(e.RunAfter),
+		Key:       e.Key,
+		Size:      e.Size,
+		ExpiresAt: timeFromPgtype(e.ExpiresAt),
+	}
+}
+
+const dataExportColumns = `
+	id, user_id, status, attempts, last_error, run_after, key, size, expires_at, created_at, updated_at
+`
+
+func (r *PGXRepository) CreateDataExport(ctx context.Context, export domain.DataExport) (domain.DataExport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.CreateDataExport")
+	defer span.Finish()
+
+	query := `
+		INSERT INTO data_exports (id, user_id, status, run_after, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING` + dataExportColumns
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity dataExportEntity
+	err := pgxscan.Get(
+		ctx,
+		engine,
+		&entity,
+		query,
+		uuidToPgtype(export.ID),
+		uuidToPgtype(export.UserID),
+		export.Status.String(),
+		timeToPgtype(export.RunAfter),
+		timeToPgtype(export.CreatedAt),
+		timeToPgtype(export.UpdatedAt),
+	)
+	if err != nil {
+		logger.Errorf("failed to create data export: %v", err)
+		return domain.DataExport{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) GetDataExportByID(ctx context.Context, id domain.ID) (domain.DataExport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetDataExportByID")
+	defer span.Finish()
+
+	query := `SELECT` + dataExportColumns + `FROM data_exports WHERE id = $1`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity dataExportEntity
+	if err := pgxscan.Get(ctx, engine, &entity, query, uuidToPgtype(id)); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.DataExport{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get data export: %v", err)
+		return domain.DataExport{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) GetDataExports(ctx context.Context, userID domain.ID) ([]domain.DataExport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetDataExports")
+	defer span.Finish()
+
+	query := `SELECT` + dataExportColumns + `FROM data_exports WHERE user_id = $1 ORDER BY created_at DESC`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entities []dataExportEntity
+	if err := pgxscan.Select(ctx, engine, &entities, query, uuidToPgtype(userID)); err != nil {
+		logger.Errorf("failed to get data exports: %v", err)
+		return nil, err
+	}
+
+	result := make([]domain.DataExport, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, entity.toDomain())
+	}
+
+	return result, nil
+}
+
+// ClaimDataExports marks up to limit due exports as running for the lease duration and
+// returns them. Running exports whose lease has expired are claimed again.
+func (r *PGXRepository) ClaimDataExports(ctx context.Context, limit int, lease time.Duration) ([]domain.DataExport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.ClaimDataExports")
+	defer span.Finish()
+
+	query := `
+		UPDATE data_exports
+		SET status = 'running', attempts = attempts + 1, run_after = NOW() + $2::INTERVAL, updated_at = NOW()
+		WHERE id IN (
+			SELECT id
+			FROM data_exports
+			WHERE status IN ('pending', 'running') AND run_after <= NOW()
+			ORDER BY run_after
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING` + dataExportColumns
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entities []dataExportEntity
+	if err := pgxscan.Select(ctx, engine, &entities, query, limit, lease); err != nil {
+		logger.Errorf("failed to claim data exports: %v", err)
+		return nil, err
+	}
+
+	result := make([]domain.DataExport, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, entity.toDomain())
+	}
+
+	return result, nil
+}
+
+func (r *PGXRepository) UpdateDataExport(ctx context.Context, id domain.ID, export domain.DataExport) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.UpdateDataExport")
+	defer span.Finish()
+
+	query := `
+		UPDATE data_exports
+		SET status = $2, last_error = $3, run_after = $4, key = $5, size = $6, expires_at = $7, updated_at = NOW()
+		WHERE id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	_, err := engine.Exec(
+		ctx,
+		query,
+		uuidToPgtype(id),
+		export.Status.String(),
+		export.LastError,
+		timeToPgtype(export.RunAfter),
+		export.Key,
+		export.Size,
+		timeToPgtype(export.ExpiresAt),
+	)
+	if err != nil {
+		logger.Errorf("failed to update data export: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
 	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5"
@@ -12,31 +14,126 @@ import (
 	"github.com/opentracing/opentracing-go"
 )
 
+type exerciseMuscleGroupEntity struct {
+	MuscleGroupID string  `json:"muscle_group_id"`
+	Name          string  `json:"name"`
+	Role          string  `json:"role"`
+	Activation    float32 `json:"activation"`
+}
+
+func (e exerciseMuscleGroupEntity) toDomain() domain.ExerciseMuscleGroup {
+	muscleGroupID, err := domain.ParseID(e.MuscleGroupID)
+	if err != nil {
+		logger.Errorf("failed to parse muscle group id %q: %v", e.MuscleGroupID, err)
+	}
+
+	return domain.ExerciseMuscleGroup{
+		MuscleGroupID: muscleGroupID,
+		MuscleGroup:   domain.MuscleGroup(e.Name),
+		Role:          domain.MuscleGroupRole(e.Role),
+		Activation:    e.Activation,
+	}
+}
+
+type exerciseAliasEntity struct {
+	Language string `json:"language"`
+	Alias    string `json:"alias"`
+}
+
+// exerciseMediaItemEntity is a ready media file aggregated into an exercise row.
+type exerciseMediaItemEntity struct {
+	ID          string               `json:"id"`
+	Kind        string               `json:"kind"`
+	Key         string               `json:"key"`
+	URL         string               `json:"url"`
+	ContentType string               `json:"content_type"`
+	Size        int64                `json:"size_bytes"`
+	Caption     string               `json:"caption"`
+	Position    int                  `json:"position"`
+	Variants    []imageVariantEntity `json:"variants"`
+}
+
 type exerciseEntity struct {
 	ID                 pgtype.UUID
+	OwnerID            pgtype.UUID
+	Visibility         string
+	ShareToken         pgtype.Text
+	ModerationStatus   string
+	ModerationComment  string
+	ArchivedAt         pgtype.Timestamptz
+	MergedIntoID       pgtype.UUID
 	Name               string
 	Description        pgtype.Text
 	VideoURL           pgtype.Text
+	Equipment          string
+	Aliases            []exerciseAliasEntity
+	Media              []exerciseMediaItemEntity
 	TargetMuscleGroups pgtype.Array[string]
+	MuscleGroups       []exerciseMuscleGroupEntity
 	CreatedAt          pgtype.Timestamptz
 	UpdatedAt          pgtype.Timestamptz
 }
 
+// rankedExerciseEntity is an exercise with its position in the order of GetExercises.
+type rankedExerciseEntity struct {
+	exerciseEntity
+	Rank float64
+}
+
 func (e exerciseEntity) toDomain() domain.Exercise {
 	musclegroups := make([]domain.MuscleGroup, len(e.TargetMuscleGroups.Elements))
 	for i, mg := range e.TargetMuscleGroups.Elements {
 		musclegroups[i] = domain.MuscleGroup(mg)
 	}
+	exerciseMuscleGroups := make([]domain.ExerciseMuscleGroup, len(e.MuscleGroups))
+	for i, mg := range e.MuscleGroups {
+		exerciseMuscleGroups[i] = mg.toDomain()
+	}
+	aliases := make([]domain.ExerciseAlias, len(e.Aliases))
+	for i, alias := range e.Aliases {
+		aliases[i] = domain.ExerciseAlias{Language: alias.Language, Alias: alias.Alias}
+	}
+	media := make([]domain.ExerciseMedia, len(e.Media))
+	for i, m := range e.Media {
+		mediaID, err := domain.ParseID(m.ID)
+		if err != nil {
+			logger.Errorf("failed to parse exercise media id %q: %v", m.ID, err)
+		}
+		media[i] = domain.ExerciseMedia{
+			Model:       domain.Model{ID: mediaID},
+			ExerciseID:  domain.ID(e.ID.Bytes),
+			Kind:        domain.ExerciseMediaKind(m.Kind),
+			Status:      domain.ExerciseMediaStatusReady,
+			Key:         m.Key,
+			URL:         m.URL,
+			ContentType: m.ContentType,
+			Size:        m.Size,
+			Caption:     m.Caption,
+			Position:    m.Position,
+			Variants:    imageVariantsToDomain(m.Variants),
+		}
+	}
 	return domain.Exercise{
 		Model: domain.Model{
 			ID:        domain.ID(e.ID.Bytes),
 			CreatedAt: e.CreatedAt.Time,
 			UpdatedAt: e.UpdatedAt.Time,
 		},
+		OwnerID:            utils.NewNullable(domain.ID(e.OwnerID.Bytes), e.OwnerID.Valid),
+		Visibility:         domain.ExerciseVisibility(e.Visibility),
+		ShareToken:         e.ShareToken.String,
+		ModerationStatus:   domain.ModerationStatus(e.ModerationStatus),
+		ModerationComment:  e.ModerationComment,
+		ArchivedAt:         timeFromPgtype(e.ArchivedAt),
+		MergedIntoID:       utils.NewNullable(domain.ID(e.MergedIntoID.Bytes), e.MergedIntoID.Valid),
 		Name:               e.Name,
 		Description:        e.Description.String,
 		VideoURL:           e.VideoURL.String,
+		Equipment:          domain.Equipment(e.Equipment),
+		Aliases:            aliases,
+		Media:              media,
 		TargetMuscleGroups: musclegroups,
+		MuscleGroups:       exerciseMuscleGroups,
 	}
 }
 
@@ -45,50 +142,232 @@ func exerciseFromDomain(exercise domain.Exercise) exerciseEntity {
 	for i, mg := range exercise.TargetMuscleGroups {
 		musclegroups[i] = string(mg)
 	}
+	ownerID := pgtype.UUID{}
+	if exercise.OwnerID.IsValid {
+		ownerID = uuidToPgtype(exercise.OwnerID.V)
+	}
+	mergedIntoID := pgtype.UUID{}
+	if exercise.MergedIntoID.IsValid {
+		mergedIntoID = uuidToPgtype(exercise.MergedIntoID.V)
+	}
 	return exerciseEntity{
 		ID:                 uuidToPgtype(exercise.ID),
+		OwnerID:            ownerID,
+		Visibility:         exercise.Visibility.String(),
+		ShareToken:         pgtype.Text{String: exercise.ShareToken, Valid: exercise.ShareToken != ""},
+		ModerationStatus:   exercise.ModerationStatus.String(),
+		ModerationComment:  exercise.ModerationComment,
+		ArchivedAt:         timeToPgtype(exercise.ArchivedAt),
+		MergedIntoID:       mergedIntoID,
 		Name:               exercise.Name,
 		Description:        pgtype.Text{String: exercise.Description, Valid: exercise.Description != ""},
 		VideoURL:           pgtype.Text{String: exercise.VideoURL, Valid: exercise.VideoURL != ""},
+		Equipment:          exercise.Equipment.String(),
 		TargetMuscleGroups: pgtype.Array[string]{Elements: musclegroups, Valid: true},
 		CreatedAt:          timeToPgtype(exercise.CreatedAt),
 		UpdatedAt:          timeToPgtype(exercise.UpdatedAt),
 	}
 }
 
-func (r *PGXRepository) GetExercises(ctx context.Context, muscleGroups, excludedExercises []domain.ID) ([]domain.Exercise, error) {
+const exerciseColumns = `
+	e.id, e.owner_id, e.visibility, e.share_token, e.moderation_status, e.moderation_comment,
+	e.archived_at, e.merged_into_id, e.name, e.description, e.video_url, e.equipment, e.created_at, e.updated_at,
+	(
+		SELECT COALESCE(JSON_AGG(JSON_BUILD_OBJECT('language', ea.language, 'alias', ea.alias) ORDER BY ea.language, ea.alias), '[]')
+		FROM exercise_aliases ea
+		WHERE ea.exercise_id = e.id
+	) AS aliases,
+	(
+		SELECT COALESCE(JSON_AGG(JSON_BUILD_OBJECT(
+			'id', em.id,
+			'kind', em.kind,
+			'key', em.key,
+			'url', em.url,
+			'content_type', em.content_type,
+			'size_bytes', em.size_bytes,
+			'caption', em.caption,
+			'position', em.position,
+			'variants', em.variants
+		) ORDER BY em.position, em.created_at), '[]')
+		FROM exercise_media em
+		WHERE em.exercise_id = e.id AND em.status = 'ready'
+	) AS media,
+`
+
+// exerciseVisibleToUser is a condition on exercises joined as e which keeps only
+// exercises the user with id $1 can see: global ones, own ones and shared ones
+// whose share link the user has opened.
+const exerciseVisibleToUser = `
+	(
+		e.visibility = 'global'
+		OR e.owner_id = $1
+		OR (
+			e.visibility = 'shared'
+			AND EXISTS (SELECT 1 FROM exercise_shares es WHERE es.exercise_id = e.id AND es.user_id = $1)
+		)
+	)
+`
+
+// exerciseMuscleGroupsColumns aggregates muscle groups of an exercise joined as
+// emg (exercise_muscle_groups) and mg (muscle_groups). Only primary muscle groups
+// are reported as target ones.
+const exerciseMuscleGroupsColumns = `
+	COALESCE(
+		ARRAY_AGG(mg.name ORDER BY emg.activation DESC, mg.name) FILTER (WHERE emg.role = 'primary'),
+		'{}'
+	) AS target_muscle_groups,
+	JSON_AGG(
+		JSON_BUILD_OBJECT(
+			'muscle_group_id', mg.id,
+			'name', mg.name,
+			'role', emg.role,
+			'activation', emg.activation
+		) ORDER BY emg.activation DESC, mg.name
+	) AS muscle_groups
+`
+
+// exerciseSearchQuery is a condition on exercises joined as e which matches the search
+// query $5 against names, descriptions and aliases, either as a full-text query or
+// fuzzily by trigrams.
+const exerciseSearchQuery = `
+	(
+		$5::TEXT = ''
+		OR e.search_vector @@ websearch_to_tsquery('russian', $5::TEXT)
+		OR $5::TEXT <% e.name
+		OR $5::TEXT <% e.description
+		OR EXISTS (
+			SELECT 1
+			FROM exercise_aliases ea
+			WHERE ea.exercise_id = e.id
+				AND (
+					to_tsvector('russian', ea.alias) @@ websearch_to_tsquery('russian', $5::TEXT)
+					OR $5::TEXT <% ea.alias
+				)
+		)
+	)
+`
+
+// exerciseRank orders exercises by search relevance when there is a search query
+// and by activation of the requested muscle groups otherwise.
+const exerciseRank = `
+	CASE WHEN $5::TEXT = '' THEN
+		COALESCE(SUM(emg.activation) FILTER (WHERE emg.muscle_group_id = ANY($2::UUID[])), 0)
+	ELSE
+		GREATEST(
+			ts_rank(e.search_vector, websearch_to_tsquery('russian', $5::TEXT)),
+			word_similarity($5::TEXT, e.name),
+			COALESCE((SELECT MAX(word_similarity($5::TEXT, ea.alias)) FROM exercise_aliases ea WHERE ea.exercise_id = e.id), 0)
+		)
+	END::DOUBLE PRECISION AS rank
+`
+
+func (r *PGXRepository) GetExercises(ctx context.Context, filter dto.GetExercisesDTO) (dto.ExercisesPageDTO, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetExercises")
 	defer span.Finish()
 
 	query := `
-		SELECT e.id, e.name, e.description, e.created_at, ARRAY_AGG(mg.name) AS target_muscle_groups, e.updated_at
-		FROM exercise_muscle_groups emg
-		JOIN exercises e ON emg.exercise_id = e.id
-		JOIN muscle_groups mg ON emg.muscle_group_id = mg.id
-		WHERE e.id NOT IN (SELECT UNNEST($2::UUID[]))
-		GROUP BY e.id
-		HAVING ARRAY_AGG(mg.id) && $1 OR $1 = '{}'
-		ORDER BY e.created_at DESC;
+		WITH ranked AS (
+			SELECT` + exerciseColumns + exerciseMuscleGroupsColumns + `,` + exerciseRank + `
+			FROM exercise_muscle_groups emg
+			JOIN exercises e ON emg.exercise_id = e.id
+			JOIN muscle_groups mg ON emg.muscle_group_id = mg.id
+			WHERE e.id NOT IN (SELECT UNNEST($4::UUID[]))
+				AND e.archived_at IS NULL
+				AND ($6::VARCHAR[] = '{}' OR e.equipment = ANY($6::VARCHAR[]))
+				AND (
+					$7::TIMESTAMPTZ IS NULL
+					OR EXISTS (
+						SELECT 1
+						FROM exercise_logs el
+						JOIN workouts w ON el.workout_id = w.id
+						WHERE el.exercise_id = e.id AND w.user_id = $1 AND el.created_at >= $7::TIMESTAMPTZ
+					)
+				)
+				AND` + exerciseVisibleToUser + `
+				AND` + exerciseSearchQuery + `
+			GROUP BY e.id
+			HAVING $2::UUID[] = '{}'
+				OR BOOL_OR(emg.muscle_group_id = ANY($2::UUID[]) AND ($3::VARCHAR[] = '{}' OR emg.role = ANY($3::VARCHAR[])))
+		)
+		SELECT *
+		FROM ranked
+		WHERE $8::DOUBLE PRECISION IS NULL
+			OR (rank, created_at, id) < ($8::DOUBLE PRECISION, $9::TIMESTAMPTZ, $10::UUID)
+		ORDER BY rank DESC, created_at DESC, id DESC
+		LIMIT NULLIF($11::INT, 0);
 	`
 
+	roles := make([]string, 0, len(filter.MuscleGroupRoles))
+	for _, role := range filter.MuscleGroupRoles {
+		roles = append(roles, role.String())
+	}
+
+	equipment := make([]string, 0, len(filter.Equipment))
+	for _, e := range filter.Equipment {
+		equipment = append(equipment, e.String())
+	}
+
+	var cursorRank pgtype.Float8
+	var cursorCreatedAt pgtype.Timestamptz
+	var cursorID pgtype.UUID
+	if filter.Cursor.IsValid {
+		cursorRank = pgtype.Float8{Float64: filter.Cursor.V.Rank, Valid: true}
+		cursorCreatedAt = timeToPgtype(filter.Cursor.V.CreatedAt)
+		cursorID = uuidToPgtype(filter.Cursor.V.ID)
+	}
+
+	// One extra row tells whether there is a next page
+	limit := filter.Limit
+	if limit > 0 {
+		limit++
+	}
+
 	engine := r.contextManager.GetEngineFromContext(ctx)
 
-	var exercises []exerciseEntity
-	err := pgxscan.Select(ctx, engine, &exercises, query, muscleGroups, excludedExercises)
+	var exercises []rankedExerciseEntity
+	err := pgxscan.Select(
+		ctx,
+		engine,
+		&exercises,
+		query,
+		uuidToPgtype(filter.UserID),
+		uuidsToPgtype(filter.MuscleGroups),
+		roles,
+		uuidsToPgtype(filter.ExcludedExercises),
+		filter.Query,
+		equipment,
+		timeToPgtype(filter.RecentlyUsedSince),
+		cursorRank,
+		cursorCreatedAt,
+		cursorID,
+		limit,
+	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return []domain.Exercise{}, nil
+			return dto.ExercisesPageDTO{Exercises: []domain.Exercise{}}, nil
 		}
 		logger.Errorf("failed to get exercises: %v", err)
-		return nil, err
+		return dto.ExercisesPageDTO{}, err
 	}
 
-	result := make([]domain.Exercise, len(exercises))
+	var page dto.ExercisesPageDTO
+	if filter.Limit > 0 && len(exercises) > filter.Limit {
+		exercises = exercises[:filter.Limit]
+
+		last := exercises[len(exercises)-1]
+		page.NextCursor = utils.NewNullable(dto.ExercisesCursor{
+			Rank:      last.Rank,
+			CreatedAt: last.CreatedAt.Time,
+			ID:        domain.ID(last.ID.Bytes),
+		}, true)
+	}
+
+	page.Exercises = make([]domain.Exercise, len(exercises))
 	for i, e := range exercises {
-		result[i] = e.toDomain()
+		page.Exercises[i] = e.toDomain()
 	}
 
-	return result, nil
+	return page, nil
 }
 
 func (r *PGXRepository) GetExerciseByID(ctx context.Context, id domain.ID) (domain.Exercise, error) {
@@ -96,7 +375,7 @@ func (r *PGXRepository) GetExerciseByID(ctx context.Context, id domain.ID) (doma
 	defer span.Finish()
 
 	query := `
-		SELECT e.id, e.name, e.description, e.created_at, ARRAY_AGG(mg.name) AS target_muscle_groups, e.updated_at
+		SELECT` + exerciseColumns + exerciseMuscleGroupsColumns + `
 		FROM exercises e
 		JOIN exercise_muscle_groups emg ON e.id = emg.exercise_id
 		JOIN muscle_groups mg ON emg.muscle_group_id = mg.id
@@ -120,54 +399,459 @@ func (r *PGXRepository) GetExerciseByID(ctx context.Context, id domain.ID) (doma
 }
 
 // CreateExercise must be called within a transaction
-func (r *PGXRepository) CreateExercise(ctx context.Context, exercise domain.Exercise, muscleGroupIDs []domain.ID) (domain.Exercise, error) {
+func (r *PGXRepository) CreateExercise(ctx context.Context, exercise domain.Exercise) (domain.Exercise, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.CreateExercise")
 	defer span.Finish()
 
 	exerciseQuery := `
-		INSERT INTO exercises (id, name, description, created_at)
-		VALUES ($1, $2, $3, $4)
-		RETURNING *
+		INSERT INTO exercises (
+			id, owner_id, visibility, share_token, moderation_status, moderation_comment,
+			name, description, video_url, equipment, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	`
 
-	exercieMuscleGroupsQuery := `
-		INSERT INTO exercise_muscle_groups (muscle_group_id, exercise_id)
-		SELECT UNNEST($1::UUID[]), $2
-		RETURNING *
-	`
-
-	convertedMuscleGroupIDs := make([]pgtype.UUID, len(muscleGroupIDs))
-	for i, id := range muscleGroupIDs {
-		convertedMuscleGroupIDs[i] = uuidToPgtype(id)
-	}
-
 	engine := r.contextManager.GetEngineFromContext(ctx)
 
 	exerciseEntity := exerciseFromDomain(exercise)
 
-	err := pgxscan.Get(
+	_, err := engine.Exec(
 		ctx,
-		engine,
-		&exerciseEntity,
 		exerciseQuery,
 		exerciseEntity.ID,
+		exerciseEntity.OwnerID,
+		exerciseEntity.Visibility,
+		exerciseEntity.ShareToken,
+		exerciseEntity.ModerationStatus,
+		exerciseEntity.ModerationComment,
 		exerciseEntity.Name,
 		exerciseEntity.Description,
+		exerciseEntity.VideoURL,
+		exerciseEntity.Equipment,
 		exerciseEntity.CreatedAt,
 	)
 	if err != nil {
-		return domain.Exercise{}, nil
+		logger.Errorf("failed to create exercise: %v", err)
+		return domain.Exercise{}, err
+	}
+
+	if err := r.insertExerciseMuscleGroups(ctx, exercise.ID, exercise.MuscleGroups); err != nil {
+		return domain.Exercise{}, err
 	}
 
-	_, err = engine.Exec(
+	if err := r.insertExerciseAliases(ctx, exercise.ID, exercise.Aliases); err != nil {
+		return domain.Exercise{}, err
+	}
+
+	return r.GetExerciseByID(ctx, exercise.ID)
+}
+
+func (r *PGXRepository) insertExerciseMuscleGroups(ctx context.Context, exerciseID domain.ID, muscleGroups []domain.ExerciseMuscleGroup) error {
+	query := `
+		INSERT INTO exercise_muscle_groups (exercise_id, muscle_group_id, role, activation)
+		SELECT $1, t.muscle_group_id, t.role, t.activation
+		FROM UNNEST($2::UUID[], $3::VARCHAR[], $4::REAL[]) AS t(muscle_group_id, role, activation)
+	`
+
+	muscleGroupIDs := make([]domain.ID, 0, len(muscleGroups))
+	roles := make([]string, 0, len(muscleGroups))
+	activations := make([]float32, 0, len(muscleGroups))
+	for _, mg := range muscleGroups {
+		muscleGroupIDs = append(muscleGroupIDs, mg.MuscleGroupID)
+		roles = append(roles, mg.Role.String())
+		activations = append(activations, mg.Activation)
+	}
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	_, err := engine.Exec(
 		ctx,
-		exercieMuscleGroupsQuery,
-		convertedMuscleGroupIDs,
-		exerciseEntity.ID,
+		query,
+		uuidToPgtype(exerciseID),
+		uuidsToPgtype(muscleGroupIDs),
+		roles,
+		activations,
 	)
 	if err != nil {
-		return domain.Exercise{}, nil
+		logger.Errorf("failed to create exercise muscle groups: %v", err)
+		return err
 	}
 
-	return r.GetExerciseByID(ctx, exercise.ID)
+	return nil
+}
+
+func (r *PGXRepository) insertExerciseAliases(ctx context.Context, exerciseID domain.ID, aliases []domain.ExerciseAlias) error {
+	query := `
+		INSERT INTO exercise_aliases (exercise_id, language, alias)
+		SELECT $1, t.language, t.alias
+		FROM UNNEST($2::VARCHAR[], $3::VARCHAR[]) AS t(language, alias)
+		ON CONFLICT (exercise_id, language, alias) DO NOTHING
+	`
+
+	languages := make([]string, 0, len(aliases))
+	values := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		languages = append(languages, alias.Language)
+		values = append(values, alias.Alias)
+	}
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidToPgtype(exerciseID), languages, values); err != nil {
+		logger.Errorf("failed to create exercise aliases: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// ReplaceExerciseAliases must be called within a transaction
+func (r *PGXRepository) ReplaceExerciseAliases(ctx context.Context, exerciseID domain.ID, aliases []domain.ExerciseAlias) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.ReplaceExerciseAliases")
+	defer span.Finish()
+
+	query := `
+		DELETE FROM exercise_aliases WHERE exercise_id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidToPgtype(exerciseID)); err != nil {
+		logger.Errorf("failed to delete exercise aliases: %v", err)
+		return err
+	}
+
+	return r.insertExerciseAliases(ctx, exerciseID, aliases)
+}
+
+// ReplaceExerciseMuscleGroups must be called within a transaction
+func (r *PGXRepository) ReplaceExerciseMuscleGroups(ctx context.Context, exerciseID domain.ID, muscleGroups []domain.ExerciseMuscleGroup) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.ReplaceExerciseMuscleGroups")
+	defer span.Finish()
+
+	query := `
+		DELETE FROM exercise_muscle_groups WHERE exercise_id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidToPgtype(exerciseID)); err != nil {
+		logger.Errorf("failed to delete exercise muscle groups: %v", err)
+		return err
+	}
+
+	return r.insertExerciseMuscleGroups(ctx, exerciseID, muscleGroups)
+}
+
+// ReassignExerciseReferences points exercise logs and routine exercise instances
+// of one exercise to another one, only the workouts and routines of the user are
+// touched if the user is given. Returns the routines whose instances were changed.
+// Must be called within a transaction.
+func (r *PGXRepository) ReassignExerciseReferences(ctx context.Context, fromID, toID domain.ID, userID utils.Nullable[domain.ID]) ([]domain.ID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.ReassignExerciseReferences")
+	defer span.Finish()
+
+	exerciseLogsQuery := `
+		UPDATE exercise_logs l SET exercise_id = $2, updated_at = NOW()
+		FROM workouts w
+		WHERE l.workout_id = w.id
+			AND l.exercise_id = $1
+			AND ($3::UUID IS NULL OR w.user_id = $3)
+	`
+
+	exerciseInstancesQuery := `
+		UPDATE exercise_instances i SET exercise_id = $2, updated_at = NOW()
+		FROM routines r
+		WHERE i.routine_id = r.id
+			AND i.exercise_id = $1
+			AND ($3::UUID IS NULL OR r.user_id = $3)
+		RETURNING i.routine_id
+	`
+
+	user := pgtype.UUID{}
+	if userID.IsValid {
+		user = uuidToPgtype(userID.V)
+	}
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, exerciseLogsQuery, uuidToPgtype(fromID), uuidToPgtype(toID), user); err != nil {
+		logger.Errorf("failed to reassign exercise logs: %v", err)
+		return nil, err
+	}
+
+	var routineIDs []pgtype.UUID
+	if err := pgxscan.Select(ctx, engine, &routineIDs, exerciseInstancesQuery, uuidToPgtype(fromID), uuidToPgtype(toID), user); err != nil {
+		logger.Errorf("failed to reassign exercise instances: %v", err)
+		return nil, err
+	}
+
+	seen := make(map[domain.ID]bool, len(routineIDs))
+	result := make([]domain.ID, 0, len(routineIDs))
+	for _, routineID := range routineIDs {
+		id := domain.ID(routineID.Bytes)
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+
+	return result, nil
+}
+
+// HasExerciseReferences reports whether any exercise log or routine exercise instance
+// still points to the exercise.
+func (r *PGXRepository) HasExerciseReferences(ctx context.Context, exerciseID domain.ID) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.HasExerciseReferences")
+	defer span.Finish()
+
+	query := `
+		SELECT EXISTS (SELECT 1 FROM exercise_logs WHERE exercise_id = $1)
+			OR EXISTS (SELECT 1 FROM exercise_instances WHERE exercise_id = $1)
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var referenced bool
+	if err := pgxscan.Get(ctx, engine, &referenced, query, uuidToPgtype(exerciseID)); err != nil {
+		logger.Errorf("failed to check exercise references: %v", err)
+		return false, err
+	}
+
+	return referenced, nil
+}
+
+func (r *PGXRepository) GetExercisesByModerationStatus(ctx context.Context, status domain.ModerationStatus) ([]domain.Exercise, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetExercisesByModerationStatus")
+	defer span.Finish()
+
+	query := `
+		SELECT` + exerciseColumns + exerciseMuscleGroupsColumns + `
+		FROM exercises e
+		JOIN exercise_muscle_groups emg ON e.id = emg.exercise_id
+		JOIN muscle_groups mg ON emg.muscle_group_id = mg.id
+		WHERE e.moderation_status = $1
+		GROUP BY e.id
+		ORDER BY e.updated_at;
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var exercises []exerciseEntity
+	if err := pgxscan.Select(ctx, engine, &exercises, query, status.String()); err != nil {
+		logger.Errorf("failed to get exercises by moderation status: %v", err)
+		return nil, err
+	}
+
+	result := make([]domain.Exercise, len(exercises))
+	for i, e := range exercises {
+		result[i] = e.toDomain()
+	}
+
+	return result, nil
+}
+
+func (r *PGXRepository) GetExerciseByShareToken(ctx context.Context, token string) (domain.Exercise, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetExerciseByShareToken")
+	defer span.Finish()
+
+	query := `
+		SELECT id FROM exercises WHERE share_token = $1;
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var id pgtype.UUID
+	if err := pgxscan.Get(ctx, engine, &id, query, token); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Exercise{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get exercise by share token: %v", err)
+		return domain.Exercise{}, err
+	}
+
+	return r.GetExerciseByID(ctx, domain.ID(id.Bytes))
+}
+
+func (r *PGXRepository) UpdateExercise(ctx context.Context, id domain.ID, exercise domain.Exercise) (domain.Exercise, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.UpdateExercise")
+	defer span.Finish()
+
+	query := `
+		UPDATE exercises
+		SET owner_id = $2, visibility = $3, share_token = $4, moderation_status = $5, moderation_comment = $6,
+			name = $7, description = $8, video_url = $9, archived_at = $10, merged_into_id = $11, equipment = $12,
+			updated_at = NOW()
+		WHERE id = $1
+	`
+
+	exerciseEntity := exerciseFromDomain(exercise)
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	tag, err := engine.Exec(
+		ctx,
+		query,
+		uuidToPgtype(id),
+		exerciseEntity.OwnerID,
+		exerciseEntity.Visibility,
+		exerciseEntity.ShareToken,
+		exerciseEntity.ModerationStatus,
+		exerciseEntity.ModerationComment,
+		exerciseEntity.Name,
+		exerciseEntity.Description,
+		exerciseEntity.VideoURL,
+		exerciseEntity.ArchivedAt,
+		exerciseEntity.MergedIntoID,
+		exerciseEntity.Equipment,
+	)
+	if err != nil {
+		logger.Errorf("failed to update exercise: %v", err)
+		return domain.Exercise{}, err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.Exercise{}, domain.ErrNotFound
+	}
+
+	return r.GetExerciseByID(ctx, id)
+}
+
+func (r *PGXRepository) CreateExerciseShare(ctx context.Context, exerciseID, userID domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.CreateExerciseShare")
+	defer span.Finish()
+
+	query := `
+		INSERT INTO exercise_shares (exercise_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (exercise_id, user_id) DO NOTHING
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidToPgtype(exerciseID), uuidToPgtype(userID)); err != nil {
+		logger.Errorf("failed to create exercise share: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *PGXRepository) HasExerciseShare(ctx context.Context, exerciseID, userID domain.ID) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.HasExerciseShare")
+	defer span.Finish()
+
+	query := `
+		SELECT EXISTS (SELECT 1 FROM exercise_shares WHERE exercise_id = $1 AND user_id = $2)
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var exists bool
+	if err := pgxscan.Get(ctx, engine, &exists, query, uuidToPgtype(exerciseID), uuidToPgtype(userID)); err != nil {
+		logger.Errorf("failed to check exercise share: %v", err)
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *PGXRepository) DeleteExerciseShares(ctx context.Context, exerciseID domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.DeleteExerciseShares")
+	defer span.Finish()
+
+	query := `
+		DELETE FROM exercise_shares WHERE exercise_id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidToPgtype(exerciseID)); err != nil {
+		logger.Errorf("failed to delete exercise shares: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// GetGlobalExerciseIDByName finds an active exercise of the global catalog by its name or
+// one of its aliases, ignoring case.
+func (r *PGXRepository) GetGlobalExerciseIDByName(ctx context.Context, name string) (domain.ID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetGlobalExerciseIDByName")
+	defer span.Finish()
+
+	query := `
+		SELECT e.id FROM exercises e
+		WHERE e.visibility = 'global'
+			AND e.archived_at IS NULL
+			AND e.merged_into_id IS NULL
+			AND (
+				LOWER(e.name) = LOWER($1)
+				OR EXISTS (
+					SELECT 1 FROM exercise_aliases a
+					WHERE a.exercise_id = e.id AND LOWER(a.alias) = LOWER($1)
+				)
+			)
+		ORDER BY LOWER(e.name) = LOWER($1) DESC, e.created_at
+		LIMIT 1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var id pgtype.UUID
+	if err := pgxscan.Get(ctx, engine, &id, query, name); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ID{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get global exercise by name: %v", err)
+		return domain.ID{}, err
+	}
+
+	return domain.ID(id.Bytes), nil
+}
+
+// MatchExerciseByName returns the exercise visible to the user whose name or alias is the
+// most similar to the name, along with the trigram similarity.
+func (r *PGXRepository) MatchExerciseByName(ctx context.Context, userID domain.ID, name string) (domain.Exercise, float64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.MatchExerciseByName")
+	defer span.Finish()
+
+	query := `
+		WITH matches AS (
+			SELECT e.id, GREATEST(
+				similarity(LOWER(e.name), LOWER($2)),
+				COALESCE((SELECT MAX(similarity(LOWER(ea.alias), LOWER($2))) FROM exercise_aliases ea WHERE ea.exercise_id = e.id), 0)
+			)::DOUBLE PRECISION AS similarity
+			FROM exercises e
+			WHERE e.archived_at IS NULL
+				AND e.merged_into_id IS NULL
+				AND` + exerciseVisibleToUser + `
+		)
+		SELECT id, similarity
+		FROM matches
+		WHERE similarity > 0
+		ORDER BY similarity DESC, id
+		LIMIT 1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var match struct {
+		ID         pgtype.UUID
+		Similarity float64
+	}
+	if err := pgxscan.Get(ctx, engine, &match, query, uuidToPgtype(userID), name); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Exercise{}, 0, domain.ErrNotFound
+		}
+		logger.Errorf("failed to match exercise by name: %v", err)
+		return domain.Exercise{}, 0, err
+	}
+
+	exercise, err := r.GetExerciseByID(ctx, domain.ID(match.ID.Bytes))
+	if err != nil {
+		return domain.Exercise{}, 0, err
+	}
+
+	return exercise, match.Similarity, nil
 }
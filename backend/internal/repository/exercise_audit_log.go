@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+type exerciseAuditEntryEntity struct {
+	ID         pgtype.UUID
+	ExerciseID pgtype.UUID
+	UserID     pgtype.UUID
+	Action     string
+	Changes    map[string]domain.FieldChange
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+}
+
+func (e exerciseAuditEntryEntity) toDomain() domain.ExerciseAuditEntry {
+	return domain.ExerciseAuditEntry{
+		Model: domain.Model{
+			ID:        domain.ID(e.ID.Bytes),
+			CreatedAt: timeFromPgtype(e.CreatedAt),
+			UpdatedAt: timeFromPgtype(e.UpdatedAt),
+		},
+		ExerciseID: domain.ID(e.ExerciseID.Bytes),
+		UserID:     domain.ID(e.UserID.Bytes),
+		Action:     domain.ExerciseAuditAction(e.Action),
+		Changes:    e.Changes,
+	}
+}
+
+func (r *PGXRepository) CreateExerciseAuditEntry(ctx context.Context, entry domain.ExerciseAuditEntry) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.CreateExerciseAuditEntry")
+	defer span.Finish()
+
+	query := `
+		INSERT INTO exercise_audit_log (id, exercise_id, user_id, action, changes, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	_, err := engine.Exec(
+		ctx,
+		query,
+		uuidToPgtype(entry.ID),
+		uuidToPgtype(entry.ExerciseID),
+		uuidToPgtype(entry.UserID),
+		entry.Action.String(),
+		entry.Changes,
+		timeToPgtype(entry.CreatedAt),
+		timeToPgtype(entry.UpdatedAt),
+	)
+	if err != nil {
+		logger.Errorf("failed to create exercise audit entry: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *PGXRepository) GetExerciseAuditLog(ctx context.Context, exerciseID domain.ID, offset, limit int) ([]domain.ExerciseAuditEntry, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetExerciseAuditLog")
+	defer span.Finish()
+
+	query := `
+		SELECT id, exercise_id, user_id, action, changes, created_at, updated_at
+		FROM exercise_audit_log
+		WHERE exercise_id = $1
+		ORDER BY created_at DESC
+		OFFSET $2
+		LIMIT $3
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entries []exerciseAuditEntryEntity
+	if err := pgxscan.Select(ctx, engine, &entries, query, uuidToPgtype(exerciseID), offset, limit); err != nil {
+		logger.Errorf("failed to get exercise audit log: %v", err)
+		return nil, err
+	}
+
+	result := make([]domain.ExerciseAuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, entry.toDomain())
+	}
+
+	return result, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5"
@@ -13,11 +14,14 @@ import (
 )
 
 type exerciseInstanceEntity struct {
-	ID         pgtype.UUID
-	RoutineID  pgtype.UUID
-	ExerciseID pgtype.UUID
-	CreatedAt  pgtype.Timestamptz
-	UpdatedAt  pgtype.Timestamptz
+	ID          pgtype.UUID
+	RoutineID   pgtype.UUID
+	ExerciseID  pgtype.UUID
+	GroupID     pgtype.UUID
+	GroupType   pgtype.Text
+	GroupRounds pgtype.Int4
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
 }
 
 func (e exerciseInstanceEntity) toDomain() domain.ExerciseInstance {
@@ -29,16 +33,40 @@ func (e exerciseInstanceEntity) toDomain() domain.ExerciseInstance {
 		},
 		RoutineID:  domain.ID(e.RoutineID.Bytes),
 		ExerciseID: domain.ID(e.ExerciseID.Bytes),
+		Group:      exerciseGroupFromPgtype(e.GroupID, e.GroupType, e.GroupRounds),
 	}
 }
 
+func exerciseGroupFromPgtype(id pgtype.UUID, groupType pgtype.Text, rounds pgtype.Int4) utils.Nullable[domain.ExerciseGroup] {
+	return utils.NewNullable(domain.ExerciseGroup{
+		ID:     domain.ID(id.Bytes),
+		Type:   domain.ExerciseGroupType(groupType.String),
+		Rounds: int(rounds.Int32),
+	}, id.Valid)
+}
+
+func exerciseGroupToPgtype(group utils.Nullable[domain.ExerciseGroup]) (pgtype.UUID, pgtype.Text, pgtype.Int4) {
+	if !group.IsValid {
+		return pgtype.UUID{}, pgtype.Text{}, pgtype.Int4{}
+	}
+
+	return uuidToPgtype(group.V.ID),
+		pgtype.Text{String: group.V.Type.String(), Valid: true},
+		pgtype.Int4{Int32: int32(group.V.Rounds), Valid: true}
+}
+
 func exerciseInstanceFromDomain(exerciseInstance domain.ExerciseInstance) exerciseInstanceEntity {
+	groupID, groupType, groupRounds := exerciseGroupToPgtype(exerciseInstance.Group)
+
 	return exerciseInstanceEntity{
-		ID:         uuidToPgtype(exerciseInstance.ID),
-		RoutineID:  uuidToPgtype(exerciseInstance.RoutineID),
-		ExerciseID: uuidToPgtype(exerciseInstance.ExerciseID),
-		CreatedAt:  timeToPgtype(exerciseInstance.CreatedAt),
-		UpdatedAt:  timeToPgtype(exerciseInstance.UpdatedAt),
+		ID:          uuidToPgtype(exerciseInstance.ID),
+		RoutineID:   uuidToPgtype(exerciseInstance.RoutineID),
+		ExerciseID:  uuidToPgtype(exerciseInstance.ExerciseID),
+		GroupID:     groupID,
+		GroupType:   groupType,
+		GroupRounds: groupRounds,
+		CreatedAt:   timeToPgtype(exerciseInstance.CreatedAt),
+		UpdatedAt:   timeToPgtype(exerciseInstance.UpdatedAt),
 	}
 }
 
@@ -47,7 +75,7 @@ func (r *PGXRepository) GetExerciseInstanceByID(ctx context.Context, id domain.I
 	defer span.Finish()
 
 	query := `
-		SELECT ei.id, ei.routine_id, ei.exercise_id, ei.created_at, ei.updated_at
+		SELECT ei.id, ei.routine_id, ei.exercise_id, ei.group_id, ei.group_type, ei.group_rounds, ei.created_at, ei.updated_at
 		FROM exercise_instances ei
 		WHERE ei.id = $1
 	`
@@ -72,7 +100,7 @@ func (r *PGXRepository) GetExerciseInstancesByRoutineID(ctx context.Context, rou
 	defer span.Finish()
 
 	query := `
-		SELECT ei.id, ei.routine_id, ei.exercise_id, ei.created_at, ei.updated_at
+		SELECT ei.id, ei.routine_id, ei.exercise_id, ei.group_id, ei.group_type, ei.group_rounds, ei.created_at, ei.updated_at
 		FROM exercise_instances ei
 		WHERE ei.routine_id = $1
 		ORDER BY ei.position, ei.created_at
@@ -103,15 +131,15 @@ func (r *PGXRepository) CreateExerciseInstance(ctx context.Context, exerciseInst
 	defer span.Finish()
 
 	query := `
-		INSERT INTO exercise_instances (id, routine_id, exercise_id, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, routine_id, exercise_id, created_at, updated_at
+		INSERT INTO exercise_instances (id, routine_id, exercise_id, group_id, group_type, group_rounds, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, routine_id, exercise_id, group_id, group_type, group_rounds, created_at, updated_at
 	`
 
 	engine := r.contextManager.GetEngineFromContext(ctx)
 
 	entity := exerciseInstanceFromDomain(exerciseInstance)
-	err := pgxscan.Get(ctx, engine, &entity, query, entity.ID, entity.RoutineID, entity.ExerciseID, entity.CreatedAt, entity.UpdatedAt)
+	err := pgxscan.Get(ctx, engine, &entity, query, entity.ID, entity.RoutineID, entity.ExerciseID, entity.GroupID, entity.GroupType, entity.GroupRounds, entity.CreatedAt, entity.UpdatedAt)
 	if err != nil {
 		logger.Errorf("failed to create exercise instance: %v", err)
 		return domain.ExerciseInstance{}, err
@@ -162,3 +190,27 @@ func (r *PGXRepository) SetExerciseOrder(ctx context.Context, routineID domain.I
 
 	return nil
 }
+
+// SetExerciseInstancesGroup puts the exercise instances of the routine into the group, an
+// invalid group removes them from their groups.
+func (r *PGXRepository) SetExerciseInstancesGroup(ctx context.Context, routineID domain.ID, exerciseInstanceIDs []domain.ID, group utils.Nullable[domain.ExerciseGroup]) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.SetExerciseInstancesGroup")
+	defer span.Finish()
+
+	query := `
+		UPDATE exercise_instances
+		SET group_id = $1, group_type = $2, group_rounds = $3, updated_at = now()
+		WHERE id = ANY($4) AND routine_id = $5
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	groupID, groupType, groupRounds := exerciseGroupToPgtype(group)
+	_, err := engine.Exec(ctx, query, groupID, groupType, groupRounds, uuidsToPgtype(exerciseInstanceIDs), uuidToPgtype(routineID))
+	if err != nil {
+		logger.Errorf("failed to set exercise instances group: %v", err)
+		return err
+	}
+
+	return nil
+}
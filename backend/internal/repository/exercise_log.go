@@ -4,6 +4,7 @@ import (
 	"context"
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -16,6 +17,10 @@ type exerciseLogEntity struct {
 	WorkoutID   pgtype.UUID        `db:"workout_id"`
 	Notes       string             `db:"notes"`
 	PowerRating int                `db:"power_rating"`
+	GroupID     pgtype.UUID        `db:"group_id"`
+	GroupType   pgtype.Text        `db:"group_type"`
+	GroupRounds pgtype.Int4        `db:"group_rounds"`
+	Position    int                `db:"position"`
 	CreatedAt   pgtype.Timestamptz `db:"created_at"`
 	UpdateAt    pgtype.Timestamptz `db:"updated_at"`
 }
@@ -31,16 +36,24 @@ func (e exerciseLogEntity) toDomain() domain.ExerciseLog {
 		WorkoutID:   domain.ID(e.WorkoutID.Bytes),
 		Notes:       e.Notes,
 		PowerRating: e.PowerRating,
+		Group:       exerciseGroupFromPgtype(e.GroupID, e.GroupType, e.GroupRounds),
+		Position:    e.Position,
 	}
 }
 
 func exerciseLogFromDomain(exerciseLog domain.ExerciseLog) exerciseLogEntity {
+	groupID, groupType, groupRounds := exerciseGroupToPgtype(exerciseLog.Group)
+
 	return exerciseLogEntity{
 		ID:          uuidToPgtype(exerciseLog.ID),
 		ExerciseID:  uuidToPgtype(exerciseLog.ExerciseID),
 		WorkoutID:   uuidToPgtype(exerciseLog.WorkoutID),
 		Notes:       exerciseLog.Notes,
 		PowerRating: exerciseLog.PowerRating,
+		GroupID:     groupID,
+		GroupType:   groupType,
+		GroupRounds: groupRounds,
+		Position:    exerciseLog.Position,
 		CreatedAt:   timeToPgtype(exerciseLog.CreatedAt),
 		UpdateAt:    timeToPgtype(exerciseLog.UpdatedAt),
 	}
@@ -60,10 +73,10 @@ func (r *PGXRepository) GetExerciseLogsByWorkoutID(ctx context.Context, workoutI
 	defer span.Finish()
 
 	query := `
-		SELECT id, exercise_id, workout_id, notes, power_rating, created_at, updated_at
+		SELECT id, exercise_id, workout_id, notes, power_rating, group_id, group_type, group_rounds, position, created_at, updated_at
 		FROM exercise_logs
 		WHERE workout_id = $1
-		ORDER BY created_at
+		ORDER BY position, created_at
 	`
 
 	engine := r.contextManager.GetEngineFromContext(ctx)
@@ -76,20 +89,25 @@ func (r *PGXRepository) GetExerciseLogsByWorkoutID(ctx context.Context, workoutI
 	return toExerciseLogsDomain(exerciseLogs), nil
 }
 
+// CreateExerciseLog puts the exercise log after all other exercises of the workout.
 func (r *PGXRepository) CreateExerciseLog(ctx context.Context, exerciseLog domain.ExerciseLog) (domain.ExerciseLog, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.CreateExerciseLog")
 	defer span.Finish()
 
 	query := `
-		INSERT INTO exercise_logs (id, exercise_id, workout_id, notes, power_rating, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO exercise_logs (id, exercise_id, workout_id, notes, power_rating, group_id, group_type, group_rounds, position, created_at)
+		VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8,
+			(SELECT COALESCE(MAX(position) + 1, 0) FROM exercise_logs WHERE workout_id = $3),
+			$9
+		)
 		RETURNING *
 	`
 
 	engine := r.contextManager.GetEngineFromContext(ctx)
 
 	exerciseLogEntity := exerciseLogFromDomain(exerciseLog)
-	if err := pgxscan.Get(ctx, engine, &exerciseLogEntity, query, exerciseLogEntity.ID, exerciseLogEntity.ExerciseID, exerciseLogEntity.WorkoutID, exerciseLogEntity.Notes, exerciseLogEntity.PowerRating, exerciseLogEntity.CreatedAt); err != nil {
+	if err := pgxscan.Get(ctx, engine, &exerciseLogEntity, query, exerciseLogEntity.ID, exerciseLogEntity.ExerciseID, exerciseLogEntity.WorkoutID, exerciseLogEntity.Notes, exerciseLogEntity.PowerRating, exerciseLogEntity.GroupID, exerciseLogEntity.GroupType, exerciseLogEntity.GroupRounds, exerciseLogEntity.CreatedAt); err != nil {
 		return domain.ExerciseLog{}, err
 	}
 
@@ -101,7 +119,7 @@ func (r *PGXRepository) GetExerciseLogByID(ctx context.Context, id domain.ID) (d
 	defer span.Finish()
 
 	query := `
-		SELECT id, exercise_id, workout_id, notes, power_rating, created_at, updated_at
+		SELECT id, exercise_id, workout_id, notes, power_rating, group_id, group_type, group_rounds, position, created_at, updated_at
 		FROM exercise_logs
 		WHERE id = $1
 	`
@@ -184,3 +202,49 @@ func (r *PGXRepository) UpdateExerciseLog(ctx context.Context, id domain.ID, exe
 
 	return exerciseLogEntity.toDomain(), nil
 }
+
+// SetExerciseLogsGroup puts the exercise logs of the workout into the group, an invalid
+// group removes them from their groups.
+func (r *PGXRepository) SetExerciseLogsGroup(ctx context.Context, workoutID domain.ID, exerciseLogIDs []domain.ID, group utils.Nullable[domain.ExerciseGroup]) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.SetExerciseLogsGroup")
+	defer span.Finish()
+
+	query := `
+		UPDATE exercise_logs
+		SET group_id = $1, group_type = $2, group_rounds = $3, updated_at = now()
+		WHERE id = ANY($4) AND workout_id = $5
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	groupID, groupType, groupRounds := exerciseGroupToPgtype(group)
+	_, err := engine.Exec(ctx, query, groupID, groupType, groupRounds, uuidsToPgtype(exerciseLogIDs), uuidToPgtype(workoutID))
+	if err != nil {
+		logger.Errorf("failed to set exercise logs group: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *PGXRepository) SetExerciseLogOrder(ctx context.Context, workoutID domain.ID, exerciseLogIDs []domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.SetExerciseLogOrder")
+	defer span.Finish()
+
+	query := `
+		UPDATE exercise_logs el
+		SET position = new_order.idx, updated_at = NOW()
+		FROM (SELECT unnest($1::UUID[]) AS id, generate_series(0, array_length($1, 1) - 1) AS idx) AS new_order
+		WHERE el.id = new_order.id
+		AND el.workout_id = $2
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidsToPgtype(exerciseLogIDs), uuidToPgtype(workoutID)); err != nil {
+		logger.Errorf("failed to set exercise log order: %v", err)
+		return err
+	}
+
+	return nil
+}
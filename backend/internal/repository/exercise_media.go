@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+type exerciseMediaEntity struct {
+	ID          pgtype.UUID
+	ExerciseID  pgtype.UUID
+	UploadedBy  pgtype.UUID
+	Kind        string
+	Status      string
+	Key         string
+	URL         string
+	ContentType string
+	SizeBytes   int64
+	Caption     string
+	Position    int
+	Variants    []imageVariantEntity
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
+func (e exerciseMediaEntity) toDomain() domain.ExerciseMedia {
+	return domain.ExerciseMedia{
+		Model: domain.Model{
+			ID:        domain.ID(e.ID.Bytes),
+			CreatedAt: timeFromPgtype(e.CreatedAt),
+			UpdatedAt: timeFromPgtype(e.UpdatedAt),
+		},
+		ExerciseID:  domain.ID(e.ExerciseID.Bytes),
+		UploadedBy:  domain.ID(e.UploadedBy.Bytes),
+		Kind:        domain.ExerciseMediaKind(e.Kind),
+		Status:      domain.ExerciseMediaStatus(e.Status),
+		Key:         e.Key,
+		URL:         e.URL,
+		ContentType: e.ContentType,
+		Size:        e.SizeBytes,
+		Caption:     e.Caption,
+		Position:    e.Position,
+		Variants:    imageVariantsToDomain(e.Variants),
+	}
+}
+
+const exerciseMediaColumns = `
+	id, exercise_id, uploaded_by, kind, status, key, url, content_type, size_bytes, caption, position, variants,
+	created_at, updated_at
+`
+
+// CreateExerciseMedia puts the media after all other media of the exercise.
+func (r *PGXRepository) CreateExerciseMedia(ctx context.Context, media domain.ExerciseMedia) (domain.ExerciseMedia, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.CreateExerciseMedia")
+	defer span.Finish()
+
+	query := `
+		INSERT INTO exercise_media (
+			id, exercise_id, uploaded_by, kind, status, key, url, content_type, size_bytes, caption, position,
+			created_at, updated_at
+		)
+		VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10,
+			(SELECT COALESCE(MAX(position) + 1, 0) FROM exercise_media WHERE exercise_id = $2),
+			$11, $12
+		)
+		RETURNING` + exerciseMediaColumns
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity exerciseMediaEntity
+	err := pgxscan.Get(
+		ctx,
+		engine,
+		&entity,
+		query,
+		uuidToPgtype(media.ID),
+		uuidToPgtype(media.ExerciseID),
+		uuidToPgtype(media.UploadedBy),
+		media.Kind.String(),
+		media.Status.String(),
+		media.Key,
+		media.URL,
+		media.ContentType,
+		media.Size,
+		media.Caption,
+		timeToPgtype(media.CreatedAt),
+		timeToPgtype(media.UpdatedAt),
+	)
+	if err != nil {
+		logger.Errorf("failed to create exercise media: %v", err)
+		return domain.ExerciseMedia{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) GetExerciseMediaByID(ctx context.Context, id domain.ID) (domain.ExerciseMedia, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetExerciseMediaByID")
+	defer span.Finish()
+
+	query := `
+		SELECT` + exerciseMediaColumns + `
+		FROM exercise_media
+		WHERE id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity exerciseMediaEntity
+	if err := pgxscan.Get(ctx, engine, &entity, query, uuidToPgtype(id)); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ExerciseMedia{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get exercise media by id: %v", err)
+		return domain.ExerciseMedia{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) UpdateExerciseMedia(ctx context.Context, id domain.ID, media domain.ExerciseMedia) (domain.ExerciseMedia, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.UpdateExerciseMedia")
+	defer span.Finish()
+
+	query := `
+		UPDATE exercise_media
+		SET status = $2, url = $3, content_type = $4, size_bytes = $5, caption = $6, updated_at = NOW()
+		WHERE id = $1
+		RETURNING` + exerciseMediaColumns
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity exerciseMediaEntity
+	err := pgxscan.Get(
+		ctx,
+		engine,
+		&entity,
+		query,
+		uuidToPgtype(id),
+		media.Status.String(),
+		media.URL,
+		media.ContentType,
+		media.Size,
+		media.Caption,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ExerciseMedia{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to update exercise media: %v", err)
+		return domain.ExerciseMedia{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) DeleteExerciseMedia(ctx context.Context, id domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.DeleteExerciseMedia")
+	defer span.Finish()
+
+	query := `
+		DELETE FROM exercise_media WHERE id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidToPgtype(id)); err != nil {
+		logger.Errorf("failed to delete exercise media: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *PGXRepository) SetExerciseMediaOrder(ctx context.Context, exerciseID domain.ID, mediaIDs []domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.SetExerciseMediaOrder")
+	defer span.Finish()
+
+	query := `
+		UPDATE exercise_media em
+		SET position = new_order.idx, updated_at = NOW()
+		FROM (SELECT unnest($1::UUID[]) AS id, generate_series(0, array_length($1, 1) - 1) AS idx) AS new_order
+		WHERE em.id = new_order.id
+		AND em.exercise_id = $2
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidsToPgtype(mediaIDs), uuidToPgtype(exerciseID)); err != nil {
+		logger.Errorf("failed to set exercise media order: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -16,6 +16,9 @@ type expectedSetEntity struct {
 	Reps          pgtype.Int8        `db:"reps"`
 	Weight        pgtype.Float4      `db:"weight"`
 	Time          pgtype.Interval    `db:"time"`
+	Rest          pgtype.Interval    `db:"rest"`
+	IsWarmup      bool               `db:"is_warmup"`
+	Position      int                `db:"position"`
 	CreatedAt     pgtype.Timestamptz `db:"created_at"`
 	UpdatedAt     pgtype.Timestamptz `db:"updated_at"`
 }
@@ -32,6 +35,9 @@ func (s expectedSetEntity) toDomain() domain.ExpectedSet {
 		Reps:          int(s.Reps.Int64),
 		Weight:        s.Weight.Float32,
 		Time:          durationFromPgtype(s.Time),
+		Rest:          durationFromPgtype(s.Rest),
+		IsWarmup:      s.IsWarmup,
+		Position:      s.Position,
 	}
 }
 
@@ -43,6 +49,9 @@ func expectedSetFromDomain(expectedSet domain.ExpectedSet) expectedSetEntity {
 		Reps:          pgtype.Int8{Int64: int64(expectedSet.Reps), Valid: expectedSet.Reps != 0},
 		Weight:        pgtype.Float4{Float32: expectedSet.Weight, Valid: expectedSet.Weight != 0},
 		Time:          intervalToPgtype(expectedSet.Time),
+		Rest:          intervalToPgtype(expectedSet.Rest),
+		IsWarmup:      expectedSet.IsWarmup,
+		Position:      expectedSet.Position,
 		CreatedAt:     timeToPgtype(expectedSet.CreatedAt),
 		UpdatedAt:     timeToPgtype(expectedSet.UpdatedAt),
 	}
@@ -64,7 +73,7 @@ func (r *PGXRepository) GetExpectedSetsByExerciseLogID(ctx context.Context, exer
 	query := `
 		SELECT * FROM expected_sets es
 		WHERE es.exercise_log_id = $1
-		ORDER BY es.created_at
+		ORDER BY es.position, es.created_at
 	`
 
 	engine := r.contextManager.GetEngineFromContext(ctx)
@@ -83,15 +92,15 @@ func (r *PGXRepository) CreateExpectedSet(ctx context.Context, expectedSet domai
 	defer span.Finish()
 
 	query := `
-		INSERT INTO expected_sets (id, exercise_log_id, set_type, reps, weight, time)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO expected_sets (id, exercise_log_id, set_type, reps, weight, time, rest, is_warmup, position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		RETURNING *
 	`
 
 	engine := r.contextManager.GetEngineFromContext(ctx)
 
 	expectedSetEntity := expectedSetFromDomain(expectedSet)
-	err := pgxscan.Get(ctx, engine, &expectedSetEntity, query, expectedSet.ID, expectedSetEntity.ExerciseLogID, expectedSetEntity.SetType, expectedSetEntity.Reps, expectedSetEntity.Weight, expectedSetEntity.Time)
+	err := pgxscan.Get(ctx, engine, &expectedSetEntity, query, expectedSet.ID, expectedSetEntity.ExerciseLogID, expectedSetEntity.SetType, expectedSetEntity.Reps, expectedSetEntity.Weight, expectedSetEntity.Time, expectedSetEntity.Rest, expectedSetEntity.IsWarmup, expectedSetEntity.Position, expectedSetEntity.CreatedAt, expectedSetEntity.UpdatedAt)
 	if err != nil {
 		return domain.ExpectedSet{}, err
 	}
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+type fileEntity struct {
+	ID           pgtype.UUID
+	UserID       pgtype.UUID
+	Purpose      string
+	Status       string
+	Key          string
+	URL          string
+	OriginalName string
+	ContentType  string
+	SizeBytes    int64
+	ConfirmedAt  pgtype.Timestamptz
+	Variants     []imageVariantEntity
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+}
+
+func (e fileEntity) toDomain() domain.File {
+	return domain.File{
+		Model: domain.Model{
+			ID:        domain.ID(e.ID.Bytes),
+			CreatedAt: timeFromPgtype(e.CreatedAt),
+			UpdatedAt: timeFromPgtype(e.UpdatedAt),
+		},
+		UserID:       domain.ID(e.UserID.Bytes),
+		Purpose:      domain.FilePurpose(e.Purpose),
+		Status:       domain.FileStatus(e.Status),
+		Key:          e.Key,
+		URL:          e.URL,
+		OriginalName: e.OriginalName,
+		ContentType:  e.ContentType,
+		Size:         e.SizeBytes,
+		ConfirmedAt:  timeFromPgtype(e.ConfirmedAt),
+		Variants:     imageVariantsToDomain(e.Variants),
+	}
+}
+
+const fileColumns = `
+	id, user_id, purpose, status, key, url, original_name, content_type, size_bytes, confirmed_at, variants,
+	created_at, updated_at
+`
+
+func (r *PGXRepository) CreateFile(ctx context.Context, file domain.File) (domain.File, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.CreateFile")
+	defer span.Finish()
+
+	query := `
+		INSERT INTO files (
+			id, user_id, purpose, status, key, url, original_name, content_type, size_bytes, confirmed_at,
+			created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING` + fileColumns
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity fileEntity
+	err := pgxscan.Get(
+		ctx,
+		engine,
+		&entity,
+		query,
+		uuidToPgtype(file.ID),
+		uuidToPgtype(file.UserID),
+		file.Purpose.String(),
+		file.Status.String(),
+		file.Key,
+		file.URL,
+		file.OriginalName,
+		file.ContentType,
+		file.Size,
+		timeToPgtype(file.ConfirmedAt),
+		timeToPgtype(file.CreatedAt),
+		timeToPgtype(file.UpdatedAt),
+	)
+	if err != nil {
+		logger.Errorf("failed to create file: %v", err)
+		return domain.File{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) GetFileByID(ctx context.Context, id domain.ID) (domain.File, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetFileByID")
+	defer span.Finish()
+
+	query := `
+		SELECT` + fileColumns + `
+		FROM files
+		WHERE id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity fileEntity
+	if err := pgxscan.Get(ctx, engine, &entity, query, uuidToPgtype(id)); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.File{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get file by id: %v", err)
+		return domain.File{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+// GetFileByURL returns a file of the user served from the URL.
+func (r *PGXRepository) GetFileByURL(ctx context.Context, userID domain.ID, url string) (domain.File, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetFileByURL")
+	defer span.Finish()
+
+	query := `
+		SELECT` + fileColumns + `
+		FROM files
+		WHERE user_id = $1 AND url = $2
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity fileEntity
+	if err := pgxscan.Get(ctx, engine, &entity, query, uuidToPgtype(userID), url); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.File{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get file by url: %v", err)
+		return domain.File{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) UpdateFile(ctx context.Context, id domain.ID, file domain.File) (domain.File, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.UpdateFile")
+	defer span.Finish()
+
+	query := `
+		UPDATE files
+		SET status = $2, size_bytes = $3, confirmed_at = $4, updated_at = NOW()
+		WHERE id = $1
+		RETURNING` + fileColumns
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity fileEntity
+	err := pgxscan.Get(
+		ctx,
+		engine,
+		&entity,
+		query,
+		uuidToPgtype(id),
+		file.Status.String(),
+		file.Size,
+		timeToPgtype(file.ConfirmedAt),
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.File{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to update file: %v", err)
+		return domain.File{}, err
+	}
+
+	return entity.toDomain(), nil
+}
@@ -0,0 +1,221 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+type plateInventoryEntity struct {
+	Weight float32 `json:"weight"`
+	Count  int     `json:"count"`
+}
+
+type gymProfileEntity struct {
+	ID        pgtype.UUID
+	UserID    pgtype.UUID
+	Name      string
+	BarWeight float32
+	Plates    []plateInventoryEntity
+	IsDefault bool
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+func (e gymProfileEntity) toDomain() domain.GymProfile {
+	plates := make([]domain.PlateInventory, 0, len(e.Plates))
+	for _, plate := range e.Plates {
+		plates = append(plates, domain.PlateInventory{Weight: plate.Weight, Count: plate.Count})
+	}
+
+	return domain.GymProfile{
+		Model: domain.Model{
+			ID:        domain.ID(e.ID.Bytes),
+			CreatedAt: timeFromPgtype(e.CreatedAt),
+			UpdatedAt: timeFromPgtype(e.UpdatedAt),
+		},
+		UserID:    domain.ID(e.UserID.Bytes),
+		Name:      e.Name,
+		BarWeight: e.BarWeight,
+		Plates:    plates,
+		IsDefault: e.IsDefault,
+	}
+}
+
+func platesFromDomain(plates []domain.PlateInventory) []plateInventoryEntity {
+	result := make([]plateInventoryEntity, 0, len(plates))
+	for _, plate := range plates {
+		result = append(result, plateInventoryEntity{Weight: plate.Weight, Count: plate.Count})
+	}
+
+	return result
+}
+
+const gymProfileColumns = `
+	id, user_id, name, bar_weight, plates, is_default, created_at, updated_at
+`
+
+func (r *PGXRepository) CreateGymProfile(ctx context.Context, profile domain.GymProfile) (domain.GymProfile, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.CreateGymProfile")
+	defer span.Finish()
+
+	query := `
+		INSERT INTO gym_profiles (id, user_id, name, bar_weight, plates, is_default, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING` + gymProfileColumns
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity gymProfileEntity
+	err := pgxscan.Get(
+		ctx,
+		engine,
+		&entity,
+		query,
+		uuidToPgtype(profile.ID),
+		uuidToPgtype(profile.UserID),
+		profile.Name,
+		profile.BarWeight,
+		platesFromDomain(profile.Plates),
+		profile.IsDefault,
+		timeToPgtype(profile.CreatedAt),
+		timeToPgtype(profile.UpdatedAt),
+	)
+	if err != nil {
+		logger.Errorf("failed to create gym profile: %v", err)
+		return domain.GymProfile{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) GetGymProfileByID(ctx context.Context, id domain.ID) (domain.GymProfile, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetGymProfileByID")
+	defer span.Finish()
+
+	query := `
+		SELECT` + gymProfileColumns + `
+		FROM gym_profiles
+		WHERE id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity gymProfileEntity
+	if err := pgxscan.Get(ctx, engine, &entity, query, uuidToPgtype(id)); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.GymProfile{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get gym profile by id: %v", err)
+		return domain.GymProfile{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+// GetGymProfiles returns gym profiles of the user, the default one first.
+func (r *PGXRepository) GetGymProfiles(ctx context.Context, userID domain.ID) ([]domain.GymProfile, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetGymProfiles")
+	defer span.Finish()
+
+	query := `
+		SELECT` + gymProfileColumns + `
+		FROM gym_profiles
+		WHERE user_id = $1
+		ORDER BY is_default DESC, created_at
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entities []gymProfileEntity
+	if err := pgxscan.Select(ctx, engine, &entities, query, uuidToPgtype(userID)); err != nil {
+		logger.Errorf("failed to get gym profiles: %v", err)
+		return nil, err
+	}
+
+	profiles := make([]domain.GymProfile, 0, len(entities))
+	for _, entity := range entities {
+		profiles = append(profiles, entity.toDomain())
+	}
+
+	return profiles, nil
+}
+
+func (r *PGXRepository) UpdateGymProfile(ctx context.Context, id domain.ID, profile domain.GymProfile) (domain.GymProfile, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.UpdateGymProfile")
+	defer span.Finish()
+
+	query := `
+		UPDATE gym_profiles
+		SET name = $2, bar_weight = $3, plates = $4, is_default = $5, updated_at = NOW()
+		WHERE id = $1
+		RETURNING` + gymProfileColumns
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity gymProfileEntity
+	err := pgxscan.Get(
+		ctx,
+		engine,
+		&entity,
+		query,
+		uuidToPgtype(id),
+		profile.Name,
+		profile.BarWeight,
+		platesFromDomain(profile.Plates),
+		profile.IsDefault,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.GymProfile{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to update gym profile: %v", err)
+		return domain.GymProfile{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+// UnsetDefaultGymProfile makes no gym profile of the user the default one.
+func (r *PGXRepository) UnsetDefaultGymProfile(ctx context.Context, userID domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.UnsetDefaultGymProfile")
+	defer span.Finish()
+
+	query := `
+		UPDATE gym_profiles SET is_default = FALSE, updated_at = NOW() WHERE user_id = $1 AND is_default
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidToPgtype(userID)); err != nil {
+		logger.Errorf("failed to unset default gym profile: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *PGXRepository) DeleteGymProfile(ctx context.Context, id domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.DeleteGymProfile")
+	defer span.Finish()
+
+	query := `
+		DELETE FROM gym_profiles WHERE id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidToPgtype(id)); err != nil {
+		logger.Errorf("failed to delete gym profile: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+type imageVariantEntity struct {
+	Size int    `json:"size"`
+	Key  string `json:"key"`
+	URL  string `json:"url"`
+}
+
+func imageVariantsToDomain(variants []imageVariantEntity) []domain.ImageVariant {
+	result := make([]domain.ImageVariant, 0, len(variants))
+	for _, v := range variants {
+		result = append(result, domain.ImageVariant{Size: v.Size, Key: v.Key, URL: v.URL})
+	}
+
+	return result
+}
+
+func imageVariantsFromDomain(variants []domain.ImageVariant) []imageVariantEntity {
+	result := make([]imageVariantEntity, 0, len(variants))
+	for _, v := range variants {
+		result = append(result, imageVariantEntity{Size: v.Size, Key: v.Key, URL: v.URL})
+	}
+
+	return result
+}
+
+type imageProcessingJobEntity struct {
+	ID         pgtype.UUID
+	SourceType string
+	SourceID   pgtype.UUID
+	SourceKey  string
+	Status     string
+	Attempts   int
+	LastError  string
+	RunAfter   pgtype.Timestamptz
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+}
+
+func (e imageProcessingJobEntity) toDomain() domain.ImageProcessingJob {
+	return domain.ImageProcessingJob{
+		Model: domain.Model{
+			ID:        domain.ID(e.ID.Bytes),
+			CreatedAt: timeFromPgtype(e.CreatedAt),
+			UpdatedAt: timeFromPgtype(e.UpdatedAt),
+		},
+		SourceType: domain.ImageJobSourceType(e.SourceType),
+		SourceID:   domain.ID(e.SourceID.Bytes),
+		SourceKey:  e.SourceKey,
+		Status:     domain.ImageJobStatus(e.Status),
+		Attempts:   e.Attempts,
+		LastError:  e.LastError,
+		RunAfter:   timeFromPgtype(e.RunAfter),
+	}
+}
+
+const imageProcessingJobColumns = `
+	id, source_type, source_id, source_key, status, attempts, last_error, run_after, created_at, updated_at
+`
+
+func (r *PGXRepository) CreateImageProcessingJob(ctx context.Context, job domain.ImageProcessingJob) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.CreateImageProcessingJob")
+	defer span.Finish()
+
+	query := `
+		INSERT INTO image_processing_jobs (id, source_type, source_id, source_key, status, run_after, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	_, err := engine.Exec(
+		ctx,
+		query,
+		uuidToPgtype(job.ID),
+		job.SourceType.String(),
+		uuidToPgtype(job.SourceID),
+		job.SourceKey,
+		job.Status.String(),
+		timeToPgtype(job.RunAfter),
+		timeToPgtype(job.CreatedAt),
+		timeToPgtype(job.UpdatedAt),
+	)
+	if err != nil {
+		logger.Errorf("failed to create image processing job: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// ClaimImageProcessingJobs marks up to limit due jobs as running for the lease duration
+// and returns them. Running jobs whose lease has expired are claimed again, so jobs of a
+// crashed worker are not lost.
+func (r *PGXRepository) ClaimImageProcessingJobs(ctx context.Context, limit int, lease time.Duration) ([]domain.ImageProcessingJob, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.ClaimImageProcessingJobs")
+	defer span.Finish()
+
+	query := `
+		UPDATE image_processing_jobs
+		SET status = 'running', attempts = attempts + 1, run_after = NOW() + $2::INTERVAL, updated_at = NOW()
+		WHERE id IN (
+			SELECT id
+			FROM image_processing_jobs
+			WHERE status IN ('pending', 'running') AND run_after <= NOW()
+			ORDER BY run_after
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING` + imageProcessingJobColumns
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var jobs []imageProcessingJobEntity
+	if err := pgxscan.Select(ctx, engine, &jobs, query, limit, lease); err != nil {
+		logger.Errorf("failed to claim image processing jobs: %v", err)
+		return nil, err
+	}
+
+	result := make([]domain.ImageProcessingJob, 0, len(jobs))
+	for _, job := range jobs {
+		result = append(result, job.toDomain())
+	}
+
+	return result, nil
+}
+
+func (r *PGXRepository) UpdateImageProcessingJob(ctx context.Context, id domain.ID, job domain.ImageProcessingJob) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.UpdateImageProcessingJob")
+	defer span.Finish()
+
+	query := `
+		UPDATE image_processing_jobs
+		SET status = $2, last_error = $3, run_after = $4, updated_at = NOW()
+		WHERE id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	_, err := engine.Exec(
+		ctx,
+		query,
+		uuidToPgtype(id),
+		job.Status.String(),
+		job.LastError,
+		timeToPgtype(job.RunAfter),
+	)
+	if err != nil {
+		logger.Errorf("failed to update image processing job: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// SetFileVariants stores thumbnails of the file and of every profile picture served from it.
+func (r *PGXRepository) SetFileVariants(ctx context.Context, fileID domain.ID, variants []domain.ImageVariant) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.SetFileVariants")
+	defer span.Finish()
+
+	fileQuery := `
+		UPDATE files SET variants = $2, updated_at = NOW() WHERE id = $1
+	`
+
+	userQuery := `
+		UPDATE users SET profile_picture_variants = $2 WHERE profile_picture_file_id = $1
+	`
+
+	entities := imageVariantsFromDomain(variants)
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, fileQuery, uuidToPgtype(fileID), entities); err != nil {
+		logger.Errorf("failed to set file variants: %v", err)
+		return err
+	}
+
+	if _, err := engine.Exec(ctx, userQuery, uuidToPgtype(fileID), entities); err != nil {
+		logger.Errorf("failed to set profile picture variants: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *PGXRepository) SetExerciseMediaVariants(ctx context.Context, mediaID domain.ID, variants []domain.ImageVariant) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.SetExerciseMediaVariants")
+	defer span.Finish()
+
+	query := `
+		UPDATE exercise_media SET variants = $2, updated_at = NOW() WHERE id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidToPgtype(mediaID), imageVariantsFromDomain(variants)); err != nil {
+		logger.Errorf("failed to set exercise media variants: %v", err)
+		return err
+	}
+
+	return nil
+}
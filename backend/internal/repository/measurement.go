@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+type measurementEntity struct {
+	ID         pgtype.UUID
+	UserID     pgtype.UUID
+	Kind       string
+	Value      float64
+	Unit       string
+	MeasuredAt pgtype.Timestamptz
+	Note       string
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+}
+
+func (e measurementEntity) toDomain() domain.Measurement {
+	return domain.Measurement{
+		Model: domain.Model{
+			ID:        domain.ID(e.ID.Bytes),
+			CreatedAt: timeFromPgtype(e.CreatedAt),
+			UpdatedAt: timeFromPgtype(e.UpdatedAt),
+		},
+		UserID:     domain.ID(e.UserID.Bytes),
+		Kind:       domain.MeasurementKind(e.Kind),
+		Value:      e.Value,
+		Unit:       domain.MeasurementUnit(e.Unit),
+		MeasuredAt: timeFromPgtype(e.MeasuredAt),
+		Note:       e.Note,
+	}
+}
+
+const measurementColumns = `
+	id, user_id, kind, value, unit, measured_at, note, created_at, updated_at
+`
+
+func (r *PGXRepository) CreateMeasurement(ctx context.Context, measurement domain.Measurement) (domain.Measurement, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.CreateMeasurement")
+	defer span.Finish()
+
+	query := `
+		INSERT INTO measurements (id, user_id, kind, value, unit, measured_at, note, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING` + measurementColumns
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity measurementEntity
+	err := pgxscan.Get(
+		ctx,
+		engine,
+		&entity,
+		query,
+		uuidToPgtype(measurement.ID),
+		uuidToPgtype(measurement.UserID),
+		measurement.Kind.String(),
+		measurement.Value,
+		measurement.Unit.String(),
+		timeToPgtype(measurement.MeasuredAt),
+		measurement.Note,
+		timeToPgtype(measurement.CreatedAt),
+		timeToPgtype(measurement.UpdatedAt),
+	)
+	if err != nil {
+		logger.Errorf("failed to create measurement: %v", err)
+		return domain.Measurement{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) GetMeasurementByID(ctx context.Context, id domain.ID) (domain.Measurement, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetMeasurementByID")
+	defer span.Finish()
+
+	query := `
+		SELECT` + measurementColumns + `
+		FROM measurements
+		WHERE id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity measurementEntity
+	if err := pgxscan.Get(ctx, engine, &entity, query, uuidToPgtype(id)); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Measurement{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get measurement by id: %v", err)
+		return domain.Measurement{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+// GetMeasurements returns measurements ordered from the most recent one.
+func (r *PGXRepository) GetMeasurements(ctx context.Context, filter dto.GetMeasurementsDTO) ([]domain.Measurement, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetMeasurements")
+	defer span.Finish()
+
+	query := `
+		SELECT` + measurementColumns + `
+		FROM measurements
+		WHERE user_id = $1
+			AND ($2 = '' OR kind = $2)
+			AND ($3::TIMESTAMPTZ IS NULL OR measured_at >= $3)
+			AND ($4::TIMESTAMPTZ IS NULL OR measured_at < $4)
+		ORDER BY measured_at DESC, id DESC
+		OFFSET $5
+		LIMIT NULLIF($6, 0)
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entities []measurementEntity
+	err := pgxscan.Select(
+		ctx,
+		engine,
+		&entities,
+		query,
+		uuidToPgtype(filter.UserID),
+		filter.Kind.String(),
+		timeToPgtype(filter.From),
+		timeToPgtype(filter.To),
+		filter.Offset,
+		filter.Limit,
+	)
+	if err != nil {
+		logger.Errorf("failed to get measurements: %v", err)
+		return nil, err
+	}
+
+	measurements := make([]domain.Measurement, 0, len(entities))
+	for _, entity := range entities {
+		measurements = append(measurements, entity.toDomain())
+	}
+
+	return measurements, nil
+}
+
+func (r *PGXRepository) UpdateMeasurement(ctx context.Context, id domain.ID, measurement domain.Measurement) (domain.Measurement, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.UpdateMeasurement")
+	defer span.Finish()
+
+	query := `
+		UPDATE measurements
+		SET value = $2, unit = $3, measured_at = $4, note = $5, updated_at = NOW()
+		WHERE id = $1
+		RETURNING` + measurementColumns
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity measurementEntity
+	err := pgxscan.Get(
+		ctx,
+		engine,
+		&entity,
+		query,
+		uuidToPgtype(id),
+		measurement.Value,
+		measurement.Unit.String(),
+		timeToPgtype(measurement.MeasuredAt),
+		measurement.Note,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Measurement{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to update measurement: %v", err)
+		return domain.Measurement{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) DeleteMeasurement(ctx context.Context, id domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.DeleteMeasurement")
+	defer span.Finish()
+
+	query := `
+		DELETE FROM measurements WHERE id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidToPgtype(id)); err != nil {
+		logger.Errorf("failed to delete measurement: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+type progressPhotoEntity struct {
+	ID            pgtype.UUID
+	UserID        pgtype.UUID
+	FileID        pgtype.UUID
+	Pose          string
+	TakenAt       pgtype.Timestamptz
+	MeasurementID pgtype.UUID
+	IsPrivate     bool
+	Note          string
+	URL           string
+	Variants      []imageVariantEntity
+	CreatedAt     pgtype.Timestamptz
+	UpdatedAt     pgtype.Timestamptz
+}
+
+func (e progressPhotoEntity) toDomain() domain.ProgressPhoto {
+	return domain.ProgressPhoto{
+		Model: domain.Model{
+			ID:        domain.ID(e.ID.Bytes),
+			CreatedAt: timeFromPgtype(e.CreatedAt),
+			UpdatedAt: timeFromPgtype(e.UpdatedAt),
+		},
+		UserID:        domain.ID(e.UserID.Bytes),
+		FileID:        domain.ID(e.FileID.Bytes),
+		Pose:          domain.ProgressPhotoPose(e.Pose),
+		TakenAt:       timeFromPgtype(e.TakenAt),
+		MeasurementID: utils.NewNullable(domain.ID(e.MeasurementID.Bytes), e.MeasurementID.Valid),
+		IsPrivate:     e.IsPrivate,
+		Note:          e.Note,
+		URL:           e.URL,
+		Variants:      imageVariantsToDomain(e.Variants),
+	}
+}
+
+func progressPhotosToDomain(entities []progressPhotoEntity) []domain.ProgressPhoto {
+	photos := make([]domain.ProgressPhoto, 0, len(entities))
+	for _, entity := range entities {
+		photos = append(photos, entity.toDomain())
+	}
+
+	return photos
+}
+
+// progressPhotoColumns expects progress_photos as p joined with files as f
+const progressPhotoColumns = `
+	p.id, p.user_id, p.file_id, p.pose, p.taken_at, p.measurement_id, p.is_private, p.note,
+	f.url, f.variants, p.created_at, p.updated_at
+`
+
+func (r *PGXRepository) CreateProgressPhoto(ctx context.Context, photo domain.ProgressPhoto) (domain.ProgressPhoto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.CreateProgressPhoto")
+	defer span.Finish()
+
+	query := `
+		WITH p AS (
+			INSERT INTO progress_photos (
+				id, user_id, file_id, pose, taken_at, measurement_id, is_private, note, created_at, updated_at
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			RETURNING *
+		)
+		SELECT` + progressPhotoColumns + `
+		FROM p
+		JOIN files f ON f.id = p.file_id
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity progressPhotoEntity
+	err := pgxscan.Get(
+		ctx,
+		engine,
+		&entity,
+		query,
+		uuidToPgtype(photo.ID),
+		uuidToPgtype(photo.UserID),
+		uuidToPgtype(photo.FileID),
+		photo.Pose.String(),
+		timeToPgtype(photo.TakenAt),
+		uuidToPgtype(photo.MeasurementID.V),
+		photo.IsPrivate,
+		photo.Note,
+		timeToPgtype(photo.CreatedAt),
+		timeToPgtype(photo.UpdatedAt),
+	)
+	if err != nil {
+		logger.Errorf("failed to create progress photo: %v", err)
+		return domain.ProgressPhoto{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) GetProgressPhotoByID(ctx context.Context, id domain.ID) (domain.ProgressPhoto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetProgressPhotoByID")
+	defer span.Finish()
+
+	query := `
+		SELECT` + progressPhotoColumns + `
+		FROM progress_photos p
+		JOIN files f ON f.id = p.file_id
+		WHERE p.id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity progressPhotoEntity
+	if err := pgxscan.Get(ctx, engine, &entity, query, uuidToPgtype(id)); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ProgressPhoto{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get progress photo by id: %v", err)
+		return domain.ProgressPhoto{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+// GetProgressPhotos returns photos ordered from the most recent one.
+func (r *PGXRepository) GetProgressPhotos(ctx context.Context, filter dto.GetProgressPhotosDTO) ([]domain.ProgressPhoto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetProgressPhotos")
+	defer span.Finish()
+
+	query := `
+		SELECT` + progressPhotoColumns + `
+		FROM progress_photos p
+		JOIN files f ON f.id = p.file_id
+		WHERE p.user_id = $1
+			AND ($2 = '' OR p.pose = $2)
+			AND ($3::TIMESTAMPTZ IS NULL OR p.taken_at >= $3)
+			AND ($4::TIMESTAMPTZ IS NULL OR p.taken_at < $4)
+		ORDER BY p.taken_at DESC, p.id DESC
+		OFFSET $5
+		LIMIT NULLIF($6, 0)
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entities []progressPhotoEntity
+	err := pgxscan.Select(
+		ctx,
+		engine,
+		&entities,
+		query,
+		uuidToPgtype(filter.UserID),
+		filter.Pose.String(),
+		timeToPgtype(filter.From),
+		timeToPgtype(filter.To),
+		filter.Offset,
+		filter.Limit,
+	)
+	if err != nil {
+		logger.Errorf("failed to get progress photos: %v", err)
+		return nil, err
+	}
+
+	return progressPhotosToDomain(entities), nil
+}
+
+// GetClosestProgressPhotos returns a photo of every pose, the one taken closest to the time.
+// Private photos are skipped unless includePrivate is set.
+func (r *PGXRepository) GetClosestProgressPhotos(ctx context.Context, userID domain.ID, at time.Time, includePrivate bool) ([]domain.ProgressPhoto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetClosestProgressPhotos")
+	defer span.Finish()
+
+	query := `
+		SELECT DISTINCT ON (p.pose)` + progressPhotoColumns + `
+		FROM progress_photos p
+		JOIN files f ON f.id = p.file_id
+		WHERE p.user_id = $1
+			AND ($3 OR NOT p.is_private)
+		ORDER BY p.pose, ABS(EXTRACT(EPOCH FROM p.taken_at - $2::TIMESTAMPTZ)), p.taken_at DESC
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entities []progressPhotoEntity
+	if err := pgxscan.Select(ctx, engine, &entities, query, uuidToPgtype(userID), timeToPgtype(at), includePrivate); err != nil {
+		logger.Errorf("failed to get closest progress photos: %v", err)
+		return nil, err
+	}
+
+	return progressPhotosToDomain(entities), nil
+}
+
+func (r *PGXRepository) UpdateProgressPhoto(ctx context.Context, id domain.ID, photo domain.ProgressPhoto) (domain.ProgressPhoto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.UpdateProgressPhoto")
+	defer span.Finish()
+
+	query := `
+		WITH p AS (
+			UPDATE progress_photos
+			SET pose = $2, taken_at = $3, measurement_id = $4, is_private = $5, note = $6, updated_at = NOW()
+			WHERE id = $1
+			RETURNING *
+		)
+		SELECT` + progressPhotoColumns + `
+		FROM p
+		JOIN files f ON f.id = p.file_id
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity progressPhotoEntity
+	err := pgxscan.Get(
+		ctx,
+		engine,
+		&entity,
+		query,
+		uuidToPgtype(id),
+		photo.Pose.String(),
+		timeToPgtype(photo.TakenAt),
+		uuidToPgtype(photo.MeasurementID.V),
+		photo.IsPrivate,
+		photo.Note,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ProgressPhoto{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to update progress photo: %v", err)
+		return domain.ProgressPhoto{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) DeleteProgressPhoto(ctx context.Context, id domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.DeleteProgressPhoto")
+	defer span.Finish()
+
+	query := `
+		DELETE FROM progress_photos WHERE id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidToPgtype(id)); err != nil {
+		logger.Errorf("failed to delete progress photo: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -13,36 +13,84 @@ import (
 	"github.com/opentracing/opentracing-go"
 )
 
+type warmupStepEntity struct {
+	Percent int `json:"percent"`
+	Reps    int `json:"reps"`
+}
+
+type routineTranslationEntity struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
 type routineEntity struct {
-	ID          pgtype.UUID
-	Name        string
-	Description string
-	UserID      pgtype.UUID
-	CreatedAt   pgtype.Timestamptz
-	UpdatedAt   pgtype.Timestamptz
+	ID              pgtype.UUID
+	Name            string
+	Description     string
+	UserID          pgtype.UUID
+	WarmupSteps     []warmupStepEntity
+	TemplateKey     pgtype.Text
+	TemplateVersion int
+	Program         string
+	Translations    map[string]routineTranslationEntity
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
 }
 
 func (r routineEntity) toDomain() domain.Routine {
+	warmupSteps := make([]domain.WarmupStep, 0, len(r.WarmupSteps))
+	for _, step := range r.WarmupSteps {
+		warmupSteps = append(warmupSteps, domain.WarmupStep{Percent: step.Percent, Reps: step.Reps})
+	}
+
+	var translations map[string]domain.RoutineTranslation
+	if len(r.Translations) > 0 {
+		translations = make(map[string]domain.RoutineTranslation, len(r.Translations))
+		for locale, translation := range r.Translations {
+			translations[locale] = domain.RoutineTranslation{Name: translation.Name, Description: translation.Description}
+		}
+	}
+
 	return domain.Routine{
 		Model: domain.Model{
 			ID:        domain.ID(r.ID.Bytes),
 			CreatedAt: r.CreatedAt.Time,
 			UpdatedAt: r.UpdatedAt.Time,
 		},
-		Name:        r.Name,
-		Description: r.Description,
-		UserID:      domain.ID(r.UserID.Bytes),
+		Name:            r.Name,
+		Description:     r.Description,
+		UserID:          domain.ID(r.UserID.Bytes),
+		WarmupSteps:     warmupSteps,
+		TemplateKey:     r.TemplateKey.String,
+		TemplateVersion: r.TemplateVersion,
+		Program:         r.Program,
+		Translations:    translations,
 	}
 }
 
 func routineFromDomain(routine domain.Routine) routineEntity {
+	warmupSteps := make([]warmupStepEntity, 0, len(routine.WarmupSteps))
+	for _, step := range routine.WarmupSteps {
+		warmupSteps = append(warmupSteps, warmupStepEntity{Percent: step.Percent, Reps: step.Reps})
+	}
+
+	translations := make(map[string]routineTranslationEntity, len(routine.Translations))
+	for locale, translation := range routine.Translations {
+		translations[locale] = routineTranslationEntity{Name: translation.Name, Description: translation.Description}
+	}
+
 	return routineEntity{
-		ID:          uuidToPgtype(routine.ID),
-		Name:        routine.Name,
-		Description: routine.Description,
-		UserID:      uuidToPgtype(routine.UserID),
-		CreatedAt:   timeToPgtype(routine.CreatedAt),
-		UpdatedAt:   timeToPgtype(routine.UpdatedAt),
+		ID:              uuidToPgtype(routine.ID),
+		Name:            routine.Name,
+		Description:     routine.Description,
+		UserID:          uuidToPgtype(routine.UserID),
+		WarmupSteps:     warmupSteps,
+		TemplateKey:     pgtype.Text{String: routine.TemplateKey, Valid: routine.TemplateKey != ""},
+		TemplateVersion: routine.TemplateVersion,
+		Program:         routine.Program,
+		Translations:    translations,
+		CreatedAt:       timeToPgtype(routine.CreatedAt),
+		UpdatedAt:       timeToPgtype(routine.UpdatedAt),
 	}
 }
 
@@ -78,15 +126,16 @@ func (r *PGXRepository) CreateRoutine(ctx context.Context, routine domain.Routin
 	defer span.Finish()
 
 	query := `
-		INSERT INTO routines (id, name, description, user_id)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO routines (id, name, description, user_id, warmup_steps, template_key, template_version, program, translations)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING *
 	`
 
 	engine := r.contextManager.GetEngineFromContext(ctx)
 
 	entity := routineFromDomain(routine)
-	err := pgxscan.Get(ctx, engine, &entity, query, entity.ID, entity.Name, entity.Description, entity.UserID)
+	err := pgxscan.Get(ctx, engine, &entity, query, entity.ID, entity.Name, entity.Description, entity.UserID, entity.WarmupSteps,
+		entity.TemplateKey, entity.TemplateVersion, entity.Program, entity.Translations)
 	if err != nil {
 		logger.Errorf("failed to create routine: %v", err)
 		return domain.Routine{}, err
@@ -150,7 +199,7 @@ func (r *PGXRepository) UpdateRoutine(ctx context.Context, id domain.ID, routine
 
 	query := `
 		UPDATE routines
-		SET name = $2, description = $3
+		SET name = $2, description = $3, warmup_steps = $4, template_version = $5, program = $6, translations = $7
 		WHERE id = $1
 		RETURNING *
 	`
@@ -158,7 +207,8 @@ func (r *PGXRepository) UpdateRoutine(ctx context.Context, id domain.ID, routine
 	engine := r.contextManager.GetEngineFromContext(ctx)
 
 	entity := routineFromDomain(routine)
-	err := pgxscan.Get(ctx, engine, &entity, query, entity.ID, entity.Name, entity.Description)
+	err := pgxscan.Get(ctx, engine, &entity, query, entity.ID, entity.Name, entity.Description, entity.WarmupSteps,
+		entity.TemplateVersion, entity.Program, entity.Translations)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.Routine{}, domain.ErrNotFound
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+type routineShareLinkEntity struct {
+	ID        pgtype.UUID
+	RoutineID pgtype.UUID
+	Token     string
+	ExpiresAt pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+func (e routineShareLinkEntity) toDomain() domain.RoutineShareLink {
+	return domain.RoutineShareLink{
+		Model: domain.Model{
+			ID:        domain.ID(e.ID.Bytes),
+			CreatedAt: timeFromPgtype(e.CreatedAt),
+			UpdatedAt: timeFromPgtype(e.UpdatedAt),
+		},
+		RoutineID: domain.ID(e.RoutineID.Bytes),
+		Token:     e.Token,
+		ExpiresAt: timeFromPgtype(e.ExpiresAt),
+	}
+}
+
+func (r *PGXRepository) CreateRoutineShareLink(ctx context.Context, link domain.RoutineShareLink) (domain.RoutineShareLink, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.CreateRoutineShareLink")
+	defer span.Finish()
+
+	query := `
+		INSERT INTO routine_share_links (id, routine_id, token, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, routine_id, token, expires_at, created_at, updated_at
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity routineShareLinkEntity
+	err := pgxscan.Get(
+		ctx,
+		engine,
+		&entity,
+		query,
+		uuidToPgtype(link.ID),
+		uuidToPgtype(link.RoutineID),
+		link.Token,
+		timeToPgtype(link.ExpiresAt),
+		timeToPgtype(link.CreatedAt),
+		timeToPgtype(link.UpdatedAt),
+	)
+	if err != nil {
+		logger.Errorf("failed to create routine share link: %v", err)
+		return domain.RoutineShareLink{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) GetRoutineShareLinks(ctx context.Context, routineID domain.ID) ([]domain.RoutineShareLink, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetRoutineShareLinks")
+	defer span.Finish()
+
+	query := `
+		SELECT id, routine_id, token, expires_at, created_at, updated_at
+		FROM routine_share_links
+		WHERE routine_id = $1
+		ORDER BY created_at DESC
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entities []routineShareLinkEntity
+	if err := pgxscan.Select(ctx, engine, &entities, query, uuidToPgtype(routineID)); err != nil {
+		logger.Errorf("failed to get routine share links: %v", err)
+		return nil, err
+	}
+
+	result := make([]domain.RoutineShareLink, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, entity.toDomain())
+	}
+
+	return result, nil
+}
+
+func (r *PGXRepository) GetRoutineShareLinkByToken(ctx context.Context, token string) (domain.RoutineShareLink, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetRoutineShareLinkByToken")
+	defer span.Finish()
+
+	query := `
+		SELECT id, routine_id, token, expires_at, created_at, updated_at
+		FROM routine_share_links
+		WHERE token = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity routineShareLinkEntity
+	err := pgxscan.Get(ctx, engine, &entity, query, token)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.RoutineShareLink{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get routine share link by token: %v", err)
+		return domain.RoutineShareLink{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) DeleteRoutineShareLink(ctx context.Context, routineID, id domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.DeleteRoutineShareLink")
+	defer span.Finish()
+
+	query := `
+		DELETE FROM routine_share_links
+		WHERE id = $1 AND routine_id = $2
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	tag, err := engine.Exec(ctx, query, uuidToPgtype(id), uuidToPgtype(routineID))
+	if err != nil {
+		logger.Errorf("failed to delete routine share link: %v", err)
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (r *PGXRepository) GetRoutineTemplates(ctx context.Context, filter dto.RoutineTemplatesFilterDTO) ([]domain.Routine, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetRoutineTemplates")
+	defer span.Finish()
+
+	query := `
+		SELECT * FROM routines r
+		WHERE r.template_key IS NOT NULL
+			AND ($1 = '' OR r.program = $1)
+			AND ($2 = '' OR r.name ILIKE '%' || $2 || '%' OR r.description ILIKE '%' || $2 || '%' OR r.program ILIKE '%' || $2 || '%'
+				OR EXISTS (
+					SELECT 1 FROM jsonb_each(r.translations) t
+					WHERE t.value->>'name' ILIKE '%' || $2 || '%' OR t.value->>'description' ILIKE '%' || $2 || '%'
+				))
+		ORDER BY r.program, r.template_key
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var routines []routineEntity
+	if err := pgxscan.Select(ctx, engine, &routines, query, filter.Program, filter.Query); err != nil {
+		logger.Errorf("failed to get routine templates: %v", err)
+		return nil, err
+	}
+
+	result := make([]domain.Routine, 0, len(routines))
+	for _, routine := range routines {
+		result = append(result, routine.toDomain())
+	}
+
+	return result, nil
+}
+
+func (r *PGXRepository) GetRoutineTemplateByKey(ctx context.Context, key string) (domain.Routine, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetRoutineTemplateByKey")
+	defer span.Finish()
+
+	query := `
+		SELECT * FROM routines r
+		WHERE r.template_key = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var routine routineEntity
+	err := pgxscan.Get(ctx, engine, &routine, query, key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Routine{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get routine template by key: %v", err)
+		return domain.Routine{}, err
+	}
+
+	return routine.toDomain(), nil
+}
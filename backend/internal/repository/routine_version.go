@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+type snapshotSetEntity struct {
+	ID      uuid.UUID     `json:"id"`
+	SetType string        `json:"set_type"`
+	Reps    int           `json:"reps"`
+	Weight  float32       `json:"weight"`
+	Time    time.Duration `json:"time"`
+	Rest    time.Duration `json:"rest"`
+}
+
+type snapshotGroupEntity struct {
+	ID     uuid.UUID `json:"id"`
+	Type   string    `json:"type"`
+	Rounds int       `json:"rounds"`
+}
+
+type snapshotExerciseEntity struct {
+	ExerciseInstanceID uuid.UUID            `json:"exercise_instance_id"`
+	ExerciseID         uuid.UUID            `json:"exercise_id"`
+	Group              *snapshotGroupEntity `json:"group,omitempty"`
+	Sets               []snapshotSetEntity  `json:"sets"`
+}
+
+type routineSnapshotEntity struct {
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	WarmupSteps []warmupStepEntity       `json:"warmup_steps"`
+	Exercises   []snapshotExerciseEntity `json:"exercises"`
+}
+
+type routineVersionEntity struct {
+	ID        pgtype.UUID
+	RoutineID pgtype.UUID
+	Version   int
+	Snapshot  routineSnapshotEntity
+	CreatedAt pgtype.Timestamptz
+}
+
+func (e routineVersionEntity) toDomain() domain.RoutineVersion {
+	snapshot := domain.RoutineSnapshot{
+		Name:        e.Snapshot.Name,
+		Description: e.Snapshot.Description,
+		WarmupSteps: make([]domain.WarmupStep, 0, len(e.Snapshot.WarmupSteps)),
+		Exercises:   make([]domain.RoutineSnapshotExercise, 0, len(e.Snapshot.Exercises)),
+	}
+
+	for _, step := range e.Snapshot.WarmupSteps {
+		snapshot.WarmupSteps = append(snapshot.WarmupSteps, domain.WarmupStep{Percent: step.Percent, Reps: step.Reps})
+	}
+
+	for _, exercise := range e.Snapshot.Exercises {
+		item := domain.RoutineSnapshotExercise{
+			ExerciseInstanceID: domain.ID(exercise.ExerciseInstanceID),
+			ExerciseID:         domain.ID(exercise.ExerciseID),
+			Sets:               make([]domain.Set, 0, len(exercise.Sets)),
+		}
+
+		if exercise.Group != nil {
+			item.Group = utils.NewNullable(domain.ExerciseGroup{
+				ID:     domain.ID(exercise.Group.ID),
+				Type:   domain.ExerciseGroupType(exercise.Group.Type),
+				Rounds: exercise.Group.Rounds,
+			}, true)
+		}
+
+		for i, set := range exercise.Sets {
+			item.Sets = append(item.Sets, domain.Set{
+				Model:              domain.Model{ID: domain.ID(set.ID)},
+				ExerciseInstanceID: domain.ID(exercise.ExerciseInstanceID),
+				SetType:            domain.SetType(set.SetType),
+				Reps:               set.Reps,
+				Weight:             set.Weight,
+				Time:               set.Time,
+				Rest:               set.Rest,
+				Position:           i,
+			})
+		}
+
+		snapshot.Exercises = append(snapshot.Exercises, item)
+	}
+
+	return domain.RoutineVersion{
+		Model: domain.Model{
+			ID:        domain.ID(e.ID.Bytes),
+			CreatedAt: e.CreatedAt.Time,
+			UpdatedAt: e.CreatedAt.Time,
+		},
+		RoutineID: utils.NewNullable(domain.ID(e.RoutineID.Bytes), e.RoutineID.Valid),
+		Version:   e.Version,
+		Snapshot:  snapshot,
+	}
+}
+
+func routineSnapshotFromDomain(snapshot domain.RoutineSnapshot) routineSnapshotEntity {
+	entity := routineSnapshotEntity{
+		Name:        snapshot.Name,
+		Description: snapshot.Description,
+		WarmupSteps: make([]warmupStepEntity, 0, len(snapshot.WarmupSteps)),
+		Exercises:   make([]snapshotExerciseEntity, 0, len(snapshot.Exercises)),
+	}
+
+	for _, step := range snapshot.WarmupSteps {
+		entity.WarmupSteps = append(entity.WarmupSteps, warmupStepEntity{Percent: step.Percent, Reps: step.Reps})
+	}
+
+	for _, exercise := range snapshot.Exercises {
+		item := snapshotExerciseEntity{
+			ExerciseInstanceID: uuid.UUID(exercise.ExerciseInstanceID),
+			ExerciseID:         uuid.UUID(exercise.ExerciseID),
+			Sets:               make([]snapshotSetEntity, 0, len(exercise.Sets)),
+		}
+
+		if exercise.Group.IsValid {
+			item.Group = &snapshotGroupEntity{
+				ID:     uuid.UUID(exercise.Group.V.ID),
+				Type:   exercise.Group.V.Type.String(),
+				Rounds: exercise.Group.V.Rounds,
+			}
+		}
+
+		for _, set := range exercise.Sets {
+			item.Sets = append(item.Sets, snapshotSetEntity{
+				ID:      uuid.UUID(set.ID),
+				SetType: string(set.SetType),
+				Reps:    set.Reps,
+				Weight:  set.Weight,
+				Time:    set.Time,
+				Rest:    set.Rest,
+			})
+		}
+
+		entity.Exercises = append(entity.Exercises, item)
+	}
+
+	return entity
+}
+
+func (r *PGXRepository) CreateRoutineVersion(ctx context.Context, version domain.RoutineVersion) (domain.RoutineVersion, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.CreateRoutineVersion")
+	defer span.Finish()
+
+	query := `
+		INSERT INTO routine_versions (id, routine_id, version, snapshot, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, routine_id, version, snapshot, created_at
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity routineVersionEntity
+	err := pgxscan.Get(
+		ctx,
+		engine,
+		&entity,
+		query,
+		uuidToPgtype(version.ID),
+		uuidToPgtype(version.RoutineID.V),
+		version.Version,
+		routineSnapshotFromDomain(version.Snapshot),
+		timeToPgtype(version.CreatedAt),
+	)
+	if err != nil {
+		logger.Errorf("failed to create routine version: %v", err)
+		return domain.RoutineVersion{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+// GetLatestRoutineVersion returns domain.ErrNotFound if the routine has no versions yet.
+func (r *PGXRepository) GetLatestRoutineVersion(ctx context.Context, routineID domain.ID) (domain.RoutineVersion, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetLatestRoutineVersion")
+	defer span.Finish()
+
+	query := `
+		SELECT id, routine_id, version, snapshot, created_at
+		FROM routine_versions
+		WHERE routine_id = $1
+		ORDER BY version DESC
+		LIMIT 1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity routineVersionEntity
+	err := pgxscan.Get(ctx, engine, &entity, query, uuidToPgtype(routineID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.RoutineVersion{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get latest routine version: %v", err)
+		return domain.RoutineVersion{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) GetRoutineVersion(ctx context.Context, routineID domain.ID, version int) (domain.RoutineVersion, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetRoutineVersion")
+	defer span.Finish()
+
+	query := `
+		SELECT id, routine_id, version, snapshot, created_at
+		FROM routine_versions
+		WHERE routine_id = $1 AND version = $2
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity routineVersionEntity
+	err := pgxscan.Get(ctx, engine, &entity, query, uuidToPgtype(routineID), version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.RoutineVersion{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get routine version: %v", err)
+		return domain.RoutineVersion{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) GetRoutineVersionByID(ctx context.Context, id domain.ID) (domain.RoutineVersion, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetRoutineVersionByID")
+	defer span.Finish()
+
+	query := `
+		SELECT id, routine_id, version, snapshot, created_at
+		FROM routine_versions
+		WHERE id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity routineVersionEntity
+	err := pgxscan.Get(ctx, engine, &entity, query, uuidToPgtype(id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.RoutineVersion{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get routine version: %v", err)
+		return domain.RoutineVersion{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) GetRoutineVersions(ctx context.Context, routineID domain.ID) ([]domain.RoutineVersion, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetRoutineVersions")
+	defer span.Finish()
+
+	query := `
+		SELECT id, routine_id, version, snapshot, created_at
+		FROM routine_versions
+		WHERE routine_id = $1
+		ORDER BY version DESC
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entities []routineVersionEntity
+	if err := pgxscan.Select(ctx, engine, &entities, query, uuidToPgtype(routineID)); err != nil {
+		logger.Errorf("failed to get routine versions: %v", err)
+		return nil, err
+	}
+
+	result := make([]domain.RoutineVersion, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, entity.toDomain())
+	}
+
+	return result, nil
+}
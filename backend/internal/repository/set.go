@@ -17,6 +17,8 @@ type setEntity struct {
 	Reps               pgtype.Int8        `db:"reps"`
 	Weight             pgtype.Float4      `db:"weight"`
 	Time               pgtype.Interval    `db:"time"`
+	Rest               pgtype.Interval    `db:"rest"`
+	Position           int                `db:"position"`
 	UpdatedAt          pgtype.Timestamptz `db:"updated_at"`
 	CreatedAt          pgtype.Timestamptz `db:"created_at"`
 }
@@ -46,6 +48,8 @@ func (s setEntity) toDomain() domain.Set {
 		Reps:               int(s.Reps.Int64),
 		Weight:             s.Weight.Float32,
 		Time:               durationFromPgtype(s.Time),
+		Rest:               durationFromPgtype(s.Rest),
+		Position:           s.Position,
 	}
 }
 
@@ -57,6 +61,8 @@ func setFromDomain(set domain.Set) setEntity {
 		Reps:               pgtype.Int8{Int64: int64(set.Reps), Valid: set.Reps != 0},
 		Weight:             pgtype.Float4{Float32: set.Weight, Valid: set.Weight != 0},
 		Time:               intervalToPgtype(set.Time),
+		Rest:               intervalToPgtype(set.Rest),
+		Position:           set.Position,
 		CreatedAt:          timeToPgtype(set.CreatedAt),
 		UpdatedAt:          timeToPgtype(set.UpdatedAt),
 	}
@@ -76,9 +82,10 @@ func (r *PGXRepository) GetSetsByExerciseInstanceID(ctx context.Context, exercis
 	defer span.Finish()
 
 	query := `
-		SELECT id, exercise_instance_id, reps, weight, time, set_type, updated_at, created_at
+		SELECT id, exercise_instance_id, reps, weight, time, rest, set_type, position, updated_at, created_at
 		FROM sets
 		WHERE exercise_instance_id = $1
+		ORDER BY position, created_at
 	`
 
 	engine := r.contextManager.GetEngineFromContext(ctx)
@@ -96,15 +103,15 @@ func (r *PGXRepository) CreateSet(ctx context.Context, set domain.Set) (domain.S
 	defer span.Finish()
 
 	query := `
-		INSERT INTO sets (id, exercise_instance_id, reps, weight, time, set_type)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO sets (id, exercise_instance_id, reps, weight, time, rest, set_type, position)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 		RETURNING created_at
 	`
 
 	engine := r.contextManager.GetEngineFromContext(ctx)
 
 	entity := setFromDomain(set)
-	if err := pgxscan.Get(ctx, engine, &entity.CreatedAt, query, entity.ID, entity.ExerciseInstanceID, entity.Reps, entity.Weight, entity.Time, entity.SetType); err != nil {
+	if err := pgxscan.Get(ctx, engine, &entity.CreatedAt, query, entity.ID, entity.ExerciseInstanceID, entity.Reps, entity.Weight, entity.Time, entity.Rest, entity.SetType, entity.Position); err != nil {
 		logger.Errorf("failed to create set: %v", err)
 		return domain.Set{}, err
 	}
@@ -117,7 +124,7 @@ func (r *PGXRepository) GetSetByID(ctx context.Context, id domain.ID) (domain.Se
 	defer span.Finish()
 
 	query := `
-		SELECT id, exercise_instance_id, reps, weight, time, set_type, updated_at, created_at
+		SELECT id, exercise_instance_id, reps, weight, time, rest, set_type, position, updated_at, created_at
 		FROM sets
 		WHERE id = $1
 	`
@@ -156,8 +163,8 @@ func (r *PGXRepository) UpdateSet(ctx context.Context, id domain.ID, set domain.
 
 	query := `
 		UPDATE sets
-		SET reps = $1, weight = $2, time = $3, set_type = $4
-		WHERE id = $5
+		SET reps = $1, weight = $2, time = $3, set_type = $4, rest = $5
+		WHERE id = $6
 		RETURNING updated_at
 	`
 
@@ -165,9 +172,53 @@ func (r *PGXRepository) UpdateSet(ctx context.Context, id domain.ID, set domain.
 
 	engine := r.contextManager.GetEngineFromContext(ctx)
 
-	if err := pgxscan.Get(ctx, engine,  &entity.UpdatedAt, query, entity.Reps, entity.Weight, entity.Time, entity.SetType, entity.ID); err != nil {
+	if err := pgxscan.Get(ctx, engine,  &entity.UpdatedAt, query, entity.Reps, entity.Weight, entity.Time, entity.SetType, entity.Rest, entity.ID); err != nil {
 		return domain.Set{}, err
 	}
 
 	return entity.toDomain(), nil
 }
+
+// ShiftSetPositions moves sets of the exercise instance from the position on one place down
+// to free the position for a new set.
+func (r *PGXRepository) ShiftSetPositions(ctx context.Context, exerciseInstanceID domain.ID, from int) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.ShiftSetPositions")
+	defer span.Finish()
+
+	query := `
+		UPDATE sets
+		SET position = position + 1
+		WHERE exercise_instance_id = $1 AND position >= $2
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidToPgtype(exerciseInstanceID), from); err != nil {
+		logger.Errorf("failed to shift set positions: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *PGXRepository) SetSetOrder(ctx context.Context, exerciseInstanceID domain.ID, setIDs []domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.SetSetOrder")
+	defer span.Finish()
+
+	query := `
+		UPDATE sets s
+		SET position = new_order.idx, updated_at = NOW()
+		FROM (SELECT unnest($1::UUID[]) AS id, generate_series(0, array_length($1, 1) - 1) AS idx) AS new_order
+		WHERE s.id = new_order.id
+		AND s.exercise_instance_id = $2
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidsToPgtype(setIDs), uuidToPgtype(exerciseInstanceID)); err != nil {
+		logger.Errorf("failed to set set order: %v", err)
+		return err
+	}
+
+	return nil
+}
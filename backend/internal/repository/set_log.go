@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -15,6 +16,9 @@ type setLogEntity struct {
 	Reps          int                `db:"reps"`
 	Weight        float32            `db:"weight"`
 	Time          pgtype.Interval    `db:"time"`
+	StartedAt     pgtype.Timestamptz `db:"started_at"`
+	CompletedAt   pgtype.Timestamptz `db:"completed_at"`
+	Position      int                `db:"position"`
 	UpdatedAt     pgtype.Timestamptz `db:"updated_at"`
 	CreatedAt     pgtype.Timestamptz `db:"created_at"`
 }
@@ -30,6 +34,9 @@ func (s setLogEntity) toDomain() domain.ExerciseSetLog {
 		Reps:          s.Reps,
 		Weight:        s.Weight,
 		Time:          durationFromPgtype(s.Time),
+		StartedAt:     timeFromPgtype(s.StartedAt),
+		CompletedAt:   s.CompletedAt.Time,
+		Position:      s.Position,
 	}
 }
 
@@ -40,6 +47,9 @@ func setLogFromDomain(setLog domain.ExerciseSetLog) setLogEntity {
 		Reps:          setLog.Reps,
 		Weight:        setLog.Weight,
 		Time:          intervalToPgtype(setLog.Time),
+		StartedAt:     timeToPgtype(setLog.StartedAt),
+		CompletedAt:   timeToPgtype(setLog.CompletedAt),
+		Position:      setLog.Position,
 		CreatedAt:     timeToPgtype(setLog.CreatedAt),
 		UpdatedAt:     timeToPgtype(setLog.UpdatedAt),
 	}
@@ -59,10 +69,10 @@ func (r *PGXRepository) GetSetLogsByExerciseLogID(ctx context.Context, exerciseL
 	defer span.Finish()
 
 	query := `
-		SELECT id, created_at, exercise_log_id, reps, weight, time, updated_at
+		SELECT id, created_at, exercise_log_id, reps, weight, time, started_at, completed_at, position, updated_at
 		FROM set_logs
 		WHERE exercise_log_id = $1
-		ORDER BY created_at
+		ORDER BY position, created_at
 	`
 
 	engine := r.contextManager.GetEngineFromContext(ctx)
@@ -80,8 +90,8 @@ func (r *PGXRepository) CreateSetLog(ctx context.Context, setLog domain.Exercise
 	defer span.Finish()
 
 	query := `
-		INSERT INTO set_logs (id, created_at, exercise_log_id, reps, weight, time)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO set_logs (id, created_at, exercise_log_id, reps, weight, time, started_at, completed_at, position)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING *
 	`
 
@@ -89,7 +99,7 @@ func (r *PGXRepository) CreateSetLog(ctx context.Context, setLog domain.Exercise
 
 	setLogEntity := setLogFromDomain(setLog)
 
-	if err := pgxscan.Get(ctx, engine, &setLogEntity, query, setLogEntity.ID, setLogEntity.CreatedAt, setLogEntity.ExerciseLogID, setLogEntity.Reps, setLogEntity.Weight, setLogEntity.Time); err != nil {
+	if err := pgxscan.Get(ctx, engine, &setLogEntity, query, setLogEntity.ID, setLogEntity.CreatedAt, setLogEntity.ExerciseLogID, setLogEntity.Reps, setLogEntity.Weight, setLogEntity.Time, setLogEntity.StartedAt, setLogEntity.CompletedAt, setLogEntity.Position); err != nil {
 		return domain.ExerciseSetLog{}, err
 	}
 
@@ -101,7 +111,7 @@ func (r *PGXRepository) GetSetLogByID(ctx context.Context, id domain.ID) (domain
 	defer span.Finish()
 
 	query := `
-		SELECT id, created_at, exercise_log_id, reps, weight, time, updated_at
+		SELECT id, created_at, exercise_log_id, reps, weight, time, started_at, completed_at, position, updated_at
 		FROM set_logs
 		WHERE id = $1
 	`
@@ -155,3 +165,47 @@ func (r *PGXRepository) UpdateSetLog(ctx context.Context, id domain.ID, setLog d
 
 	return setLogEntity.toDomain(), nil
 }
+
+// ShiftSetLogPositions moves set logs of the exercise log from the position on one place
+// down to free the position for a new set log.
+func (r *PGXRepository) ShiftSetLogPositions(ctx context.Context, exerciseLogID domain.ID, from int) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.ShiftSetLogPositions")
+	defer span.Finish()
+
+	query := `
+		UPDATE set_logs
+		SET position = position + 1
+		WHERE exercise_log_id = $1 AND position >= $2
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidToPgtype(exerciseLogID), from); err != nil {
+		logger.Errorf("failed to shift set log positions: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *PGXRepository) SetSetLogOrder(ctx context.Context, exerciseLogID domain.ID, setLogIDs []domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.SetSetLogOrder")
+	defer span.Finish()
+
+	query := `
+		UPDATE set_logs sl
+		SET position = new_order.idx, updated_at = NOW()
+		FROM (SELECT unnest($1::UUID[]) AS id, generate_series(0, array_length($1, 1) - 1) AS idx) AS new_order
+		WHERE sl.id = new_order.id
+		AND sl.exercise_log_id = $2
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidsToPgtype(setLogIDs), uuidToPgtype(exerciseLogID)); err != nil {
+		logger.Errorf("failed to set set log order: %v", err)
+		return err
+	}
+
+	return nil
+}
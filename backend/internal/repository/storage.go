@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/opentracing/opentracing-go"
+)
+
+// GetObjectReferences returns keys and URLs of every object the app still uses. Uploads
+// created after recentSince are referenced even if nothing points to them yet, because
+// they may still be confirmed or attached.
+func (r *PGXRepository) GetObjectReferences(ctx context.Context, recentSince time.Time) (dto.ObjectReferencesDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetObjectReferences")
+	defer span.Finish()
+
+	keysQuery := `
+		SELECT f.key FROM files f
+		WHERE f.created_at >= $1
+			OR EXISTS (SELECT 1 FROM users u WHERE u.profile_picture_file_id = f.id)
+			OR EXISTS (SELECT 1 FROM progress_photos p WHERE p.file_id = f.id)
+		UNION
+		SELECT v->>'key' FROM files f, jsonb_array_elements(f.variants) v
+		WHERE f.created_at >= $1
+			OR EXISTS (SELECT 1 FROM progress_photos p WHERE p.file_id = f.id)
+		UNION
+		SELECT v->>'key' FROM users u, jsonb_array_elements(u.profile_picture_variants) v
+		UNION
+		SELECT em.key FROM exercise_media em
+		WHERE em.status = 'ready' OR em.created_at >= $1
+		UNION
+		SELECT v->>'key' FROM exercise_media em, jsonb_array_elements(em.variants) v
+		UNION
+		SELECT de.key FROM data_exports de
+		WHERE de.key <> '' AND de.expires_at > NOW()
+	`
+
+	urlsQuery := `
+		SELECT picture_profile_url FROM users WHERE picture_profile_url <> ''
+		UNION
+		SELECT video_url FROM exercises WHERE video_url <> ''
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var references dto.ObjectReferencesDTO
+	if err := pgxscan.Select(ctx, engine, &references.Keys, keysQuery, timeToPgtype(recentSince)); err != nil {
+		logger.Errorf("failed to get referenced object keys: %v", err)
+		return dto.ObjectReferencesDTO{}, err
+	}
+
+	if err := pgxscan.Select(ctx, engine, &references.URLs, urlsQuery); err != nil {
+		logger.Errorf("failed to get referenced object urls: %v", err)
+		return dto.ObjectReferencesDTO{}, err
+	}
+
+	return references, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgerrcode"
@@ -23,7 +24,11 @@ type userEntity struct {
 	FirstName string
 	LastName  string
 
-	PictureProfileURL pgtype.Text `db:"picture_profile_url"`
+	PictureProfileURL      pgtype.Text `db:"picture_profile_url"`
+	ProfilePictureFileID   pgtype.UUID
+	ProfilePictureVariants []imageVariantEntity
+
+	Role string
 
 	DateOfBirth pgtype.Timestamptz
 
@@ -41,6 +46,7 @@ func (u userEntity) toDomain() domain.User {
 			CreatedAt: timeFromPgtype(u.CreatedAt),
 			UpdatedAt: timeFromPgtype(u.UpdatedAt),
 		},
+		Role:          domain.UserRole(u.Role),
 		Email:         u.Email,
 		Password:      u.Password,
 		FirstName:     u.FirstName,
@@ -49,12 +55,16 @@ func (u userEntity) toDomain() domain.User {
 		Weight:        u.Weight.Float32,
 		Height:        u.Height.Float32,
 		ProfilePicURL: u.PictureProfileURL.String,
+
+		ProfilePictureFileID:   utils.NewNullable(domain.ID(u.ProfilePictureFileID.Bytes), u.ProfilePictureFileID.Valid),
+		ProfilePictureVariants: imageVariantsToDomain(u.ProfilePictureVariants),
 	}
 }
 
 func userFromDomain(user domain.User) userEntity {
 	return userEntity{
 		ID:                uuidToPgtype(user.ID),
+		Role:              user.Role.String(),
 		Email:             user.Email,
 		FirstName:         user.FirstName,
 		LastName:          user.LastName,
@@ -65,6 +75,9 @@ func userFromDomain(user domain.User) userEntity {
 		CreatedAt:         timeToPgtype(user.CreatedAt),
 		UpdatedAt:         timeToPgtype(user.UpdatedAt),
 		PictureProfileURL: pgtype.Text{String: user.ProfilePicURL, Valid: user.ProfilePicURL != ""},
+
+		ProfilePictureFileID:   pgtype.UUID{Bytes: user.ProfilePictureFileID.V, Valid: user.ProfilePictureFileID.IsValid},
+		ProfilePictureVariants: imageVariantsFromDomain(user.ProfilePictureVariants),
 	}
 }
 
@@ -73,7 +86,7 @@ func (r *PGXRepository) GetUserByEmail(ctx context.Context, email string) (domai
 	defer span.Finish()
 
 	const query = `
-		select id, email, password, first_name, last_name, date_of_birth, height, weight, created_at, updated_at, picture_profile_url
+		select id, email, password, first_name, last_name, date_of_birth, height, weight, created_at, updated_at, picture_profile_url, profile_picture_file_id, profile_picture_variants, role
 		from users u 
 		where u.email=$1;
 	`
@@ -98,7 +111,7 @@ func (r *PGXRepository) GetUserByID(ctx context.Context, id domain.ID) (domain.U
 	defer span.Finish()
 
 	const query = `
-		select id, email, password, first_name, last_name, date_of_birth, height, weight, created_at, updated_at, picture_profile_url
+		select id, email, password, first_name, last_name, date_of_birth, height, weight, created_at, updated_at, picture_profile_url, profile_picture_file_id, profile_picture_variants, role
 		from users u 
 		where u.id=$1;
 	`
@@ -125,7 +138,7 @@ func (r *PGXRepository) CreateUser(ctx context.Context, user domain.User) (domai
 	const query = `
 		insert into users (id, email, password, first_name, last_name, date_of_birth, height, weight, created_at, updated_at, picture_profile_url)
 		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
-		returning id, email, password, first_name, last_name, date_of_birth, height, weight, created_at, updated_at, picture_profile_url;
+		returning id, email, password, first_name, last_name, date_of_birth, height, weight, created_at, updated_at, picture_profile_url, profile_picture_file_id, profile_picture_variants, role;
 	`
 
 	userEntity := userFromDomain(user)
@@ -165,9 +178,10 @@ func (r *PGXRepository) UpdateUser(ctx context.Context, user domain.User) (domai
 
 	const query = `
 		update users
-		set email=$2, first_name=$3, last_name=$4, date_of_birth=$5, height=$6, weight=$7, updated_at=$8, picture_profile_url=$9
+		set email=$2, first_name=$3, last_name=$4, date_of_birth=$5, height=$6, weight=$7, updated_at=$8, picture_profile_url=$9,
+			profile_picture_file_id=$10, profile_picture_variants=$11
 		where id=$1
-		returning id, email, password, first_name, last_name, date_of_birth, height, weight, created_at, updated_at;
+		returning id, email, password, first_name, last_name, date_of_birth, height, weight, created_at, updated_at, picture_profile_url, profile_picture_file_id, profile_picture_variants, role;
 	`
 
 	userEntity := userFromDomain(user)
@@ -185,6 +199,8 @@ func (r *PGXRepository) UpdateUser(ctx context.Context, user domain.User) (domai
 		floatToPgtype(user.Weight),
 		timeToPgtype(user.UpdatedAt),
 		pgtype.Text{String: user.ProfilePicURL, Valid: user.ProfilePicURL != ""},
+		userEntity.ProfilePictureFileID,
+		userEntity.ProfilePictureVariants,
 	)
 	if err != nil {
 		logger.Errorf("error updating user: %v", err)
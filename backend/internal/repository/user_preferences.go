@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+type userPreferencesEntity struct {
+	UserID         pgtype.UUID
+	WeightUnit     string
+	LengthUnit     string
+	Locale         string
+	FirstDayOfWeek int
+	Timezone       string
+	UpdatedAt      pgtype.Timestamptz
+}
+
+func (e userPreferencesEntity) toDomain() domain.UserPreferences {
+	return domain.UserPreferences{
+		UserID:         domain.ID(e.UserID.Bytes),
+		WeightUnit:     domain.MeasurementUnit(e.WeightUnit),
+		LengthUnit:     domain.MeasurementUnit(e.LengthUnit),
+		Locale:         e.Locale,
+		FirstDayOfWeek: time.Weekday(e.FirstDayOfWeek),
+		Timezone:       e.Timezone,
+		UpdatedAt:      timeFromPgtype(e.UpdatedAt),
+	}
+}
+
+const userPreferencesColumns = `
+	user_id, weight_unit, length_unit, locale, first_day_of_week, timezone, updated_at
+`
+
+func (r *PGXRepository) GetUserPreferences(ctx context.Context, userID domain.ID) (domain.UserPreferences, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetUserPreferences")
+	defer span.Finish()
+
+	query := `
+		SELECT` + userPreferencesColumns + `
+		FROM user_preferences
+		WHERE user_id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity userPreferencesEntity
+	if err := pgxscan.Get(ctx, engine, &entity, query, uuidToPgtype(userID)); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.UserPreferences{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get user preferences: %v", err)
+		return domain.UserPreferences{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) SaveUserPreferences(ctx context.Context, preferences domain.UserPreferences) (domain.UserPreferences, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.SaveUserPreferences")
+	defer span.Finish()
+
+	query := `
+		INSERT INTO user_preferences (user_id, weight_unit, length_unit, locale, first_day_of_week, timezone, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		ON CONFLICT (user_id) DO UPDATE
+		SET weight_unit = EXCLUDED.weight_unit,
+			length_unit = EXCLUDED.length_unit,
+			locale = EXCLUDED.locale,
+			first_day_of_week = EXCLUDED.first_day_of_week,
+			timezone = EXCLUDED.timezone,
+			updated_at = EXCLUDED.updated_at
+		RETURNING` + userPreferencesColumns
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity userPreferencesEntity
+	err := pgxscan.Get(
+		ctx,
+		engine,
+		&entity,
+		query,
+		uuidToPgtype(preferences.UserID),
+		preferences.WeightUnit.String(),
+		preferences.LengthUnit.String(),
+		preferences.Locale,
+		int(preferences.FirstDayOfWeek),
+		preferences.Timezone,
+	)
+	if err != nil {
+		logger.Errorf("failed to save user preferences: %v", err)
+		return domain.UserPreferences{}, err
+	}
+
+	return entity.toDomain(), nil
+}
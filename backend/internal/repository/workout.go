@@ -5,6 +5,7 @@ import (
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/logger"
 	"fitness-trainer/internal/utils"
+	"time"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/google/uuid"
@@ -14,16 +15,18 @@ import (
 )
 
 type workoutEntity struct {
-	ID            pgtype.UUID
-	UserID        pgtype.UUID
-	RoutineID     pgtype.UUID
-	Notes         string
-	Rating        int
-	FinishedAt    pgtype.Timestamptz
-	CreatedAt     pgtype.Timestamptz
-	UpdatedAt     pgtype.Timestamptz
-	IsAIGenerated pgtype.Bool `db:"is_ai_generated"`
-	Reasoning     pgtype.Text
+	ID               pgtype.UUID
+	UserID           pgtype.UUID
+	RoutineID        pgtype.UUID
+	RoutineVersionID pgtype.UUID
+	Notes            string
+	Rating           int
+	FinishedAt       pgtype.Timestamptz
+	CreatedAt        pgtype.Timestamptz
+	UpdatedAt        pgtype.Timestamptz
+	IsAIGenerated    pgtype.Bool `db:"is_ai_generated"`
+	Reasoning        pgtype.Text
+	ExternalID       pgtype.Text
 }
 
 func (w workoutEntity) toDomain() domain.Workout {
@@ -33,28 +36,32 @@ func (w workoutEntity) toDomain() domain.Workout {
 			CreatedAt: w.CreatedAt.Time,
 			UpdatedAt: w.UpdatedAt.Time,
 		},
-		UserID:        domain.ID(w.UserID.Bytes),
-		RoutineID:     utils.NewNullable(domain.ID(w.RoutineID.Bytes), w.RoutineID.Valid),
-		Notes:         w.Notes,
-		Rating:        w.Rating,
-		FinishedAt:    w.FinishedAt.Time,
-		IsAIGenerated: w.IsAIGenerated.Bool,
-		Reasoning:     w.Reasoning.String,
+		UserID:           domain.ID(w.UserID.Bytes),
+		RoutineID:        utils.NewNullable(domain.ID(w.RoutineID.Bytes), w.RoutineID.Valid),
+		RoutineVersionID: utils.NewNullable(domain.ID(w.RoutineVersionID.Bytes), w.RoutineVersionID.Valid),
+		Notes:            w.Notes,
+		Rating:           w.Rating,
+		FinishedAt:       w.FinishedAt.Time,
+		IsAIGenerated:    w.IsAIGenerated.Bool,
+		Reasoning:        w.Reasoning.String,
+		ExternalID:       w.ExternalID.String,
 	}
 }
 
 func workoutFromDomain(workout domain.Workout) workoutEntity {
 	return workoutEntity{
-		ID:            uuidToPgtype(workout.ID),
-		UserID:        uuidToPgtype(workout.UserID),
-		RoutineID:     pgtype.UUID{Bytes: uuid.UUID(workout.RoutineID.V), Valid: workout.RoutineID.IsValid},
-		Notes:         workout.Notes,
-		Rating:        workout.Rating,
-		FinishedAt:    timeToPgtype(workout.FinishedAt),
-		CreatedAt:     timeToPgtype(workout.CreatedAt),
-		UpdatedAt:     timeToPgtype(workout.UpdatedAt),
-		IsAIGenerated: pgtype.Bool{Bool: workout.IsAIGenerated, Valid: true},
-		Reasoning:     pgtype.Text{String: workout.Reasoning, Valid: workout.Reasoning != ""},
+		ID:               uuidToPgtype(workout.ID),
+		UserID:           uuidToPgtype(workout.UserID),
+		RoutineID:        pgtype.UUID{Bytes: uuid.UUID(workout.RoutineID.V), Valid: workout.RoutineID.IsValid},
+		RoutineVersionID: pgtype.UUID{Bytes: uuid.UUID(workout.RoutineVersionID.V), Valid: workout.RoutineVersionID.IsValid},
+		Notes:            workout.Notes,
+		Rating:           workout.Rating,
+		FinishedAt:       timeToPgtype(workout.FinishedAt),
+		CreatedAt:        timeToPgtype(workout.CreatedAt),
+		UpdatedAt:        timeToPgtype(workout.UpdatedAt),
+		IsAIGenerated:    pgtype.Bool{Bool: workout.IsAIGenerated, Valid: true},
+		Reasoning:        pgtype.Text{String: workout.Reasoning, Valid: workout.Reasoning != ""},
+		ExternalID:       pgtype.Text{String: workout.ExternalID, Valid: workout.ExternalID != ""},
 	}
 }
 
@@ -72,8 +79,8 @@ func (r *PGXRepository) CreateWorkout(ctx context.Context, workout domain.Workou
 	defer span.Finish()
 
 	query := `
-		INSERT INTO workouts (id, user_id, routine_id, notes, rating, finished_at, is_ai_generated, reasoning)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO workouts (id, user_id, routine_id, routine_version_id, notes, rating, finished_at, is_ai_generated, reasoning, external_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, COALESCE($11, NOW()))
 		RETURNING created_at
 	`
 
@@ -85,11 +92,14 @@ func (r *PGXRepository) CreateWorkout(ctx context.Context, workout domain.Workou
 		entity.ID,
 		entity.UserID,
 		entity.RoutineID,
+		entity.RoutineVersionID,
 		entity.Notes,
 		entity.Rating,
 		entity.FinishedAt,
 		entity.IsAIGenerated,
 		entity.Reasoning,
+		entity.ExternalID,
+		entity.CreatedAt,
 	); err != nil {
 		logger.Errorf("failed to create workout: %v", err)
 		return domain.Workout{}, err
@@ -103,7 +113,7 @@ func (r *PGXRepository) GetWorkoutByID(ctx context.Context, id domain.ID) (domai
 	defer span.Finish()
 
 	query := `
-		SELECT id, user_id, routine_id, created_at, notes, rating, finished_at, updated_at, is_ai_generated, reasoning
+		SELECT id, user_id, routine_id, routine_version_id, created_at, notes, rating, finished_at, updated_at, is_ai_generated, reasoning, external_id
 		FROM workouts
 		WHERE id = $1
 	`
@@ -127,7 +137,7 @@ func (r *PGXRepository) GetActiveWorkouts(ctx context.Context, userID domain.ID)
 	defer span.Finish()
 
 	query := `
-		SELECT id, user_id, routine_id, created_at, notes, rating, finished_at, updated_at, is_ai_generated, reasoning
+		SELECT id, user_id, routine_id, routine_version_id, created_at, notes, rating, finished_at, updated_at, is_ai_generated, reasoning, external_id
 		FROM workouts
 		WHERE user_id = $1 AND finished_at IS NULL
 	`
@@ -200,12 +210,39 @@ func (r *PGXRepository) DeleteWorkout(ctx context.Context, id domain.ID) error {
 	return nil
 }
 
+// GetFinishedWorkoutTimes returns start times of finished workouts of the user in [from, to)
+// in ascending order, zero bounds leave the range open.
+func (r *PGXRepository) GetFinishedWorkoutTimes(ctx context.Context, userID domain.ID, from, to time.Time) ([]time.Time, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetFinishedWorkoutTimes")
+	defer span.Finish()
+
+	query := `
+		SELECT created_at
+		FROM workouts
+		WHERE user_id = $1
+			AND finished_at IS NOT NULL
+			AND ($2::TIMESTAMPTZ IS NULL OR created_at >= $2)
+			AND ($3::TIMESTAMPTZ IS NULL OR created_at < $3)
+		ORDER BY created_at
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var times []time.Time
+	if err := pgxscan.Select(ctx, engine, &times, query, uuidToPgtype(userID), timeToPgtype(from), timeToPgtype(to)); err != nil {
+		logger.Errorf("failed to get finished workout times: %v", err)
+		return nil, domain.ErrInternal
+	}
+
+	return times, nil
+}
+
 func (r *PGXRepository) GetWorkouts(ctx context.Context, userID domain.ID, limit, offset int) ([]domain.Workout, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetWorkouts")
 	defer span.Finish()
 
 	query := `
-		SELECT id, user_id, routine_id, created_at, notes, rating, finished_at, updated_at, is_ai_generated, reasoning
+		SELECT id, user_id, routine_id, routine_version_id, created_at, notes, rating, finished_at, updated_at, is_ai_generated, reasoning, external_id
 		FROM workouts
 		WHERE user_id = $1 AND finished_at IS NOT NULL
 		ORDER BY created_at DESC
@@ -222,3 +259,85 @@ func (r *PGXRepository) GetWorkouts(ctx context.Context, userID domain.ID, limit
 
 	return toWorkoutsDomain(workouts), nil
 }
+
+func (r *PGXRepository) HasWorkoutWithExternalID(ctx context.Context, userID domain.ID, externalID string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.HasWorkoutWithExternalID")
+	defer span.Finish()
+
+	query := `
+		SELECT EXISTS (SELECT 1 FROM workouts WHERE user_id = $1 AND external_id = $2)
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var exists bool
+	if err := pgxscan.Get(ctx, engine, &exists, query, uuidToPgtype(userID), externalID); err != nil {
+		logger.Errorf("failed to check workout external id: %v", err)
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *PGXRepository) HasWorkoutWithRoutineVersion(ctx context.Context, userID, routineVersionID domain.ID) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.HasWorkoutWithRoutineVersion")
+	defer span.Finish()
+
+	query := `
+		SELECT EXISTS (SELECT 1 FROM workouts WHERE user_id = $1 AND routine_version_id = $2)
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var exists bool
+	if err := pgxscan.Get(ctx, engine, &exists, query, uuidToPgtype(userID), uuidToPgtype(routineVersionID)); err != nil {
+		logger.Errorf("failed to check workout routine version: %v", err)
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// LockUserWorkouts serializes creation of workouts of the user until the end of the
+// transaction, so overlap checks are not raced. Must be called within a transaction.
+func (r *PGXRepository) LockUserWorkouts(ctx context.Context, userID domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.LockUserWorkouts")
+	defer span.Finish()
+
+	query := `
+		SELECT pg_advisory_xact_lock(hashtextextended('workouts:' || $1::UUID::TEXT, 0))
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	if _, err := engine.Exec(ctx, query, uuidToPgtype(userID)); err != nil {
+		logger.Errorf("failed to lock workouts of user: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// HasOverlappingWorkout reports whether the user has a workout running at some point between
+// from and to. Active workouts are treated as running until now.
+func (r *PGXRepository) HasOverlappingWorkout(ctx context.Context, userID domain.ID, from, to time.Time) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.HasOverlappingWorkout")
+	defer span.Finish()
+
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM workouts
+			WHERE user_id = $1 AND created_at < $3 AND COALESCE(finished_at, NOW()) > $2
+		)
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var exists bool
+	if err := pgxscan.Get(ctx, engine, &exists, query, uuidToPgtype(userID), timeToPgtype(from), timeToPgtype(to)); err != nil {
+		logger.Errorf("failed to check overlapping workouts: %v", err)
+		return false, err
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+type workoutAmendmentEntity struct {
+	ID            pgtype.UUID
+	WorkoutID     pgtype.UUID
+	UserID        pgtype.UUID
+	ExerciseLogID pgtype.UUID
+	SetLogID      pgtype.UUID
+	Action        string
+	Changes       map[string]domain.FieldChange
+	CreatedAt     pgtype.Timestamptz
+	UpdatedAt     pgtype.Timestamptz
+}
+
+func (e workoutAmendmentEntity) toDomain() domain.WorkoutAmendment {
+	return domain.WorkoutAmendment{
+		Model: domain.Model{
+			ID:        domain.ID(e.ID.Bytes),
+			CreatedAt: timeFromPgtype(e.CreatedAt),
+			UpdatedAt: timeFromPgtype(e.UpdatedAt),
+		},
+		WorkoutID:     domain.ID(e.WorkoutID.Bytes),
+		UserID:        domain.ID(e.UserID.Bytes),
+		ExerciseLogID: domain.ID(e.ExerciseLogID.Bytes),
+		SetLogID:      domain.ID(e.SetLogID.Bytes),
+		Action:        domain.WorkoutAmendmentAction(e.Action),
+		Changes:       e.Changes,
+	}
+}
+
+func (r *PGXRepository) CreateWorkoutAmendment(ctx context.Context, amendment domain.WorkoutAmendment) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.CreateWorkoutAmendment")
+	defer span.Finish()
+
+	query := `
+		INSERT INTO workout_amendments (id, workout_id, user_id, exercise_log_id, set_log_id, action, changes, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	_, err := engine.Exec(
+		ctx,
+		query,
+		uuidToPgtype(amendment.ID),
+		uuidToPgtype(amendment.WorkoutID),
+		uuidToPgtype(amendment.UserID),
+		uuidToPgtype(amendment.ExerciseLogID),
+		uuidToPgtype(amendment.SetLogID),
+		amendment.Action.String(),
+		amendment.Changes,
+		timeToPgtype(amendment.CreatedAt),
+		timeToPgtype(amendment.UpdatedAt),
+	)
+	if err != nil {
+		logger.Errorf("failed to create workout amendment: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *PGXRepository) GetWorkoutAmendments(ctx context.Context, workoutID domain.ID) ([]domain.WorkoutAmendment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetWorkoutAmendments")
+	defer span.Finish()
+
+	query := `
+		SELECT id, workout_id, user_id, exercise_log_id, set_log_id, action, changes, created_at, updated_at
+		FROM workout_amendments
+		WHERE workout_id = $1
+		ORDER BY created_at DESC
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var amendments []workoutAmendmentEntity
+	if err := pgxscan.Select(ctx, engine, &amendments, query, uuidToPgtype(workoutID)); err != nil {
+		logger.Errorf("failed to get workout amendments: %v", err)
+		return nil, err
+	}
+
+	result := make([]domain.WorkoutAmendment, 0, len(amendments))
+	for _, amendment := range amendments {
+		result = append(result, amendment.toDomain())
+	}
+
+	return result, nil
+}
@@ -0,0 +1,329 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+type importedSetEntity struct {
+	Reps   int           `json:"reps"`
+	Weight float32       `json:"weight"`
+	Time   time.Duration `json:"time"`
+}
+
+type importedExerciseEntity struct {
+	Name  string              `json:"name"`
+	Notes string              `json:"notes"`
+	Sets  []importedSetEntity `json:"sets"`
+}
+
+type importedWorkoutEntity struct {
+	ExternalID string                   `json:"external_id"`
+	Title      string                   `json:"title"`
+	Notes      string                   `json:"notes"`
+	StartedAt  time.Time                `json:"started_at"`
+	FinishedAt time.Time                `json:"finished_at"`
+	Exercises  []importedExerciseEntity `json:"exercises"`
+}
+
+type importExerciseMappingEntity struct {
+	Name         string     `json:"name"`
+	ExerciseID   *uuid.UUID `json:"exercise_id,omitempty"`
+	ExerciseName string     `json:"exercise_name"`
+	Similarity   float64    `json:"similarity"`
+	Skip         bool       `json:"skip"`
+	SetCount     int        `json:"set_count"`
+}
+
+type workoutImportEntity struct {
+	ID           pgtype.UUID
+	UserID       pgtype.UUID
+	Source       string
+	FileID       pgtype.UUID
+	Status       string
+	Attempts     int
+	LastError    string
+	RunAfter     pgtype.Timestamptz
+	Workouts     []importedWorkoutEntity
+	Mappings     []importExerciseMappingEntity
+	CreatedCount int
+	SkippedCount int
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+}
+
+func (e workoutImportEntity) toDomain() domain.WorkoutImport {
+	workouts := make([]domain.ImportedWorkout, 0, len(e.Workouts))
+	for _, workout := range e.Workouts {
+		item := domain.ImportedWorkout{
+			ExternalID: workout.ExternalID,
+			Title:      workout.Title,
+			Notes:      workout.Notes,
+			StartedAt:  workout.StartedAt,
+			FinishedAt: workout.FinishedAt,
+			Exercises:  make([]domain.ImportedExercise, 0, len(workout.Exercises)),
+		}
+
+		for _, exercise := range workout.Exercises {
+			sets := make([]domain.ImportedSet, 0, len(exercise.Sets))
+			for _, set := range exercise.Sets {
+				sets = append(sets, domain.ImportedSet{Reps: set.Reps, Weight: set.Weight, Time: set.Time})
+			}
+
+			item.Exercises = append(item.Exercises, domain.ImportedExercise{Name: exercise.Name, Notes: exercise.Notes, Sets: sets})
+		}
+
+		workouts = append(workouts, item)
+	}
+
+	mappings := make([]domain.ImportExerciseMapping, 0, len(e.Mappings))
+	for _, mapping := range e.Mappings {
+		item := domain.ImportExerciseMapping{
+			Name:         mapping.Name,
+			ExerciseName: mapping.ExerciseName,
+			Similarity:   mapping.Similarity,
+			Skip:         mapping.Skip,
+			SetCount:     mapping.SetCount,
+		}
+		if mapping.ExerciseID != nil {
+			item.ExerciseID = utils.NewNullable(domain.ID(*mapping.ExerciseID), true)
+		}
+
+		mappings = append(mappings, item)
+	}
+
+	return domain.WorkoutImport{
+		Model: domain.Model{
+			ID:        domain.ID(e.ID.Bytes),
+			CreatedAt: timeFromPgtype(e.CreatedAt),
+			UpdatedAt: timeFromPgtype(e.UpdatedAt),
+		},
+		UserID:    domain.ID(e.UserID.Bytes),
+		Source:    domain.WorkoutImportSource(e.Source),
+		FileID:    domain.ID(e.FileID.Bytes),
+		Status:    domain.WorkoutImportStatus(e.Status),
+		Attempts:  e.Attempts,
+		LastError: e.LastError,
+		RunAfter:  timeFromPgtype(e.RunAfter),
+		Workouts:  workouts,
+		Mappings:  mappings,
+		Created:   e.CreatedCount,
+		Skipped:   e.SkippedCount,
+	}
+}
+
+func importedWorkoutsFromDomain(workouts []domain.ImportedWorkout) []importedWorkoutEntity {
+	result := make([]importedWorkoutEntity, 0, len(workouts))
+	for _, workout := range workouts {
+		item := importedWorkoutEntity{
+			ExternalID: workout.ExternalID,
+			Title:      workout.Title,
+			Notes:      workout.Notes,
+			StartedAt:  workout.StartedAt,
+			FinishedAt: workout.FinishedAt,
+			Exercises:  make([]importedExerciseEntity, 0, len(workout.Exercises)),
+		}
+
+		for _, exercise := range workout.Exercises {
+			sets := make([]importedSetEntity, 0, len(exercise.Sets))
+			for _, set := range exercise.Sets {
+				sets = append(sets, importedSetEntity{Reps: set.Reps, Weight: set.Weight, Time: set.Time})
+			}
+
+			item.Exercises = append(item.Exercises, importedExerciseEntity{Name: exercise.Name, Notes: exercise.Notes, Sets: sets})
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
+func importExerciseMappingsFromDomain(mappings []domain.ImportExerciseMapping) []importExerciseMappingEntity {
+	result := make([]importExerciseMappingEntity, 0, len(mappings))
+	for _, mapping := range mappings {
+		item := importExerciseMappingEntity{
+			Name:         mapping.Name,
+			ExerciseName: mapping.ExerciseName,
+			Similarity:   mapping.Similarity,
+			Skip:         mapping.Skip,
+			SetCount:     mapping.SetCount,
+		}
+		if mapping.ExerciseID.IsValid {
+			id := uuid.UUID(mapping.ExerciseID.V)
+			item.ExerciseID = &id
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
+const workoutImportColumns = `
+	id, user_id, source, file_id, status, attempts, last_error, run_after, workouts, mappings,
+	created_count, skipped_count, created_at, updated_at
+`
+
+// workoutImportSummaryColumns leave out the parsed workouts, which may be large
+const workoutImportSummaryColumns = `
+	id, user_id, source, file_id, status, attempts, last_error, run_after, '[]'::JSONB AS workouts, mappings,
+	created_count, skipped_count, created_at, updated_at
+`
+
+func (r *PGXRepository) CreateWorkoutImport(ctx context.Context, workoutImport domain.WorkoutImport) (domain.WorkoutImport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.CreateWorkoutImport")
+	defer span.Finish()
+
+	query := `
+		INSERT INTO workout_imports (id, user_id, source, file_id, status, run_after, workouts, mappings, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING` + workoutImportColumns
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity workoutImportEntity
+	err := pgxscan.Get(
+		ctx,
+		engine,
+		&entity,
+		query,
+		uuidToPgtype(workoutImport.ID),
+		uuidToPgtype(workoutImport.UserID),
+		workoutImport.Source.String(),
+		uuidToPgtype(workoutImport.FileID),
+		workoutImport.Status.String(),
+		timeToPgtype(workoutImport.RunAfter),
+		importedWorkoutsFromDomain(workoutImport.Workouts),
+		importExerciseMappingsFromDomain(workoutImport.Mappings),
+		timeToPgtype(workoutImport.CreatedAt),
+		timeToPgtype(workoutImport.UpdatedAt),
+	)
+	if err != nil {
+		logger.Errorf("failed to create workout import: %v", err)
+		return domain.WorkoutImport{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+func (r *PGXRepository) GetWorkoutImportByID(ctx context.Context, id domain.ID) (domain.WorkoutImport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetWorkoutImportByID")
+	defer span.Finish()
+
+	query := `SELECT` + workoutImportColumns + `FROM workout_imports WHERE id = $1`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entity workoutImportEntity
+	if err := pgxscan.Get(ctx, engine, &entity, query, uuidToPgtype(id)); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.WorkoutImport{}, domain.ErrNotFound
+		}
+		logger.Errorf("failed to get workout import: %v", err)
+		return domain.WorkoutImport{}, err
+	}
+
+	return entity.toDomain(), nil
+}
+
+// GetWorkoutImports returns imports of the user without their parsed workouts.
+func (r *PGXRepository) GetWorkoutImports(ctx context.Context, userID domain.ID) ([]domain.WorkoutImport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.GetWorkoutImports")
+	defer span.Finish()
+
+	query := `SELECT` + workoutImportSummaryColumns + `FROM workout_imports WHERE user_id = $1 ORDER BY created_at DESC`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entities []workoutImportEntity
+	if err := pgxscan.Select(ctx, engine, &entities, query, uuidToPgtype(userID)); err != nil {
+		logger.Errorf("failed to get workout imports: %v", err)
+		return nil, err
+	}
+
+	result := make([]domain.WorkoutImport, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, entity.toDomain())
+	}
+
+	return result, nil
+}
+
+// ClaimWorkoutImports marks up to limit due imports as running for the lease duration and
+// returns them. Running imports whose lease has expired are claimed again.
+func (r *PGXRepository) ClaimWorkoutImports(ctx context.Context, limit int, lease time.Duration) ([]domain.WorkoutImport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.ClaimWorkoutImports")
+	defer span.Finish()
+
+	query := `
+		UPDATE workout_imports
+		SET status = 'running', attempts = attempts + 1, run_after = NOW() + $2::INTERVAL, updated_at = NOW()
+		WHERE id IN (
+			SELECT id
+			FROM workout_imports
+			WHERE status IN ('pending', 'running') AND run_after <= NOW()
+			ORDER BY run_after
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING` + workoutImportColumns
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	var entities []workoutImportEntity
+	if err := pgxscan.Select(ctx, engine, &entities, query, limit, lease); err != nil {
+		logger.Errorf("failed to claim workout imports: %v", err)
+		return nil, err
+	}
+
+	result := make([]domain.WorkoutImport, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, entity.toDomain())
+	}
+
+	return result, nil
+}
+
+// UpdateWorkoutImport stores the state and mappings of the import, parsed workouts never change.
+func (r *PGXRepository) UpdateWorkoutImport(ctx context.Context, id domain.ID, workoutImport domain.WorkoutImport) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.UpdateWorkoutImport")
+	defer span.Finish()
+
+	query := `
+		UPDATE workout_imports
+		SET status = $2, last_error = $3, run_after = $4, mappings = $5, created_count = $6, skipped_count = $7, updated_at = NOW()
+		WHERE id = $1
+	`
+
+	engine := r.contextManager.GetEngineFromContext(ctx)
+
+	_, err := engine.Exec(
+		ctx,
+		query,
+		uuidToPgtype(id),
+		workoutImport.Status.String(),
+		workoutImport.LastError,
+		timeToPgtype(workoutImport.RunAfter),
+		importExerciseMappingsFromDomain(workoutImport.Mappings),
+		workoutImport.Created,
+		workoutImport.Skipped,
+	)
+	if err != nil {
+		logger.Errorf("failed to update workout import: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (s *Service) GetMuscleGroupVolume(ctx context.Context, userID domain.ID, from, to time.Time) ([]dto.MuscleGroupVolumeDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetMuscleGroupVolume")
+	defer span.Finish()
+
+	if !from.Before(to) {
+		return nil, fmt.Errorf("%w: from must be before to", domain.ErrInvalidArgument)
+	}
+
+	return s.analyticsRepository.GetMuscleGroupVolume(ctx, userID, from, to)
+}
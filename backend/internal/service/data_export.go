@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	dataExportBatchSize   = 2
+	dataExportLease       = 10 * time.Minute
+	dataExportMaxAttempts = 3
+	// dataExportRetention is how long an archive can be downloaded before the storage GC
+	// may delete it
+	dataExportRetention = 7 * 24 * time.Hour
+	dataExportLinkTTL   = time.Hour
+)
+
+// RequestDataExport enqueues an export of all data of the user. An export which is still
+// in progress is returned instead of creating another one.
+func (s *Service) RequestDataExport(ctx context.Context, userID domain.ID) (dto.DataExportDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.RequestDataExport")
+	defer span.Finish()
+
+	exports, err := s.dataExportRepository.GetDataExports(ctx, userID)
+	if err != nil {
+		return dto.DataExportDTO{}, err
+	}
+
+	for _, export := range exports {
+		if export.IsActive() {
+			return dto.DataExportDTO{Export: export}, nil
+		}
+	}
+
+	export, err := s.dataExportRepository.CreateDataExport(ctx, domain.NewDataExport(userID))
+	if err != nil {
+		return dto.DataExportDTO{}, err
+	}
+
+	return dto.DataExportDTO{Export: export}, nil
+}
+
+func (s *Service) GetDataExports(ctx context.Context, userID domain.ID) ([]dto.DataExportDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetDataExports")
+	defer span.Finish()
+
+	exports, err := s.dataExportRepository.GetDataExports(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.DataExportDTO, 0, len(exports))
+	for _, export := range exports {
+		item, err := s.dataExportToDTO(ctx, export)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetDataExport(ctx context.Context, userID, exportID domain.ID) (dto.DataExportDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetDataExport")
+	defer span.Finish()
+
+	export, err := s.dataExportRepository.GetDataExportByID(ctx, exportID)
+	if err != nil {
+		return dto.DataExportDTO{}, err
+	}
+
+	if export.UserID != userID {
+		logger.Errorf("user %s tried to access data export %s", userID, exportID)
+		return dto.DataExportDTO{}, domain.ErrNotFound
+	}
+
+	return s.dataExportToDTO(ctx, export)
+}
+
+// dataExportToDTO attaches a download link to exports whose archive is still kept.
+func (s *Service) dataExportToDTO(ctx context.Context, export domain.DataExport) (dto.DataExportDTO, error) {
+	now := time.Now()
+	if !export.IsDownloadable(now) {
+		return dto.DataExportDTO{Export: export}, nil
+	}
+
+	url, err := s.s3Client.PresignGetObject(ctx, export.Key, min(dataExportLinkTTL, export.ExpiresAt.Sub(now)))
+	if err != nil {
+		logger.Errorf("failed to presign data export %s: %v", export.ID, err)
+		return dto.DataExportDTO{}, domain.ErrInternal
+	}
+
+	return dto.DataExportDTO{Export: export, DownloadURL: url}, nil
+}
+
+// RunDataExports builds requested archives every interval until the context is done.
+func (s *Service) RunDataExports(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			processed, err := s.ProcessDataExports(ctx)
+			if err != nil {
+				logger.Errorf("failed to process data exports: %v", err)
+				break
+			}
+			// A full batch means there may be more due exports
+			if processed < dataExportBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessDataExports builds archives of a batch of due exports and returns the number of
+// processed exports. Failed exports are retried with a backoff.
+func (s *Service) ProcessDataExports(ctx context.Context) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.ProcessDataExports")
+	defer span.Finish()
+
+	exports, err := s.dataExportRepository.ClaimDataExports(ctx, dataExportBatchSize, dataExportLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, export := range exports {
+		if err := s.processDataExport(ctx, &export); err != nil {
+			logger.Errorf("data export %s failed on attempt %d: %v", export.ID, export.Attempts, err)
+
+			export.LastError = err.Error()
+			export.Status = domain.DataExportStatusPending
+			export.RunAfter = time.Now().Add(time.Duration(export.Attempts*export.Attempts) * time.Minute)
+			if export.Attempts >= dataExportMaxAttempts {
+				export.Status = domain.DataExportStatusFailed
+			}
+		} else {
+			export.LastError = ""
+			export.Status = domain.DataExportStatusDone
+		}
+
+		if err := s.dataExportRepository.UpdateDataExport(ctx, export.ID, export); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(exports), nil
+}
+
+func (s *Service) processDataExport(ctx context.Context, export *domain.DataExport) error {
+	archive, err := s.buildDataExportArchive(ctx, export.UserID)
+	if err != nil {
+		return err
+	}
+
+	data, err := writeDataExportZip(archive)
+	if err != nil {
+		return err
+	}
+
+	key := domain.DataExportKey(export.UserID, export.ID)
+	if err := s.s3Client.PutObject(ctx, key, "application/zip", data); err != nil {
+		return err
+	}
+
+	export.Key = key
+	export.Size = int64(len(data))
+	export.ExpiresAt = time.Now().Add(dataExportRetention)
+
+	return nil
+}
@@ -0,0 +1,493 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+)
+
+// dataExportReadme documents the files of the archive and is included in it
+//
+//go:embed data_export_readme.md
+var dataExportReadme []byte
+
+const dataExportWorkoutsPageSize = 100
+
+// buildDataExportArchive collects everything the user has entered into the app.
+func (s *Service) buildDataExportArchive(ctx context.Context, userID domain.ID) (dto.DataExportArchiveDTO, error) {
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return dto.DataExportArchiveDTO{}, err
+	}
+
+	preferences, err := s.GetUserPreferences(ctx, userID)
+	if err != nil {
+		return dto.DataExportArchiveDTO{}, err
+	}
+
+	archive := dto.DataExportArchiveDTO{
+		SchemaVersion: dto.DataExportSchemaVersion,
+		ExportedAt:    time.Now().UTC(),
+		User: dto.DataExportUserDTO{
+			ID:          user.ID.String(),
+			Email:       user.Email,
+			FirstName:   user.FirstName,
+			LastName:    user.LastName,
+			DateOfBirth: exportTime(user.DateOfBirth),
+			Height:      user.Height,
+			Weight:      user.Weight,
+			CreatedAt:   user.CreatedAt,
+		},
+		Preferences: dto.DataExportPreferencesDTO{
+			WeightUnit:     preferences.WeightUnit.String(),
+			LengthUnit:     preferences.LengthUnit.String(),
+			Locale:         preferences.Locale,
+			FirstDayOfWeek: preferences.FirstDayOfWeek.String(),
+			Timezone:       preferences.Timezone,
+		},
+	}
+
+	settings, err := s.generationSettingsRepository.GetGenerationSettings(ctx, userID)
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+	case err != nil:
+		return dto.DataExportArchiveDTO{}, err
+	default:
+		archive.GenerationSettings = &dto.DataExportGenerationSettingsDTO{
+			BasePrompt:   settings.BasePrompt,
+			VarietyLevel: settings.VarietyLevel,
+		}
+	}
+
+	if archive.GymProfiles, err = s.exportGymProfiles(ctx, userID); err != nil {
+		return dto.DataExportArchiveDTO{}, err
+	}
+
+	exerciseNames := make(map[domain.ID]string)
+	exerciseName := func(id domain.ID) (string, error) {
+		if name, ok := exerciseNames[id]; ok {
+			return name, nil
+		}
+
+		exercise, err := s.exerciseRepository.GetExerciseByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		exerciseNames[id] = exercise.Name
+
+		return exercise.Name, nil
+	}
+
+	if archive.Routines, err = s.exportRoutines(ctx, userID, exerciseName); err != nil {
+		return dto.DataExportArchiveDTO{}, err
+	}
+
+	if archive.Workouts, err = s.exportWorkouts(ctx, userID, exerciseName); err != nil {
+		return dto.DataExportArchiveDTO{}, err
+	}
+
+	measurements, err := s.measurementRepository.GetMeasurements(ctx, dto.GetMeasurementsDTO{UserID: userID})
+	if err != nil {
+		return dto.DataExportArchiveDTO{}, err
+	}
+
+	archive.Measurements = make([]dto.DataExportMeasurementDTO, 0, len(measurements))
+	for _, measurement := range measurements {
+		archive.Measurements = append(archive.Measurements, dto.DataExportMeasurementDTO{
+			ID:         measurement.ID.String(),
+			Kind:       measurement.Kind.String(),
+			Value:      measurement.Value,
+			Unit:       measurement.Unit.String(),
+			MeasuredAt: measurement.MeasuredAt,
+			Note:       measurement.Note,
+		})
+	}
+
+	return archive, nil
+}
+
+func (s *Service) exportGymProfiles(ctx context.Context, userID domain.ID) ([]dto.DataExportGymProfileDTO, error) {
+	profiles, err := s.gymProfileRepository.GetGymProfiles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.DataExportGymProfileDTO, 0, len(profiles))
+	for _, profile := range profiles {
+		item := dto.DataExportGymProfileDTO{
+			ID:        profile.ID.String(),
+			Name:      profile.Name,
+			BarWeight: profile.BarWeight,
+			Plates:    make([]dto.DataExportPlateDTO, 0, len(profile.Plates)),
+			IsDefault: profile.IsDefault,
+		}
+		for _, plate := range profile.Plates {
+			item.Plates = append(item.Plates, dto.DataExportPlateDTO{Weight: plate.Weight, Count: plate.Count})
+		}
+
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+func (s *Service) exportRoutines(ctx context.Context, userID domain.ID, exerciseName func(domain.ID) (string, error)) ([]dto.DataExportRoutineDTO, error) {
+	routines, err := s.routineRepository.GetRoutines(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.DataExportRoutineDTO, 0, len(routines))
+	for _, routine := range routines {
+		item := dto.DataExportRoutineDTO{
+			ID:          routine.ID.String(),
+			Name:        routine.Name,
+			Description: routine.Description,
+			WarmupSteps: make([]dto.DataExportWarmupStepDTO, 0, len(routine.WarmupSteps)),
+			CreatedAt:   routine.CreatedAt,
+			UpdatedAt:   routine.UpdatedAt,
+		}
+		for _, step := range routine.WarmupSteps {
+			item.WarmupSteps = append(item.WarmupSteps, dto.DataExportWarmupStepDTO{Percent: step.Percent, Reps: step.Reps})
+		}
+
+		instances, err := s.exerciseInstanceRepository.GetExerciseInstancesByRoutineID(ctx, routine.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		item.Exercises = make([]dto.DataExportRoutineExerciseDTO, 0, len(instances))
+		for _, instance := range instances {
+			name, err := exerciseName(instance.ExerciseID)
+			if err != nil {
+				return nil, err
+			}
+
+			sets, err := s.setRepository.GetSetsByExerciseInstanceID(ctx, instance.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			exercise := dto.DataExportRoutineExerciseDTO{
+				ID:           instance.ID.String(),
+				ExerciseID:   instance.ExerciseID.String(),
+				ExerciseName: name,
+				Group:        exportGroup(instance.Group.V, instance.Group.IsValid),
+				Sets:         make([]dto.DataExportSetDTO, 0, len(sets)),
+			}
+			for _, set := range sets {
+				exercise.Sets = append(exercise.Sets, dto.DataExportSetDTO{
+					ID:       set.ID.String(),
+					Position: set.Position,
+					Type:     string(set.SetType),
+					Reps:     set.Reps,
+					Weight:   set.Weight,
+					Time:     int64(set.Time.Seconds()),
+					Rest:     int64(set.Rest.Seconds()),
+				})
+			}
+
+			item.Exercises = append(item.Exercises, exercise)
+		}
+
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+// exportWorkouts returns finished and active workouts of the user from the oldest one.
+func (s *Service) exportWorkouts(ctx context.Context, userID domain.ID, exerciseName func(domain.ID) (string, error)) ([]dto.DataExportWorkoutDTO, error) {
+	workouts, err := s.workoutRepository.GetActiveWorkouts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for offset := 0; ; offset += dataExportWorkoutsPageSize {
+		page, err := s.workoutRepository.GetWorkouts(ctx, userID, dataExportWorkoutsPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		workouts = append(workouts, page...)
+
+		if len(page) < dataExportWorkoutsPageSize {
+			break
+		}
+	}
+
+	sort.SliceStable(workouts, func(i, j int) bool {
+		return workouts[i].CreatedAt.Before(workouts[j].CreatedAt)
+	})
+
+	result := make([]dto.DataExportWorkoutDTO, 0, len(workouts))
+	for _, workout := range workouts {
+		item := dto.DataExportWorkoutDTO{
+			ID:            workout.ID.String(),
+			StartedAt:     workout.CreatedAt,
+			FinishedAt:    exportTime(workout.FinishedAt),
+			Notes:         workout.Notes,
+			Rating:        workout.Rating,
+			IsAIGenerated: workout.IsAIGenerated,
+			Reasoning:     workout.Reasoning,
+		}
+		if workout.RoutineID.IsValid {
+			item.RoutineID = workout.RoutineID.V.String()
+		}
+		if workout.RoutineVersionID.IsValid {
+			item.RoutineVersionID = workout.RoutineVersionID.V.String()
+		}
+
+		exerciseLogs, err := s.exerciseLogRepository.GetExerciseLogsByWorkoutID(ctx, workout.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		item.Exercises = make([]dto.DataExportExerciseLogDTO, 0, len(exerciseLogs))
+		for _, exerciseLog := range exerciseLogs {
+			exercise, err := s.exportExerciseLog(ctx, exerciseLog, exerciseName)
+			if err != nil {
+				return nil, err
+			}
+			item.Exercises = append(item.Exercises, exercise)
+		}
+
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+func (s *Service) exportExerciseLog(ctx context.Context, exerciseLog domain.ExerciseLog, exerciseName func(domain.ID) (string, error)) (dto.DataExportExerciseLogDTO, error) {
+	name, err := exerciseName(exerciseLog.ExerciseID)
+	if err != nil {
+		return dto.DataExportExerciseLogDTO{}, err
+	}
+
+	expectedSets, err := s.expectedSetRepository.GetExpectedSetsByExerciseLogID(ctx, exerciseLog.ID)
+	if err != nil {
+		return dto.DataExportExerciseLogDTO{}, err
+	}
+
+	setLogs, err := s.setLogRepository.GetSetLogsByExerciseLogID(ctx, exerciseLog.ID)
+	if err != nil {
+		return dto.DataExportExerciseLogDTO{}, err
+	}
+
+	result := dto.DataExportExerciseLogDTO{
+		ID:           exerciseLog.ID.String(),
+		ExerciseID:   exerciseLog.ExerciseID.String(),
+		ExerciseName: name,
+		Position:     exerciseLog.Position,
+		Notes:        exerciseLog.Notes,
+		PowerRating:  exerciseLog.PowerRating,
+		Group:        exportGroup(exerciseLog.Group.V, exerciseLog.Group.IsValid),
+		ExpectedSets: make([]dto.DataExportSetDTO, 0, len(expectedSets)),
+		Sets:         make([]dto.DataExportSetLogDTO, 0, len(setLogs)),
+	}
+
+	for _, set := range expectedSets {
+		result.ExpectedSets = append(result.ExpectedSets, dto.DataExportSetDTO{
+			ID:       set.ID.String(),
+			Position: set.Position,
+			Type:     string(set.SetType),
+			Reps:     set.Reps,
+			Weight:   set.Weight,
+			Time:     int64(set.Time.Seconds()),
+			Rest:     int64(set.Rest.Seconds()),
+			IsWarmup: set.IsWarmup,
+		})
+	}
+
+	for _, setLog := range setLogs {
+		result.Sets = append(result.Sets, dto.DataExportSetLogDTO{
+			ID:          setLog.ID.String(),
+			Position:    setLog.Position,
+			Reps:        setLog.Reps,
+			Weight:      setLog.Weight,
+			Time:        int64(setLog.Time.Seconds()),
+			StartedAt:   exportTime(setLog.StartedAt),
+			CompletedAt: setLog.CompletedAt,
+		})
+	}
+
+	return result, nil
+}
+
+func exportGroup(group domain.ExerciseGroup, ok bool) *dto.DataExportGroupDTO {
+	if !ok {
+		return nil
+	}
+
+	return &dto.DataExportGroupDTO{
+		ID:     group.ID.String(),
+		Type:   group.Type.String(),
+		Rounds: group.Rounds,
+	}
+}
+
+// exportTime returns nil for the zero time so it is exported as null.
+func exportTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+// writeDataExportZip writes the archive as export.json, flat CSVs of the same data and
+// the readme describing them.
+func writeDataExportZip(archive dto.DataExportArchiveDTO) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+
+	document, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	files := []dataExportFile{
+		{name: "README.md", data: dataExportReadme},
+		{name: "export.json", data: document},
+	}
+
+	for _, table := range dataExportTables(archive) {
+		data, err := writeCSV(table.rows)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, dataExportFile{name: table.name, data: data})
+	}
+
+	for _, file := range files {
+		w, err := writer.CreateHeader(&zip.FileHeader{
+			Name:     file.name,
+			Method:   zip.Deflate,
+			Modified: archive.ExportedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := w.Write(file.data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type dataExportFile struct {
+	name string
+	data []byte
+}
+
+type dataExportTable struct {
+	name string
+	// rows start with the header
+	rows [][]string
+}
+
+func dataExportTables(archive dto.DataExportArchiveDTO) []dataExportTable {
+	workouts := [][]string{{"workout_id", "started_at", "finished_at", "routine_id", "rating", "notes"}}
+	sets := [][]string{{
+		"workout_id", "workout_started_at", "exercise_log_id", "exercise_id", "exercise_name", "exercise_position",
+		"set_position", "reps", "weight_kg", "time_seconds", "started_at", "completed_at",
+	}}
+	expectedSets := [][]string{{
+		"workout_id", "exercise_log_id", "exercise_id", "exercise_name", "set_position", "type",
+		"reps", "weight_kg", "time_seconds", "rest_seconds", "is_warmup",
+	}}
+
+	for _, workout := range archive.Workouts {
+		startedAt := csvTime(&workout.StartedAt)
+		workouts = append(workouts, []string{
+			workout.ID, startedAt, csvTime(workout.FinishedAt), workout.RoutineID, strconv.Itoa(workout.Rating), workout.Notes,
+		})
+
+		for _, exercise := range workout.Exercises {
+			for _, set := range exercise.Sets {
+				sets = append(sets, []string{
+					workout.ID, startedAt, exercise.ID, exercise.ExerciseID, exercise.ExerciseName, strconv.Itoa(exercise.Position),
+					strconv.Itoa(set.Position), strconv.Itoa(set.Reps), csvFloat(set.Weight), strconv.FormatInt(set.Time, 10),
+					csvTime(set.StartedAt), csvTime(&set.CompletedAt),
+				})
+			}
+
+			for _, set := range exercise.ExpectedSets {
+				expectedSets = append(expectedSets, []string{
+					workout.ID, exercise.ID, exercise.ExerciseID, exercise.ExerciseName, strconv.Itoa(set.Position), set.Type,
+					strconv.Itoa(set.Reps), csvFloat(set.Weight), strconv.FormatInt(set.Time, 10), strconv.FormatInt(set.Rest, 10),
+					strconv.FormatBool(set.IsWarmup),
+				})
+			}
+		}
+	}
+
+	routines := [][]string{{
+		"routine_id", "routine_name", "exercise_instance_id", "exercise_id", "exercise_name", "set_position", "type",
+		"reps", "weight_kg", "time_seconds", "rest_seconds",
+	}}
+	for _, routine := range archive.Routines {
+		for _, exercise := range routine.Exercises {
+			for _, set := range exercise.Sets {
+				routines = append(routines, []string{
+					routine.ID, routine.Name, exercise.ID, exercise.ExerciseID, exercise.ExerciseName, strconv.Itoa(set.Position), set.Type,
+					strconv.Itoa(set.Reps), csvFloat(set.Weight), strconv.FormatInt(set.Time, 10), strconv.FormatInt(set.Rest, 10),
+				})
+			}
+		}
+	}
+
+	measurements := [][]string{{"measurement_id", "measured_at", "kind", "value", "unit", "note"}}
+	for _, measurement := range archive.Measurements {
+		measurements = append(measurements, []string{
+			measurement.ID, csvTime(&measurement.MeasuredAt), measurement.Kind,
+			strconv.FormatFloat(measurement.Value, 'f', -1, 64), measurement.Unit, measurement.Note,
+		})
+	}
+
+	return []dataExportTable{
+		{name: "workouts.csv", rows: workouts},
+		{name: "sets.csv", rows: sets},
+		{name: "expected_sets.csv", rows: expectedSets},
+		{name: "routines.csv", rows: routines},
+		{name: "measurements.csv", rows: measurements},
+	}
+}
+
+func writeCSV(rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	writer := csv.NewWriter(&buf)
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func csvTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func csvFloat(f float32) string {
+	return strconv.FormatFloat(float64(f), 'f', -1, 32)
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"fitness-trainer/internal/domain/dto"
+)
+
+func TestWriteDataExportZip(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	startedAt := time.Date(2024, 3, 10, 9, 0, 0, 0, moscow)
+
+	archive := dto.DataExportArchiveDTO{
+		SchemaVersion: 1,
+		ExportedAt:    time.Date(2024, 3, 11, 12, 0, 0, 0, time.UTC),
+		Workouts: []dto.DataExportWorkoutDTO{{
+			ID:        "workout-1",
+			StartedAt: startedAt,
+			Notes:     "heavy, but fine",
+			Rating:    4,
+			Exercises: []dto.DataExportExerciseLogDTO{{
+				ID:           "log-1",
+				ExerciseID:   "exercise-1",
+				ExerciseName: "Bench Press",
+				Position:     0,
+				ExpectedSets: []dto.DataExportSetDTO{
+					{Position: 0, Type: "reps", Reps: 5, Weight: 62.5, Rest: 120, IsWarmup: true},
+				},
+				Sets: []dto.DataExportSetLogDTO{
+					{Position: 0, Reps: 5, Weight: 62.5, CompletedAt: startedAt.Add(time.Minute)},
+				},
+			}},
+		}},
+		Measurements: []dto.DataExportMeasurementDTO{
+			{ID: "measurement-1", Kind: "body_weight", Value: 80.25, Unit: "kg", MeasuredAt: startedAt},
+		},
+	}
+
+	data, err := writeDataExportZip(archive)
+	if err != nil {
+		t.Fatalf("writeDataExportZip() error = %v", err)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("zip.NewReader() error = %v", err)
+	}
+
+	files := make(map[string][]byte)
+	var names []string
+	for _, file := range reader.File {
+		f, err := file.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", file.Name, err)
+		}
+		content, err := io.ReadAll(f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("read %s: %v", file.Name, err)
+		}
+
+		names = append(names, file.Name)
+		files[file.Name] = content
+	}
+
+	wantNames := []string{
+		"README.md", "export.json", "workouts.csv", "sets.csv", "expected_sets.csv", "routines.csv", "measurements.csv",
+	}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Fatalf("files = %v, want %v", names, wantNames)
+	}
+
+	var document dto.DataExportArchiveDTO
+	if err := json.Unmarshal(files["export.json"], &document); err != nil {
+		t.Fatalf("export.json: %v", err)
+	}
+	if len(document.Workouts) != 1 || document.Workouts[0].FinishedAt != nil || !document.Workouts[0].StartedAt.Equal(startedAt) {
+		t.Errorf("export.json workouts = %+v, want the unfinished workout started at %s", document.Workouts, startedAt)
+	}
+
+	tests := []struct {
+		file string
+		want [][]string
+	}{
+		{
+			file: "workouts.csv",
+			want: [][]string{
+				{"workout_id", "started_at", "finished_at", "routine_id", "rating", "notes"},
+				{"workout-1", "2024-03-10T06:00:00Z", "", "", "4", "heavy, but fine"},
+			},
+		},
+		{
+			file: "sets.csv",
+			want: [][]string{
+				{
+					"workout_id", "workout_started_at", "exercise_log_id", "exercise_id", "exercise_name", "exercise_position",
+					"set_position", "reps", "weight_kg", "time_seconds", "started_at", "completed_at",
+				},
+				{
+					"workout-1", "2024-03-10T06:00:00Z", "log-1", "exercise-1", "Bench Press", "0",
+					"0", "5", "62.5", "0", "", "2024-03-10T06:01:00Z",
+				},
+			},
+		},
+		{
+			file: "expected_sets.csv",
+			want: [][]string{
+				{
+					"workout_id", "exercise_log_id", "exercise_id", "exercise_name", "set_position", "type",
+					"reps", "weight_kg", "time_seconds", "rest_seconds", "is_warmup",
+				},
+				{"workout-1", "log-1", "exercise-1", "Bench Press", "0", "reps", "5", "62.5", "0", "120", "true"},
+			},
+		},
+		{
+			file: "routines.csv",
+			want: [][]string{{
+				"routine_id", "routine_name", "exercise_instance_id", "exercise_id", "exercise_name", "set_position", "type",
+				"reps", "weight_kg", "time_seconds", "rest_seconds",
+			}},
+		},
+		{
+			file: "measurements.csv",
+			want: [][]string{
+				{"measurement_id", "measured_at", "kind", "value", "unit", "note"},
+				{"measurement-1", "2024-03-10T06:00:00Z", "body_weight", "80.25", "kg", ""},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			rows, err := csv.NewReader(bytes.NewReader(files[tt.file])).ReadAll()
+			if err != nil {
+				t.Fatalf("read csv: %v", err)
+			}
+
+			if !reflect.DeepEqual(rows, tt.want) {
+				t.Errorf("rows = %q, want %q", rows, tt.want)
+			}
+		})
+	}
+}
@@ -4,48 +4,92 @@ import (
 	"context"
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 )
 
-func (s *Service) GetExercises(ctx context.Context, muscleGroups, excludedExercises []domain.ID) ([]domain.Exercise, error) {
+// recentlyUsedExercisesPeriod is how far back logged exercises count as recently used
+const recentlyUsedExercisesPeriod = 30 * 24 * time.Hour
+
+func (s *Service) GetExercises(ctx context.Context, filter dto.GetExercisesDTO) (dto.ExercisesPageDTO, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetExercises")
 	defer span.Finish()
 
-	return s.exerciseRepository.GetExercises(ctx, muscleGroups, excludedExercises)
+	filter.Query = strings.TrimSpace(filter.Query)
+
+	if filter.RecentlyUsed {
+		filter.RecentlyUsedSince = time.Now().Add(-recentlyUsedExercisesPeriod)
+	}
+
+	return s.exerciseRepository.GetExercises(ctx, filter)
 }
 
-func (s *Service) GetExerciseByID(ctx context.Context, id domain.ID) (domain.Exercise, error) {
+func (s *Service) GetExerciseByID(ctx context.Context, userID, id domain.ID) (domain.Exercise, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetExerciseByID")
 	defer span.Finish()
 
-	return s.exerciseRepository.GetExerciseByID(ctx, id)
+	exercise, err := s.exerciseRepository.GetExerciseByID(ctx, id)
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	visible, err := s.isExerciseVisible(ctx, userID, exercise)
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	if !visible {
+		logger.Errorf("user %s tried to access exercise %s", userID, id)
+		return domain.Exercise{}, domain.ErrNotFound
+	}
+
+	return exercise, nil
 }
 
-func (s *Service) GetExerciseAlternatives(ctx context.Context, id domain.ID) ([]domain.Exercise, error) {
+func (s *Service) isExerciseVisible(ctx context.Context, userID domain.ID, exercise domain.Exercise) (bool, error) {
+	if exercise.Visibility == domain.ExerciseVisibilityGlobal || exercise.IsOwnedBy(userID) {
+		return true, nil
+	}
+
+	if exercise.Visibility != domain.ExerciseVisibilityShared {
+		return false, nil
+	}
+
+	return s.exerciseRepository.HasExerciseShare(ctx, exercise.ID, userID)
+}
+
+func (s *Service) GetExerciseAlternatives(ctx context.Context, userID, id domain.ID) ([]domain.Exercise, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetExerciseAlternatives")
 	defer span.Finish()
 
-	exercise, err := s.GetExerciseByID(ctx, id)
+	exercise, err := s.GetExerciseByID(ctx, userID, id)
 	if err != nil {
 		return nil, err
 	}
 
-	ids := make([]domain.ID, 0, len(exercise.TargetMuscleGroups))
-	for _, muscleGroup := range exercise.TargetMuscleGroups {
-		mg, err := s.muscleGroupRepository.GetMuscleGroupByName(ctx, muscleGroup.String())
-		if err != nil {
-			return nil, err
+	ids := make([]domain.ID, 0, len(exercise.MuscleGroups))
+	for _, muscleGroup := range exercise.MuscleGroups {
+		if muscleGroup.Role == domain.MuscleGroupRolePrimary {
+			ids = append(ids, muscleGroup.MuscleGroupID)
 		}
-		ids = append(ids, mg.ID)
 	}
 
-	result, err := s.exerciseRepository.GetExercises(ctx, ids, []domain.ID{id})
+	result, err := s.exerciseRepository.GetExercises(ctx, dto.GetExercisesDTO{
+		UserID:            userID,
+		MuscleGroups:      ids,
+		MuscleGroupRoles:  []domain.MuscleGroupRole{domain.MuscleGroupRolePrimary},
+		ExcludedExercises: []domain.ID{id},
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return result, nil
+	return result.Exercises, nil
 }
 
 func (s *Service) GetExerciseHistory(ctx context.Context, userID, exerciseID domain.ID, offset, limit int) ([]dto.ExerciseLogDTO, error) {
@@ -74,16 +118,38 @@ func (s *Service) CreateExercise(ctx context.Context, exerciseDTO dto.CreateExer
 	span, ctx := opentracing.StartSpanFromContext(ctx, "service.CreateExercise")
 	defer span.Finish()
 
+	muscleGroups, err := exerciseMuscleGroupsFromDTO(exerciseDTO.TargetMuscleGroups, exerciseDTO.MuscleGroups)
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
 	exercise := domain.NewExercise(
 		exerciseDTO.Name,
 		exerciseDTO.Description.V,
 		exerciseDTO.VideoURL.V,
 		[]domain.MuscleGroup{},
 	)
+	exercise.MuscleGroups = muscleGroups
+	exercise.Aliases = normalizeExerciseAliases(exerciseDTO.Aliases)
+	exercise.OwnerID = utils.NewNullable(exerciseDTO.OwnerID, true)
+	if exerciseDTO.Equipment != domain.EquipmentUnknown {
+		exercise.Equipment = exerciseDTO.Equipment
+	}
+	exercise.Visibility = domain.ExerciseVisibilityPrivate
 
-	err := s.unitOfWork.InTransaction(ctx, func(ctx context.Context) (err error) {
-		exercise, err = s.exerciseRepository.CreateExercise(ctx, exercise, exerciseDTO.TargetMuscleGroups)
-		return err
+	if exerciseDTO.Visibility != domain.ExerciseVisibilityUnknown {
+		if err := s.applyExerciseVisibility(ctx, exerciseDTO.OwnerID, &exercise, exerciseDTO.Visibility); err != nil {
+			return domain.Exercise{}, err
+		}
+	}
+
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) (err error) {
+		exercise, err = s.exerciseRepository.CreateExercise(ctx, exercise)
+		if err != nil {
+			return err
+		}
+
+		return s.recordExerciseChange(ctx, exerciseDTO.OwnerID, domain.ExerciseAuditActionCreated, domain.Exercise{}, exercise)
 	})
 	if err != nil {
 		return domain.Exercise{}, err
@@ -91,3 +157,65 @@ func (s *Service) CreateExercise(ctx context.Context, exerciseDTO dto.CreateExer
 
 	return exercise, nil
 }
+
+// exerciseMuscleGroupsFromDTO merges plain target muscle groups, which are treated as
+// primary ones, with explicitly described muscle groups. An exercise must have at least
+// one primary muscle group.
+func exerciseMuscleGroupsFromDTO(targetMuscleGroups []domain.ID, muscleGroups []dto.ExerciseMuscleGroupDTO) ([]domain.ExerciseMuscleGroup, error) {
+	result := make([]domain.ExerciseMuscleGroup, 0, len(targetMuscleGroups)+len(muscleGroups))
+	seen := make(map[domain.ID]int)
+
+	add := func(muscleGroup domain.ExerciseMuscleGroup) {
+		if i, ok := seen[muscleGroup.MuscleGroupID]; ok {
+			result[i] = muscleGroup
+			return
+		}
+		seen[muscleGroup.MuscleGroupID] = len(result)
+		result = append(result, muscleGroup)
+	}
+
+	for _, id := range targetMuscleGroups {
+		add(domain.NewExerciseMuscleGroup(id, domain.MuscleGroupRolePrimary, 0))
+	}
+
+	for _, mg := range muscleGroups {
+		if mg.Role == domain.MuscleGroupRoleUnknown {
+			return nil, fmt.Errorf("%w: muscle group %s has no role", domain.ErrInvalidArgument, mg.MuscleGroupID)
+		}
+		if mg.Activation.IsValid && (mg.Activation.V <= 0 || mg.Activation.V > 1) {
+			return nil, fmt.Errorf("%w: activation must be in (0, 1]", domain.ErrInvalidArgument)
+		}
+		add(domain.NewExerciseMuscleGroup(mg.MuscleGroupID, mg.Role, mg.Activation.V))
+	}
+
+	for _, mg := range result {
+		if mg.Role == domain.MuscleGroupRolePrimary {
+			return result, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: exercise must have at least one primary muscle group", domain.ErrInvalidArgument)
+}
+
+// normalizeExerciseAliases trims aliases, lowercases their language codes and drops
+// empty and duplicate ones.
+func normalizeExerciseAliases(aliases []domain.ExerciseAlias) []domain.ExerciseAlias {
+	result := make([]domain.ExerciseAlias, 0, len(aliases))
+	seen := make(map[domain.ExerciseAlias]struct{}, len(aliases))
+	for _, alias := range aliases {
+		alias.Language = strings.ToLower(strings.TrimSpace(alias.Language))
+		alias.Alias = strings.TrimSpace(alias.Alias)
+		if alias.Language == "" || alias.Alias == "" {
+			continue
+		}
+
+		if _, ok := seen[alias]; ok {
+			continue
+		}
+		seen[alias] = struct{}{}
+
+		result = append(result, alias)
+	}
+
+	return result
+}
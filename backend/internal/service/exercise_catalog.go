@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// checkCanManageExercise allows owners to manage their custom exercises and admins
+// to manage any exercise including the global catalog.
+func (s *Service) checkCanManageExercise(ctx context.Context, userID domain.ID, exercise domain.Exercise) error {
+	if exercise.Visibility != domain.ExerciseVisibilityGlobal && exercise.IsOwnedBy(userID) {
+		return nil
+	}
+
+	if err := s.checkAdmin(ctx, userID); err != nil {
+		logger.Errorf("user %s tried to manage exercise %s", userID, exercise.ID)
+		if exercise.Visibility == domain.ExerciseVisibilityGlobal {
+			return domain.ErrForbidden
+		}
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *Service) recordExerciseChange(ctx context.Context, userID domain.ID, action domain.ExerciseAuditAction, before, after domain.Exercise) error {
+	entry := domain.NewExerciseAuditEntry(after.ID, userID, action, domain.DiffExercises(before, after))
+	return s.exerciseRepository.CreateExerciseAuditEntry(ctx, entry)
+}
+
+// saveExerciseChange stores the updated exercise and records the change in the audit trail.
+// Users who added a shared exercise lose access to it once it stops being shared.
+func (s *Service) saveExerciseChange(ctx context.Context, userID domain.ID, action domain.ExerciseAuditAction, before, after domain.Exercise) (domain.Exercise, error) {
+	err := s.unitOfWork.InTransaction(ctx, func(ctx context.Context) (err error) {
+		after, err = s.exerciseRepository.UpdateExercise(ctx, after.ID, after)
+		if err != nil {
+			return err
+		}
+
+		if before.Visibility == domain.ExerciseVisibilityShared && after.Visibility != domain.ExerciseVisibilityShared {
+			if err := s.exerciseRepository.DeleteExerciseShares(ctx, after.ID); err != nil {
+				return err
+			}
+		}
+
+		return s.recordExerciseChange(ctx, userID, action, before, after)
+	})
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	return after, nil
+}
+
+func (s *Service) UpdateExercise(ctx context.Context, userID, exerciseID domain.ID, exerciseDTO dto.UpdateExerciseDTO) (domain.Exercise, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.UpdateExercise")
+	defer span.Finish()
+
+	exercise, err := s.exerciseRepository.GetExerciseByID(ctx, exerciseID)
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	if err := s.checkCanManageExercise(ctx, userID, exercise); err != nil {
+		return domain.Exercise{}, err
+	}
+
+	updated := exercise
+	{
+		if exerciseDTO.Name.IsValid {
+			updated.Name = exerciseDTO.Name.V
+		}
+
+		if exerciseDTO.Description.IsValid {
+			updated.Description = exerciseDTO.Description.V
+		}
+
+		if exerciseDTO.VideoURL.IsValid {
+			updated.VideoURL = exerciseDTO.VideoURL.V
+		}
+
+		if exerciseDTO.Equipment != domain.EquipmentUnknown {
+			updated.Equipment = exerciseDTO.Equipment
+		}
+	}
+
+	replaceAliases := len(exerciseDTO.Aliases) > 0
+	if replaceAliases {
+		updated.Aliases = normalizeExerciseAliases(exerciseDTO.Aliases)
+	}
+
+	replaceMuscleGroups := len(exerciseDTO.TargetMuscleGroups) > 0 || len(exerciseDTO.MuscleGroups) > 0
+	if replaceMuscleGroups {
+		updated.MuscleGroups, err = exerciseMuscleGroupsFromDTO(exerciseDTO.TargetMuscleGroups, exerciseDTO.MuscleGroups)
+		if err != nil {
+			return domain.Exercise{}, err
+		}
+	}
+
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		if replaceMuscleGroups {
+			if err := s.exerciseRepository.ReplaceExerciseMuscleGroups(ctx, exerciseID, updated.MuscleGroups); err != nil {
+				return err
+			}
+		}
+
+		if replaceAliases {
+			if err := s.exerciseRepository.ReplaceExerciseAliases(ctx, exerciseID, updated.Aliases); err != nil {
+				return err
+			}
+		}
+
+		updated, err = s.exerciseRepository.UpdateExercise(ctx, exerciseID, updated)
+		if err != nil {
+			return err
+		}
+
+		return s.recordExerciseChange(ctx, userID, domain.ExerciseAuditActionUpdated, exercise, updated)
+	})
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	return updated, nil
+}
+
+// ArchiveExercise hides the exercise from pickers and generation. Workouts and routines
+// which already use it are kept as is.
+func (s *Service) ArchiveExercise(ctx context.Context, userID, exerciseID domain.ID) (domain.Exercise, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.ArchiveExercise")
+	defer span.Finish()
+
+	exercise, err := s.exerciseRepository.GetExerciseByID(ctx, exerciseID)
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	if err := s.checkCanManageExercise(ctx, userID, exercise); err != nil {
+		return domain.Exercise{}, err
+	}
+
+	if exercise.IsArchived() {
+		return exercise, nil
+	}
+
+	updated := exercise
+	updated.ArchivedAt = time.Now()
+
+	return s.saveExerciseChange(ctx, userID, domain.ExerciseAuditActionArchived, exercise, updated)
+}
+
+func (s *Service) RestoreExercise(ctx context.Context, userID, exerciseID domain.ID) (domain.Exercise, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.RestoreExercise")
+	defer span.Finish()
+
+	exercise, err := s.exerciseRepository.GetExerciseByID(ctx, exerciseID)
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	if err := s.checkCanManageExercise(ctx, userID, exercise); err != nil {
+		return domain.Exercise{}, err
+	}
+
+	if !exercise.IsArchived() {
+		return exercise, nil
+	}
+
+	if exercise.MergedIntoID.IsValid {
+		return domain.Exercise{}, fmt.Errorf("%w: exercise %s was merged into %s", domain.ErrInvalidArgument, exerciseID, exercise.MergedIntoID.V)
+	}
+
+	updated := exercise
+	updated.ArchivedAt = time.Time{}
+
+	return s.saveExerciseChange(ctx, userID, domain.ExerciseAuditActionRestored, exercise, updated)
+}
+
+// MergeExercises moves all exercise logs and routine exercise instances of the duplicate
+// to the canonical exercise and archives the duplicate. A merge into an exercise which is
+// not global is refused while other users still use the duplicate, as it would be archived
+// for them too.
+func (s *Service) MergeExercises(ctx context.Context, userID, canonicalID, duplicateID domain.ID) (domain.Exercise, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.MergeExercises")
+	defer span.Finish()
+
+	if canonicalID == duplicateID {
+		return domain.Exercise{}, fmt.Errorf("%w: cannot merge exercise into itself", domain.ErrInvalidArgument)
+	}
+
+	canonical, err := s.exerciseRepository.GetExerciseByID(ctx, canonicalID)
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	duplicate, err := s.exerciseRepository.GetExerciseByID(ctx, duplicateID)
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	if err := s.checkCanManageExercise(ctx, userID, duplicate); err != nil {
+		return domain.Exercise{}, err
+	}
+
+	visible, err := s.isExerciseVisible(ctx, userID, canonical)
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	if !visible {
+		logger.Errorf("user %s tried to merge exercise %s into exercise %s", userID, duplicateID, canonicalID)
+		return domain.Exercise{}, domain.ErrNotFound
+	}
+
+	if canonical.IsArchived() {
+		return domain.Exercise{}, fmt.Errorf("%w: exercise %s is archived", domain.ErrInvalidArgument, canonicalID)
+	}
+
+	// Other users may reference the duplicate, only a global exercise is visible to all of
+	// them, so merging into anything else rewrites the caller's workouts and routines only
+	scope := utils.NewNullable(userID, canonical.Visibility != domain.ExerciseVisibilityGlobal)
+
+	updated := duplicate
+	updated.ArchivedAt = time.Now()
+	updated.MergedIntoID = utils.NewNullable(canonicalID, true)
+
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		routineIDs, err := s.exerciseRepository.ReassignExerciseReferences(ctx, duplicateID, canonicalID, scope)
+		if err != nil {
+			return err
+		}
+
+		if scope.IsValid {
+			referenced, err := s.exerciseRepository.HasExerciseReferences(ctx, duplicateID)
+			if err != nil {
+				return err
+			}
+
+			if referenced {
+				return fmt.Errorf("%w: exercise %s is used by other users, it can only be merged into a global exercise", domain.ErrInvalidArgument, duplicateID)
+			}
+		}
+
+		for _, routineID := range routineIDs {
+			if _, err := s.recordRoutineVersion(ctx, routineID); err != nil {
+				return err
+			}
+		}
+
+		if _, err := s.exerciseRepository.UpdateExercise(ctx, duplicateID, updated); err != nil {
+			return err
+		}
+
+		if err := s.recordExerciseChange(ctx, userID, domain.ExerciseAuditActionMerged, duplicate, updated); err != nil {
+			return err
+		}
+
+		entry := domain.NewExerciseAuditEntry(canonicalID, userID, domain.ExerciseAuditActionMerged, map[string]domain.FieldChange{
+			"merged_from_id": {To: duplicateID.String()},
+		})
+		return s.exerciseRepository.CreateExerciseAuditEntry(ctx, entry)
+	})
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	return s.exerciseRepository.GetExerciseByID(ctx, canonicalID)
+}
+
+func (s *Service) GetExerciseAuditLog(ctx context.Context, userID, exerciseID domain.ID, offset, limit int) ([]domain.ExerciseAuditEntry, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetExerciseAuditLog")
+	defer span.Finish()
+
+	exercise, err := s.exerciseRepository.GetExerciseByID(ctx, exerciseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exercise.IsOwnedBy(userID) {
+		if err := s.checkAdmin(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.exerciseRepository.GetExerciseAuditLog(ctx, exerciseID, offset, limit)
+}
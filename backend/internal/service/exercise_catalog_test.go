@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/utils"
+)
+
+// fakeUnitOfWork runs the function without a transaction.
+type fakeUnitOfWork struct {
+	unitOfWork
+}
+
+func (fakeUnitOfWork) InTransaction(ctx context.Context, f func(ctx context.Context) error) error {
+	return f(ctx)
+}
+
+// fakeExerciseRepository keeps exercises in memory, references of exercises to other users
+// are left by a scoped reassignment.
+type fakeExerciseRepository struct {
+	exerciseRepository
+
+	exercises map[domain.ID]domain.Exercise
+	// otherUsersReference is whether other users still use the reassigned exercise
+	otherUsersReference bool
+
+	reassignScope utils.Nullable[domain.ID]
+	reassigned    bool
+
+	sharesDeleted bool
+	// shared are the exercises shared with the user
+	shared map[domain.ID]bool
+}
+
+func (r *fakeExerciseRepository) GetExerciseByID(_ context.Context, id domain.ID) (domain.Exercise, error) {
+	exercise, ok := r.exercises[id]
+	if !ok {
+		return domain.Exercise{}, domain.ErrNotFound
+	}
+
+	return exercise, nil
+}
+
+func (r *fakeExerciseRepository) UpdateExercise(_ context.Context, id domain.ID, exercise domain.Exercise) (domain.Exercise, error) {
+	r.exercises[id] = exercise
+	return exercise, nil
+}
+
+func (r *fakeExerciseRepository) ReassignExerciseReferences(_ context.Context, _, _ domain.ID, userID utils.Nullable[domain.ID]) ([]domain.ID, error) {
+	r.reassigned = true
+	r.reassignScope = userID
+	return nil, nil
+}
+
+func (r *fakeExerciseRepository) HasExerciseReferences(_ context.Context, _ domain.ID) (bool, error) {
+	return r.reassigned && r.reassignScope.IsValid && r.otherUsersReference, nil
+}
+
+func (r *fakeExerciseRepository) CreateExercise(_ context.Context, exercise domain.Exercise) (domain.Exercise, error) {
+	r.exercises[exercise.ID] = exercise
+	return exercise, nil
+}
+
+func (r *fakeExerciseRepository) HasExerciseShare(_ context.Context, exerciseID, _ domain.ID) (bool, error) {
+	return r.shared[exerciseID], nil
+}
+
+func (r *fakeExerciseRepository) CreateExerciseShare(_ context.Context, exerciseID, _ domain.ID) error {
+	if r.shared == nil {
+		r.shared = make(map[domain.ID]bool)
+	}
+	r.shared[exerciseID] = true
+	return nil
+}
+
+func (r *fakeExerciseRepository) DeleteExerciseShares(_ context.Context, _ domain.ID) error {
+	r.sharesDeleted = true
+	return nil
+}
+
+func (r *fakeExerciseRepository) CreateExerciseAuditEntry(_ context.Context, _ domain.ExerciseAuditEntry) error {
+	return nil
+}
+
+func TestMergeExercises(t *testing.T) {
+	userID := domain.NewID()
+
+	tests := []struct {
+		name                string
+		canonicalVisibility domain.ExerciseVisibility
+		otherUsersReference bool
+		wantScoped          bool
+		wantErr             error
+	}{
+		{
+			name:                "into a global exercise",
+			canonicalVisibility: domain.ExerciseVisibilityGlobal,
+			otherUsersReference: true,
+		},
+		{
+			name:                "into an own exercise used by the caller only",
+			canonicalVisibility: domain.ExerciseVisibilityPrivate,
+			wantScoped:          true,
+		},
+		{
+			name:                "into an own exercise while other users use the duplicate",
+			canonicalVisibility: domain.ExerciseVisibilityPrivate,
+			otherUsersReference: true,
+			wantScoped:          true,
+			wantErr:             domain.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canonical := domain.NewExercise("Bench Press", "", "", nil)
+			canonical.Visibility = tt.canonicalVisibility
+			if tt.canonicalVisibility != domain.ExerciseVisibilityGlobal {
+				canonical.OwnerID = utils.NewNullable(userID, true)
+			}
+
+			duplicate := domain.NewExercise("Bench", "", "", nil)
+			duplicate.Visibility = domain.ExerciseVisibilityShared
+			duplicate.OwnerID = utils.NewNullable(userID, true)
+
+			repository := &fakeExerciseRepository{
+				exercises: map[domain.ID]domain.Exercise{
+					canonical.ID: canonical,
+					duplicate.ID: duplicate,
+				},
+				otherUsersReference: tt.otherUsersReference,
+			}
+			s := &Service{unitOfWork: fakeUnitOfWork{}, exerciseRepository: repository}
+
+			_, err := s.MergeExercises(context.Background(), userID, canonical.ID, duplicate.ID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+
+			if repository.reassignScope.IsValid != tt.wantScoped {
+				t.Errorf("reassignment scoped = %v, want %v", repository.reassignScope.IsValid, tt.wantScoped)
+			}
+			if tt.wantScoped && repository.reassignScope.V != userID {
+				t.Errorf("reassignment scope = %s, want %s", repository.reassignScope.V, userID)
+			}
+
+			merged := repository.exercises[duplicate.ID]
+			if tt.wantErr != nil {
+				if merged.IsArchived() || merged.MergedIntoID.IsValid {
+					t.Errorf("duplicate was archived although the merge was refused")
+				}
+				return
+			}
+
+			if !merged.IsArchived() || merged.MergedIntoID != utils.NewNullable(canonical.ID, true) {
+				t.Errorf("duplicate archived = %v, merged into %v, want merged into %s", merged.IsArchived(), merged.MergedIntoID, canonical.ID)
+			}
+		})
+	}
+}
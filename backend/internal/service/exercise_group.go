@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// GroupExerciseInstances puts exercise instances of the routine into a new group, members
+// keep their order in the routine.
+func (s *Service) GroupExerciseInstances(ctx context.Context, userID, routineID domain.ID, input dto.GroupExercisesDTO) ([]domain.ExerciseInstance, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GroupExerciseInstances")
+	defer span.Finish()
+
+	routine, err := s.routineRepository.GetRoutineByID(ctx, routineID)
+	if err != nil {
+		return nil, err
+	}
+
+	if routine.UserID != userID {
+		logger.Errorf("user %s tried to access routine %s", userID, routineID)
+		return nil, domain.ErrNotFound
+	}
+
+	group := domain.NewExerciseGroup(input.Type, input.Rounds)
+	if err := group.Validate(len(input.IDs)); err != nil {
+		return nil, err
+	}
+
+	instances, err := s.exerciseInstanceRepository.GetExerciseInstancesByRoutineID(ctx, routineID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := pickGroupMembers(instances, input.IDs, func(instance domain.ExerciseInstance) (domain.ID, bool) {
+		return instance.ID, instance.Group.IsValid
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.editRoutine(ctx, routineID, func(ctx context.Context) error {
+		return s.exerciseInstanceRepository.SetExerciseInstancesGroup(ctx, routineID, input.IDs, utils.NewNullable(group, true))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range members {
+		members[i].Group = utils.NewNullable(group, true)
+	}
+
+	return members, nil
+}
+
+func (s *Service) UngroupExerciseInstances(ctx context.Context, userID, routineID, groupID domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.UngroupExerciseInstances")
+	defer span.Finish()
+
+	routine, err := s.routineRepository.GetRoutineByID(ctx, routineID)
+	if err != nil {
+		return err
+	}
+
+	if routine.UserID != userID {
+		logger.Errorf("user %s tried to access routine %s", userID, routineID)
+		return domain.ErrNotFound
+	}
+
+	instances, err := s.exerciseInstanceRepository.GetExerciseInstancesByRoutineID(ctx, routineID)
+	if err != nil {
+		return err
+	}
+
+	ids := groupMemberIDs(instances, groupID, func(instance domain.ExerciseInstance) (domain.ID, utils.Nullable[domain.ExerciseGroup]) {
+		return instance.ID, instance.Group
+	})
+	if len(ids) == 0 {
+		return domain.ErrNotFound
+	}
+
+	return s.editRoutine(ctx, routineID, func(ctx context.Context) error {
+		return s.exerciseInstanceRepository.SetExerciseInstancesGroup(ctx, routineID, ids, utils.Nullable[domain.ExerciseGroup]{})
+	})
+}
+
+// releaseExerciseInstanceGroup ungroups the remaining members of a group which is no longer
+// valid after one of its exercise instances was removed.
+func (s *Service) releaseExerciseInstanceGroup(ctx context.Context, routineID domain.ID, group domain.ExerciseGroup) error {
+	instances, err := s.exerciseInstanceRepository.GetExerciseInstancesByRoutineID(ctx, routineID)
+	if err != nil {
+		return err
+	}
+
+	ids := groupMemberIDs(instances, group.ID, func(instance domain.ExerciseInstance) (domain.ID, utils.Nullable[domain.ExerciseGroup]) {
+		return instance.ID, instance.Group
+	})
+	if len(ids) == 0 || group.Validate(len(ids)) == nil {
+		return nil
+	}
+
+	return s.exerciseInstanceRepository.SetExerciseInstancesGroup(ctx, routineID, ids, utils.Nullable[domain.ExerciseGroup]{})
+}
+
+// GroupExerciseLogs puts exercise logs of an active workout into a new group, members keep
+// their order in the workout.
+func (s *Service) GroupExerciseLogs(ctx context.Context, userID, workoutID domain.ID, input dto.GroupExercisesDTO) ([]domain.ExerciseLog, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GroupExerciseLogs")
+	defer span.Finish()
+
+	workout, err := s.workoutRepository.GetWorkoutByID(ctx, workoutID)
+	if err != nil {
+		return nil, err
+	}
+
+	if workout.UserID != userID {
+		logger.Errorf("user %s tried to access workout %s", userID, workoutID)
+		return nil, domain.ErrNotFound
+	}
+
+	if !workout.FinishedAt.IsZero() {
+		return nil, fmt.Errorf("%w: workout %s is already finished", domain.ErrInvalidArgument, workoutID)
+	}
+
+	group := domain.NewExerciseGroup(input.Type, input.Rounds)
+	if err := group.Validate(len(input.IDs)); err != nil {
+		return nil, err
+	}
+
+	exerciseLogs, err := s.exerciseLogRepository.GetExerciseLogsByWorkoutID(ctx, workoutID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := pickGroupMembers(exerciseLogs, input.IDs, func(exerciseLog domain.ExerciseLog) (domain.ID, bool) {
+		return exerciseLog.ID, exerciseLog.Group.IsValid
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.exerciseLogRepository.SetExerciseLogsGroup(ctx, workoutID, input.IDs, utils.NewNullable(group, true))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range members {
+		members[i].Group = utils.NewNullable(group, true)
+	}
+
+	return members, nil
+}
+
+func (s *Service) UngroupExerciseLogs(ctx context.Context, userID, workoutID, groupID domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.UngroupExerciseLogs")
+	defer span.Finish()
+
+	workout, err := s.workoutRepository.GetWorkoutByID(ctx, workoutID)
+	if err != nil {
+		return err
+	}
+
+	if workout.UserID != userID {
+		logger.Errorf("user %s tried to access workout %s", userID, workoutID)
+		return domain.ErrNotFound
+	}
+
+	if !workout.FinishedAt.IsZero() {
+		return fmt.Errorf("%w: workout %s is already finished", domain.ErrInvalidArgument, workoutID)
+	}
+
+	exerciseLogs, err := s.exerciseLogRepository.GetExerciseLogsByWorkoutID(ctx, workoutID)
+	if err != nil {
+		return err
+	}
+
+	ids := groupMemberIDs(exerciseLogs, groupID, func(exerciseLog domain.ExerciseLog) (domain.ID, utils.Nullable[domain.ExerciseGroup]) {
+		return exerciseLog.ID, exerciseLog.Group
+	})
+	if len(ids) == 0 {
+		return domain.ErrNotFound
+	}
+
+	return s.exerciseLogRepository.SetExerciseLogsGroup(ctx, workoutID, ids, utils.Nullable[domain.ExerciseGroup]{})
+}
+
+// releaseExerciseLogGroup ungroups the remaining members of a group which is no longer
+// valid after one of its exercise logs was deleted.
+func (s *Service) releaseExerciseLogGroup(ctx context.Context, workoutID domain.ID, group domain.ExerciseGroup) error {
+	exerciseLogs, err := s.exerciseLogRepository.GetExerciseLogsByWorkoutID(ctx, workoutID)
+	if err != nil {
+		return err
+	}
+
+	ids := groupMemberIDs(exerciseLogs, group.ID, func(exerciseLog domain.ExerciseLog) (domain.ID, utils.Nullable[domain.ExerciseGroup]) {
+		return exerciseLog.ID, exerciseLog.Group
+	})
+	if len(ids) == 0 || group.Validate(len(ids)) == nil {
+		return nil
+	}
+
+	return s.exerciseLogRepository.SetExerciseLogsGroup(ctx, workoutID, ids, utils.Nullable[domain.ExerciseGroup]{})
+}
+
+// pickGroupMembers returns the items with the given IDs in their original order, none of
+// them may already be in a group.
+func pickGroupMembers[T any](items []T, ids []domain.ID, key func(T) (domain.ID, bool)) ([]T, error) {
+	wanted := make(map[domain.ID]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := wanted[id]; ok {
+			return nil, fmt.Errorf("%w: duplicate exercise %s in group", domain.ErrInvalidArgument, id)
+		}
+		wanted[id] = struct{}{}
+	}
+
+	members := make([]T, 0, len(ids))
+	for _, item := range items {
+		id, grouped := key(item)
+		if _, ok := wanted[id]; !ok {
+			continue
+		}
+
+		if grouped {
+			return nil, fmt.Errorf("%w: exercise %s is already in a group", domain.ErrInvalidArgument, id)
+		}
+		members = append(members, item)
+	}
+
+	if len(members) != len(ids) {
+		return nil, domain.ErrNotFound
+	}
+
+	return members, nil
+}
+
+func groupMemberIDs[T any](items []T, groupID domain.ID, key func(T) (domain.ID, utils.Nullable[domain.ExerciseGroup])) []domain.ID {
+	var ids []domain.ID
+	for _, item := range items {
+		id, group := key(item)
+		if group.IsValid && group.V.ID == groupID {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
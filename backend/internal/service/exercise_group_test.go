@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/utils"
+)
+
+func TestPickGroupMembers(t *testing.T) {
+	type item struct {
+		id      domain.ID
+		grouped bool
+	}
+
+	a, b, c := item{id: domain.NewID()}, item{id: domain.NewID()}, item{id: domain.NewID()}
+	grouped := item{id: domain.NewID(), grouped: true}
+	items := []item{a, b, grouped, c}
+
+	tests := []struct {
+		name    string
+		ids     []domain.ID
+		want    []item
+		wantErr error
+	}{
+		{
+			name: "members keep their order",
+			ids:  []domain.ID{c.id, a.id},
+			want: []item{a, c},
+		},
+		{
+			name:    "duplicate member",
+			ids:     []domain.ID{a.id, a.id},
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name:    "member already in a group",
+			ids:     []domain.ID{a.id, grouped.id},
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name:    "unknown member",
+			ids:     []domain.ID{a.id, domain.NewID()},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pickGroupMembers(items, tt.ids, func(i item) (domain.ID, bool) {
+				return i.id, i.grouped
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d members, want %d", len(got), len(tt.want))
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("member %d = %v, want %v", i, got[i].id, tt.want[i].id)
+				}
+			}
+		})
+	}
+}
+
+func TestReleaseExerciseLogGroup(t *testing.T) {
+	tests := []struct {
+		name      string
+		groupType domain.ExerciseGroupType
+		// left is the number of members left after one was deleted
+		left         int
+		wantReleased bool
+	}{
+		{
+			name:         "superset left with one exercise",
+			groupType:    domain.ExerciseGroupTypeSuperset,
+			left:         1,
+			wantReleased: true,
+		},
+		{
+			name:         "giant set left with two exercises",
+			groupType:    domain.ExerciseGroupTypeGiantSet,
+			left:         2,
+			wantReleased: true,
+		},
+		{
+			name:      "giant set left with three exercises",
+			groupType: domain.ExerciseGroupTypeGiantSet,
+			left:      3,
+		},
+		{
+			name:      "circuit left with two exercises",
+			groupType: domain.ExerciseGroupTypeCircuit,
+			left:      2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workoutID := domain.NewID()
+			group := domain.NewExerciseGroup(tt.groupType, 3)
+
+			// An exercise log of another group must never be touched
+			other := domain.NewExerciseLog(workoutID, domain.NewID())
+			other.Group = utils.NewNullable(domain.NewExerciseGroup(domain.ExerciseGroupTypeSuperset, 3), true)
+
+			repository := &fakeExerciseLogRepository{exerciseLogs: []domain.ExerciseLog{other}}
+			for range tt.left {
+				exerciseLog := domain.NewExerciseLog(workoutID, domain.NewID())
+				exerciseLog.Group = utils.NewNullable(group, true)
+				repository.exerciseLogs = append(repository.exerciseLogs, exerciseLog)
+			}
+			s := &Service{exerciseLogRepository: repository}
+
+			if err := s.releaseExerciseLogGroup(context.Background(), workoutID, group); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !repository.exerciseLogs[0].Group.IsValid {
+				t.Errorf("exercise log of another group was released")
+			}
+			for _, exerciseLog := range repository.exerciseLogs[1:] {
+				if exerciseLog.Group.IsValid == tt.wantReleased {
+					t.Errorf("exercise log grouped = %v, want released = %v", exerciseLog.Group.IsValid, tt.wantReleased)
+				}
+			}
+		})
+	}
+}
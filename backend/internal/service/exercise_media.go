@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// getManagedExerciseMedia returns the media of the exercise if the user can manage the exercise.
+func (s *Service) getManagedExerciseMedia(ctx context.Context, userID, exerciseID, mediaID domain.ID) (domain.ExerciseMedia, error) {
+	exercise, err := s.exerciseRepository.GetExerciseByID(ctx, exerciseID)
+	if err != nil {
+		return domain.ExerciseMedia{}, err
+	}
+
+	if err := s.checkCanManageExercise(ctx, userID, exercise); err != nil {
+		return domain.ExerciseMedia{}, err
+	}
+
+	media, err := s.exerciseMediaRepository.GetExerciseMediaByID(ctx, mediaID)
+	if err != nil {
+		return domain.ExerciseMedia{}, err
+	}
+
+	if media.ExerciseID != exerciseID {
+		logger.Errorf("user %s tried to access media %s of exercise %s", userID, mediaID, media.ExerciseID)
+		return domain.ExerciseMedia{}, domain.ErrNotFound
+	}
+
+	return media, nil
+}
+
+func (s *Service) recordExerciseMediaChange(ctx context.Context, userID, exerciseID domain.ID, action domain.ExerciseAuditAction, changes map[string]domain.FieldChange) error {
+	entry := domain.NewExerciseAuditEntry(exerciseID, userID, action, changes)
+	return s.exerciseRepository.CreateExerciseAuditEntry(ctx, entry)
+}
+
+// CreateExerciseMediaUpload registers a pending media file and returns a presigned URL to
+// upload a file of exactly the given size to. The media is attached to the exercise once the
+// upload is confirmed.
+func (s *Service) CreateExerciseMediaUpload(ctx context.Context, userID, exerciseID domain.ID, contentType string, size int64, caption string) (domain.ExerciseMedia, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.CreateExerciseMediaUpload")
+	defer span.Finish()
+
+	exercise, err := s.exerciseRepository.GetExerciseByID(ctx, exerciseID)
+	if err != nil {
+		return domain.ExerciseMedia{}, "", err
+	}
+
+	if err := s.checkCanManageExercise(ctx, userID, exercise); err != nil {
+		return domain.ExerciseMedia{}, "", err
+	}
+
+	if exercise.IsArchived() {
+		return domain.ExerciseMedia{}, "", fmt.Errorf("%w: exercise %s is archived", domain.ErrInvalidArgument, exerciseID)
+	}
+
+	kind, extension, err := domain.ExerciseMediaKindFromContentType(contentType)
+	if err != nil {
+		return domain.ExerciseMedia{}, "", err
+	}
+
+	if size <= 0 || size > kind.MaxSize() {
+		return domain.ExerciseMedia{}, "", fmt.Errorf("%w: file size must be between 1 and %d bytes", domain.ErrInvalidArgument, kind.MaxSize())
+	}
+
+	media := domain.NewExerciseMedia(exerciseID, userID, kind, "", contentType, size, strings.TrimSpace(caption))
+	// Media of shared exercises is uploaded by different users, keys are namespaced by them
+	media.Key = fmt.Sprintf("exercises/%s/media/%s/%s%s", exerciseID, userID, media.ID, extension)
+
+	uploadURL, err := s.s3Client.PresignPutObject(ctx, media.Key, contentType, size)
+	if err != nil {
+		logger.Errorf("failed to presign upload of exercise media: %v", err)
+		return domain.ExerciseMedia{}, "", err
+	}
+
+	media, err = s.exerciseMediaRepository.CreateExerciseMedia(ctx, media)
+	if err != nil {
+		return domain.ExerciseMedia{}, "", err
+	}
+
+	return media, uploadURL, nil
+}
+
+// ConfirmExerciseMedia checks that the media file has been uploaded with the declared content type
+// and size and attaches it to the exercise.
+func (s *Service) ConfirmExerciseMedia(ctx context.Context, userID, exerciseID, mediaID domain.ID) (domain.ExerciseMedia, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.ConfirmExerciseMedia")
+	defer span.Finish()
+
+	media, err := s.getManagedExerciseMedia(ctx, userID, exerciseID, mediaID)
+	if err != nil {
+		return domain.ExerciseMedia{}, err
+	}
+
+	if media.Status == domain.ExerciseMediaStatusReady {
+		return media, nil
+	}
+
+	contentType, size, err := s.s3Client.HeadObject(ctx, media.Key)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ExerciseMedia{}, fmt.Errorf("%w: media %s has not been uploaded", domain.ErrInvalidArgument, mediaID)
+		}
+		logger.Errorf("failed to check uploaded exercise media %s: %v", mediaID, err)
+		return domain.ExerciseMedia{}, err
+	}
+
+	if contentType != media.ContentType || size != media.Size || size > media.Kind.MaxSize() {
+		if err := s.s3Client.DeleteObject(ctx, media.Key); err != nil {
+			logger.Errorf("failed to delete rejected exercise media %s: %v", mediaID, err)
+		}
+		return domain.ExerciseMedia{}, fmt.Errorf("%w: uploaded file does not match the declared content type and size", domain.ErrInvalidArgument)
+	}
+
+	media.Status = domain.ExerciseMediaStatusReady
+	media.URL = s.s3Client.ObjectURL(media.Key)
+
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) (err error) {
+		media, err = s.exerciseMediaRepository.UpdateExerciseMedia(ctx, mediaID, media)
+		if err != nil {
+			return err
+		}
+
+		if media.Kind == domain.ExerciseMediaKindImage || media.Kind == domain.ExerciseMediaKindGIF {
+			if err := s.enqueueImageProcessing(ctx, domain.ImageJobSourceExerciseMedia, media.ID, media.Key); err != nil {
+				return err
+			}
+		}
+
+		return s.recordExerciseMediaChange(ctx, userID, exerciseID, domain.ExerciseAuditActionMediaAdded, map[string]domain.FieldChange{
+			"media": {To: mediaID.String()},
+		})
+	})
+	if err != nil {
+		return domain.ExerciseMedia{}, err
+	}
+
+	return media, nil
+}
+
+func (s *Service) UpdateExerciseMediaCaption(ctx context.Context, userID, exerciseID, mediaID domain.ID, caption string) (domain.ExerciseMedia, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.UpdateExerciseMediaCaption")
+	defer span.Finish()
+
+	media, err := s.getManagedExerciseMedia(ctx, userID, exerciseID, mediaID)
+	if err != nil {
+		return domain.ExerciseMedia{}, err
+	}
+
+	before := media.Caption
+	media.Caption = strings.TrimSpace(caption)
+	if media.Caption == before {
+		return media, nil
+	}
+
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) (err error) {
+		media, err = s.exerciseMediaRepository.UpdateExerciseMedia(ctx, mediaID, media)
+		if err != nil {
+			return err
+		}
+
+		return s.recordExerciseMediaChange(ctx, userID, exerciseID, domain.ExerciseAuditActionMediaUpdated, map[string]domain.FieldChange{
+			"media." + mediaID.String() + ".caption": {From: before, To: media.Caption},
+		})
+	})
+	if err != nil {
+		return domain.ExerciseMedia{}, err
+	}
+
+	return media, nil
+}
+
+func (s *Service) DeleteExerciseMedia(ctx context.Context, userID, exerciseID, mediaID domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.DeleteExerciseMedia")
+	defer span.Finish()
+
+	media, err := s.getManagedExerciseMedia(ctx, userID, exerciseID, mediaID)
+	if err != nil {
+		return err
+	}
+
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		if err := s.exerciseMediaRepository.DeleteExerciseMedia(ctx, mediaID); err != nil {
+			return err
+		}
+
+		if media.Status != domain.ExerciseMediaStatusReady {
+			return nil
+		}
+
+		return s.recordExerciseMediaChange(ctx, userID, exerciseID, domain.ExerciseAuditActionMediaRemoved, map[string]domain.FieldChange{
+			"media": {From: mediaID.String()},
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.s3Client.DeleteObject(ctx, media.Key); err != nil {
+		logger.Errorf("failed to delete exercise media %s from storage: %v", mediaID, err)
+	}
+
+	return nil
+}
+
+func (s *Service) SetExerciseMediaOrder(ctx context.Context, userID, exerciseID domain.ID, mediaIDs []domain.ID) (domain.Exercise, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.SetExerciseMediaOrder")
+	defer span.Finish()
+
+	exercise, err := s.exerciseRepository.GetExerciseByID(ctx, exerciseID)
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	if err := s.checkCanManageExercise(ctx, userID, exercise); err != nil {
+		return domain.Exercise{}, err
+	}
+
+	current := make(map[domain.ID]struct{}, len(exercise.Media))
+	before := make([]string, 0, len(exercise.Media))
+	for _, media := range exercise.Media {
+		current[media.ID] = struct{}{}
+		before = append(before, media.ID.String())
+	}
+
+	after := make([]string, 0, len(mediaIDs))
+	seen := make(map[domain.ID]struct{}, len(mediaIDs))
+	for _, id := range mediaIDs {
+		if _, ok := current[id]; !ok {
+			return domain.Exercise{}, fmt.Errorf("%w: media %s does not belong to exercise %s", domain.ErrInvalidArgument, id, exerciseID)
+		}
+		if _, ok := seen[id]; ok {
+			return domain.Exercise{}, fmt.Errorf("%w: media %s is listed twice", domain.ErrInvalidArgument, id)
+		}
+		seen[id] = struct{}{}
+		after = append(after, id.String())
+	}
+
+	if len(mediaIDs) != len(exercise.Media) {
+		return domain.Exercise{}, fmt.Errorf("%w: order must list all media of the exercise", domain.ErrInvalidArgument)
+	}
+
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		if err := s.exerciseMediaRepository.SetExerciseMediaOrder(ctx, exerciseID, mediaIDs); err != nil {
+			return err
+		}
+
+		return s.recordExerciseMediaChange(ctx, userID, exerciseID, domain.ExerciseAuditActionMediaUpdated, map[string]domain.FieldChange{
+			"media_order": {From: strings.Join(before, ","), To: strings.Join(after, ",")},
+		})
+	})
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	return s.exerciseRepository.GetExerciseByID(ctx, exerciseID)
+}
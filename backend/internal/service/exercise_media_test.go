@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/utils"
+)
+
+// fakeS3Client holds a single uploaded object.
+type fakeS3Client struct {
+	s3Client
+
+	contentType string
+	size        int64
+	deleted     bool
+
+	presignedKey string
+}
+
+func (c *fakeS3Client) PresignPutObject(_ context.Context, key, _ string, _ int64) (string, error) {
+	c.presignedKey = key
+	return "https://storage/upload/" + key, nil
+}
+
+func (c *fakeS3Client) HeadObject(_ context.Context, _ string) (string, int64, error) {
+	return c.contentType, c.size, nil
+}
+
+func (c *fakeS3Client) DeleteObject(_ context.Context, _ string) error {
+	c.deleted = true
+	return nil
+}
+
+func (c *fakeS3Client) ObjectURL(key string) string {
+	return "https://storage/" + key
+}
+
+type fakeExerciseMediaRepository struct {
+	exerciseMediaRepository
+
+	media domain.ExerciseMedia
+}
+
+func (r *fakeExerciseMediaRepository) GetExerciseMediaByID(_ context.Context, _ domain.ID) (domain.ExerciseMedia, error) {
+	return r.media, nil
+}
+
+func (r *fakeExerciseMediaRepository) UpdateExerciseMedia(_ context.Context, _ domain.ID, media domain.ExerciseMedia) (domain.ExerciseMedia, error) {
+	r.media = media
+	return media, nil
+}
+
+func TestConfirmExerciseMedia(t *testing.T) {
+	userID := domain.NewID()
+
+	tests := []struct {
+		name        string
+		contentType string
+		size        int64
+		wantErr     error
+	}{
+		{
+			name:        "declared size",
+			contentType: "video/mp4",
+			size:        1024,
+		},
+		{
+			name:        "smaller than declared",
+			contentType: "video/mp4",
+			size:        512,
+			wantErr:     domain.ErrInvalidArgument,
+		},
+		{
+			name:        "larger than declared",
+			contentType: "video/mp4",
+			size:        2048,
+			wantErr:     domain.ErrInvalidArgument,
+		},
+		{
+			name:        "other content type",
+			contentType: "video/webm",
+			size:        1024,
+			wantErr:     domain.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exercise := domain.NewExercise("Bench Press", "", "", nil)
+			exercise.OwnerID = utils.NewNullable(userID, true)
+			exercise.Visibility = domain.ExerciseVisibilityPrivate
+
+			media := domain.NewExerciseMedia(exercise.ID, userID, domain.ExerciseMediaKindVideo, "exercises/media.mp4", "video/mp4", 1024, "")
+
+			storage := &fakeS3Client{contentType: tt.contentType, size: tt.size}
+			mediaRepository := &fakeExerciseMediaRepository{media: media}
+			s := &Service{
+				unitOfWork:              fakeUnitOfWork{},
+				s3Client:                storage,
+				exerciseRepository:      &fakeExerciseRepository{exercises: map[domain.ID]domain.Exercise{exercise.ID: exercise}},
+				exerciseMediaRepository: mediaRepository,
+			}
+
+			confirmed, err := s.ConfirmExerciseMedia(context.Background(), userID, exercise.ID, media.ID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				if !storage.deleted {
+					t.Errorf("rejected upload was not deleted")
+				}
+				if mediaRepository.media.Status != domain.ExerciseMediaStatusPending {
+					t.Errorf("status = %s, want %s", mediaRepository.media.Status, domain.ExerciseMediaStatusPending)
+				}
+				return
+			}
+
+			if confirmed.Status != domain.ExerciseMediaStatusReady || confirmed.Size != tt.size {
+				t.Errorf("status = %s, size = %d, want %s of %d bytes", confirmed.Status, confirmed.Size, domain.ExerciseMediaStatusReady, tt.size)
+			}
+		})
+	}
+}
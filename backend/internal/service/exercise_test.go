@@ -0,0 +1,108 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/utils"
+)
+
+func TestExerciseMuscleGroupsFromDTO(t *testing.T) {
+	chest, triceps, core := domain.NewID(), domain.NewID(), domain.NewID()
+
+	tests := []struct {
+		name               string
+		targetMuscleGroups []domain.ID
+		muscleGroups       []dto.ExerciseMuscleGroupDTO
+		want               []domain.ExerciseMuscleGroup
+		wantErr            error
+	}{
+		{
+			name:               "target muscle groups are primary",
+			targetMuscleGroups: []domain.ID{chest},
+			want: []domain.ExerciseMuscleGroup{
+				{MuscleGroupID: chest, Role: domain.MuscleGroupRolePrimary, Activation: 1},
+			},
+		},
+		{
+			name: "roles get their default activation",
+			muscleGroups: []dto.ExerciseMuscleGroupDTO{
+				{MuscleGroupID: chest, Role: domain.MuscleGroupRolePrimary},
+				{MuscleGroupID: triceps, Role: domain.MuscleGroupRoleSecondary},
+				{MuscleGroupID: core, Role: domain.MuscleGroupRoleStabilizer},
+			},
+			want: []domain.ExerciseMuscleGroup{
+				{MuscleGroupID: chest, Role: domain.MuscleGroupRolePrimary, Activation: 1},
+				{MuscleGroupID: triceps, Role: domain.MuscleGroupRoleSecondary, Activation: 0.5},
+				{MuscleGroupID: core, Role: domain.MuscleGroupRoleStabilizer, Activation: 0.25},
+			},
+		},
+		{
+			name:               "described muscle group overrides a target one in place",
+			targetMuscleGroups: []domain.ID{chest, triceps},
+			muscleGroups: []dto.ExerciseMuscleGroupDTO{
+				{MuscleGroupID: triceps, Role: domain.MuscleGroupRoleSecondary, Activation: utils.NewNullable[float32](0.4, true)},
+			},
+			want: []domain.ExerciseMuscleGroup{
+				{MuscleGroupID: chest, Role: domain.MuscleGroupRolePrimary, Activation: 1},
+				{MuscleGroupID: triceps, Role: domain.MuscleGroupRoleSecondary, Activation: 0.4},
+			},
+		},
+		{
+			name: "no primary muscle group",
+			muscleGroups: []dto.ExerciseMuscleGroupDTO{
+				{MuscleGroupID: triceps, Role: domain.MuscleGroupRoleSecondary},
+			},
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name:               "primary muscle group demoted",
+			targetMuscleGroups: []domain.ID{chest},
+			muscleGroups: []dto.ExerciseMuscleGroupDTO{
+				{MuscleGroupID: chest, Role: domain.MuscleGroupRoleStabilizer},
+			},
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name: "no role",
+			muscleGroups: []dto.ExerciseMuscleGroupDTO{
+				{MuscleGroupID: chest},
+			},
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name: "zero activation",
+			muscleGroups: []dto.ExerciseMuscleGroupDTO{
+				{MuscleGroupID: chest, Role: domain.MuscleGroupRolePrimary, Activation: utils.NewNullable[float32](0, true)},
+			},
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name: "activation above one",
+			muscleGroups: []dto.ExerciseMuscleGroupDTO{
+				{MuscleGroupID: chest, Role: domain.MuscleGroupRolePrimary, Activation: utils.NewNullable[float32](1.5, true)},
+			},
+			wantErr: domain.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := exerciseMuscleGroupsFromDTO(tt.targetMuscleGroups, tt.muscleGroups)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d muscle groups, want %d", len(got), len(tt.want))
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("muscle group %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
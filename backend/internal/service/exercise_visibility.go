@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+const exerciseShareTokenBytes = 24
+
+// applyExerciseVisibility changes visibility of the exercise on behalf of the user.
+// Only admins can put exercises into the global catalog directly, other users have
+// to request a promotion.
+func (s *Service) applyExerciseVisibility(ctx context.Context, userID domain.ID, exercise *domain.Exercise, visibility domain.ExerciseVisibility) error {
+	if visibility == domain.ExerciseVisibilityGlobal || exercise.Visibility == domain.ExerciseVisibilityGlobal {
+		if err := s.checkAdmin(ctx, userID); err != nil {
+			return err
+		}
+	}
+
+	switch visibility {
+	case domain.ExerciseVisibilityShared:
+		if exercise.ShareToken == "" {
+			token, err := utils.GenerateToken(exerciseShareTokenBytes)
+			if err != nil {
+				logger.Errorf("failed to generate share token: %v", err)
+				return domain.ErrInternal
+			}
+			exercise.ShareToken = token
+		}
+	case domain.ExerciseVisibilityPrivate, domain.ExerciseVisibilityGlobal:
+		exercise.ShareToken = ""
+	default:
+		return fmt.Errorf("%w: unknown visibility %q", domain.ErrInvalidArgument, visibility)
+	}
+
+	exercise.Visibility = visibility
+
+	return nil
+}
+
+func (s *Service) SetExerciseVisibility(ctx context.Context, userID, exerciseID domain.ID, visibility domain.ExerciseVisibility) (domain.Exercise, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.SetExerciseVisibility")
+	defer span.Finish()
+
+	exercise, err := s.exerciseRepository.GetExerciseByID(ctx, exerciseID)
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	if err := s.checkCanManageExercise(ctx, userID, exercise); err != nil {
+		return domain.Exercise{}, err
+	}
+
+	updated := exercise
+	if err := s.applyExerciseVisibility(ctx, userID, &updated, visibility); err != nil {
+		return domain.Exercise{}, err
+	}
+
+	return s.saveExerciseChange(ctx, userID, domain.ExerciseAuditActionVisibilityChanged, exercise, updated)
+}
+
+// AddSharedExercise gives the user access to a shared exercise by its share link token.
+func (s *Service) AddSharedExercise(ctx context.Context, userID domain.ID, token string) (domain.Exercise, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.AddSharedExercise")
+	defer span.Finish()
+
+	exercise, err := s.exerciseRepository.GetExerciseByShareToken(ctx, token)
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	if exercise.Visibility != domain.ExerciseVisibilityShared {
+		return domain.Exercise{}, domain.ErrNotFound
+	}
+
+	if exercise.IsOwnedBy(userID) {
+		return exercise, nil
+	}
+
+	if err := s.exerciseRepository.CreateExerciseShare(ctx, exercise.ID, userID); err != nil {
+		return domain.Exercise{}, err
+	}
+
+	return exercise, nil
+}
+
+// RequestExercisePromotion puts a custom exercise of the user into the moderation queue
+// to be promoted into the global catalog.
+func (s *Service) RequestExercisePromotion(ctx context.Context, userID, exerciseID domain.ID) (domain.Exercise, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.RequestExercisePromotion")
+	defer span.Finish()
+
+	exercise, err := s.exerciseRepository.GetExerciseByID(ctx, exerciseID)
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	if !exercise.IsOwnedBy(userID) {
+		logger.Errorf("user %s tried to request promotion of exercise %s", userID, exerciseID)
+		return domain.Exercise{}, domain.ErrNotFound
+	}
+
+	if exercise.Visibility == domain.ExerciseVisibilityGlobal {
+		return domain.Exercise{}, fmt.Errorf("%w: exercise %s is already global", domain.ErrInvalidArgument, exerciseID)
+	}
+
+	if exercise.ModerationStatus == domain.ModerationStatusPending {
+		return exercise, nil
+	}
+
+	updated := exercise
+	updated.ModerationStatus = domain.ModerationStatusPending
+	updated.ModerationComment = ""
+
+	return s.saveExerciseChange(ctx, userID, domain.ExerciseAuditActionPromotionRequested, exercise, updated)
+}
+
+func (s *Service) GetExercisesForModeration(ctx context.Context, userID domain.ID) ([]domain.Exercise, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetExercisesForModeration")
+	defer span.Finish()
+
+	if err := s.checkAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return s.exerciseRepository.GetExercisesByModerationStatus(ctx, domain.ModerationStatusPending)
+}
+
+// ModerateExercise resolves a promotion request. Approved exercises become global and
+// keep their owner as the author.
+func (s *Service) ModerateExercise(ctx context.Context, userID, exerciseID domain.ID, approve bool, comment string) (domain.Exercise, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.ModerateExercise")
+	defer span.Finish()
+
+	if err := s.checkAdmin(ctx, userID); err != nil {
+		return domain.Exercise{}, err
+	}
+
+	exercise, err := s.exerciseRepository.GetExerciseByID(ctx, exerciseID)
+	if err != nil {
+		return domain.Exercise{}, err
+	}
+
+	if exercise.ModerationStatus != domain.ModerationStatusPending {
+		return domain.Exercise{}, fmt.Errorf("%w: exercise %s is not waiting for moderation", domain.ErrInvalidArgument, exerciseID)
+	}
+
+	updated := exercise
+	updated.ModerationComment = comment
+	if approve {
+		updated.ModerationStatus = domain.ModerationStatusApproved
+		updated.Visibility = domain.ExerciseVisibilityGlobal
+		updated.ShareToken = ""
+	} else {
+		updated.ModerationStatus = domain.ModerationStatusRejected
+	}
+
+	return s.saveExerciseChange(ctx, userID, domain.ExerciseAuditActionModerated, exercise, updated)
+}
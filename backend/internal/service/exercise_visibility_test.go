@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/utils"
+)
+
+func TestSetExerciseVisibility(t *testing.T) {
+	userID := domain.NewID()
+
+	tests := []struct {
+		name              string
+		from              domain.ExerciseVisibility
+		to                domain.ExerciseVisibility
+		wantSharesDeleted bool
+		wantShareToken    bool
+	}{
+		{
+			name:              "shared to private",
+			from:              domain.ExerciseVisibilityShared,
+			to:                domain.ExerciseVisibilityPrivate,
+			wantSharesDeleted: true,
+		},
+		{
+			name:           "private to shared",
+			from:           domain.ExerciseVisibilityPrivate,
+			to:             domain.ExerciseVisibilityShared,
+			wantShareToken: true,
+		},
+		{
+			name:           "shared stays shared",
+			from:           domain.ExerciseVisibilityShared,
+			to:             domain.ExerciseVisibilityShared,
+			wantShareToken: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exercise := domain.NewExercise("Bench Press", "", "", nil)
+			exercise.OwnerID = utils.NewNullable(userID, true)
+			exercise.Visibility = tt.from
+			if tt.from == domain.ExerciseVisibilityShared {
+				exercise.ShareToken = "token"
+			}
+
+			repository := &fakeExerciseRepository{
+				exercises: map[domain.ID]domain.Exercise{exercise.ID: exercise},
+			}
+			s := &Service{unitOfWork: fakeUnitOfWork{}, exerciseRepository: repository}
+
+			updated, err := s.SetExerciseVisibility(context.Background(), userID, exercise.ID, tt.to)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if updated.Visibility != tt.to {
+				t.Errorf("visibility = %s, want %s", updated.Visibility, tt.to)
+			}
+			if repository.sharesDeleted != tt.wantSharesDeleted {
+				t.Errorf("shares deleted = %v, want %v", repository.sharesDeleted, tt.wantSharesDeleted)
+			}
+			if (updated.ShareToken != "") != tt.wantShareToken {
+				t.Errorf("share token = %q, want set = %v", updated.ShareToken, tt.wantShareToken)
+			}
+		})
+	}
+}
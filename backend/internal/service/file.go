@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// PresignUpload registers a pending file and returns a presigned URL to upload it to.
+// The key is generated under the user's prefix, and the URL only accepts an object of
+// the declared content type and size.
+func (s *Service) PresignUpload(ctx context.Context, userID domain.ID, input dto.PresignUploadDTO) (domain.File, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.PresignUpload")
+	defer span.Finish()
+
+	extension, err := input.Purpose.FileExtension(input.ContentType)
+	if err != nil {
+		return domain.File{}, "", err
+	}
+
+	if input.Size <= 0 || input.Size > input.Purpose.MaxSize() {
+		return domain.File{}, "", fmt.Errorf("%w: file size must be between 1 and %d bytes", domain.ErrInvalidArgument, input.Purpose.MaxSize())
+	}
+
+	file := domain.NewFile(userID, input.Purpose, "", input.Filename, input.ContentType, input.Size)
+	file.Key = fmt.Sprintf("users/%s/%s/%s%s", userID, input.Purpose, file.ID, extension)
+	if input.Purpose.IsPrivate() {
+		// The prefix is never publicly readable, even if the rest of the bucket is
+		file.Key = "private/" + file.Key
+	}
+	file.URL = s.s3Client.ObjectURL(file.Key)
+
+	uploadURL, err := s.s3Client.PresignPutObject(ctx, file.Key, file.ContentType, file.Size)
+	if err != nil {
+		logger.Errorf("failed to presign upload of file %s: %v", file.ID, err)
+		return domain.File{}, "", err
+	}
+
+	file, err = s.fileRepository.CreateFile(ctx, file)
+	if err != nil {
+		return domain.File{}, "", err
+	}
+
+	file, err = s.signFile(ctx, file)
+	if err != nil {
+		return domain.File{}, "", err
+	}
+
+	return file, uploadURL, nil
+}
+
+// ConfirmUpload checks that the object has been uploaded as declared and marks the file
+// as confirmed, so it can be referenced from other entities.
+func (s *Service) ConfirmUpload(ctx context.Context, userID, fileID domain.ID) (domain.File, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.ConfirmUpload")
+	defer span.Finish()
+
+	file, err := s.fileRepository.GetFileByID(ctx, fileID)
+	if err != nil {
+		return domain.File{}, err
+	}
+
+	if file.UserID != userID {
+		logger.Errorf("user %s tried to confirm file %s of user %s", userID, fileID, file.UserID)
+		return domain.File{}, domain.ErrNotFound
+	}
+
+	if file.IsConfirmed() {
+		return s.signFile(ctx, file)
+	}
+
+	contentType, size, err := s.s3Client.HeadObject(ctx, file.Key)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.File{}, fmt.Errorf("%w: file %s has not been uploaded", domain.ErrInvalidArgument, fileID)
+		}
+		logger.Errorf("failed to check uploaded file %s: %v", fileID, err)
+		return domain.File{}, err
+	}
+
+	if contentType != file.ContentType || size != file.Size || size > file.Purpose.MaxSize() {
+		if err := s.s3Client.DeleteObject(ctx, file.Key); err != nil {
+			logger.Errorf("failed to delete rejected file %s: %v", fileID, err)
+		}
+		return domain.File{}, fmt.Errorf("%w: uploaded file does not match the declared content type and size", domain.ErrInvalidArgument)
+	}
+
+	file.Status = domain.FileStatusConfirmed
+	file.ConfirmedAt = time.Now()
+
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) (err error) {
+		file, err = s.fileRepository.UpdateFile(ctx, fileID, file)
+		if err != nil {
+			return err
+		}
+
+		if !file.Purpose.IsImage() {
+			return nil
+		}
+
+		return s.enqueueImageProcessing(ctx, domain.ImageJobSourceFile, file.ID, file.Key)
+	})
+	if err != nil {
+		return domain.File{}, err
+	}
+
+	return s.signFile(ctx, file)
+}
+
+// getConfirmedFile returns a confirmed file of the user uploaded for the purpose.
+func (s *Service) getConfirmedFile(ctx context.Context, userID, fileID domain.ID, purpose domain.FilePurpose) (domain.File, error) {
+	file, err := s.fileRepository.GetFileByID(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.File{}, fmt.Errorf("%w: file %s not found", domain.ErrInvalidArgument, fileID)
+		}
+		return domain.File{}, err
+	}
+
+	if file.UserID != userID {
+		logger.Errorf("user %s tried to use file %s of user %s", userID, fileID, file.UserID)
+		return domain.File{}, fmt.Errorf("%w: file %s not found", domain.ErrInvalidArgument, fileID)
+	}
+
+	if !file.IsConfirmed() {
+		return domain.File{}, fmt.Errorf("%w: upload of file %s is not confirmed", domain.ErrInvalidArgument, fileID)
+	}
+
+	if file.Purpose != purpose {
+		return domain.File{}, fmt.Errorf("%w: file %s was not uploaded as %s", domain.ErrInvalidArgument, fileID, purpose)
+	}
+
+	return file, nil
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+)
+
+type fakeFileRepository struct {
+	fileRepository
+
+	file domain.File
+}
+
+func (r *fakeFileRepository) CreateFile(_ context.Context, file domain.File) (domain.File, error) {
+	r.file = file
+	return file, nil
+}
+
+func (r *fakeFileRepository) GetFileByID(_ context.Context, id domain.ID) (domain.File, error) {
+	if r.file.ID != id {
+		return domain.File{}, domain.ErrNotFound
+	}
+
+	return r.file, nil
+}
+
+type fakeURLSigner struct{}
+
+func (fakeURLSigner) SignURL(_ context.Context, rawURL string) (string, error) {
+	return rawURL + "?signature", nil
+}
+
+func TestPresignUpload(t *testing.T) {
+	userID := domain.NewID()
+
+	tests := []struct {
+		name       string
+		input      dto.PresignUploadDTO
+		wantPrefix string
+		wantSigned bool
+		wantErr    error
+	}{
+		{
+			name: "profile picture",
+			input: dto.PresignUploadDTO{
+				Purpose:     domain.FilePurposeProfilePicture,
+				Filename:    "../../avatar.png",
+				ContentType: "image/png",
+				Size:        1024,
+			},
+			wantPrefix: "users/" + userID.String() + "/profile_picture/",
+		},
+		{
+			name: "progress photo is stored privately",
+			input: dto.PresignUploadDTO{
+				Purpose:     domain.FilePurposeProgressPhoto,
+				Filename:    "front.jpg",
+				ContentType: "image/jpeg",
+				Size:        1024,
+			},
+			wantPrefix: "private/users/" + userID.String() + "/progress_photo/",
+			wantSigned: true,
+		},
+		{
+			name: "content type not allowed for the purpose",
+			input: dto.PresignUploadDTO{
+				Purpose:     domain.FilePurposeProfilePicture,
+				Filename:    "avatar.csv",
+				ContentType: "text/csv",
+				Size:        1024,
+			},
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name: "empty file",
+			input: dto.PresignUploadDTO{
+				Purpose:     domain.FilePurposeProfilePicture,
+				Filename:    "avatar.png",
+				ContentType: "image/png",
+			},
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name: "larger than allowed for the purpose",
+			input: dto.PresignUploadDTO{
+				Purpose:     domain.FilePurposeProfilePicture,
+				Filename:    "avatar.png",
+				ContentType: "image/png",
+				Size:        domain.FilePurposeProfilePicture.MaxSize() + 1,
+			},
+			wantErr: domain.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &fakeS3Client{}
+			s := &Service{
+				s3Client:       storage,
+				urlSigner:      fakeURLSigner{},
+				fileRepository: &fakeFileRepository{},
+			}
+
+			file, _, err := s.PresignUpload(context.Background(), userID, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			// The key never contains the client supplied name
+			if !strings.HasPrefix(file.Key, tt.wantPrefix) || strings.Contains(file.Key, "..") || storage.presignedKey != file.Key {
+				t.Errorf("key = %q, presigned %q, want under %q", file.Key, storage.presignedKey, tt.wantPrefix)
+			}
+			if file.Status != domain.FileStatusPending || file.Size != tt.input.Size {
+				t.Errorf("status = %s, size = %d, want pending of %d bytes", file.Status, file.Size, tt.input.Size)
+			}
+			if strings.HasSuffix(file.URL, "?signature") != tt.wantSigned {
+				t.Errorf("url = %q, want signed = %v", file.URL, tt.wantSigned)
+			}
+		})
+	}
+}
+
+func TestGetConfirmedFile(t *testing.T) {
+	userID := domain.NewID()
+
+	confirmed := func(file domain.File) domain.File {
+		file.Status = domain.FileStatusConfirmed
+		return file
+	}
+
+	tests := []struct {
+		name    string
+		file    domain.File
+		purpose domain.FilePurpose
+		wantErr error
+	}{
+		{
+			name:    "confirmed file of the user",
+			file:    confirmed(domain.NewFile(userID, domain.FilePurposeProfilePicture, "key", "avatar.png", "image/png", 1024)),
+			purpose: domain.FilePurposeProfilePicture,
+		},
+		{
+			name:    "file of another user",
+			file:    confirmed(domain.NewFile(domain.NewID(), domain.FilePurposeProfilePicture, "key", "avatar.png", "image/png", 1024)),
+			purpose: domain.FilePurposeProfilePicture,
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name:    "upload not confirmed",
+			file:    domain.NewFile(userID, domain.FilePurposeProfilePicture, "key", "avatar.png", "image/png", 1024),
+			purpose: domain.FilePurposeProfilePicture,
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name:    "uploaded for another purpose",
+			file:    confirmed(domain.NewFile(userID, domain.FilePurposeProgressPhoto, "key", "front.jpg", "image/jpeg", 1024)),
+			purpose: domain.FilePurposeProfilePicture,
+			wantErr: domain.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{fileRepository: &fakeFileRepository{file: tt.file}}
+
+			file, err := s.getConfirmedFile(context.Background(), userID, tt.file.ID, tt.purpose)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && file.ID != tt.file.ID {
+				t.Errorf("file = %s, want %s", file.ID, tt.file.ID)
+			}
+		})
+	}
+
+	t.Run("unknown file", func(t *testing.T) {
+		s := &Service{fileRepository: &fakeFileRepository{}}
+
+		if _, err := s.getConfirmedFile(context.Background(), userID, domain.NewID(), domain.FilePurposeProfilePicture); !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("error = %v, want %v", err, domain.ErrInvalidArgument)
+		}
+	})
+}
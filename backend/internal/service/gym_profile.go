@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// getOwnGymProfile returns the gym profile if it belongs to the user.
+func (s *Service) getOwnGymProfile(ctx context.Context, userID, profileID domain.ID) (domain.GymProfile, error) {
+	profile, err := s.gymProfileRepository.GetGymProfileByID(ctx, profileID)
+	if err != nil {
+		return domain.GymProfile{}, err
+	}
+
+	if profile.UserID != userID {
+		logger.Errorf("user %s tried to access gym profile %s of user %s", userID, profileID, profile.UserID)
+		return domain.GymProfile{}, domain.ErrNotFound
+	}
+
+	return profile, nil
+}
+
+// getDefaultGymProfile returns the default gym profile of the user, the equipment of a
+// typical gym if the user has no profiles.
+func (s *Service) getDefaultGymProfile(ctx context.Context, userID domain.ID) (domain.GymProfile, error) {
+	profiles, err := s.gymProfileRepository.GetGymProfiles(ctx, userID)
+	if err != nil {
+		return domain.GymProfile{}, err
+	}
+
+	if len(profiles) == 0 {
+		return domain.DefaultGymProfile(userID), nil
+	}
+
+	return profiles[0], nil
+}
+
+func (s *Service) CreateGymProfile(ctx context.Context, userID domain.ID, input dto.CreateGymProfileDTO) (domain.GymProfile, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.CreateGymProfile")
+	defer span.Finish()
+
+	profile, err := domain.NewGymProfile(userID, input.Name, input.BarWeight, input.Plates, input.IsDefault)
+	if err != nil {
+		return domain.GymProfile{}, err
+	}
+
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		profiles, err := s.gymProfileRepository.GetGymProfiles(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		// The first profile is the default one
+		if len(profiles) == 0 {
+			profile.IsDefault = true
+		} else if profile.IsDefault {
+			if err := s.gymProfileRepository.UnsetDefaultGymProfile(ctx, userID); err != nil {
+				return err
+			}
+		}
+
+		profile, err = s.gymProfileRepository.CreateGymProfile(ctx, profile)
+		return err
+	})
+	if err != nil {
+		return domain.GymProfile{}, err
+	}
+
+	return profile, nil
+}
+
+func (s *Service) GetGymProfiles(ctx context.Context, userID domain.ID) ([]domain.GymProfile, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetGymProfiles")
+	defer span.Finish()
+
+	return s.gymProfileRepository.GetGymProfiles(ctx, userID)
+}
+
+func (s *Service) UpdateGymProfile(ctx context.Context, userID, profileID domain.ID, input dto.UpdateGymProfileDTO) (domain.GymProfile, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.UpdateGymProfile")
+	defer span.Finish()
+
+	profile, err := s.getOwnGymProfile(ctx, userID, profileID)
+	if err != nil {
+		return domain.GymProfile{}, err
+	}
+
+	if input.Name.IsValid {
+		profile.Name = input.Name.V
+	}
+
+	if input.BarWeight.IsValid {
+		profile.BarWeight = input.BarWeight.V
+	}
+
+	if input.Plates.IsValid {
+		profile.Plates = input.Plates.V
+	}
+
+	if err := profile.Validate(); err != nil {
+		return domain.GymProfile{}, err
+	}
+
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		if input.IsDefault && !profile.IsDefault {
+			if err := s.gymProfileRepository.UnsetDefaultGymProfile(ctx, userID); err != nil {
+				return err
+			}
+			profile.IsDefault = true
+		}
+
+		profile, err = s.gymProfileRepository.UpdateGymProfile(ctx, profileID, profile)
+		return err
+	})
+	if err != nil {
+		return domain.GymProfile{}, err
+	}
+
+	return profile, nil
+}
+
+func (s *Service) DeleteGymProfile(ctx context.Context, userID, profileID domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.DeleteGymProfile")
+	defer span.Finish()
+
+	profile, err := s.getOwnGymProfile(ctx, userID, profileID)
+	if err != nil {
+		return err
+	}
+
+	return s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		if err := s.gymProfileRepository.DeleteGymProfile(ctx, profileID); err != nil {
+			return err
+		}
+
+		if !profile.IsDefault {
+			return nil
+		}
+
+		// The oldest profile left becomes the default one
+		profiles, err := s.gymProfileRepository.GetGymProfiles(ctx, userID)
+		if err != nil || len(profiles) == 0 {
+			return err
+		}
+
+		next := profiles[0]
+		next.IsDefault = true
+		_, err = s.gymProfileRepository.UpdateGymProfile(ctx, next.ID, next)
+		return err
+	})
+}
+
+// CalculatePlates returns the plates to load to get the weight closest to the target with
+// the equipment of the gym profile.
+func (s *Service) CalculatePlates(ctx context.Context, userID domain.ID, input dto.CalculatePlatesDTO) (domain.PlateLoadout, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.CalculatePlates")
+	defer span.Finish()
+
+	var (
+		profile domain.GymProfile
+		err     error
+	)
+	if input.GymProfileID.IsValid {
+		profile, err = s.getOwnGymProfile(ctx, userID, input.GymProfileID.V)
+	} else {
+		profile, err = s.getDefaultGymProfile(ctx, userID)
+	}
+	if err != nil {
+		return domain.PlateLoadout{}, err
+	}
+
+	if input.BarWeight.IsValid {
+		profile.BarWeight = input.BarWeight.V
+		if err := profile.Validate(); err != nil {
+			return domain.PlateLoadout{}, err
+		}
+	}
+
+	return profile.Loadout(input.TargetWeight), nil
+}
+
+// loadExpectedSets rounds weights of the expected sets to the ones that can be loaded with
+// the gym profile and annotates them with their loadouts.
+func loadExpectedSets(profile domain.GymProfile, expectedSets []domain.ExpectedSet) {
+	for i := range expectedSets {
+		// Lighter sets are done with another bar or without one
+		if expectedSets[i].Weight < profile.BarWeight || expectedSets[i].Weight == 0 {
+			continue
+		}
+
+		loadout := profile.Loadout(expectedSets[i].Weight)
+		expectedSets[i].Weight = loadout.Weight
+		expectedSets[i].Loadout = utils.NewNullable(loadout, true)
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	imageJobBatchSize   = 10
+	imageJobLease       = 5 * time.Minute
+	imageJobMaxAttempts = 5
+	// maxProcessedImageSize bounds how much of an upload the worker reads into memory
+	maxProcessedImageSize = 20 << 20
+)
+
+func (s *Service) enqueueImageProcessing(ctx context.Context, sourceType domain.ImageJobSourceType, sourceID domain.ID, sourceKey string) error {
+	job := domain.NewImageProcessingJob(sourceType, sourceID, sourceKey)
+	return s.imageProcessingJobRepository.CreateImageProcessingJob(ctx, job)
+}
+
+// RunImageProcessing processes image jobs every interval until the context is done.
+func (s *Service) RunImageProcessing(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			processed, err := s.ProcessImageJobs(ctx)
+			if err != nil {
+				logger.Errorf("failed to process image jobs: %v", err)
+				break
+			}
+			// A full batch means there may be more due jobs
+			if processed < imageJobBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessImageJobs generates thumbnails for a batch of due jobs and returns the number of
+// processed jobs. Failed jobs are retried with a backoff.
+func (s *Service) ProcessImageJobs(ctx context.Context) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.ProcessImageJobs")
+	defer span.Finish()
+
+	jobs, err := s.imageProcessingJobRepository.ClaimImageProcessingJobs(ctx, imageJobBatchSize, imageJobLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, job := range jobs {
+		if err := s.processImageJob(ctx, job); err != nil {
+			logger.Errorf("image job %s failed on attempt %d: %v", job.ID, job.Attempts, err)
+
+			job.LastError = err.Error()
+			job.Status = domain.ImageJobStatusPending
+			job.RunAfter = time.Now().Add(time.Duration(job.Attempts*job.Attempts) * time.Minute)
+			if job.Attempts >= imageJobMaxAttempts {
+				job.Status = domain.ImageJobStatusFailed
+			}
+		} else {
+			job.LastError = ""
+			job.Status = domain.ImageJobStatusDone
+		}
+
+		if err := s.imageProcessingJobRepository.UpdateImageProcessingJob(ctx, job.ID, job); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(jobs), nil
+}
+
+func (s *Service) processImageJob(ctx context.Context, job domain.ImageProcessingJob) error {
+	data, err := s.s3Client.GetObject(ctx, job.SourceKey, maxProcessedImageSize)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			logger.Infof("source %s of image job %s has been deleted", job.SourceKey, job.ID)
+			return nil
+		}
+		return err
+	}
+
+	image, err := s.imageProcessor.Process(ctx, data, domain.ThumbnailSizes)
+	if err != nil {
+		return err
+	}
+
+	// Originals are public unless the purpose is private, they must not leak the location
+	// the photo was taken at
+	if err := s.s3Client.PutObject(ctx, job.SourceKey, image.ContentType, image.Original); err != nil {
+		return err
+	}
+
+	variants := make([]domain.ImageVariant, 0, len(domain.ThumbnailSizes))
+	for _, size := range domain.ThumbnailSizes {
+		key := domain.ImageVariantKey(job.SourceKey, size)
+		if err := s.s3Client.PutObject(ctx, key, "image/jpeg", image.Thumbnails[size]); err != nil {
+			return err
+		}
+
+		variants = append(variants, domain.ImageVariant{
+			Size: size,
+			Key:  key,
+			URL:  s.s3Client.ObjectURL(key),
+		})
+	}
+
+	switch job.SourceType {
+	case domain.ImageJobSourceFile:
+		return s.imageProcessingJobRepository.SetFileVariants(ctx, job.SourceID, variants)
+	case domain.ImageJobSourceExerciseMedia:
+		return s.imageProcessingJobRepository.SetExerciseMediaVariants(ctx, job.SourceID, variants)
+	default:
+		return fmt.Errorf("unknown image job source type %q", job.SourceType)
+	}
+}
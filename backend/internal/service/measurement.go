@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+const defaultMeasurementTrendWindowDays = 7
+
+// getOwnMeasurement returns the measurement if it belongs to the user.
+func (s *Service) getOwnMeasurement(ctx context.Context, userID, measurementID domain.ID) (domain.Measurement, error) {
+	measurement, err := s.measurementRepository.GetMeasurementByID(ctx, measurementID)
+	if err != nil {
+		return domain.Measurement{}, err
+	}
+
+	if measurement.UserID != userID {
+		logger.Errorf("user %s tried to access measurement %s of user %s", userID, measurementID, measurement.UserID)
+		return domain.Measurement{}, domain.ErrNotFound
+	}
+
+	return measurement, nil
+}
+
+// syncUserWeight sets the weight of the user to the latest bodyweight measurement. The
+// weight is kept as is if there are no measurements left.
+func (s *Service) syncUserWeight(ctx context.Context, userID domain.ID) error {
+	latest, err := s.measurementRepository.GetMeasurements(ctx, dto.GetMeasurementsDTO{
+		UserID: userID,
+		Kind:   domain.MeasurementKindBodyweight,
+		Limit:  1,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(latest) == 0 {
+		return nil
+	}
+
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	user.Weight = float32(latest[0].BaseValue())
+	user.UpdatedAt = time.Now()
+
+	_, err = s.userRepository.UpdateUser(ctx, user)
+	return err
+}
+
+func (s *Service) CreateMeasurement(ctx context.Context, userID domain.ID, input dto.CreateMeasurementDTO) (domain.Measurement, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.CreateMeasurement")
+	defer span.Finish()
+
+	measuredAt := input.MeasuredAt
+	if measuredAt.IsZero() {
+		measuredAt = time.Now()
+	}
+
+	measurement, err := domain.NewMeasurement(userID, input.Kind, input.Value, input.Unit, measuredAt, input.Note)
+	if err != nil {
+		return domain.Measurement{}, err
+	}
+
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		measurement, err = s.measurementRepository.CreateMeasurement(ctx, measurement)
+		if err != nil {
+			return err
+		}
+
+		if measurement.Kind != domain.MeasurementKindBodyweight {
+			return nil
+		}
+
+		return s.syncUserWeight(ctx, userID)
+	})
+	if err != nil {
+		return domain.Measurement{}, err
+	}
+
+	return measurement, nil
+}
+
+func (s *Service) GetMeasurements(ctx context.Context, filter dto.GetMeasurementsDTO) ([]domain.Measurement, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetMeasurements")
+	defer span.Finish()
+
+	return s.measurementRepository.GetMeasurements(ctx, filter)
+}
+
+func (s *Service) UpdateMeasurement(ctx context.Context, userID, measurementID domain.ID, input dto.UpdateMeasurementDTO) (domain.Measurement, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.UpdateMeasurement")
+	defer span.Finish()
+
+	measurement, err := s.getOwnMeasurement(ctx, userID, measurementID)
+	if err != nil {
+		return domain.Measurement{}, err
+	}
+
+	if input.Value.IsValid {
+		measurement.Value = input.Value.V
+	}
+
+	if input.Unit.IsValid {
+		measurement.Unit = input.Unit.V
+	}
+
+	if input.MeasuredAt.IsValid {
+		measurement.MeasuredAt = input.MeasuredAt.V
+	}
+
+	if input.Note.IsValid {
+		measurement.Note = input.Note.V
+	}
+
+	if err := measurement.Validate(); err != nil {
+		return domain.Measurement{}, err
+	}
+
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		measurement, err = s.measurementRepository.UpdateMeasurement(ctx, measurementID, measurement)
+		if err != nil {
+			return err
+		}
+
+		if measurement.Kind != domain.MeasurementKindBodyweight {
+			return nil
+		}
+
+		return s.syncUserWeight(ctx, userID)
+	})
+	if err != nil {
+		return domain.Measurement{}, err
+	}
+
+	return measurement, nil
+}
+
+func (s *Service) DeleteMeasurement(ctx context.Context, userID, measurementID domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.DeleteMeasurement")
+	defer span.Finish()
+
+	measurement, err := s.getOwnMeasurement(ctx, userID, measurementID)
+	if err != nil {
+		return err
+	}
+
+	return s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		if err := s.measurementRepository.DeleteMeasurement(ctx, measurementID); err != nil {
+			return err
+		}
+
+		if measurement.Kind != domain.MeasurementKindBodyweight {
+			return nil
+		}
+
+		return s.syncUserWeight(ctx, userID)
+	})
+}
+
+// GetMeasurementTrend returns daily values of the measurement kind in [from, to) along
+// with their moving average over windowDays days.
+func (s *Service) GetMeasurementTrend(ctx context.Context, userID domain.ID, kind domain.MeasurementKind, from, to time.Time, windowDays int) (dto.MeasurementTrendDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetMeasurementTrend")
+	defer span.Finish()
+
+	if kind.BaseUnit() == domain.MeasurementUnitUnknown {
+		return dto.MeasurementTrendDTO{}, fmt.Errorf("%w: unknown measurement kind", domain.ErrInvalidArgument)
+	}
+
+	if windowDays <= 0 {
+		windowDays = defaultMeasurementTrendWindowDays
+	}
+
+	if to.IsZero() {
+		to = time.Now()
+	}
+
+	// Days start in the time zone of the user
+	preferences, err := s.GetUserPreferences(ctx, userID)
+	if err != nil {
+		return dto.MeasurementTrendDTO{}, err
+	}
+
+	location := preferences.Location()
+
+	if !from.IsZero() {
+		from = domain.StartOfDay(from, location)
+	}
+	window := time.Duration(windowDays) * 24 * time.Hour
+
+	// The first points need the measurements of the preceding window too
+	var since time.Time
+	if !from.IsZero() {
+		since = from.Add(-window)
+	}
+
+	measurements, err := s.measurementRepository.GetMeasurements(ctx, dto.GetMeasurementsDTO{
+		UserID: userID,
+		Kind:   kind,
+		From:   since,
+		To:     to,
+	})
+	if err != nil {
+		return dto.MeasurementTrendDTO{}, err
+	}
+
+	// Measurements are ordered from the most recent one, days are collected oldest first
+	var (
+		days   []time.Time
+		sums   = make(map[time.Time]float64)
+		counts = make(map[time.Time]int)
+	)
+	for i := len(measurements) - 1; i >= 0; i-- {
+		day := domain.StartOfDay(measurements[i].MeasuredAt, location)
+		if counts[day] == 0 {
+			days = append(days, day)
+		}
+		sums[day] += measurements[i].BaseValue()
+		counts[day]++
+	}
+
+	trend := dto.MeasurementTrendDTO{
+		Kind: kind,
+		Unit: kind.BaseUnit(),
+	}
+
+	windowStart := 0
+	windowSum := 0.0
+	for i, day := range days {
+		value := sums[day] / float64(counts[day])
+		windowSum += value
+
+		for !days[windowStart].After(day.Add(-window)) {
+			windowSum -= sums[days[windowStart]] / float64(counts[days[windowStart]])
+			windowStart++
+		}
+
+		if day.Before(from) {
+			continue
+		}
+
+		trend.Points = append(trend.Points, dto.MeasurementTrendPointDTO{
+			Date:          day,
+			Value:         value,
+			MovingAverage: windowSum / float64(i-windowStart+1),
+		})
+	}
+
+	if len(trend.Points) > 0 {
+		trend.Change = trend.Points[len(trend.Points)-1].MovingAverage - trend.Points[0].MovingAverage
+	}
+
+	return trend, nil
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+)
+
+type fakeUserPreferencesRepository struct {
+	userPreferencesRepository
+
+	preferences domain.UserPreferences
+}
+
+func (r fakeUserPreferencesRepository) GetUserPreferences(_ context.Context, _ domain.ID) (domain.UserPreferences, error) {
+	return r.preferences, nil
+}
+
+// fakeMeasurementRepository returns the measurements as they are, the most recent one first.
+type fakeMeasurementRepository struct {
+	measurementRepository
+
+	measurements []domain.Measurement
+	filter       dto.GetMeasurementsDTO
+}
+
+func (r *fakeMeasurementRepository) GetMeasurements(_ context.Context, filter dto.GetMeasurementsDTO) ([]domain.Measurement, error) {
+	r.filter = filter
+	return r.measurements, nil
+}
+
+func TestGetMeasurementTrend(t *testing.T) {
+	userID := domain.NewID()
+
+	preferences := domain.DefaultUserPreferences(userID)
+	preferences.Timezone = "Europe/Moscow"
+	location, err := time.LoadLocation(preferences.Timezone)
+	if err != nil {
+		t.Skipf("time zone is not available: %v", err)
+	}
+
+	day := func(d int) time.Time {
+		return time.Date(2024, time.January, d, 0, 0, 0, 0, location)
+	}
+	measurement := func(at time.Time, value float64, unit domain.MeasurementUnit) domain.Measurement {
+		m, err := domain.NewMeasurement(userID, domain.MeasurementKindBodyweight, value, unit, at, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return m
+	}
+
+	repository := &fakeMeasurementRepository{
+		measurements: []domain.Measurement{
+			measurement(day(5).Add(8*time.Hour), 84, domain.MeasurementUnitKilogram),
+			// 82 kg measured in pounds
+			measurement(day(3).Add(8*time.Hour), 82/0.45359237, domain.MeasurementUnitPound),
+			// Late evening of January 2 in Moscow, January 1 in UTC
+			measurement(time.Date(2024, time.January, 1, 22, 0, 0, 0, time.UTC), 79, domain.MeasurementUnitKilogram),
+			measurement(day(2).Add(8*time.Hour), 81, domain.MeasurementUnitKilogram),
+			measurement(day(1).Add(8*time.Hour), 80, domain.MeasurementUnitKilogram),
+		},
+	}
+	s := &Service{
+		measurementRepository:     repository,
+		userPreferencesRepository: fakeUserPreferencesRepository{preferences: preferences},
+	}
+
+	trend, err := s.GetMeasurementTrend(context.Background(), userID, domain.MeasurementKindBodyweight, day(3).Add(12*time.Hour), day(6), 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The preceding window is fetched for the moving average of the first point
+	if !repository.filter.From.Equal(day(3).Add(-3 * 24 * time.Hour)) {
+		t.Errorf("measurements fetched from %s, want %s", repository.filter.From, day(3).Add(-3*24*time.Hour))
+	}
+
+	want := []dto.MeasurementTrendPointDTO{
+		{Date: day(3), Value: 82, MovingAverage: (80 + 80 + 82) / 3.0},
+		{Date: day(5), Value: 84, MovingAverage: (82 + 84) / 2.0},
+	}
+
+	if trend.Unit != domain.MeasurementUnitKilogram {
+		t.Errorf("unit = %s, want %s", trend.Unit, domain.MeasurementUnitKilogram)
+	}
+	if len(trend.Points) != len(want) {
+		t.Fatalf("got %d points, want %d: %+v", len(trend.Points), len(want), trend.Points)
+	}
+	for i, point := range trend.Points {
+		if !point.Date.Equal(want[i].Date) || math.Abs(point.Value-want[i].Value) > 1e-9 || math.Abs(point.MovingAverage-want[i].MovingAverage) > 1e-9 {
+			t.Errorf("point %d = %+v, want %+v", i, point, want[i])
+		}
+	}
+	if wantChange := want[1].MovingAverage - want[0].MovingAverage; math.Abs(trend.Change-wantChange) > 1e-9 {
+		t.Errorf("change = %f, want %f", trend.Change, wantChange)
+	}
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"fmt"
+
+	"fitness-trainer/internal/domain"
+)
+
+// checkOrder verifies that order lists every item of current exactly once.
+func checkOrder(kind string, current, order []domain.ID) error {
+	known := make(map[domain.ID]struct{}, len(current))
+	for _, id := range current {
+		known[id] = struct{}{}
+	}
+
+	seen := make(map[domain.ID]struct{}, len(order))
+	for _, id := range order {
+		if _, ok := known[id]; !ok {
+			return fmt.Errorf("%w: unknown %s %s", domain.ErrInvalidArgument, kind, id)
+		}
+		if _, ok := seen[id]; ok {
+			return fmt.Errorf("%w: %s %s is listed twice", domain.ErrInvalidArgument, kind, id)
+		}
+		seen[id] = struct{}{}
+	}
+
+	if len(order) != len(current) {
+		return fmt.Errorf("%w: order must list every %s", domain.ErrInvalidArgument, kind)
+	}
+
+	return nil
+}
+
+// insertPosition returns the position of an item inserted at index into items with the
+// given ordered positions, an index past the end appends the item.
+func insertPosition(positions []int, index int) int {
+	if index < len(positions) {
+		return positions[index]
+	}
+
+	if len(positions) == 0 {
+		return 0
+	}
+
+	return positions[len(positions)-1] + 1
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"fitness-trainer/internal/domain"
+)
+
+func TestCheckOrder(t *testing.T) {
+	a, b, c := domain.NewID(), domain.NewID(), domain.NewID()
+
+	tests := []struct {
+		name    string
+		current []domain.ID
+		order   []domain.ID
+		wantErr bool
+	}{
+		{
+			name:    "same order",
+			current: []domain.ID{a, b, c},
+			order:   []domain.ID{a, b, c},
+		},
+		{
+			name:    "reordered",
+			current: []domain.ID{a, b, c},
+			order:   []domain.ID{c, a, b},
+		},
+		{
+			name: "both empty",
+		},
+		{
+			name:    "unknown item",
+			current: []domain.ID{a, b},
+			order:   []domain.ID{a, c},
+			wantErr: true,
+		},
+		{
+			name:    "item listed twice",
+			current: []domain.ID{a, b},
+			order:   []domain.ID{a, a},
+			wantErr: true,
+		},
+		{
+			name:    "missing item",
+			current: []domain.ID{a, b, c},
+			order:   []domain.ID{a, b},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkOrder("set", tt.current, tt.order)
+
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, domain.ErrInvalidArgument) {
+				t.Errorf("error = %v, want %v", err, domain.ErrInvalidArgument)
+			}
+		})
+	}
+}
+
+func TestInsertPosition(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions []int
+		index     int
+		want      int
+	}{
+		{name: "into empty", positions: nil, index: 0, want: 0},
+		{name: "at the start", positions: []int{0, 1, 2}, index: 0, want: 0},
+		{name: "in the middle", positions: []int{0, 1, 2}, index: 1, want: 1},
+		{name: "with gaps", positions: []int{0, 4, 9}, index: 2, want: 9},
+		{name: "at the end", positions: []int{0, 1, 2}, index: 3, want: 3},
+		{name: "past the end", positions: []int{0, 4, 9}, index: 10, want: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := insertPosition(tt.positions, tt.index); got != tt.want {
+				t.Errorf("insertPosition(%v, %d) = %d, want %d", tt.positions, tt.index, got, tt.want)
+			}
+		})
+	}
+}
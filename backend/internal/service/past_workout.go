@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/utils"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// pastWorkoutMaxDuration catches start and finish times which are days apart by mistake
+const pastWorkoutMaxDuration = 24 * time.Hour
+
+// CreatePastWorkout creates a finished workout with the given times, exercises and sets.
+// It must not overlap other workouts of the user, including the active ones.
+func (s *Service) CreatePastWorkout(ctx context.Context, userID domain.ID, input dto.CreatePastWorkoutDTO) (dto.WorkoutDetailsDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.CreatePastWorkout")
+	defer span.Finish()
+
+	if !input.FinishedAt.After(input.StartedAt) {
+		return dto.WorkoutDetailsDTO{}, fmt.Errorf("%w: workout must finish after it starts", domain.ErrInvalidArgument)
+	}
+
+	if input.FinishedAt.After(time.Now()) {
+		return dto.WorkoutDetailsDTO{}, fmt.Errorf("%w: workout must finish in the past", domain.ErrInvalidArgument)
+	}
+
+	if input.FinishedAt.Sub(input.StartedAt) > pastWorkoutMaxDuration {
+		return dto.WorkoutDetailsDTO{}, fmt.Errorf("%w: workout can't be longer than %s", domain.ErrInvalidArgument, pastWorkoutMaxDuration)
+	}
+
+	setCount := 0
+	for _, exerciseLog := range input.Exercises {
+		exercise, err := s.GetExerciseByID(ctx, userID, exerciseLog.ExerciseID)
+		if err != nil {
+			return dto.WorkoutDetailsDTO{}, err
+		}
+
+		if exercise.IsArchived() {
+			return dto.WorkoutDetailsDTO{}, fmt.Errorf("%w: exercise %s is archived", domain.ErrInvalidArgument, exercise.ID)
+		}
+
+		for _, set := range exerciseLog.Sets {
+			if set.Reps <= 0 && set.Time <= 0 {
+				return dto.WorkoutDetailsDTO{}, fmt.Errorf("%w: set must have reps or time", domain.ErrInvalidArgument)
+			}
+
+			completedAt := set.CompletedAt.V
+			if set.CompletedAt.IsValid && (completedAt.Before(input.StartedAt) || completedAt.After(input.FinishedAt)) {
+				return dto.WorkoutDetailsDTO{}, fmt.Errorf("%w: set must be completed during the workout", domain.ErrInvalidArgument)
+			}
+		}
+		setCount += len(exerciseLog.Sets)
+	}
+
+	workout := domain.NewWorkout(userID, utils.Nullable[domain.ID]{}, false)
+	workout.CreatedAt = input.StartedAt
+	workout.FinishedAt = input.FinishedAt
+	workout.Notes = input.Notes
+	workout.Rating = input.Rating
+
+	err := s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		if err := s.workoutRepository.LockUserWorkouts(ctx, userID); err != nil {
+			return err
+		}
+
+		overlaps, err := s.workoutRepository.HasOverlappingWorkout(ctx, userID, input.StartedAt, input.FinishedAt)
+		if err != nil {
+			return err
+		}
+
+		if overlaps {
+			return fmt.Errorf("%w: workout overlaps another workout", domain.ErrInvalidArgument)
+		}
+
+		workout, err = s.workoutRepository.CreateWorkout(ctx, workout)
+		if err != nil {
+			return err
+		}
+
+		// Sets without a time are spread evenly over the workout in the order they are given
+		step := input.FinishedAt.Sub(input.StartedAt) / time.Duration(max(setCount, 1))
+		index := 0
+		for _, exerciseLogInput := range input.Exercises {
+			exerciseLog := domain.NewExerciseLog(workout.ID, exerciseLogInput.ExerciseID)
+			exerciseLog.CreatedAt = input.StartedAt
+			exerciseLog.Notes = exerciseLogInput.Notes
+
+			exerciseLog, err := s.exerciseLogRepository.CreateExerciseLog(ctx, exerciseLog)
+			if err != nil {
+				return err
+			}
+
+			for position, set := range exerciseLogInput.Sets {
+				index++
+
+				setLog := domain.NewExerciseSetLog(exerciseLog.ID, set.Reps, set.Weight, set.Time)
+				setLog.CreatedAt = input.StartedAt
+				setLog.CompletedAt = input.StartedAt.Add(step * time.Duration(index))
+				if set.CompletedAt.IsValid {
+					setLog.CompletedAt = set.CompletedAt.V
+				}
+				setLog.Position = position
+
+				if _, err := s.setLogRepository.CreateSetLog(ctx, setLog); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return dto.WorkoutDetailsDTO{}, err
+	}
+
+	return s.GetWorkout(ctx, userID, workout.ID)
+}
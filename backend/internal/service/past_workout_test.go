@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/utils"
+)
+
+// fakeWorkoutRepository records the calls made while creating a workout.
+type fakeWorkoutRepository struct {
+	workoutRepository
+
+	overlaps bool
+	calls    []string
+	workout  domain.Workout
+}
+
+func (r *fakeWorkoutRepository) LockUserWorkouts(_ context.Context, _ domain.ID) error {
+	r.calls = append(r.calls, "lock")
+	return nil
+}
+
+func (r *fakeWorkoutRepository) HasOverlappingWorkout(_ context.Context, _ domain.ID, _, _ time.Time) (bool, error) {
+	r.calls = append(r.calls, "overlap")
+	return r.overlaps, nil
+}
+
+func (r *fakeWorkoutRepository) CreateWorkout(_ context.Context, workout domain.Workout) (domain.Workout, error) {
+	r.calls = append(r.calls, "create")
+	r.workout = workout
+	return workout, nil
+}
+
+func (r *fakeWorkoutRepository) GetWorkoutByID(_ context.Context, _ domain.ID) (domain.Workout, error) {
+	return r.workout, nil
+}
+
+// fakeExerciseLogRepository holds the given exercise logs of a single workout, created
+// exercise logs are not kept.
+type fakeExerciseLogRepository struct {
+	exerciseLogRepository
+
+	exerciseLogs []domain.ExerciseLog
+}
+
+func (r *fakeExerciseLogRepository) CreateExerciseLog(_ context.Context, exerciseLog domain.ExerciseLog) (domain.ExerciseLog, error) {
+	return exerciseLog, nil
+}
+
+func (r *fakeExerciseLogRepository) GetExerciseLogsByWorkoutID(_ context.Context, _ domain.ID) ([]domain.ExerciseLog, error) {
+	return r.exerciseLogs, nil
+}
+
+func (r *fakeExerciseLogRepository) SetExerciseLogOrder(_ context.Context, _ domain.ID, ids []domain.ID) error {
+	ordered := make([]domain.ExerciseLog, 0, len(ids))
+	for _, id := range ids {
+		for _, exerciseLog := range r.exerciseLogs {
+			if exerciseLog.ID == id {
+				ordered = append(ordered, exerciseLog)
+			}
+		}
+	}
+	r.exerciseLogs = ordered
+	return nil
+}
+
+func (r *fakeExerciseLogRepository) SetExerciseLogsGroup(_ context.Context, _ domain.ID, ids []domain.ID, group utils.Nullable[domain.ExerciseGroup]) error {
+	for _, id := range ids {
+		for i := range r.exerciseLogs {
+			if r.exerciseLogs[i].ID == id {
+				r.exerciseLogs[i].Group = group
+			}
+		}
+	}
+	return nil
+}
+
+type fakeSetLogRepository struct {
+	setLogRepository
+
+	setLogs []domain.ExerciseSetLog
+}
+
+func (r *fakeSetLogRepository) CreateSetLog(_ context.Context, setLog domain.ExerciseSetLog) (domain.ExerciseSetLog, error) {
+	r.setLogs = append(r.setLogs, setLog)
+	return setLog, nil
+}
+
+func TestCreatePastWorkout(t *testing.T) {
+	userID := domain.NewID()
+	exercise := domain.NewExercise("Bench Press", "", "", nil)
+
+	startedAt := time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC)
+	finishedAt := startedAt.Add(90 * time.Minute)
+	completedAt := startedAt.Add(10 * time.Minute)
+
+	sets := func(count int) []dto.PastSetLogDTO {
+		result := make([]dto.PastSetLogDTO, count)
+		for i := range result {
+			result[i] = dto.PastSetLogDTO{Reps: 10, Weight: 60}
+		}
+		return result
+	}
+
+	tests := []struct {
+		name       string
+		startedAt  time.Time
+		finishedAt time.Time
+		exercises  []dto.PastExerciseLogDTO
+		overlaps   bool
+		wantErr    error
+		wantCalls  []string
+		// wantCompletedAt are the completion times of the created sets in order
+		wantCompletedAt []time.Time
+	}{
+		{
+			name:       "sets are spread evenly over the workout",
+			startedAt:  startedAt,
+			finishedAt: finishedAt,
+			exercises: []dto.PastExerciseLogDTO{
+				{ExerciseID: exercise.ID, Sets: sets(1)},
+				{ExerciseID: exercise.ID, Sets: sets(2)},
+			},
+			wantCalls: []string{"lock", "overlap", "create"},
+			wantCompletedAt: []time.Time{
+				startedAt.Add(30 * time.Minute),
+				startedAt.Add(60 * time.Minute),
+				finishedAt,
+			},
+		},
+		{
+			name:       "given completion times are kept",
+			startedAt:  startedAt,
+			finishedAt: finishedAt,
+			exercises: []dto.PastExerciseLogDTO{
+				{ExerciseID: exercise.ID, Sets: []dto.PastSetLogDTO{
+					{Reps: 10, CompletedAt: utils.NewNullable(completedAt, true)},
+					{Reps: 10},
+				}},
+			},
+			wantCalls:       []string{"lock", "overlap", "create"},
+			wantCompletedAt: []time.Time{completedAt, finishedAt},
+		},
+		{
+			name:       "overlapping another workout",
+			startedAt:  startedAt,
+			finishedAt: finishedAt,
+			exercises:  []dto.PastExerciseLogDTO{{ExerciseID: exercise.ID, Sets: sets(1)}},
+			overlaps:   true,
+			wantErr:    domain.ErrInvalidArgument,
+			wantCalls:  []string{"lock", "overlap"},
+		},
+		{
+			name:       "longer than a day",
+			startedAt:  startedAt,
+			finishedAt: startedAt.Add(pastWorkoutMaxDuration + time.Minute),
+			exercises:  []dto.PastExerciseLogDTO{{ExerciseID: exercise.ID, Sets: sets(1)}},
+			wantErr:    domain.ErrInvalidArgument,
+		},
+		{
+			name:       "exactly a day",
+			startedAt:  startedAt,
+			finishedAt: startedAt.Add(pastWorkoutMaxDuration),
+			exercises:  []dto.PastExerciseLogDTO{{ExerciseID: exercise.ID, Sets: sets(1)}},
+			wantCalls:  []string{"lock", "overlap", "create"},
+			wantCompletedAt: []time.Time{
+				startedAt.Add(pastWorkoutMaxDuration),
+			},
+		},
+		{
+			name:       "finished before started",
+			startedAt:  finishedAt,
+			finishedAt: startedAt,
+			exercises:  []dto.PastExerciseLogDTO{{ExerciseID: exercise.ID, Sets: sets(1)}},
+			wantErr:    domain.ErrInvalidArgument,
+		},
+		{
+			name:       "finished in the future",
+			startedAt:  time.Now().Add(-time.Hour),
+			finishedAt: time.Now().Add(time.Hour),
+			exercises:  []dto.PastExerciseLogDTO{{ExerciseID: exercise.ID, Sets: sets(1)}},
+			wantErr:    domain.ErrInvalidArgument,
+		},
+		{
+			name:       "set completed after the workout",
+			startedAt:  startedAt,
+			finishedAt: finishedAt,
+			exercises: []dto.PastExerciseLogDTO{
+				{ExerciseID: exercise.ID, Sets: []dto.PastSetLogDTO{
+					{Reps: 10, CompletedAt: utils.NewNullable(finishedAt.Add(time.Minute), true)},
+				}},
+			},
+			wantErr: domain.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workoutRepository := &fakeWorkoutRepository{overlaps: tt.overlaps}
+			setLogRepository := &fakeSetLogRepository{}
+			s := &Service{
+				unitOfWork:            fakeUnitOfWork{},
+				exerciseRepository:    &fakeExerciseRepository{exercises: map[domain.ID]domain.Exercise{exercise.ID: exercise}},
+				workoutRepository:     workoutRepository,
+				exerciseLogRepository: &fakeExerciseLogRepository{},
+				setLogRepository:      setLogRepository,
+			}
+
+			workout, err := s.CreatePastWorkout(context.Background(), userID, dto.CreatePastWorkoutDTO{
+				StartedAt:  tt.startedAt,
+				FinishedAt: tt.finishedAt,
+				Exercises:  tt.exercises,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(workoutRepository.calls) != len(tt.wantCalls) {
+				t.Fatalf("calls = %v, want %v", workoutRepository.calls, tt.wantCalls)
+			}
+			for i := range tt.wantCalls {
+				if workoutRepository.calls[i] != tt.wantCalls[i] {
+					t.Fatalf("calls = %v, want %v", workoutRepository.calls, tt.wantCalls)
+				}
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if !workout.Workout.CreatedAt.Equal(tt.startedAt) || !workout.Workout.FinishedAt.Equal(tt.finishedAt) {
+				t.Errorf("workout from %s to %s, want from %s to %s", workout.Workout.CreatedAt, workout.Workout.FinishedAt, tt.startedAt, tt.finishedAt)
+			}
+
+			if len(setLogRepository.setLogs) != len(tt.wantCompletedAt) {
+				t.Fatalf("created %d sets, want %d", len(setLogRepository.setLogs), len(tt.wantCompletedAt))
+			}
+			for i, setLog := range setLogRepository.setLogs {
+				if !setLog.CompletedAt.Equal(tt.wantCompletedAt[i]) {
+					t.Errorf("set %d completed at %s, want %s", i, setLog.CompletedAt, tt.wantCompletedAt[i])
+				}
+			}
+		})
+	}
+}
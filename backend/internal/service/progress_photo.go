@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// signImageURLs replaces the URL of a private image and its variants with signed URLs.
+func (s *Service) signImageURLs(ctx context.Context, url string, variants []domain.ImageVariant) (string, []domain.ImageVariant, error) {
+	signedURL, err := s.urlSigner.SignURL(ctx, url)
+	if err != nil {
+		return "", nil, err
+	}
+
+	signedVariants := make([]domain.ImageVariant, 0, len(variants))
+	for _, variant := range variants {
+		variant.URL, err = s.urlSigner.SignURL(ctx, variant.URL)
+		if err != nil {
+			return "", nil, err
+		}
+		signedVariants = append(signedVariants, variant)
+	}
+
+	return signedURL, signedVariants, nil
+}
+
+// signFile signs the URLs of files which are not publicly readable.
+func (s *Service) signFile(ctx context.Context, file domain.File) (domain.File, error) {
+	if !file.Purpose.IsPrivate() {
+		return file, nil
+	}
+
+	var err error
+	file.URL, file.Variants, err = s.signImageURLs(ctx, file.URL, file.Variants)
+	if err != nil {
+		return domain.File{}, err
+	}
+
+	return file, nil
+}
+
+// signProgressPhotos signs the URLs of the photos, which are always stored privately.
+func (s *Service) signProgressPhotos(ctx context.Context, photos ...domain.ProgressPhoto) ([]domain.ProgressPhoto, error) {
+	for i := range photos {
+		var err error
+		photos[i].URL, photos[i].Variants, err = s.signImageURLs(ctx, photos[i].URL, photos[i].Variants)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return photos, nil
+}
+
+// getOwnProgressPhoto returns the photo if it belongs to the user.
+func (s *Service) getOwnProgressPhoto(ctx context.Context, userID, photoID domain.ID) (domain.ProgressPhoto, error) {
+	photo, err := s.progressPhotoRepository.GetProgressPhotoByID(ctx, photoID)
+	if err != nil {
+		return domain.ProgressPhoto{}, err
+	}
+
+	if photo.UserID != userID {
+		logger.Errorf("user %s tried to access progress photo %s of user %s", userID, photoID, photo.UserID)
+		return domain.ProgressPhoto{}, domain.ErrNotFound
+	}
+
+	return photo, nil
+}
+
+// checkMeasurementLink checks that the measurement the photo is linked to belongs to the user.
+func (s *Service) checkMeasurementLink(ctx context.Context, userID domain.ID, measurementID domain.ID) error {
+	if _, err := s.getOwnMeasurement(ctx, userID, measurementID); err != nil {
+		return fmt.Errorf("%w: measurement %s not found", domain.ErrInvalidArgument, measurementID)
+	}
+
+	return nil
+}
+
+func (s *Service) CreateProgressPhoto(ctx context.Context, userID domain.ID, input dto.CreateProgressPhotoDTO) (domain.ProgressPhoto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.CreateProgressPhoto")
+	defer span.Finish()
+
+	if _, err := s.getConfirmedFile(ctx, userID, input.FileID, domain.FilePurposeProgressPhoto); err != nil {
+		return domain.ProgressPhoto{}, err
+	}
+
+	if input.MeasurementID.IsValid {
+		if err := s.checkMeasurementLink(ctx, userID, input.MeasurementID.V); err != nil {
+			return domain.ProgressPhoto{}, err
+		}
+	}
+
+	takenAt := input.TakenAt
+	if takenAt.IsZero() {
+		takenAt = time.Now()
+	}
+
+	photo := domain.NewProgressPhoto(userID, input.FileID, input.Pose, takenAt, input.IsPrivate, input.Note)
+	photo.MeasurementID = input.MeasurementID
+
+	photo, err := s.progressPhotoRepository.CreateProgressPhoto(ctx, photo)
+	if err != nil {
+		return domain.ProgressPhoto{}, err
+	}
+
+	photos, err := s.signProgressPhotos(ctx, photo)
+	if err != nil {
+		return domain.ProgressPhoto{}, err
+	}
+
+	return photos[0], nil
+}
+
+func (s *Service) GetProgressPhotos(ctx context.Context, filter dto.GetProgressPhotosDTO) ([]domain.ProgressPhoto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetProgressPhotos")
+	defer span.Finish()
+
+	photos, err := s.progressPhotoRepository.GetProgressPhotos(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.signProgressPhotos(ctx, photos...)
+}
+
+func (s *Service) UpdateProgressPhoto(ctx context.Context, userID, photoID domain.ID, input dto.UpdateProgressPhotoDTO) (domain.ProgressPhoto, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.UpdateProgressPhoto")
+	defer span.Finish()
+
+	photo, err := s.getOwnProgressPhoto(ctx, userID, photoID)
+	if err != nil {
+		return domain.ProgressPhoto{}, err
+	}
+
+	if input.Pose.IsValid {
+		photo.Pose = input.Pose.V
+	}
+
+	if input.TakenAt.IsValid {
+		photo.TakenAt = input.TakenAt.V
+	}
+
+	if input.MeasurementID.IsValid {
+		if input.MeasurementID.V != (domain.ID{}) {
+			if err := s.checkMeasurementLink(ctx, userID, input.MeasurementID.V); err != nil {
+				return domain.ProgressPhoto{}, err
+			}
+		}
+		photo.MeasurementID = input.MeasurementID
+	}
+
+	if input.IsPrivate.IsValid {
+		photo.IsPrivate = input.IsPrivate.V
+	}
+
+	if input.Note.IsValid {
+		photo.Note = input.Note.V
+	}
+
+	photo, err = s.progressPhotoRepository.UpdateProgressPhoto(ctx, photoID, photo)
+	if err != nil {
+		return domain.ProgressPhoto{}, err
+	}
+
+	photos, err := s.signProgressPhotos(ctx, photo)
+	if err != nil {
+		return domain.ProgressPhoto{}, err
+	}
+
+	return photos[0], nil
+}
+
+// DeleteProgressPhoto deletes the photo, its object is removed by the storage garbage collection.
+func (s *Service) DeleteProgressPhoto(ctx context.Context, userID, photoID domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.DeleteProgressPhoto")
+	defer span.Finish()
+
+	if _, err := s.getOwnProgressPhoto(ctx, userID, photoID); err != nil {
+		return err
+	}
+
+	return s.progressPhotoRepository.DeleteProgressPhoto(ctx, photoID)
+}
+
+// CompareProgressPhotos returns, for every pose, the photos taken closest to the two dates.
+// Comparisons are meant to be shown side by side, so private photos are left out unless
+// they are asked for.
+func (s *Service) CompareProgressPhotos(ctx context.Context, userID domain.ID, before, after time.Time, includePrivate bool) ([]dto.ProgressPhotoComparisonDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.CompareProgressPhotos")
+	defer span.Finish()
+
+	if !before.Before(after) {
+		return nil, fmt.Errorf("%w: the first date must be before the second one", domain.ErrInvalidArgument)
+	}
+
+	beforePhotos, err := s.progressPhotoRepository.GetClosestProgressPhotos(ctx, userID, before, includePrivate)
+	if err != nil {
+		return nil, err
+	}
+
+	afterPhotos, err := s.progressPhotoRepository.GetClosestProgressPhotos(ctx, userID, after, includePrivate)
+	if err != nil {
+		return nil, err
+	}
+
+	beforePhotos, err = s.signProgressPhotos(ctx, beforePhotos...)
+	if err != nil {
+		return nil, err
+	}
+
+	afterPhotos, err = s.signProgressPhotos(ctx, afterPhotos...)
+	if err != nil {
+		return nil, err
+	}
+
+	comparisons := make([]dto.ProgressPhotoComparisonDTO, 0, 3)
+	for _, pose := range []domain.ProgressPhotoPose{domain.ProgressPhotoPoseFront, domain.ProgressPhotoPoseSide, domain.ProgressPhotoPoseBack} {
+		comparison := dto.ProgressPhotoComparisonDTO{Pose: pose}
+
+		for _, photo := range beforePhotos {
+			if photo.Pose == pose {
+				comparison.Before = utils.NewNullable(photo, true)
+			}
+		}
+
+		for _, photo := range afterPhotos {
+			// A single photo closest to both dates is only shown once
+			if photo.Pose == pose && (!comparison.Before.IsValid || photo.ID != comparison.Before.V.ID) {
+				comparison.After = utils.NewNullable(photo, true)
+			}
+		}
+
+		if comparison.Before.IsValid || comparison.After.IsValid {
+			comparisons = append(comparisons, comparison)
+		}
+	}
+
+	return comparisons, nil
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"fitness-trainer/internal/domain"
+)
+
+// fakeProgressPhotoRepository picks the photo of every pose taken closest to the date,
+// the same way the query does.
+type fakeProgressPhotoRepository struct {
+	progressPhotoRepository
+
+	photos []domain.ProgressPhoto
+}
+
+func (r fakeProgressPhotoRepository) GetClosestProgressPhotos(_ context.Context, _ domain.ID, at time.Time, includePrivate bool) ([]domain.ProgressPhoto, error) {
+	closest := make(map[domain.ProgressPhotoPose]domain.ProgressPhoto)
+	for _, photo := range r.photos {
+		if photo.IsPrivate && !includePrivate {
+			continue
+		}
+
+		current, ok := closest[photo.Pose]
+		if !ok || photo.TakenAt.Sub(at).Abs() < current.TakenAt.Sub(at).Abs() {
+			closest[photo.Pose] = photo
+		}
+	}
+
+	result := make([]domain.ProgressPhoto, 0, len(closest))
+	for _, photo := range closest {
+		result = append(result, photo)
+	}
+
+	return result, nil
+}
+
+func TestCompareProgressPhotos(t *testing.T) {
+	userID := domain.NewID()
+	start := time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 3, 0)
+
+	photo := func(pose domain.ProgressPhotoPose, takenAt time.Time, isPrivate bool) domain.ProgressPhoto {
+		return domain.ProgressPhoto{
+			Model:     domain.NewModel(),
+			UserID:    userID,
+			Pose:      pose,
+			TakenAt:   takenAt,
+			IsPrivate: isPrivate,
+			URL:       "https://storage/private/photo.jpg",
+		}
+	}
+
+	frontBefore := photo(domain.ProgressPhotoPoseFront, start, false)
+	frontAfter := photo(domain.ProgressPhotoPoseFront, end.Add(-24*time.Hour), false)
+	// The private photo is the closest one to the second date
+	privateFrontAfter := photo(domain.ProgressPhotoPoseFront, end, true)
+	side := photo(domain.ProgressPhotoPoseSide, start, false)
+	privateBack := photo(domain.ProgressPhotoPoseBack, end, true)
+
+	photos := []domain.ProgressPhoto{frontBefore, frontAfter, privateFrontAfter, side, privateBack}
+
+	tests := []struct {
+		name           string
+		includePrivate bool
+		// want maps poses to the IDs of the photos before and after, nil for no photo
+		want map[domain.ProgressPhotoPose][2]*domain.ID
+	}{
+		{
+			name: "private photos are left out",
+			want: map[domain.ProgressPhotoPose][2]*domain.ID{
+				domain.ProgressPhotoPoseFront: {&frontBefore.ID, &frontAfter.ID},
+				// The only side photo is closest to both dates and is shown once
+				domain.ProgressPhotoPoseSide: {&side.ID, nil},
+			},
+		},
+		{
+			name:           "private photos asked for",
+			includePrivate: true,
+			want: map[domain.ProgressPhotoPose][2]*domain.ID{
+				domain.ProgressPhotoPoseFront: {&frontBefore.ID, &privateFrontAfter.ID},
+				domain.ProgressPhotoPoseSide:  {&side.ID, nil},
+				domain.ProgressPhotoPoseBack:  {&privateBack.ID, nil},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				progressPhotoRepository: fakeProgressPhotoRepository{photos: photos},
+				urlSigner:               fakeURLSigner{},
+			}
+
+			comparisons, err := s.CompareProgressPhotos(context.Background(), userID, start, end, tt.includePrivate)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(comparisons) != len(tt.want) {
+				t.Fatalf("got %d comparisons, want %d", len(comparisons), len(tt.want))
+			}
+
+			for _, comparison := range comparisons {
+				want, ok := tt.want[comparison.Pose]
+				if !ok {
+					t.Errorf("unexpected comparison of pose %s", comparison.Pose)
+					continue
+				}
+
+				for i, got := range []struct {
+					id      domain.ID
+					isValid bool
+					url     string
+				}{
+					{comparison.Before.V.ID, comparison.Before.IsValid, comparison.Before.V.URL},
+					{comparison.After.V.ID, comparison.After.IsValid, comparison.After.V.URL},
+				} {
+					if got.isValid != (want[i] != nil) || (got.isValid && got.id != *want[i]) {
+						t.Errorf("pose %s photo %d = %v (%v), want %v", comparison.Pose, i, got.id, got.isValid, want[i])
+					}
+					if got.isValid && got.url != "https://storage/private/photo.jpg?signature" {
+						t.Errorf("pose %s photo %d url = %q, want a signed one", comparison.Pose, i, got.url)
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("dates in the wrong order", func(t *testing.T) {
+		s := &Service{progressPhotoRepository: fakeProgressPhotoRepository{photos: photos}}
+
+		if _, err := s.CompareProgressPhotos(context.Background(), userID, end, start, false); !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("error = %v, want %v", err, domain.ErrInvalidArgument)
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/utils"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// GetWorkoutRestAnalytics compares the rests between consecutive sets of the workout with
+// the targets of the expected sets they follow.
+func (s *Service) GetWorkoutRestAnalytics(ctx context.Context, userID, workoutID domain.ID) (dto.RestAnalyticsDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetWorkoutRestAnalytics")
+	defer span.Finish()
+
+	details, err := s.GetWorkout(ctx, userID, workoutID)
+	if err != nil {
+		return dto.RestAnalyticsDTO{}, err
+	}
+
+	return restAnalytics(details.ExerciseLogs), nil
+}
+
+// restAnalytics measures the rests between the sets of the exercise logs in the order the
+// sets were completed.
+func restAnalytics(exerciseLogs []dto.ExerciseLogDTO) dto.RestAnalyticsDTO {
+	type loggedSet struct {
+		setLog domain.ExerciseSetLog
+		target utils.Nullable[time.Duration]
+	}
+
+	var sets []loggedSet
+	for _, exerciseLog := range exerciseLogs {
+		// Set logs follow the expected sets in order
+		for i, setLog := range exerciseLog.SetLogs {
+			var target utils.Nullable[time.Duration]
+			if i < len(exerciseLog.ExpectedSets) && exerciseLog.ExpectedSets[i].Rest > 0 {
+				target = utils.NewNullable(exerciseLog.ExpectedSets[i].Rest, true)
+			}
+			sets = append(sets, loggedSet{setLog: setLog, target: target})
+		}
+	}
+
+	slices.SortStableFunc(sets, func(a, b loggedSet) int {
+		return cmp.Compare(a.setLog.CompletedAt.UnixNano(), b.setLog.CompletedAt.UnixNano())
+	})
+
+	var (
+		result      dto.RestAnalyticsDTO
+		totalTarget time.Duration
+		targets     int
+	)
+	for i := 1; i < len(sets); i++ {
+		previous, next := sets[i-1], sets[i]
+
+		// Without a reported start the rest also covers the set itself
+		startedAt := next.setLog.StartedAt
+		if startedAt.IsZero() {
+			startedAt = next.setLog.CompletedAt.Add(-next.setLog.Time)
+		}
+		actual := max(startedAt.Sub(previous.setLog.CompletedAt), 0)
+
+		interval := dto.RestIntervalDTO{
+			ExerciseLogID:    previous.setLog.ExerciseLogID,
+			PreviousSetLogID: previous.setLog.ID,
+			SetLogID:         next.setLog.ID,
+			Actual:           actual,
+			Target:           previous.target,
+			IsLong:           domain.IsLongRest(actual, previous.target.V),
+		}
+		result.Intervals = append(result.Intervals, interval)
+
+		result.TotalRest += actual
+		if interval.IsLong {
+			result.LongGaps++
+		}
+		if interval.Target.IsValid {
+			totalTarget += interval.Target.V
+			targets++
+		}
+	}
+
+	if len(result.Intervals) > 0 {
+		result.AverageRest = result.TotalRest / time.Duration(len(result.Intervals))
+	}
+	if targets > 0 {
+		result.AverageTargetRest = totalTarget / time.Duration(targets)
+	}
+
+	return result
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+)
+
+func TestRestAnalytics(t *testing.T) {
+	start := time.Date(2024, time.May, 1, 18, 0, 0, 0, time.UTC)
+
+	setLog := func(exerciseLogID domain.ID, startedAt, completedAt, duration time.Duration) domain.ExerciseSetLog {
+		s := domain.NewExerciseSetLog(exerciseLogID, 10, 60, duration)
+		if startedAt > 0 {
+			s.StartedAt = start.Add(startedAt)
+		}
+		s.CompletedAt = start.Add(completedAt)
+		return s
+	}
+	expectedSet := func(rest time.Duration) domain.ExpectedSet {
+		return domain.ExpectedSet{Reps: 10, Rest: rest}
+	}
+
+	// A superset: the sets of two exercises are done alternately
+	first, second := domain.NewID(), domain.NewID()
+	a1 := setLog(first, 30*time.Second, time.Minute, 0)
+	a2 := setLog(first, 0, 10*time.Minute, 0)
+	b1 := setLog(second, 2*time.Minute, 3*time.Minute, 0)
+	b2 := setLog(second, 0, 13*time.Minute, 30*time.Second)
+	// Started before a1 was completed
+	overlapping := setLog(first, 50*time.Second, 2*time.Minute, 0)
+
+	tests := []struct {
+		name         string
+		exerciseLogs []dto.ExerciseLogDTO
+		want         dto.RestAnalyticsDTO
+	}{
+		{
+			name: "sets of grouped exercises in completion order",
+			exerciseLogs: []dto.ExerciseLogDTO{
+				{
+					SetLogs:      []domain.ExerciseSetLog{a1, a2},
+					ExpectedSets: []domain.ExpectedSet{expectedSet(90 * time.Second), expectedSet(90 * time.Second)},
+				},
+				{
+					SetLogs: []domain.ExerciseSetLog{b1, b2},
+				},
+			},
+			want: dto.RestAnalyticsDTO{
+				Intervals: []dto.RestIntervalDTO{
+					{PreviousSetLogID: a1.ID, SetLogID: b1.ID, Actual: time.Minute, IsLong: false},
+					// Without a target only rests over five minutes are long
+					{PreviousSetLogID: b1.ID, SetLogID: a2.ID, Actual: 7 * time.Minute, IsLong: true},
+					// The set without a start began its duration before completion
+					{PreviousSetLogID: a2.ID, SetLogID: b2.ID, Actual: 150 * time.Second, IsLong: false},
+				},
+				TotalRest:         630 * time.Second,
+				AverageRest:       210 * time.Second,
+				AverageTargetRest: 90 * time.Second,
+				LongGaps:          1,
+			},
+		},
+		{
+			name: "set started before the previous one completed",
+			exerciseLogs: []dto.ExerciseLogDTO{
+				{
+					SetLogs:      []domain.ExerciseSetLog{a1, overlapping},
+					ExpectedSets: []domain.ExpectedSet{expectedSet(10 * time.Second)},
+				},
+			},
+			want: dto.RestAnalyticsDTO{
+				Intervals:         []dto.RestIntervalDTO{{PreviousSetLogID: a1.ID, SetLogID: overlapping.ID, Actual: 0}},
+				AverageTargetRest: 10 * time.Second,
+			},
+		},
+		{
+			name: "single set",
+			exerciseLogs: []dto.ExerciseLogDTO{
+				{SetLogs: []domain.ExerciseSetLog{a1}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := restAnalytics(tt.exerciseLogs)
+
+			if len(got.Intervals) != len(tt.want.Intervals) {
+				t.Fatalf("got %d intervals, want %d", len(got.Intervals), len(tt.want.Intervals))
+			}
+			for i, want := range tt.want.Intervals {
+				interval := got.Intervals[i]
+				if interval.PreviousSetLogID != want.PreviousSetLogID || interval.SetLogID != want.SetLogID {
+					t.Errorf("interval %d is between %s and %s, want %s and %s", i, interval.PreviousSetLogID, interval.SetLogID, want.PreviousSetLogID, want.SetLogID)
+				}
+				if interval.Actual != want.Actual || interval.IsLong != want.IsLong {
+					t.Errorf("interval %d = %s (long %v), want %s (long %v)", i, interval.Actual, interval.IsLong, want.Actual, want.IsLong)
+				}
+			}
+
+			if got.TotalRest != tt.want.TotalRest || got.AverageRest != tt.want.AverageRest || got.AverageTargetRest != tt.want.AverageTargetRest || got.LongGaps != tt.want.LongGaps {
+				t.Errorf("total %s, average %s, average target %s, long gaps %d, want %s, %s, %s, %d",
+					got.TotalRest, got.AverageRest, got.AverageTargetRest, got.LongGaps,
+					tt.want.TotalRest, tt.want.AverageRest, tt.want.AverageTargetRest, tt.want.LongGaps)
+			}
+		})
+	}
+}
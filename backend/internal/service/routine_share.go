@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+const routineShareTokenBytes = 24
+
+// CreateRoutineShareLink creates a link to a read-only view of the routine, a zero
+// expiresAt makes the link permanent.
+func (s *Service) CreateRoutineShareLink(ctx context.Context, userID, routineID domain.ID, expiresAt time.Time) (domain.RoutineShareLink, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.CreateRoutineShareLink")
+	defer span.Finish()
+
+	if _, err := s.getOwnRoutine(ctx, userID, routineID); err != nil {
+		return domain.RoutineShareLink{}, err
+	}
+
+	if !expiresAt.IsZero() && !expiresAt.After(time.Now()) {
+		return domain.RoutineShareLink{}, fmt.Errorf("%w: expiration time must be in the future", domain.ErrInvalidArgument)
+	}
+
+	token, err := utils.GenerateToken(routineShareTokenBytes)
+	if err != nil {
+		logger.Errorf("failed to generate share token: %v", err)
+		return domain.RoutineShareLink{}, domain.ErrInternal
+	}
+
+	return s.routineRepository.CreateRoutineShareLink(ctx, domain.NewRoutineShareLink(routineID, token, expiresAt))
+}
+
+func (s *Service) GetRoutineShareLinks(ctx context.Context, userID, routineID domain.ID) ([]domain.RoutineShareLink, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetRoutineShareLinks")
+	defer span.Finish()
+
+	if _, err := s.getOwnRoutine(ctx, userID, routineID); err != nil {
+		return nil, err
+	}
+
+	return s.routineRepository.GetRoutineShareLinks(ctx, routineID)
+}
+
+func (s *Service) DeleteRoutineShareLink(ctx context.Context, userID, routineID, shareLinkID domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.DeleteRoutineShareLink")
+	defer span.Finish()
+
+	if _, err := s.getOwnRoutine(ctx, userID, routineID); err != nil {
+		return err
+	}
+
+	return s.routineRepository.DeleteRoutineShareLink(ctx, routineID, shareLinkID)
+}
+
+// getSharedRoutineID resolves a share link token, expired links are treated as missing.
+func (s *Service) getSharedRoutineID(ctx context.Context, token string) (domain.ID, error) {
+	link, err := s.routineRepository.GetRoutineShareLinkByToken(ctx, token)
+	if err != nil {
+		return domain.ID{}, err
+	}
+
+	if link.IsExpired(time.Now()) {
+		logger.Infof("routine share link %s is expired", link.ID)
+		return domain.ID{}, domain.ErrNotFound
+	}
+
+	return link.RoutineID, nil
+}
+
+// GetSharedRoutine returns the routine behind a share link.
+func (s *Service) GetSharedRoutine(ctx context.Context, token string) (dto.RoutineDetailsDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetSharedRoutine")
+	defer span.Finish()
+
+	routineID, err := s.getSharedRoutineID(ctx, token)
+	if err != nil {
+		return dto.RoutineDetailsDTO{}, err
+	}
+
+	return s.GetRoutineByID(ctx, routineID)
+}
+
+// ImportRoutine copies the routine behind a share link into the account of the user.
+// Shared exercises of the author become available to the user, private ones are copied
+// as custom exercises of the user.
+func (s *Service) ImportRoutine(ctx context.Context, userID domain.ID, token string) (domain.Routine, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.ImportRoutine")
+	defer span.Finish()
+
+	routineID, err := s.getSharedRoutineID(ctx, token)
+	if err != nil {
+		return domain.Routine{}, err
+	}
+
+	snapshot, err := s.snapshotRoutine(ctx, routineID)
+	if err != nil {
+		return domain.Routine{}, err
+	}
+
+	var routine domain.Routine
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) (err error) {
+		exerciseIDs := make(map[domain.ID]domain.ID)
+		for i, exercise := range snapshot.Exercises {
+			if _, ok := exerciseIDs[exercise.ExerciseID]; !ok {
+				exerciseIDs[exercise.ExerciseID], err = s.importExercise(ctx, userID, exercise.ExerciseID)
+				if err != nil {
+					return err
+				}
+			}
+			snapshot.Exercises[i].ExerciseID = exerciseIDs[exercise.ExerciseID]
+		}
+
+		routine, err = s.createRoutineFromSnapshot(ctx, userID, snapshot)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.recordRoutineVersion(ctx, routine.ID)
+		return err
+	})
+	if err != nil {
+		return domain.Routine{}, err
+	}
+
+	return routine, nil
+}
+
+// importExercise returns the ID of the exercise the user can use in place of the exercise
+// of an imported routine.
+func (s *Service) importExercise(ctx context.Context, userID, exerciseID domain.ID) (domain.ID, error) {
+	exercise, err := s.exerciseRepository.GetExerciseByID(ctx, exerciseID)
+	if err != nil {
+		return domain.ID{}, err
+	}
+
+	visible, err := s.isExerciseVisible(ctx, userID, exercise)
+	if err != nil {
+		return domain.ID{}, err
+	}
+
+	switch {
+	case visible:
+		return exercise.ID, nil
+	case exercise.Visibility == domain.ExerciseVisibilityShared:
+		if err := s.exerciseRepository.CreateExerciseShare(ctx, exercise.ID, userID); err != nil {
+			return domain.ID{}, err
+		}
+		return exercise.ID, nil
+	}
+
+	copied := domain.NewExercise(exercise.Name, exercise.Description, exercise.VideoURL, exercise.TargetMuscleGroups)
+	copied.OwnerID = utils.NewNullable(userID, true)
+	copied.Visibility = domain.ExerciseVisibilityPrivate
+	copied.Equipment = exercise.Equipment
+	copied.Aliases = exercise.Aliases
+	copied.MuscleGroups = exercise.MuscleGroups
+
+	copied, err = s.exerciseRepository.CreateExercise(ctx, copied)
+	if err != nil {
+		return domain.ID{}, err
+	}
+
+	if err := s.recordExerciseChange(ctx, userID, domain.ExerciseAuditActionCreated, domain.Exercise{}, copied); err != nil {
+		return domain.ID{}, err
+	}
+
+	return copied.ID, nil
+}
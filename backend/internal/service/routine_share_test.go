@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/utils"
+)
+
+func TestImportExercise(t *testing.T) {
+	userID, authorID := domain.NewID(), domain.NewID()
+
+	tests := []struct {
+		name        string
+		visibility  domain.ExerciseVisibility
+		ownerID     domain.ID
+		alreadyUsed bool
+		wantSame    bool
+		wantShared  bool
+	}{
+		{
+			name:       "global exercise",
+			visibility: domain.ExerciseVisibilityGlobal,
+			wantSame:   true,
+		},
+		{
+			name:       "own exercise",
+			visibility: domain.ExerciseVisibilityPrivate,
+			ownerID:    userID,
+			wantSame:   true,
+		},
+		{
+			name:       "shared exercise of the author",
+			visibility: domain.ExerciseVisibilityShared,
+			ownerID:    authorID,
+			wantSame:   true,
+			wantShared: true,
+		},
+		{
+			name:        "shared exercise the user already has",
+			visibility:  domain.ExerciseVisibilityShared,
+			ownerID:     authorID,
+			alreadyUsed: true,
+			wantSame:    true,
+			wantShared:  true,
+		},
+		{
+			name:       "private exercise of the author is copied",
+			visibility: domain.ExerciseVisibilityPrivate,
+			ownerID:    authorID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exercise := domain.NewExercise("Landmine Press", "Press the end of a barbell", "", nil)
+			exercise.Visibility = tt.visibility
+			if tt.ownerID != (domain.ID{}) {
+				exercise.OwnerID = utils.NewNullable(tt.ownerID, true)
+			}
+			exercise.Equipment = domain.EquipmentBarbell
+			exercise.Aliases = []domain.ExerciseAlias{{Language: "ru", Alias: "Жим лендмайн"}}
+
+			repository := &fakeExerciseRepository{
+				exercises: map[domain.ID]domain.Exercise{exercise.ID: exercise},
+				shared:    map[domain.ID]bool{exercise.ID: tt.alreadyUsed},
+			}
+			s := &Service{exerciseRepository: repository}
+
+			id, err := s.importExercise(context.Background(), userID, exercise.ID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if repository.shared[exercise.ID] != tt.wantShared {
+				t.Errorf("shared with the user = %v, want %v", repository.shared[exercise.ID], tt.wantShared)
+			}
+
+			if tt.wantSame {
+				if id != exercise.ID {
+					t.Errorf("exercise = %s, want %s", id, exercise.ID)
+				}
+				return
+			}
+
+			copied, ok := repository.exercises[id]
+			if id == exercise.ID || !ok {
+				t.Fatalf("exercise %s was not copied", exercise.ID)
+			}
+			if !copied.IsOwnedBy(userID) || copied.Visibility != domain.ExerciseVisibilityPrivate {
+				t.Errorf("copy owned by %v with visibility %s, want a private exercise of the user", copied.OwnerID, copied.Visibility)
+			}
+			if copied.Name != exercise.Name || copied.Equipment != exercise.Equipment || len(copied.Aliases) != len(exercise.Aliases) {
+				t.Errorf("copy = %+v, want the content of %+v", copied, exercise)
+			}
+		})
+	}
+}
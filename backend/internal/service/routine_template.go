@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (s *Service) GetRoutineTemplates(ctx context.Context, filter dto.RoutineTemplatesFilterDTO) ([]domain.Routine, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetRoutineTemplates")
+	defer span.Finish()
+
+	return s.routineRepository.GetRoutineTemplates(ctx, filter)
+}
+
+// CloneRoutineTemplate copies the template into the routines of the user.
+func (s *Service) CloneRoutineTemplate(ctx context.Context, userID, templateID domain.ID, name utils.Nullable[string]) (domain.Routine, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.CloneRoutineTemplate")
+	defer span.Finish()
+
+	template, err := s.routineRepository.GetRoutineByID(ctx, templateID)
+	if err != nil {
+		return domain.Routine{}, err
+	}
+
+	if !template.IsTemplate() {
+		logger.Errorf("routine %s is not a template", templateID)
+		return domain.Routine{}, domain.ErrNotFound
+	}
+
+	snapshot, err := s.snapshotRoutine(ctx, templateID)
+	if err != nil {
+		return domain.Routine{}, err
+	}
+
+	preferences, err := s.GetUserPreferences(ctx, userID)
+	if err != nil {
+		return domain.Routine{}, err
+	}
+
+	// The copy is named in the language of the user
+	localized := template.Localized(preferences.Locale)
+	snapshot.Name, snapshot.Description = localized.Name, localized.Description
+	if name.IsValid {
+		snapshot.Name = name.V
+	}
+
+	var routine domain.Routine
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) (err error) {
+		routine, err = s.createRoutineFromSnapshot(ctx, userID, snapshot)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.recordRoutineVersion(ctx, routine.ID)
+		return err
+	})
+	if err != nil {
+		return domain.Routine{}, err
+	}
+
+	return routine, nil
+}
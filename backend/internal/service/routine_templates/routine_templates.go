@@ -0,0 +1,290 @@
+package routine_templates_service
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// seeds are the template programs, bump the version of a file to reload its templates
+//
+//go:embed seeds/*.json
+var seeds embed.FS
+
+type Repository interface {
+	GetRoutineTemplateByKey(ctx context.Context, key string) (domain.Routine, error)
+	CreateRoutine(ctx context.Context, routine domain.Routine) (domain.Routine, error)
+	UpdateRoutine(ctx context.Context, id domain.ID, routine domain.Routine) (domain.Routine, error)
+	GetExerciseInstancesByRoutineID(ctx context.Context, routineID domain.ID) ([]domain.ExerciseInstance, error)
+	CreateExerciseInstance(ctx context.Context, exerciseInstance domain.ExerciseInstance) (domain.ExerciseInstance, error)
+	DeleteExerciseInstance(ctx context.Context, id domain.ID) error
+	SetExerciseOrder(ctx context.Context, routineID domain.ID, exerciseInstanceIDs []domain.ID) error
+	CreateSet(ctx context.Context, set domain.Set) (domain.Set, error)
+	GetGlobalExerciseIDByName(ctx context.Context, name string) (domain.ID, error)
+}
+
+type UnitOfWork interface {
+	InTransaction(ctx context.Context, f func(ctx context.Context) error) error
+}
+
+type programSeed struct {
+	Program   string         `json:"program"`
+	Version   int            `json:"version"`
+	Templates []templateSeed `json:"templates"`
+}
+
+type templateSeed struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Translations are the name and the description in other locales
+	Translations map[string]translationSeed `json:"translations"`
+	WarmupSteps  []domain.WarmupStep        `json:"warmup_steps"`
+	Exercises    []exerciseSeed             `json:"exercises"`
+}
+
+type translationSeed struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type exerciseSeed struct {
+	// Exercise is the name or an alias of an exercise of the global catalog
+	Exercise string    `json:"exercise"`
+	Sets     []setSeed `json:"sets"`
+}
+
+type setSeed struct {
+	Type string `json:"type"`
+	Reps int    `json:"reps"`
+	Time string `json:"time"`
+	Rest string `json:"rest"`
+	// Count repeats the set, one set if empty
+	Count int `json:"count"`
+}
+
+// Service loads the template library from the seed files.
+type Service struct {
+	repository Repository
+	unitOfWork UnitOfWork
+}
+
+func New(repository Repository, unitOfWork UnitOfWork) *Service {
+	return &Service{
+		repository: repository,
+		unitOfWork: unitOfWork,
+	}
+}
+
+// Seed creates templates of the seed files and replaces the content of templates whose
+// version is older than the version of their file. Templates referencing exercises which
+// are missing from the catalog are reported as failed.
+func (s *Service) Seed(ctx context.Context) (dto.RoutineTemplatesSeedReportDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "routine_templates_service.Seed")
+	defer span.Finish()
+
+	programs, err := loadSeeds()
+	if err != nil {
+		return dto.RoutineTemplatesSeedReportDTO{}, err
+	}
+
+	report := dto.RoutineTemplatesSeedReportDTO{Failed: map[string]string{}}
+	for _, program := range programs {
+		for _, template := range program.Templates {
+			existing, err := s.repository.GetRoutineTemplateByKey(ctx, template.Key)
+			switch {
+			case errors.Is(err, domain.ErrNotFound):
+			case err != nil:
+				return dto.RoutineTemplatesSeedReportDTO{}, err
+			case existing.TemplateVersion >= program.Version:
+				report.Unchanged++
+				continue
+			}
+
+			err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+				return s.saveTemplate(ctx, existing, program, template)
+			})
+			if err != nil {
+				logger.Errorf("failed to seed routine template %s: %v", template.Key, err)
+				report.Failed[template.Key] = err.Error()
+				continue
+			}
+
+			if existing.IsTemplate() {
+				report.Updated++
+			} else {
+				report.Created++
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// saveTemplate creates the template or replaces the content of the existing one.
+func (s *Service) saveTemplate(ctx context.Context, existing domain.Routine, program programSeed, template templateSeed) error {
+	routine := existing
+	if !existing.IsTemplate() {
+		routine = domain.NewRoutine(domain.ID{}, template.Name, template.Description)
+		routine.TemplateKey = template.Key
+	}
+	routine.Name = template.Name
+	routine.Description = template.Description
+	routine.WarmupSteps = template.WarmupSteps
+	routine.Program = program.Program
+	routine.TemplateVersion = program.Version
+	routine.Translations = make(map[string]domain.RoutineTranslation, len(template.Translations))
+	for locale, translation := range template.Translations {
+		routine.Translations[locale] = domain.RoutineTranslation{Name: translation.Name, Description: translation.Description}
+	}
+
+	var err error
+	if existing.IsTemplate() {
+		routine, err = s.repository.UpdateRoutine(ctx, routine.ID, routine)
+		if err != nil {
+			return err
+		}
+
+		instances, err := s.repository.GetExerciseInstancesByRoutineID(ctx, routine.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, instance := range instances {
+			if err := s.repository.DeleteExerciseInstance(ctx, instance.ID); err != nil {
+				return err
+			}
+		}
+	} else {
+		routine, err = s.repository.CreateRoutine(ctx, routine)
+		if err != nil {
+			return err
+		}
+	}
+
+	instanceIDs := make([]domain.ID, 0, len(template.Exercises))
+	for _, exercise := range template.Exercises {
+		exerciseID, err := s.repository.GetGlobalExerciseIDByName(ctx, exercise.Exercise)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return fmt.Errorf("exercise %q is not in the global catalog", exercise.Exercise)
+			}
+			return err
+		}
+
+		instance, err := s.repository.CreateExerciseInstance(ctx, domain.NewExerciseInstance(routine.ID, exerciseID))
+		if err != nil {
+			return err
+		}
+		instanceIDs = append(instanceIDs, instance.ID)
+
+		position := 0
+		for _, seed := range exercise.Sets {
+			for range max(seed.Count, 1) {
+				set, err := seed.toDomain(instance.ID)
+				if err != nil {
+					return err
+				}
+				set.Position = position
+				position++
+
+				if _, err := s.repository.CreateSet(ctx, set); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if len(instanceIDs) == 0 {
+		return nil
+	}
+
+	return s.repository.SetExerciseOrder(ctx, routine.ID, instanceIDs)
+}
+
+func (s setSeed) toDomain(exerciseInstanceID domain.ID) (domain.Set, error) {
+	setType, err := domain.NewSetType(s.Type)
+	if err != nil {
+		return domain.Set{}, err
+	}
+
+	var setTime, rest time.Duration
+	if s.Time != "" {
+		if setTime, err = time.ParseDuration(s.Time); err != nil {
+			return domain.Set{}, err
+		}
+	}
+	if s.Rest != "" {
+		if rest, err = time.ParseDuration(s.Rest); err != nil {
+			return domain.Set{}, err
+		}
+	}
+
+	set := domain.NewSet(exerciseInstanceID, setType, s.Reps, 0, setTime)
+	set.Rest = rest
+
+	return set, nil
+}
+
+// loadSeeds reads and checks the seed files in the order of their names.
+func loadSeeds() ([]programSeed, error) {
+	paths, err := fs.Glob(seeds, "seeds/*.json")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	keys := make(map[string]string)
+	programs := make([]programSeed, 0, len(paths))
+	for _, path := range paths {
+		data, err := seeds.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var program programSeed
+		if err := json.Unmarshal(data, &program); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+
+		if program.Program == "" || program.Version <= 0 {
+			return nil, fmt.Errorf("%s: program and a positive version are required", path)
+		}
+
+		for _, template := range program.Templates {
+			if template.Key == "" || template.Name == "" {
+				return nil, fmt.Errorf("%s: templates must have a key and a name", path)
+			}
+
+			if other, ok := keys[template.Key]; ok {
+				return nil, fmt.Errorf("%s: template %s is already defined in %s", path, template.Key, other)
+			}
+			keys[template.Key] = path
+
+			for locale, translation := range template.Translations {
+				if !domain.IsSupportedLocale(locale) || translation.Name == "" {
+					return nil, fmt.Errorf("%s: template %s: translations must have a supported locale and a name", path, template.Key)
+				}
+			}
+
+			if err := domain.ValidateWarmupSteps(template.WarmupSteps); err != nil {
+				return nil, fmt.Errorf("%s: template %s: %w", path, template.Key, err)
+			}
+		}
+
+		programs = append(programs, program)
+	}
+
+	return programs, nil
+}
@@ -0,0 +1,99 @@
+package routine_templates_service
+
+import (
+	"testing"
+	"time"
+
+	"fitness-trainer/internal/domain"
+)
+
+// TestLoadSeeds checks the shipped seed files, a broken file would only fail at seeding.
+func TestLoadSeeds(t *testing.T) {
+	programs, err := loadSeeds()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(programs) == 0 {
+		t.Fatal("no seed files")
+	}
+
+	for _, program := range programs {
+		for _, template := range program.Templates {
+			if len(template.Exercises) == 0 {
+				t.Errorf("template %s has no exercises", template.Key)
+			}
+
+			for _, exercise := range template.Exercises {
+				if exercise.Exercise == "" || len(exercise.Sets) == 0 {
+					t.Errorf("template %s: exercise %q must have a name and sets", template.Key, exercise.Exercise)
+				}
+
+				for _, seed := range exercise.Sets {
+					set, err := seed.toDomain(domain.NewID())
+					if err != nil {
+						t.Errorf("template %s: exercise %s: %v", template.Key, exercise.Exercise, err)
+						continue
+					}
+
+					if set.Reps <= 0 && set.Time <= 0 {
+						t.Errorf("template %s: exercise %s: set must have reps or time", template.Key, exercise.Exercise)
+					}
+					if seed.Count < 0 {
+						t.Errorf("template %s: exercise %s: negative set count", template.Key, exercise.Exercise)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSetSeedToDomain(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     setSeed
+		wantType domain.SetType
+		wantTime time.Duration
+		wantRest time.Duration
+		wantErr  bool
+	}{
+		{
+			name:     "weight set",
+			seed:     setSeed{Type: "weight", Reps: 5, Rest: "3m"},
+			wantType: domain.SetTypeWeight,
+			wantRest: 3 * time.Minute,
+		},
+		{
+			name:     "time set",
+			seed:     setSeed{Type: "time", Time: "45s", Rest: "1m30s"},
+			wantType: domain.SetTypeTime,
+			wantTime: 45 * time.Second,
+			wantRest: 90 * time.Second,
+		},
+		{
+			name:     "set without rest",
+			seed:     setSeed{Type: "reps", Reps: 12},
+			wantType: domain.SetTypeReps,
+		},
+		{name: "unknown type", seed: setSeed{Type: "amrap", Reps: 5}, wantErr: true},
+		{name: "malformed time", seed: setSeed{Type: "time", Time: "45"}, wantErr: true},
+		{name: "malformed rest", seed: setSeed{Type: "weight", Reps: 5, Rest: "three minutes"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, err := tt.seed.toDomain(domain.NewID())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if set.SetType != tt.wantType || set.Reps != tt.seed.Reps || set.Time != tt.wantTime || set.Rest != tt.wantRest {
+				t.Errorf("set = %s of %d reps, time %s, rest %s, want %s of %d reps, time %s, rest %s",
+					set.SetType, set.Reps, set.Time, set.Rest, tt.wantType, tt.seed.Reps, tt.wantTime, tt.wantRest)
+			}
+		})
+	}
+}
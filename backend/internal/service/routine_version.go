@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// editRoutine applies an edit to the routine and records the resulting version in the
+// same transaction. Routines created before versioning get their state before the edit
+// recorded first.
+func (s *Service) editRoutine(ctx context.Context, routineID domain.ID, edit func(ctx context.Context) error) error {
+	return s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.recordRoutineVersion(ctx, routineID); err != nil {
+			return err
+		}
+
+		if err := edit(ctx); err != nil {
+			return err
+		}
+
+		_, err := s.recordRoutineVersion(ctx, routineID)
+		return err
+	})
+}
+
+// recordRoutineVersion stores the current state of the routine as a new version, the latest
+// version is returned as is if nothing changed since it was recorded.
+func (s *Service) recordRoutineVersion(ctx context.Context, routineID domain.ID) (domain.RoutineVersion, error) {
+	snapshot, err := s.snapshotRoutine(ctx, routineID)
+	if err != nil {
+		return domain.RoutineVersion{}, err
+	}
+
+	latest, err := s.routineRepository.GetLatestRoutineVersion(ctx, routineID)
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+	case err != nil:
+		return domain.RoutineVersion{}, err
+	case domain.DiffRoutineSnapshots(latest.Snapshot, snapshot).IsEmpty():
+		return latest, nil
+	}
+
+	return s.routineRepository.CreateRoutineVersion(ctx, domain.NewRoutineVersion(routineID, latest.Version+1, snapshot))
+}
+
+func (s *Service) snapshotRoutine(ctx context.Context, routineID domain.ID) (domain.RoutineSnapshot, error) {
+	routine, err := s.routineRepository.GetRoutineByID(ctx, routineID)
+	if err != nil {
+		return domain.RoutineSnapshot{}, err
+	}
+
+	instances, err := s.exerciseInstanceRepository.GetExerciseInstancesByRoutineID(ctx, routineID)
+	if err != nil {
+		return domain.RoutineSnapshot{}, err
+	}
+
+	snapshot := domain.RoutineSnapshot{
+		Name:        routine.Name,
+		Description: routine.Description,
+		WarmupSteps: routine.WarmupSteps,
+		Exercises:   make([]domain.RoutineSnapshotExercise, 0, len(instances)),
+	}
+
+	for _, instance := range instances {
+		sets, err := s.setRepository.GetSetsByExerciseInstanceID(ctx, instance.ID)
+		if err != nil {
+			return domain.RoutineSnapshot{}, err
+		}
+
+		snapshot.Exercises = append(snapshot.Exercises, domain.RoutineSnapshotExercise{
+			ExerciseInstanceID: instance.ID,
+			ExerciseID:         instance.ExerciseID,
+			Group:              instance.Group,
+			Sets:               sets,
+		})
+	}
+
+	return snapshot, nil
+}
+
+func (s *Service) getOwnRoutine(ctx context.Context, userID, routineID domain.ID) (domain.Routine, error) {
+	routine, err := s.routineRepository.GetRoutineByID(ctx, routineID)
+	if err != nil {
+		return domain.Routine{}, err
+	}
+
+	if routine.UserID != userID {
+		logger.Errorf("user %s tried to access routine %s", userID, routineID)
+		return domain.Routine{}, domain.ErrNotFound
+	}
+
+	return routine, nil
+}
+
+func (s *Service) GetRoutineVersions(ctx context.Context, userID, routineID domain.ID) ([]domain.RoutineVersion, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetRoutineVersions")
+	defer span.Finish()
+
+	if _, err := s.getOwnRoutine(ctx, userID, routineID); err != nil {
+		return nil, err
+	}
+
+	return s.routineRepository.GetRoutineVersions(ctx, routineID)
+}
+
+// GetRoutineVersion returns a version of a routine of the user or of a routine the user
+// started a workout from, which may be a template or deleted since.
+func (s *Service) GetRoutineVersion(ctx context.Context, userID, versionID domain.ID) (domain.RoutineVersion, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetRoutineVersion")
+	defer span.Finish()
+
+	version, err := s.routineRepository.GetRoutineVersionByID(ctx, versionID)
+	if err != nil {
+		return domain.RoutineVersion{}, err
+	}
+
+	if version.RoutineID.IsValid {
+		routine, err := s.routineRepository.GetRoutineByID(ctx, version.RoutineID.V)
+		if err != nil {
+			return domain.RoutineVersion{}, err
+		}
+
+		if routine.UserID == userID {
+			return version, nil
+		}
+	}
+
+	started, err := s.workoutRepository.HasWorkoutWithRoutineVersion(ctx, userID, versionID)
+	if err != nil {
+		return domain.RoutineVersion{}, err
+	}
+
+	if !started {
+		logger.Errorf("user %s tried to access routine version %s", userID, versionID)
+		return domain.RoutineVersion{}, domain.ErrNotFound
+	}
+
+	return version, nil
+}
+
+// DiffRoutineVersions returns what changed in the routine from one version to another.
+func (s *Service) DiffRoutineVersions(ctx context.Context, userID, routineID domain.ID, fromVersion, toVersion int) (domain.RoutineDiff, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.DiffRoutineVersions")
+	defer span.Finish()
+
+	if _, err := s.getOwnRoutine(ctx, userID, routineID); err != nil {
+		return domain.RoutineDiff{}, err
+	}
+
+	from, err := s.routineRepository.GetRoutineVersion(ctx, routineID, fromVersion)
+	if err != nil {
+		return domain.RoutineDiff{}, err
+	}
+
+	to, err := s.routineRepository.GetRoutineVersion(ctx, routineID, toVersion)
+	if err != nil {
+		return domain.RoutineDiff{}, err
+	}
+
+	return domain.DiffRoutineSnapshots(from.Snapshot, to.Snapshot), nil
+}
+
+// DuplicateRoutine creates a new routine of the user with the exercises, sets and groups of
+// the routine or of one of its versions.
+func (s *Service) DuplicateRoutine(ctx context.Context, userID, routineID domain.ID, input dto.DuplicateRoutineDTO) (domain.Routine, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.DuplicateRoutine")
+	defer span.Finish()
+
+	if _, err := s.getOwnRoutine(ctx, userID, routineID); err != nil {
+		return domain.Routine{}, err
+	}
+
+	var snapshot domain.RoutineSnapshot
+	if input.Version.IsValid {
+		version, err := s.routineRepository.GetRoutineVersion(ctx, routineID, input.Version.V)
+		if err != nil {
+			return domain.Routine{}, err
+		}
+		snapshot = version.Snapshot
+	} else {
+		var err error
+		snapshot, err = s.snapshotRoutine(ctx, routineID)
+		if err != nil {
+			return domain.Routine{}, err
+		}
+	}
+
+	if input.Name.IsValid {
+		snapshot.Name = input.Name.V
+	}
+
+	var routine domain.Routine
+	err := s.unitOfWork.InTransaction(ctx, func(ctx context.Context) (err error) {
+		routine, err = s.createRoutineFromSnapshot(ctx, userID, snapshot)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.recordRoutineVersion(ctx, routine.ID)
+		return err
+	})
+	if err != nil {
+		return domain.Routine{}, err
+	}
+
+	return routine, nil
+}
+
+// createRoutineFromSnapshot creates a routine with the content of the snapshot, exercise
+// instances, sets and groups get new IDs.
+func (s *Service) createRoutineFromSnapshot(ctx context.Context, userID domain.ID, snapshot domain.RoutineSnapshot) (domain.Routine, error) {
+	routine := domain.NewRoutine(userID, snapshot.Name, snapshot.Description)
+	routine.WarmupSteps = snapshot.WarmupSteps
+
+	routine, err := s.routineRepository.CreateRoutine(ctx, routine)
+	if err != nil {
+		return domain.Routine{}, err
+	}
+
+	groupIDs := make(map[domain.ID]domain.ID)
+	instanceIDs := make([]domain.ID, 0, len(snapshot.Exercises))
+
+	for _, exercise := range snapshot.Exercises {
+		instance := domain.NewExerciseInstance(routine.ID, exercise.ExerciseID)
+		if exercise.Group.IsValid {
+			group := exercise.Group.V
+			if _, ok := groupIDs[group.ID]; !ok {
+				groupIDs[group.ID] = domain.NewID()
+			}
+			group.ID = groupIDs[group.ID]
+			instance.Group = utils.NewNullable(group, true)
+		}
+
+		instance, err := s.exerciseInstanceRepository.CreateExerciseInstance(ctx, instance)
+		if err != nil {
+			return domain.Routine{}, err
+		}
+		instanceIDs = append(instanceIDs, instance.ID)
+
+		for i, set := range exercise.Sets {
+			copied := domain.NewSet(instance.ID, set.SetType, set.Reps, set.Weight, set.Time)
+			copied.Rest = set.Rest
+			copied.Position = i
+
+			if _, err := s.setRepository.CreateSet(ctx, copied); err != nil {
+				return domain.Routine{}, err
+			}
+		}
+	}
+
+	if len(instanceIDs) > 0 {
+		if err := s.exerciseInstanceRepository.SetExerciseOrder(ctx, routine.ID, instanceIDs); err != nil {
+			return domain.Routine{}, err
+		}
+	}
+
+	return routine, nil
+}
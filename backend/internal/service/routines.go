@@ -5,6 +5,7 @@ import (
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/domain/dto"
 	"fitness-trainer/internal/logger"
+	"fmt"
 
 	"github.com/opentracing/opentracing-go"
 )
@@ -26,7 +27,12 @@ func (s *Service) CreateRoutine(ctx context.Context, dto dto.CreateRoutineDTO) (
 	}
 	defer s.unitOfWork.Rollback(ctx)
 
+	if err := domain.ValidateWarmupSteps(dto.WarmupSteps); err != nil {
+		return domain.Routine{}, err
+	}
+
 	routine := domain.NewRoutine(dto.UserID, dto.Name, dto.Description)
+	routine.WarmupSteps = dto.WarmupSteps
 
 	routine, err = s.routineRepository.CreateRoutine(ctx, routine)
 	if err != nil {
@@ -40,6 +46,10 @@ func (s *Service) CreateRoutine(ctx context.Context, dto dto.CreateRoutineDTO) (
 		}
 	}
 
+	if _, err := s.recordRoutineVersion(ctx, routine.ID); err != nil {
+		return domain.Routine{}, err
+	}
+
 	if err := s.unitOfWork.Commit(ctx); err != nil {
 		return domain.Routine{}, err
 	}
@@ -77,7 +87,7 @@ func (s *Service) fillRoutineWithWorkout(ctx context.Context, routine domain.Rou
 			return err
 		}
 
-		for _, set := range sets {
+		for i, set := range sets {
 			set := domain.NewSet(
 				exerciseInstance.ID,
 				domain.SetTypeReps,
@@ -85,6 +95,7 @@ func (s *Service) fillRoutineWithWorkout(ctx context.Context, routine domain.Rou
 				set.Weight,
 				set.Time,
 			)
+			set.Position = i
 			if _, err := s.setRepository.CreateSet(ctx, set); err != nil {
 				return err
 			}
@@ -115,6 +126,7 @@ func (s *Service) GetRoutineByID(ctx context.Context, id domain.ID) (dto.Routine
 		Description:       routine.Description,
 		CreatedAt:         routine.CreatedAt,
 		UpdatedAt:         routine.UpdatedAt,
+		WarmupSteps:       routine.WarmupSteps,
 		ExerciseInstances: make([]dto.ExerciseInstanceDetailsDTO, len(exerciseInstances)),
 	}
 
@@ -133,6 +145,7 @@ func (s *Service) GetRoutineByID(ctx context.Context, id domain.ID) (dto.Routine
 			ID:         instance.ID,
 			RoutineID:  instance.RoutineID,
 			ExerciseID: instance.ExerciseID,
+			Group:      instance.Group,
 			CreatedAt:  instance.CreatedAt,
 			UpdatedAt:  instance.UpdatedAt,
 			Exercise:   exercise,
@@ -143,12 +156,39 @@ func (s *Service) GetRoutineByID(ctx context.Context, id domain.ID) (dto.Routine
 	return result, nil
 }
 
-func (s *Service) AddExerciseToRoutine(ctx context.Context, routineID domain.ID, exerciseID domain.ID) (domain.ExerciseInstance, error) {
+func (s *Service) AddExerciseToRoutine(ctx context.Context, userID, routineID, exerciseID domain.ID) (domain.ExerciseInstance, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "service.AddExerciseToRoutine")
 	defer span.Finish()
 
+	routine, err := s.routineRepository.GetRoutineByID(ctx, routineID)
+	if err != nil {
+		return domain.ExerciseInstance{}, err
+	}
+
+	if routine.UserID != userID {
+		logger.Errorf("user %s tried to add exercise to routine %s", userID, routineID)
+		return domain.ExerciseInstance{}, domain.ErrNotFound
+	}
+
+	exercise, err := s.GetExerciseByID(ctx, userID, exerciseID)
+	if err != nil {
+		return domain.ExerciseInstance{}, err
+	}
+
+	if exercise.IsArchived() {
+		return domain.ExerciseInstance{}, fmt.Errorf("%w: exercise %s is archived", domain.ErrInvalidArgument, exerciseID)
+	}
+
 	exerciseInstance := domain.NewExerciseInstance(routineID, exerciseID)
-	return s.exerciseInstanceRepository.CreateExerciseInstance(ctx, exerciseInstance)
+	err = s.editRoutine(ctx, routineID, func(ctx context.Context) (err error) {
+		exerciseInstance, err = s.exerciseInstanceRepository.CreateExerciseInstance(ctx, exerciseInstance)
+		return err
+	})
+	if err != nil {
+		return domain.ExerciseInstance{}, err
+	}
+
+	return exerciseInstance, nil
 }
 
 func (s *Service) GetExerciseInstance(ctx context.Context, userID, routineID, exerciseInstanceID domain.ID) (dto.ExerciseInstanceDetailsDTO, error) {
@@ -179,6 +219,7 @@ func (s *Service) GetExerciseInstance(ctx context.Context, userID, routineID, ex
 		ID:         exerciseInstance.ID,
 		RoutineID:  exerciseInstance.RoutineID,
 		ExerciseID: exerciseInstance.ExerciseID,
+		Group:      exerciseInstance.Group,
 		CreatedAt:  exerciseInstance.CreatedAt,
 		UpdatedAt:  exerciseInstance.UpdatedAt,
 		Exercise:   exercise,
@@ -199,13 +240,44 @@ func (s *Service) RemoveExerciseInstanceFromRoutine(ctx context.Context, userID
 		return domain.ErrUnauthorized
 	}
 
-	return s.exerciseInstanceRepository.DeleteExerciseInstance(ctx, exerciseInstanceID)
+	exerciseInstance, err := s.exerciseInstanceRepository.GetExerciseInstanceByID(ctx, exerciseInstanceID)
+	if err != nil {
+		return err
+	}
+
+	if exerciseInstance.RoutineID != routineID {
+		logger.Errorf("exercise instance %s does not belong to routine %s", exerciseInstanceID, routineID)
+		return domain.ErrNotFound
+	}
+
+	return s.editRoutine(ctx, routineID, func(ctx context.Context) error {
+		if err := s.exerciseInstanceRepository.DeleteExerciseInstance(ctx, exerciseInstanceID); err != nil {
+			return err
+		}
+
+		if !exerciseInstance.Group.IsValid {
+			return nil
+		}
+
+		return s.releaseExerciseInstanceGroup(ctx, routineID, exerciseInstance.Group.V)
+	})
 }
 
 func (s *Service) DeleteRoutine(ctx context.Context, id domain.ID) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "service.DeleteRoutine")
 	defer span.Finish()
 
+	routine, err := s.routineRepository.GetRoutineByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// templates are managed by the seed command only
+	if routine.IsTemplate() {
+		logger.Errorf("tried to delete routine template %s", id)
+		return domain.ErrNotFound
+	}
+
 	return s.routineRepository.DeleteRoutine(ctx, id)
 }
 
@@ -218,6 +290,11 @@ func (s *Service) UpdateRoutine(ctx context.Context, id domain.ID, dto dto.Updat
 		return domain.Routine{}, err
 	}
 
+	if routine.IsTemplate() {
+		logger.Errorf("tried to update routine template %s", id)
+		return domain.Routine{}, domain.ErrNotFound
+	}
+
 	if dto.Name.IsValid {
 		routine.Name = dto.Name.V
 	}
@@ -226,15 +303,82 @@ func (s *Service) UpdateRoutine(ctx context.Context, id domain.ID, dto dto.Updat
 		routine.Description = dto.Description.V
 	}
 
-	return s.routineRepository.UpdateRoutine(ctx, id, routine)
+	if dto.WarmupSteps.IsValid {
+		if err := domain.ValidateWarmupSteps(dto.WarmupSteps.V); err != nil {
+			return domain.Routine{}, err
+		}
+		routine.WarmupSteps = dto.WarmupSteps.V
+	}
+
+	err = s.editRoutine(ctx, id, func(ctx context.Context) (err error) {
+		routine, err = s.routineRepository.UpdateRoutine(ctx, id, routine)
+		return err
+	})
+	if err != nil {
+		return domain.Routine{}, err
+	}
+
+	return routine, nil
 }
 
 func (s *Service) AddSetToExerciseInstance(ctx context.Context, userID, routineID, exerciseInstanceID domain.ID, dto dto.CreateSetDTO) (domain.Set, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "service.AddSetToExerciseInstance")
 	defer span.Finish()
 
+	routine, err := s.routineRepository.GetRoutineByID(ctx, routineID)
+	if err != nil {
+		return domain.Set{}, err
+	}
+
+	if routine.UserID != userID {
+		logger.Errorf("user %s is not authorized to add set to exercise instance %s", userID, exerciseInstanceID)
+		return domain.Set{}, domain.ErrUnauthorized
+	}
+
+	exerciseInstance, err := s.exerciseInstanceRepository.GetExerciseInstanceByID(ctx, exerciseInstanceID)
+	if err != nil {
+		return domain.Set{}, err
+	}
+
+	if exerciseInstance.RoutineID != routineID {
+		logger.Errorf("exercise instance %s does not belong to routine %s", exerciseInstanceID, routineID)
+		return domain.Set{}, domain.ErrNotFound
+	}
+
+	sets, err := s.setRepository.GetSetsByExerciseInstanceID(ctx, exerciseInstanceID)
+	if err != nil {
+		return domain.Set{}, err
+	}
+
+	positions := make([]int, 0, len(sets))
+	for _, set := range sets {
+		positions = append(positions, set.Position)
+	}
+
+	index := len(sets)
+	if dto.Position.IsValid {
+		index = min(dto.Position.V, len(sets))
+	}
+
 	set := domain.NewSet(exerciseInstanceID, dto.SetType, dto.Reps.V, dto.Weight.V, dto.Time.V)
-	return s.setRepository.CreateSet(ctx, set)
+	set.Rest = dto.Rest.V
+	set.Position = insertPosition(positions, index)
+
+	err = s.editRoutine(ctx, routineID, func(ctx context.Context) error {
+		if index < len(sets) {
+			if err := s.setRepository.ShiftSetPositions(ctx, exerciseInstanceID, set.Position); err != nil {
+				return err
+			}
+		}
+
+		set, err = s.setRepository.CreateSet(ctx, set)
+		return err
+	})
+	if err != nil {
+		return domain.Set{}, err
+	}
+
+	return set, nil
 }
 
 func (s *Service) RemoveSetFromExerciseInstance(ctx context.Context, userID, routineID, exerciseInstanceID, setID domain.ID) error {
@@ -271,7 +415,9 @@ func (s *Service) RemoveSetFromExerciseInstance(ctx context.Context, userID, rou
 		return domain.ErrNotFound
 	}
 
-	return s.setRepository.DeleteSet(ctx, setID)
+	return s.editRoutine(ctx, routineID, func(ctx context.Context) error {
+		return s.setRepository.DeleteSet(ctx, setID)
+	})
 }
 
 func (s *Service) UpdateSetInExerciseInstance(ctx context.Context, userID, routineID, exerciseInstanceID, setID domain.ID, dto dto.UpdateSetDTO) (domain.Set, error) {
@@ -320,7 +466,19 @@ func (s *Service) UpdateSetInExerciseInstance(ctx context.Context, userID, routi
 		set.Time = dto.Time.V
 	}
 
-	return s.setRepository.UpdateSet(ctx, setID, set)
+	if dto.Rest.IsValid {
+		set.Rest = dto.Rest.V
+	}
+
+	err = s.editRoutine(ctx, routineID, func(ctx context.Context) (err error) {
+		set, err = s.setRepository.UpdateSet(ctx, setID, set)
+		return err
+	})
+	if err != nil {
+		return domain.Set{}, err
+	}
+
+	return set, nil
 }
 
 func (s *Service) SetExerciseOrder(ctx context.Context, userID, routineID domain.ID, exerciseInstanceIDs []domain.ID) error {
@@ -339,5 +497,50 @@ func (s *Service) SetExerciseOrder(ctx context.Context, userID, routineID domain
 
 	// TODO: check if all exercise instances belong to the routine
 
-	return s.exerciseInstanceRepository.SetExerciseOrder(ctx, routineID, exerciseInstanceIDs)
+	return s.editRoutine(ctx, routineID, func(ctx context.Context) error {
+		return s.exerciseInstanceRepository.SetExerciseOrder(ctx, routineID, exerciseInstanceIDs)
+	})
+}
+
+func (s *Service) SetSetOrder(ctx context.Context, userID, routineID, exerciseInstanceID domain.ID, setIDs []domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.SetSetOrder")
+	defer span.Finish()
+
+	routine, err := s.routineRepository.GetRoutineByID(ctx, routineID)
+	if err != nil {
+		return err
+	}
+
+	if routine.UserID != userID {
+		logger.Errorf("user %s is not authorized to update set order", userID)
+		return domain.ErrUnauthorized
+	}
+
+	exerciseInstance, err := s.exerciseInstanceRepository.GetExerciseInstanceByID(ctx, exerciseInstanceID)
+	if err != nil {
+		return err
+	}
+
+	if exerciseInstance.RoutineID != routineID {
+		logger.Errorf("exercise instance %s does not belong to routine %s", exerciseInstanceID, routineID)
+		return domain.ErrNotFound
+	}
+
+	sets, err := s.setRepository.GetSetsByExerciseInstanceID(ctx, exerciseInstanceID)
+	if err != nil {
+		return err
+	}
+
+	current := make([]domain.ID, 0, len(sets))
+	for _, set := range sets {
+		current = append(current, set.ID)
+	}
+
+	if err := checkOrder("set", current, setIDs); err != nil {
+		return err
+	}
+
+	return s.editRoutine(ctx, routineID, func(ctx context.Context) error {
+		return s.setRepository.SetSetOrder(ctx, exerciseInstanceID, setIDs)
+	})
 }
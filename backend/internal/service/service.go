@@ -6,6 +6,7 @@ import (
 
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/utils"
 )
 
 type jwtProvider interface {
@@ -32,9 +33,22 @@ type userRepository interface {
 }
 
 type exerciseRepository interface {
-	GetExercises(ctx context.Context, muscleGroups, excludedExercises []domain.ID) ([]domain.Exercise, error)
+	GetExercises(ctx context.Context, filter dto.GetExercisesDTO) (dto.ExercisesPageDTO, error)
 	GetExerciseByID(ctx context.Context, id domain.ID) (domain.Exercise, error)
-	CreateExercise(ctx context.Context, exercise domain.Exercise, miscleGroupsIDs []domain.ID) (domain.Exercise, error)
+	CreateExercise(ctx context.Context, exercise domain.Exercise) (domain.Exercise, error)
+	UpdateExercise(ctx context.Context, id domain.ID, exercise domain.Exercise) (domain.Exercise, error)
+	GetExercisesByModerationStatus(ctx context.Context, status domain.ModerationStatus) ([]domain.Exercise, error)
+	GetExerciseByShareToken(ctx context.Context, token string) (domain.Exercise, error)
+	CreateExerciseShare(ctx context.Context, exerciseID, userID domain.ID) error
+	HasExerciseShare(ctx context.Context, exerciseID, userID domain.ID) (bool, error)
+	DeleteExerciseShares(ctx context.Context, exerciseID domain.ID) error
+	ReplaceExerciseMuscleGroups(ctx context.Context, exerciseID domain.ID, muscleGroups []domain.ExerciseMuscleGroup) error
+	ReplaceExerciseAliases(ctx context.Context, exerciseID domain.ID, aliases []domain.ExerciseAlias) error
+	ReassignExerciseReferences(ctx context.Context, fromID, toID domain.ID, userID utils.Nullable[domain.ID]) ([]domain.ID, error)
+	HasExerciseReferences(ctx context.Context, exerciseID domain.ID) (bool, error)
+	CreateExerciseAuditEntry(ctx context.Context, entry domain.ExerciseAuditEntry) error
+	GetExerciseAuditLog(ctx context.Context, exerciseID domain.ID, offset, limit int) ([]domain.ExerciseAuditEntry, error)
+	MatchExerciseByName(ctx context.Context, userID domain.ID, name string) (domain.Exercise, float64, error)
 }
 
 type routineRepository interface {
@@ -43,6 +57,16 @@ type routineRepository interface {
 	GetRoutineByID(ctx context.Context, id domain.ID) (domain.Routine, error)
 	DeleteRoutine(ctx context.Context, id domain.ID) error
 	UpdateRoutine(ctx context.Context, id domain.ID, routine domain.Routine) (domain.Routine, error)
+	CreateRoutineVersion(ctx context.Context, version domain.RoutineVersion) (domain.RoutineVersion, error)
+	GetLatestRoutineVersion(ctx context.Context, routineID domain.ID) (domain.RoutineVersion, error)
+	GetRoutineVersion(ctx context.Context, routineID domain.ID, version int) (domain.RoutineVersion, error)
+	GetRoutineVersionByID(ctx context.Context, id domain.ID) (domain.RoutineVersion, error)
+	GetRoutineVersions(ctx context.Context, routineID domain.ID) ([]domain.RoutineVersion, error)
+	CreateRoutineShareLink(ctx context.Context, link domain.RoutineShareLink) (domain.RoutineShareLink, error)
+	GetRoutineShareLinks(ctx context.Context, routineID domain.ID) ([]domain.RoutineShareLink, error)
+	GetRoutineShareLinkByToken(ctx context.Context, token string) (domain.RoutineShareLink, error)
+	DeleteRoutineShareLink(ctx context.Context, routineID, id domain.ID) error
+	GetRoutineTemplates(ctx context.Context, filter dto.RoutineTemplatesFilterDTO) ([]domain.Routine, error)
 }
 
 type exerciseInstanceRepository interface {
@@ -50,6 +74,7 @@ type exerciseInstanceRepository interface {
 	GetExerciseInstancesByRoutineID(ctx context.Context, routineID domain.ID) ([]domain.ExerciseInstance, error)
 	CreateExerciseInstance(ctx context.Context, exerciseInstance domain.ExerciseInstance) (domain.ExerciseInstance, error)
 	DeleteExerciseInstance(ctx context.Context, id domain.ID) error
+	SetExerciseInstancesGroup(ctx context.Context, routineID domain.ID, exerciseInstanceIDs []domain.ID, group utils.Nullable[domain.ExerciseGroup]) error
 	SetExerciseOrder(ctx context.Context, routineID domain.ID, exerciseInstanceIDs []domain.ID) error
 }
 
@@ -60,11 +85,18 @@ type muscleGroupRepository interface {
 
 type workoutRepository interface {
 	GetWorkouts(ctx context.Context, userID domain.ID, limit, offset int) ([]domain.Workout, error)
+	GetFinishedWorkoutTimes(ctx context.Context, userID domain.ID, from, to time.Time) ([]time.Time, error)
 	CreateWorkout(ctx context.Context, workout domain.Workout) (domain.Workout, error)
 	GetWorkoutByID(ctx context.Context, id domain.ID) (domain.Workout, error)
 	GetActiveWorkouts(ctx context.Context, userID domain.ID) ([]domain.Workout, error)
 	UpdateWorkout(ctx context.Context, id domain.ID, workout domain.Workout) (domain.Workout, error)
 	DeleteWorkout(ctx context.Context, id domain.ID) error
+	HasWorkoutWithExternalID(ctx context.Context, userID domain.ID, externalID string) (bool, error)
+	HasWorkoutWithRoutineVersion(ctx context.Context, userID, routineVersionID domain.ID) (bool, error)
+	HasOverlappingWorkout(ctx context.Context, userID domain.ID, from, to time.Time) (bool, error)
+	LockUserWorkouts(ctx context.Context, userID domain.ID) error
+	CreateWorkoutAmendment(ctx context.Context, amendment domain.WorkoutAmendment) error
+	GetWorkoutAmendments(ctx context.Context, workoutID domain.ID) ([]domain.WorkoutAmendment, error)
 }
 
 type exerciseLogRepository interface {
@@ -74,6 +106,8 @@ type exerciseLogRepository interface {
 	GetExerciseLogsByExerciseIDAndUserID(ctx context.Context, exerciseID, userID domain.ID, offset, limit int) ([]domain.ExerciseLog, error)
 	DeleteExerciseLog(ctx context.Context, id domain.ID) error
 	UpdateExerciseLog(ctx context.Context, id domain.ID, exerciseLog domain.ExerciseLog) (domain.ExerciseLog, error)
+	SetExerciseLogsGroup(ctx context.Context, workoutID domain.ID, exerciseLogIDs []domain.ID, group utils.Nullable[domain.ExerciseGroup]) error
+	SetExerciseLogOrder(ctx context.Context, workoutID domain.ID, exerciseLogIDs []domain.ID) error
 }
 
 type setLogRepository interface {
@@ -82,6 +116,8 @@ type setLogRepository interface {
 	GetSetLogByID(ctx context.Context, id domain.ID) (domain.ExerciseSetLog, error)
 	DeleteSetLog(ctx context.Context, id domain.ID) error
 	UpdateSetLog(ctx context.Context, id domain.ID, setLog domain.ExerciseSetLog) (domain.ExerciseSetLog, error)
+	ShiftSetLogPositions(ctx context.Context, exerciseLogID domain.ID, from int) error
+	SetSetLogOrder(ctx context.Context, exerciseLogID domain.ID, setLogIDs []domain.ID) error
 }
 
 type setRepository interface {
@@ -90,6 +126,8 @@ type setRepository interface {
 	CreateSet(ctx context.Context, set domain.Set) (domain.Set, error)
 	GetSetByID(ctx context.Context, id domain.ID) (domain.Set, error)
 	DeleteSet(ctx context.Context, id domain.ID) error
+	ShiftSetPositions(ctx context.Context, exerciseInstanceID domain.ID, from int) error
+	SetSetOrder(ctx context.Context, exerciseInstanceID domain.ID, setIDs []domain.ID) error
 }
 
 type expectedSetRepository interface {
@@ -102,6 +140,80 @@ type generationSettingsRepository interface {
 	SaveGenerationSettings(ctx context.Context, settings domain.GenerationSettings) (domain.GenerationSettings, error)
 }
 
+type exerciseMediaRepository interface {
+	CreateExerciseMedia(ctx context.Context, media domain.ExerciseMedia) (domain.ExerciseMedia, error)
+	GetExerciseMediaByID(ctx context.Context, id domain.ID) (domain.ExerciseMedia, error)
+	UpdateExerciseMedia(ctx context.Context, id domain.ID, media domain.ExerciseMedia) (domain.ExerciseMedia, error)
+	DeleteExerciseMedia(ctx context.Context, id domain.ID) error
+	SetExerciseMediaOrder(ctx context.Context, exerciseID domain.ID, mediaIDs []domain.ID) error
+}
+
+type fileRepository interface {
+	CreateFile(ctx context.Context, file domain.File) (domain.File, error)
+	GetFileByID(ctx context.Context, id domain.ID) (domain.File, error)
+	GetFileByURL(ctx context.Context, userID domain.ID, url string) (domain.File, error)
+	UpdateFile(ctx context.Context, id domain.ID, file domain.File) (domain.File, error)
+}
+
+type imageProcessingJobRepository interface {
+	CreateImageProcessingJob(ctx context.Context, job domain.ImageProcessingJob) error
+	ClaimImageProcessingJobs(ctx context.Context, limit int, lease time.Duration) ([]domain.ImageProcessingJob, error)
+	UpdateImageProcessingJob(ctx context.Context, id domain.ID, job domain.ImageProcessingJob) error
+	SetFileVariants(ctx context.Context, fileID domain.ID, variants []domain.ImageVariant) error
+	SetExerciseMediaVariants(ctx context.Context, mediaID domain.ID, variants []domain.ImageVariant) error
+}
+
+type measurementRepository interface {
+	CreateMeasurement(ctx context.Context, measurement domain.Measurement) (domain.Measurement, error)
+	GetMeasurementByID(ctx context.Context, id domain.ID) (domain.Measurement, error)
+	GetMeasurements(ctx context.Context, filter dto.GetMeasurementsDTO) ([]domain.Measurement, error)
+	UpdateMeasurement(ctx context.Context, id domain.ID, measurement domain.Measurement) (domain.Measurement, error)
+	DeleteMeasurement(ctx context.Context, id domain.ID) error
+}
+
+type progressPhotoRepository interface {
+	CreateProgressPhoto(ctx context.Context, photo domain.ProgressPhoto) (domain.ProgressPhoto, error)
+	GetProgressPhotoByID(ctx context.Context, id domain.ID) (domain.ProgressPhoto, error)
+	GetProgressPhotos(ctx context.Context, filter dto.GetProgressPhotosDTO) ([]domain.ProgressPhoto, error)
+	GetClosestProgressPhotos(ctx context.Context, userID domain.ID, at time.Time, includePrivate bool) ([]domain.ProgressPhoto, error)
+	UpdateProgressPhoto(ctx context.Context, id domain.ID, photo domain.ProgressPhoto) (domain.ProgressPhoto, error)
+	DeleteProgressPhoto(ctx context.Context, id domain.ID) error
+}
+
+type userPreferencesRepository interface {
+	GetUserPreferences(ctx context.Context, userID domain.ID) (domain.UserPreferences, error)
+	SaveUserPreferences(ctx context.Context, preferences domain.UserPreferences) (domain.UserPreferences, error)
+}
+
+type gymProfileRepository interface {
+	CreateGymProfile(ctx context.Context, profile domain.GymProfile) (domain.GymProfile, error)
+	GetGymProfileByID(ctx context.Context, id domain.ID) (domain.GymProfile, error)
+	GetGymProfiles(ctx context.Context, userID domain.ID) ([]domain.GymProfile, error)
+	UpdateGymProfile(ctx context.Context, id domain.ID, profile domain.GymProfile) (domain.GymProfile, error)
+	UnsetDefaultGymProfile(ctx context.Context, userID domain.ID) error
+	DeleteGymProfile(ctx context.Context, id domain.ID) error
+}
+
+type dataExportRepository interface {
+	CreateDataExport(ctx context.Context, export domain.DataExport) (domain.DataExport, error)
+	GetDataExportByID(ctx context.Context, id domain.ID) (domain.DataExport, error)
+	GetDataExports(ctx context.Context, userID domain.ID) ([]domain.DataExport, error)
+	ClaimDataExports(ctx context.Context, limit int, lease time.Duration) ([]domain.DataExport, error)
+	UpdateDataExport(ctx context.Context, id domain.ID, export domain.DataExport) error
+}
+
+type workoutImportRepository interface {
+	CreateWorkoutImport(ctx context.Context, workoutImport domain.WorkoutImport) (domain.WorkoutImport, error)
+	GetWorkoutImportByID(ctx context.Context, id domain.ID) (domain.WorkoutImport, error)
+	GetWorkoutImports(ctx context.Context, userID domain.ID) ([]domain.WorkoutImport, error)
+	ClaimWorkoutImports(ctx context.Context, limit int, lease time.Duration) ([]domain.WorkoutImport, error)
+	UpdateWorkoutImport(ctx context.Context, id domain.ID, workoutImport domain.WorkoutImport) error
+}
+
+type analyticsRepository interface {
+	GetMuscleGroupVolume(ctx context.Context, userID domain.ID, from, to time.Time) ([]dto.MuscleGroupVolumeDTO, error)
+}
+
 type unitOfWork interface {
 	Begin(ctx context.Context) (context.Context, error)
 	Commit(ctx context.Context) error
@@ -110,7 +222,21 @@ type unitOfWork interface {
 }
 
 type s3Client interface {
-	GeneratePutPresignedURL(ctx context.Context, key string) (string, error)
+	PresignPutObject(ctx context.Context, key, contentType string, size int64) (string, error)
+	PresignGetObject(ctx context.Context, key string, ttl time.Duration) (string, error)
+	HeadObject(ctx context.Context, key string) (contentType string, size int64, err error)
+	GetObject(ctx context.Context, key string, maxSize int64) ([]byte, error)
+	PutObject(ctx context.Context, key, contentType string, data []byte) error
+	DeleteObject(ctx context.Context, key string) error
+	ObjectURL(key string) string
+}
+
+type urlSigner interface {
+	SignURL(ctx context.Context, rawURL string) (string, error)
+}
+
+type imageProcessor interface {
+	Process(ctx context.Context, data []byte, sizes []int) (domain.ProcessedImage, error)
 }
 
 type generateWorkoutLimiter interface {
@@ -122,6 +248,9 @@ type Service struct {
 	s3Client                     s3Client
 	workoutGenerator             workoutGenerator
 	generateWorkoutLimiter       generateWorkoutLimiter
+	imageProcessor               imageProcessor
+	urlSigner                    urlSigner
+	amendWindow                  time.Duration
 	sessionRepository            sessionRepository
 	userRepository               userRepository
 	exerciseRepository           exerciseRepository
@@ -134,6 +263,16 @@ type Service struct {
 	setRepository                setRepository
 	expectedSetRepository        expectedSetRepository
 	generationSettingsRepository generationSettingsRepository
+	analyticsRepository          analyticsRepository
+	exerciseMediaRepository      exerciseMediaRepository
+	fileRepository               fileRepository
+	imageProcessingJobRepository imageProcessingJobRepository
+	measurementRepository        measurementRepository
+	progressPhotoRepository      progressPhotoRepository
+	userPreferencesRepository    userPreferencesRepository
+	gymProfileRepository         gymProfileRepository
+	dataExportRepository         dataExportRepository
+	workoutImportRepository      workoutImportRepository
 	unitOfWork                   unitOfWork
 }
 
@@ -143,6 +282,9 @@ func New(
 	s3Client s3Client,
 	workoutGenerator workoutGenerator,
 	generateWorkoutLimiter generateWorkoutLimiter,
+	imageProcessor imageProcessor,
+	urlSigner urlSigner,
+	amendWindow time.Duration,
 	sessionRepository sessionRepository,
 	userRepository userRepository,
 	exerciseRepository exerciseRepository,
@@ -155,6 +297,16 @@ func New(
 	setRepository setRepository,
 	expectedSetRepository expectedSetRepository,
 	generationSettingsRepository generationSettingsRepository,
+	analyticsRepository analyticsRepository,
+	exerciseMediaRepository exerciseMediaRepository,
+	fileRepository fileRepository,
+	imageProcessingJobRepository imageProcessingJobRepository,
+	measurementRepository measurementRepository,
+	progressPhotoRepository progressPhotoRepository,
+	userPreferencesRepository userPreferencesRepository,
+	gymProfileRepository gymProfileRepository,
+	dataExportRepository dataExportRepository,
+	workoutImportRepository workoutImportRepository,
 ) *Service {
 	return &Service{
 		unitOfWork:                   unitOfWork,
@@ -162,6 +314,9 @@ func New(
 		jwtProvider:                  jwtProvider,
 		s3Client:                     s3Client,
 		generateWorkoutLimiter:       generateWorkoutLimiter,
+		imageProcessor:               imageProcessor,
+		urlSigner:                    urlSigner,
+		amendWindow:                  amendWindow,
 		sessionRepository:            sessionRepository,
 		userRepository:               userRepository,
 		exerciseRepository:           exerciseRepository,
@@ -174,5 +329,15 @@ func New(
 		setRepository:                setRepository,
 		expectedSetRepository:        expectedSetRepository,
 		generationSettingsRepository: generationSettingsRepository,
+		analyticsRepository:          analyticsRepository,
+		exerciseMediaRepository:      exerciseMediaRepository,
+		fileRepository:               fileRepository,
+		imageProcessingJobRepository: imageProcessingJobRepository,
+		measurementRepository:        measurementRepository,
+		progressPhotoRepository:      progressPhotoRepository,
+		userPreferencesRepository:    userPreferencesRepository,
+		gymProfileRepository:         gymProfileRepository,
+		dataExportRepository:         dataExportRepository,
+		workoutImportRepository:      workoutImportRepository,
 	}
 }
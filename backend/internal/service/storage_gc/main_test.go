@@ -0,0 +1,13 @@
+package storage_gc_service
+
+import (
+	"os"
+	"testing"
+
+	"fitness-trainer/internal/logger"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init()
+	os.Exit(m.Run())
+}
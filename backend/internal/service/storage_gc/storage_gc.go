@@ -0,0 +1,133 @@
+package storage_gc_service
+
+import (
+	"context"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// objectPrefixes are the prefixes the app uploads objects under
+var objectPrefixes = []string{"users/", "exercises/", "private/"}
+
+type ObjectStorage interface {
+	ListObjects(ctx context.Context, prefix string) ([]domain.StorageObject, error)
+	DeleteObject(ctx context.Context, key string) error
+	KeyFromURL(rawURL string) (string, bool)
+}
+
+type ReferenceRepository interface {
+	GetObjectReferences(ctx context.Context, recentSince time.Time) (dto.ObjectReferencesDTO, error)
+}
+
+// Service deletes objects which are no longer referenced by the database, such as
+// abandoned uploads and replaced avatars.
+type Service struct {
+	storage     ObjectStorage
+	repository  ReferenceRepository
+	gracePeriod time.Duration
+}
+
+func New(storage ObjectStorage, repository ReferenceRepository, gracePeriod time.Duration) *Service {
+	return &Service{
+		storage:     storage,
+		repository:  repository,
+		gracePeriod: gracePeriod,
+	}
+}
+
+// Run collects orphaned objects every interval until the context is done.
+func (s *Service) Run(ctx context.Context, interval time.Duration, dryRun bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		report, err := s.Collect(ctx, dryRun)
+		if err != nil {
+			logger.Errorf("failed to collect orphaned objects: %v", err)
+			continue
+		}
+
+		logger.Infof(
+			"storage gc: scanned %d, recent %d, referenced %d, orphaned %d, deleted %d, failed %d, dry run %t",
+			report.Scanned, report.Recent, report.Referenced, len(report.Orphaned), report.Deleted, report.Failed, report.DryRun,
+		)
+	}
+}
+
+// Collect deletes unreferenced objects older than the grace period. In dry run mode it
+// only reports them.
+func (s *Service) Collect(ctx context.Context, dryRun bool) (dto.StorageGCReportDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage_gc_service.Collect")
+	defer span.Finish()
+
+	startedAt := time.Now()
+	cutoff := startedAt.Add(-s.gracePeriod)
+
+	// Objects are listed before references are loaded, so an object referenced in
+	// between is not mistaken for an orphan
+	var objects []domain.StorageObject
+	for _, prefix := range objectPrefixes {
+		prefixObjects, err := s.storage.ListObjects(ctx, prefix)
+		if err != nil {
+			return dto.StorageGCReportDTO{}, err
+		}
+		objects = append(objects, prefixObjects...)
+	}
+
+	references, err := s.repository.GetObjectReferences(ctx, cutoff)
+	if err != nil {
+		return dto.StorageGCReportDTO{}, err
+	}
+
+	referenced := make(map[string]struct{}, len(references.Keys)+len(references.URLs))
+	for _, key := range references.Keys {
+		referenced[key] = struct{}{}
+	}
+	for _, url := range references.URLs {
+		if key, ok := s.storage.KeyFromURL(url); ok {
+			referenced[key] = struct{}{}
+		}
+	}
+
+	report := dto.StorageGCReportDTO{
+		DryRun:  dryRun,
+		Scanned: len(objects),
+	}
+
+	for _, object := range objects {
+		if object.LastModified.After(cutoff) {
+			report.Recent++
+			continue
+		}
+
+		if _, ok := referenced[object.Key]; ok {
+			report.Referenced++
+			continue
+		}
+
+		report.Orphaned = append(report.Orphaned, object)
+		if dryRun {
+			continue
+		}
+
+		if err := s.storage.DeleteObject(ctx, object.Key); err != nil {
+			logger.Errorf("failed to delete orphaned object %s: %v", object.Key, err)
+			report.Failed++
+			continue
+		}
+		report.Deleted++
+	}
+
+	return report, nil
+}
@@ -0,0 +1,138 @@
+package storage_gc_service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+)
+
+const testBucketURL = "https://storage.example.com/media/"
+
+type fakeObjectStorage struct {
+	objects []domain.StorageObject
+	// failDelete are the keys which fail to be deleted
+	failDelete map[string]bool
+	deleted    []string
+}
+
+func (s *fakeObjectStorage) ListObjects(_ context.Context, prefix string) ([]domain.StorageObject, error) {
+	var objects []domain.StorageObject
+	for _, object := range s.objects {
+		if strings.HasPrefix(object.Key, prefix) {
+			objects = append(objects, object)
+		}
+	}
+
+	return objects, nil
+}
+
+func (s *fakeObjectStorage) DeleteObject(_ context.Context, key string) error {
+	if s.failDelete[key] {
+		return errors.New("access denied")
+	}
+
+	s.deleted = append(s.deleted, key)
+	return nil
+}
+
+func (s *fakeObjectStorage) KeyFromURL(rawURL string) (string, bool) {
+	if !strings.HasPrefix(rawURL, testBucketURL) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(rawURL, testBucketURL), true
+}
+
+type fakeReferenceRepository struct {
+	references  dto.ObjectReferencesDTO
+	recentSince time.Time
+}
+
+func (r *fakeReferenceRepository) GetObjectReferences(_ context.Context, recentSince time.Time) (dto.ObjectReferencesDTO, error) {
+	r.recentSince = recentSince
+	return r.references, nil
+}
+
+func TestCollect(t *testing.T) {
+	old := time.Now().Add(-48 * time.Hour)
+
+	objects := []domain.StorageObject{
+		{Key: "users/1/avatar.png", LastModified: old},
+		{Key: "users/1/old-avatar.png", LastModified: old},
+		{Key: "exercises/2/image.jpg", LastModified: old},
+		{Key: "private/3/upload.png", LastModified: time.Now().Add(-time.Minute)},
+		{Key: "private/4/abandoned.png", LastModified: old},
+		// keys outside the app's prefixes are never touched
+		{Key: "backups/db.dump", LastModified: old},
+	}
+	references := dto.ObjectReferencesDTO{
+		Keys: []string{"users/1/avatar.png"},
+		URLs: []string{testBucketURL + "exercises/2/image.jpg", "https://example.com/image.jpg"},
+	}
+
+	tests := []struct {
+		name        string
+		dryRun      bool
+		failDelete  map[string]bool
+		wantDeleted []string
+		wantReport  dto.StorageGCReportDTO
+	}{
+		{
+			name:        "deletes orphans",
+			wantDeleted: []string{"users/1/old-avatar.png", "private/4/abandoned.png"},
+			wantReport:  dto.StorageGCReportDTO{Scanned: 5, Recent: 1, Referenced: 2, Deleted: 2},
+		},
+		{
+			name:       "dry run",
+			dryRun:     true,
+			wantReport: dto.StorageGCReportDTO{DryRun: true, Scanned: 5, Recent: 1, Referenced: 2},
+		},
+		{
+			name:        "failed deletion",
+			failDelete:  map[string]bool{"users/1/old-avatar.png": true},
+			wantDeleted: []string{"private/4/abandoned.png"},
+			wantReport:  dto.StorageGCReportDTO{Scanned: 5, Recent: 1, Referenced: 2, Deleted: 1, Failed: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &fakeObjectStorage{objects: objects, failDelete: tt.failDelete}
+			repository := &fakeReferenceRepository{references: references}
+			s := New(storage, repository, 24*time.Hour)
+
+			report, err := s.Collect(context.Background(), tt.dryRun)
+			if err != nil {
+				t.Fatalf("Collect() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(storage.deleted, tt.wantDeleted) {
+				t.Errorf("deleted = %v, want %v", storage.deleted, tt.wantDeleted)
+			}
+
+			var orphaned []string
+			for _, object := range report.Orphaned {
+				orphaned = append(orphaned, object.Key)
+			}
+			wantOrphaned := []string{"users/1/old-avatar.png", "private/4/abandoned.png"}
+			if !reflect.DeepEqual(orphaned, wantOrphaned) {
+				t.Errorf("orphaned = %v, want %v", orphaned, wantOrphaned)
+			}
+
+			report.Orphaned = nil
+			if !reflect.DeepEqual(report, tt.wantReport) {
+				t.Errorf("report = %+v, want %+v", report, tt.wantReport)
+			}
+
+			if since := time.Since(repository.recentSince); since < 24*time.Hour || since > 25*time.Hour {
+				t.Errorf("references recent since %s, want the start of the grace period", repository.recentSince)
+			}
+		})
+	}
+}
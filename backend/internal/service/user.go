@@ -2,10 +2,13 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/domain/dto"
+	"fitness-trainer/internal/logger"
 	"fitness-trainer/internal/utils"
 
 	"github.com/opentracing/opentracing-go"
@@ -32,7 +35,11 @@ func (s *Service) CreateUser(ctx context.Context, dto dto.CreateUserDTO) (domain
 
 	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
 		user, err = s.userRepository.CreateUser(ctx, user)
-		return err
+		if err != nil {
+			return err
+		}
+
+		return s.recordBodyweight(ctx, user)
 	})
 	if err != nil {
 		return domain.User{}, err
@@ -57,6 +64,8 @@ func (s *Service) UpdateUser(ctx context.Context, id domain.ID, dto dto.UpdateUs
 		return domain.User{}, err
 	}
 
+	weightChanged := dto.Weight.IsValid && dto.Weight.V != user.Weight
+
 	{
 		if !dto.DateOfBirth.IsZero() {
 			user.DateOfBirth = dto.DateOfBirth
@@ -78,8 +87,22 @@ func (s *Service) UpdateUser(ctx context.Context, id domain.ID, dto dto.UpdateUs
 			user.Weight = dto.Weight.V
 		}
 
-		if dto.ProfilePicURL.IsValid {
-			user.ProfilePicURL = dto.ProfilePicURL.V
+		switch {
+		case !dto.ProfilePictureFileID.IsValid && dto.ProfilePicURL.IsValid && dto.ProfilePicURL.V == "":
+			// An empty URL removes the profile picture
+			user.ProfilePicURL = ""
+			user.ProfilePictureFileID = utils.Nullable[domain.ID]{}
+			user.ProfilePictureVariants = nil
+		case dto.ProfilePictureFileID.IsValid || dto.ProfilePicURL.IsValid:
+			file, err := s.getProfilePictureFile(ctx, id, dto)
+			if err != nil {
+				return domain.User{}, err
+			}
+
+			user.ProfilePicURL = file.URL
+			user.ProfilePictureFileID = utils.NewNullable(file.ID, true)
+			// Thumbnails appear here once the file is processed, if it isn't yet
+			user.ProfilePictureVariants = file.Variants
 		}
 
 		user.UpdatedAt = time.Now()
@@ -87,8 +110,15 @@ func (s *Service) UpdateUser(ctx context.Context, id domain.ID, dto dto.UpdateUs
 
 	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
 		user, err = s.userRepository.UpdateUser(ctx, user)
+		if err != nil {
+			return err
+		}
 
-		return err
+		if !weightChanged {
+			return nil
+		}
+
+		return s.recordBodyweight(ctx, user)
 	})
 	if err != nil {
 		return domain.User{}, err
@@ -96,3 +126,57 @@ func (s *Service) UpdateUser(ctx context.Context, id domain.ID, dto dto.UpdateUs
 
 	return user, nil
 }
+
+// recordBodyweight adds the weight of the user to their bodyweight history.
+func (s *Service) recordBodyweight(ctx context.Context, user domain.User) error {
+	if user.Weight <= 0 {
+		return nil
+	}
+
+	measurement, err := domain.NewMeasurement(
+		user.ID,
+		domain.MeasurementKindBodyweight,
+		float64(user.Weight),
+		domain.MeasurementUnitKilogram,
+		user.UpdatedAt,
+		"",
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.measurementRepository.CreateMeasurement(ctx, measurement)
+	return err
+}
+
+// getProfilePictureFile returns the confirmed upload the user picked as a profile picture.
+// Pictures set by URL must be served from such an upload too.
+func (s *Service) getProfilePictureFile(ctx context.Context, userID domain.ID, input dto.UpdateUserDTO) (domain.File, error) {
+	if input.ProfilePictureFileID.IsValid {
+		return s.getConfirmedFile(ctx, userID, input.ProfilePictureFileID.V, domain.FilePurposeProfilePicture)
+	}
+
+	file, err := s.fileRepository.GetFileByURL(ctx, userID, input.ProfilePicURL.V)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.File{}, fmt.Errorf("%w: profile picture must be an uploaded file", domain.ErrInvalidArgument)
+		}
+		return domain.File{}, err
+	}
+
+	return s.getConfirmedFile(ctx, userID, file.ID, domain.FilePurposeProfilePicture)
+}
+
+func (s *Service) checkAdmin(ctx context.Context, userID domain.ID) error {
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.Role != domain.UserRoleAdmin {
+		logger.Errorf("user %s is not an admin", userID)
+		return domain.ErrForbidden
+	}
+
+	return nil
+}
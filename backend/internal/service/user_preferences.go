@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// maxCalendarDays bounds the range of the workout calendar
+const maxCalendarDays = 366
+
+// GetUserPreferences returns the preferences of the user, the default ones if they were
+// never saved.
+func (s *Service) GetUserPreferences(ctx context.Context, userID domain.ID) (domain.UserPreferences, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetUserPreferences")
+	defer span.Finish()
+
+	preferences, err := s.userPreferencesRepository.GetUserPreferences(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.DefaultUserPreferences(userID), nil
+		}
+		return domain.UserPreferences{}, err
+	}
+
+	return preferences, nil
+}
+
+func (s *Service) UpdateUserPreferences(ctx context.Context, userID domain.ID, input dto.UpdateUserPreferencesDTO) (domain.UserPreferences, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.UpdateUserPreferences")
+	defer span.Finish()
+
+	var preferences domain.UserPreferences
+	if err := s.unitOfWork.InTransaction(ctx, func(ctx context.Context) (err error) {
+		preferences, err = s.GetUserPreferences(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		if input.WeightUnit.IsValid {
+			preferences.WeightUnit = input.WeightUnit.V
+		}
+
+		if input.LengthUnit.IsValid {
+			preferences.LengthUnit = input.LengthUnit.V
+		}
+
+		if input.Locale.IsValid {
+			preferences.Locale = input.Locale.V
+		}
+
+		if input.FirstDayOfWeek.IsValid {
+			preferences.FirstDayOfWeek = input.FirstDayOfWeek.V
+		}
+
+		if input.Timezone.IsValid {
+			preferences.Timezone = input.Timezone.V
+		}
+
+		if err := preferences.Validate(); err != nil {
+			return err
+		}
+
+		preferences, err = s.userPreferencesRepository.SaveUserPreferences(ctx, preferences)
+		return err
+	}); err != nil {
+		return domain.UserPreferences{}, err
+	}
+
+	return preferences, nil
+}
+
+// GetWorkoutCalendar returns the number of finished workouts per day in [from, to), days
+// start in the user's time zone and days without workouts are omitted.
+func (s *Service) GetWorkoutCalendar(ctx context.Context, userID domain.ID, from, to time.Time) ([]dto.WorkoutCalendarDayDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetWorkoutCalendar")
+	defer span.Finish()
+
+	if !from.Before(to) {
+		return nil, fmt.Errorf("%w: from must be before to", domain.ErrInvalidArgument)
+	}
+
+	if to.Sub(from) > maxCalendarDays*24*time.Hour {
+		return nil, fmt.Errorf("%w: range must not exceed %d days", domain.ErrInvalidArgument, maxCalendarDays)
+	}
+
+	preferences, err := s.GetUserPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	startedAt, err := s.workoutRepository.GetFinishedWorkoutTimes(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	location := preferences.Location()
+
+	var days []dto.WorkoutCalendarDayDTO
+	for _, t := range startedAt {
+		day := domain.StartOfDay(t, location)
+		if len(days) > 0 && days[len(days)-1].Date.Equal(day) {
+			days[len(days)-1].WorkoutCount++
+			continue
+		}
+		days = append(days, dto.WorkoutCalendarDayDTO{Date: day, WorkoutCount: 1})
+	}
+
+	return days, nil
+}
+
+// GetWorkoutStreak returns the current and the longest runs of consecutive days and
+// weeks with finished workouts.
+func (s *Service) GetWorkoutStreak(ctx context.Context, userID domain.ID) (dto.WorkoutStreakDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetWorkoutStreak")
+	defer span.Finish()
+
+	preferences, err := s.GetUserPreferences(ctx, userID)
+	if err != nil {
+		return dto.WorkoutStreakDTO{}, err
+	}
+
+	startedAt, err := s.workoutRepository.GetFinishedWorkoutTimes(ctx, userID, time.Time{}, time.Time{})
+	if err != nil {
+		return dto.WorkoutStreakDTO{}, err
+	}
+
+	var streak dto.WorkoutStreakDTO
+	if len(startedAt) == 0 {
+		return streak, nil
+	}
+	streak.LastWorkoutAt = startedAt[len(startedAt)-1]
+
+	location := preferences.Location()
+	startOfDay := func(t time.Time) time.Time {
+		return domain.StartOfDay(t, location)
+	}
+	startOfWeek := func(t time.Time) time.Time {
+		return domain.StartOfWeek(t, location, preferences.FirstDayOfWeek)
+	}
+
+	now := time.Now()
+	streak.CurrentDays, streak.LongestDays = countStreak(startedAt, startOfDay, startOfDay(now), 1)
+	streak.CurrentWeeks, streak.LongestWeeks = countStreak(startedAt, startOfWeek, startOfWeek(now), 7)
+
+	return streak, nil
+}
+
+// countStreak counts runs of consecutive periods in ordered times, period truncates a time to
+// the start of its period and days is the length of one period. The current run
+// is the one that includes the current period or the one before it.
+func countStreak(times []time.Time, period func(time.Time) time.Time, current time.Time, days int) (int, int) {
+	var (
+		longest int
+		run     int
+		last    time.Time
+	)
+	for _, t := range times {
+		start := period(t)
+		switch {
+		case run > 0 && start.Equal(last):
+			continue
+		case run > 0 && start.Equal(period(last.AddDate(0, 0, days))):
+			run++
+		default:
+			run = 1
+		}
+		last = start
+		longest = max(longest, run)
+	}
+
+	previous := period(current.AddDate(0, 0, -days))
+	if !last.Equal(current) && !last.Equal(previous) {
+		return 0, longest
+	}
+
+	return run, longest
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"fitness-trainer/internal/domain"
+)
+
+func TestCountStreak(t *testing.T) {
+	location, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone is not available: %v", err)
+	}
+
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2024, month, day, hour, 0, 0, 0, location)
+	}
+	startOfDay := func(t time.Time) time.Time {
+		return domain.StartOfDay(t, location)
+	}
+	startOfWeek := func(t time.Time) time.Time {
+		return domain.StartOfWeek(t, location, time.Monday)
+	}
+
+	tests := []struct {
+		name        string
+		times       []time.Time
+		period      func(time.Time) time.Time
+		now         time.Time
+		days        int
+		wantCurrent int
+		wantLongest int
+	}{
+		{
+			name:   "no workouts",
+			period: startOfDay,
+			now:    at(time.May, 10, 12),
+			days:   1,
+		},
+		{
+			name:        "run ending today",
+			times:       []time.Time{at(time.May, 8, 18), at(time.May, 9, 18), at(time.May, 10, 8)},
+			period:      startOfDay,
+			now:         at(time.May, 10, 12),
+			days:        1,
+			wantCurrent: 3,
+			wantLongest: 3,
+		},
+		{
+			name:        "run ending yesterday is still current",
+			times:       []time.Time{at(time.May, 8, 18), at(time.May, 9, 18)},
+			period:      startOfDay,
+			now:         at(time.May, 10, 12),
+			days:        1,
+			wantCurrent: 2,
+			wantLongest: 2,
+		},
+		{
+			name:        "broken run keeps the longest one",
+			times:       []time.Time{at(time.May, 1, 18), at(time.May, 2, 18), at(time.May, 3, 18), at(time.May, 7, 18)},
+			period:      startOfDay,
+			now:         at(time.May, 10, 12),
+			days:        1,
+			wantLongest: 3,
+		},
+		{
+			name:        "several workouts a day count once",
+			times:       []time.Time{at(time.May, 9, 8), at(time.May, 9, 19), at(time.May, 10, 8), at(time.May, 10, 9)},
+			period:      startOfDay,
+			now:         at(time.May, 10, 12),
+			days:        1,
+			wantCurrent: 2,
+			wantLongest: 2,
+		},
+		{
+			name:        "run over the switch to summer time",
+			times:       []time.Time{at(time.March, 30, 23), at(time.March, 31, 23), at(time.April, 1, 0)},
+			period:      startOfDay,
+			now:         at(time.April, 1, 12),
+			days:        1,
+			wantCurrent: 3,
+			wantLongest: 3,
+		},
+		{
+			name: "weeks",
+			// Sunday and Monday are in different weeks starting on Monday
+			times:       []time.Time{at(time.April, 28, 10), at(time.April, 29, 10), at(time.May, 8, 10)},
+			period:      startOfWeek,
+			now:         at(time.May, 10, 12),
+			days:        7,
+			wantCurrent: 3,
+			wantLongest: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, longest := countStreak(tt.times, tt.period, tt.period(tt.now), tt.days)
+			if current != tt.wantCurrent || longest != tt.wantLongest {
+				t.Errorf("streak = %d, longest %d, want %d, longest %d", current, longest, tt.wantCurrent, tt.wantLongest)
+			}
+		})
+	}
+}
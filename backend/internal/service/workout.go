@@ -5,12 +5,18 @@ import (
 	"fitness-trainer/internal/domain"
 	"fitness-trainer/internal/domain/dto"
 	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
 	"fmt"
+	"math"
+	"slices"
 	"time"
 
 	"github.com/opentracing/opentracing-go"
 )
 
+// warmupWeightStep is the precision warm-ups of exercises without a barbell are rounded to
+const warmupWeightStep = 0.5
+
 func (s *Service) StartWorkout(ctx context.Context, userID domain.ID, opts domain.StartWorkoutOpts) (domain.Workout, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "service.StartWorkout")
 	defer span.Finish()
@@ -21,15 +27,33 @@ func (s *Service) StartWorkout(ctx context.Context, userID domain.ID, opts domai
 	}
 	defer s.unitOfWork.Rollback(ctx)
 
+	// Past workouts created meanwhile must see this one in their overlap check
+	if err := s.workoutRepository.LockUserWorkouts(ctx, userID); err != nil {
+		return domain.Workout{}, err
+	}
+
 	if opts.RoutineID.IsValid {
-		_, err := s.routineRepository.GetRoutineByID(ctx, opts.RoutineID.V)
+		routine, err := s.routineRepository.GetRoutineByID(ctx, opts.RoutineID.V)
 		if err != nil {
 			return domain.Workout{}, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
 		}
+
+		if routine.UserID != userID && !routine.IsTemplate() {
+			logger.Errorf("user %s tried to start workout from routine %s", userID, routine.ID)
+			return domain.Workout{}, domain.ErrNotFound
+		}
 	}
 
 	workout := domain.NewWorkout(userID, opts.RoutineID, opts.GenerateWorkout)
 
+	if opts.RoutineID.IsValid {
+		version, err := s.recordRoutineVersion(ctx, opts.RoutineID.V)
+		if err != nil {
+			return domain.Workout{}, err
+		}
+		workout.RoutineVersionID = utils.NewNullable(version.ID, true)
+	}
+
 	workout, err = s.workoutRepository.CreateWorkout(ctx, workout)
 	if err != nil {
 		return domain.Workout{}, err
@@ -71,17 +95,42 @@ func (s *Service) enrichWorkoutFromRoutine(ctx context.Context, userID, workoutI
 		return err
 	}
 
+	var profile domain.GymProfile
+	if len(routine.WarmupSteps) > 0 {
+		profile, err = s.getDefaultGymProfile(ctx, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Routine groups get new IDs in the workout
+	groupIDs := make(map[domain.ID]domain.ID)
+
 	for _, instance := range exerciseInstances {
-		exerciseLog, err := s.LogExercise(ctx, userID, workoutID, instance.ExerciseID)
+		exerciseLog, err := s.logExercise(ctx, userID, workoutID, instance.ExerciseID, true)
 		if err != nil {
 			return err
 		}
 
+		if instance.Group.IsValid {
+			group := instance.Group.V
+			if _, ok := groupIDs[group.ID]; !ok {
+				groupIDs[group.ID] = domain.NewID()
+			}
+			group.ID = groupIDs[group.ID]
+
+			err = s.exerciseLogRepository.SetExerciseLogsGroup(ctx, workoutID, []domain.ID{exerciseLog.ID}, utils.NewNullable(group, true))
+			if err != nil {
+				return err
+			}
+		}
+
 		sets, err := s.setRepository.GetSetsByExerciseInstanceID(ctx, instance.ID)
 		if err != nil {
 			return err
 		}
 
+		expectedSets := make([]domain.ExpectedSet, 0, len(sets))
 		for _, set := range sets {
 			expectedSet := domain.NewExpectedSet(
 				exerciseLog.ID,
@@ -90,6 +139,20 @@ func (s *Service) enrichWorkoutFromRoutine(ctx context.Context, userID, workoutI
 				set.Weight,
 				set.Time,
 			)
+			expectedSet.Rest = set.Rest
+			expectedSets = append(expectedSets, expectedSet)
+		}
+
+		if len(routine.WarmupSteps) > 0 {
+			warmups, err := s.planWarmups(ctx, instance.ExerciseID, expectedSets, routine.WarmupSteps, profile)
+			if err != nil {
+				return err
+			}
+			expectedSets = append(warmups, expectedSets...)
+		}
+
+		for i, expectedSet := range expectedSets {
+			expectedSet.Position = i
 
 			_, err = s.expectedSetRepository.CreateExpectedSet(ctx, expectedSet)
 			if err != nil {
@@ -101,6 +164,33 @@ func (s *Service) enrichWorkoutFromRoutine(ctx context.Context, userID, workoutI
 	return nil
 }
 
+// planWarmups returns warm-up sets for the first working set with a weight, barbell
+// warm-ups are rounded to the weights the gym profile can load.
+func (s *Service) planWarmups(ctx context.Context, exerciseID domain.ID, expectedSets []domain.ExpectedSet, steps []domain.WarmupStep, profile domain.GymProfile) ([]domain.ExpectedSet, error) {
+	first := slices.IndexFunc(expectedSets, func(set domain.ExpectedSet) bool {
+		return set.Weight > 0
+	})
+	if first < 0 {
+		return nil, nil
+	}
+
+	exercise, err := s.exerciseRepository.GetExerciseByID(ctx, exerciseID)
+	if err != nil {
+		return nil, err
+	}
+
+	round := func(weight float32) float32 {
+		return float32(math.Round(float64(weight)/warmupWeightStep) * warmupWeightStep)
+	}
+	if exercise.Equipment == domain.EquipmentBarbell {
+		round = func(weight float32) float32 {
+			return profile.Loadout(weight).Weight
+		}
+	}
+
+	return domain.PlanWarmups(expectedSets[first], steps, round), nil
+}
+
 func (s *Service) generateWorkout(ctx context.Context, userID domain.ID, userPrompt string) (dto.GeneratedWorkoutDTO, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "service.generateWorkout")
 	defer span.Finish()
@@ -141,13 +231,13 @@ func (s *Service) generateWorkout(ctx context.Context, userID domain.ID, userPro
 		})
 	}
 
-	exercises, err := s.exerciseRepository.GetExercises(ctx, []domain.ID{}, []domain.ID{})
+	exercises, err := s.exerciseRepository.GetExercises(ctx, dto.GetExercisesDTO{UserID: userID})
 	if err != nil {
 		return dto.GeneratedWorkoutDTO{}, err
 	}
 
-	exerciseDTOs := make([]dto.SlimExerciseDTO, 0, len(exercises))
-	for _, exercise := range exercises {
+	exerciseDTOs := make([]dto.SlimExerciseDTO, 0, len(exercises.Exercises))
+	for _, exercise := range exercises.Exercises {
 		exerciseDTOs = append(exerciseDTOs, dto.SlimExerciseDTO{
 			ID:                 exercise.ID,
 			Name:               exercise.Name,
@@ -160,6 +250,11 @@ func (s *Service) generateWorkout(ctx context.Context, userID domain.ID, userPro
 		return dto.GeneratedWorkoutDTO{}, err
 	}
 
+	preferences, err := s.GetUserPreferences(ctx, userID)
+	if err != nil {
+		return dto.GeneratedWorkoutDTO{}, err
+	}
+
 	opts := &dto.GenerateWorkoutOptions{
 		UserID: userID,
 		Exercises: exerciseDTOs,
@@ -167,6 +262,7 @@ func (s *Service) generateWorkout(ctx context.Context, userID domain.ID, userPro
 		VarietyLevel: generationSettings.VarietyLevel,
 		BaseUserPrompt: generationSettings.BasePrompt,
 		UserPrompt: userPrompt,
+		Locale: preferences.Locale,
 	}
 
 	return s.workoutGenerator.GenerateWorkout(ctx, opts)
@@ -231,13 +327,28 @@ func (s *Service) GetWorkout(ctx context.Context, userID domain.ID, workoutID do
 		return dto.WorkoutDetailsDTO{}, err
 	}
 
+	// The gym profile is loaded once for all barbell exercises of an active workout
+	var profile utils.Nullable[domain.GymProfile]
+
 	exerciseLogsDTOs := make([]dto.ExerciseLogDTO, 0, len(exerciseLogs))
 	for _, exerciseLog := range exerciseLogs {
-		exerciseLogDTO, err := s.GetExerciseLog(ctx, userID, exerciseLog.ID)
+		exerciseLogDTO, err := s.getExerciseLogDetails(ctx, exerciseLog)
 		if err != nil {
 			return dto.WorkoutDetailsDTO{}, err
 		}
 
+		if needsLoadouts(workout, exerciseLogDTO.Exercise) {
+			if !profile.IsValid {
+				defaultProfile, err := s.getDefaultGymProfile(ctx, userID)
+				if err != nil {
+					return dto.WorkoutDetailsDTO{}, err
+				}
+				profile = utils.NewNullable(defaultProfile, true)
+			}
+
+			loadExpectedSets(profile.V, exerciseLogDTO.ExpectedSets)
+		}
+
 		exerciseLogsDTOs = append(exerciseLogsDTOs, exerciseLogDTO)
 	}
 
@@ -266,7 +377,25 @@ func (s *Service) GetExerciseLog(ctx context.Context, userID, exerciseLogID doma
 		return dto.ExerciseLogDTO{}, domain.ErrNotFound
 	}
 
-	setLogs, err := s.setLogRepository.GetSetLogsByExerciseLogID(ctx, exerciseLogID)
+	exerciseLogDTO, err := s.getExerciseLogDetails(ctx, exerciseLog)
+	if err != nil {
+		return dto.ExerciseLogDTO{}, err
+	}
+
+	if needsLoadouts(workout, exerciseLogDTO.Exercise) {
+		profile, err := s.getDefaultGymProfile(ctx, userID)
+		if err != nil {
+			return dto.ExerciseLogDTO{}, err
+		}
+
+		loadExpectedSets(profile, exerciseLogDTO.ExpectedSets)
+	}
+
+	return exerciseLogDTO, nil
+}
+
+func (s *Service) getExerciseLogDetails(ctx context.Context, exerciseLog domain.ExerciseLog) (dto.ExerciseLogDTO, error) {
+	setLogs, err := s.setLogRepository.GetSetLogsByExerciseLogID(ctx, exerciseLog.ID)
 	if err != nil {
 		return dto.ExerciseLogDTO{}, err
 	}
@@ -276,7 +405,7 @@ func (s *Service) GetExerciseLog(ctx context.Context, userID, exerciseLogID doma
 		return dto.ExerciseLogDTO{}, err
 	}
 
-	expectedSets, err := s.expectedSetRepository.GetExpectedSetsByExerciseLogID(ctx, exerciseLogID)
+	expectedSets, err := s.expectedSetRepository.GetExpectedSetsByExerciseLogID(ctx, exerciseLog.ID)
 	if err != nil {
 		return dto.ExerciseLogDTO{}, err
 	}
@@ -289,10 +418,22 @@ func (s *Service) GetExerciseLog(ctx context.Context, userID, exerciseLogID doma
 	}, nil
 }
 
+// needsLoadouts reports whether expected sets of the exercise are shown with the plates to
+// load, which is the case for barbell exercises of active workouts
+func needsLoadouts(workout domain.Workout, exercise domain.Exercise) bool {
+	return workout.FinishedAt.IsZero() && exercise.Equipment == domain.EquipmentBarbell
+}
+
 func (s *Service) LogExercise(ctx context.Context, userID, workoutID, exerciseID domain.ID) (domain.ExerciseLog, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "service.LogExercise")
 	defer span.Finish()
 
+	return s.logExercise(ctx, userID, workoutID, exerciseID, false)
+}
+
+// logExercise adds the exercise to the workout. Archived exercises can not be picked anymore,
+// but allowArchived keeps them usable in routines which already contain them.
+func (s *Service) logExercise(ctx context.Context, userID, workoutID, exerciseID domain.ID, allowArchived bool) (domain.ExerciseLog, error) {
 	workout, err := s.workoutRepository.GetWorkoutByID(ctx, workoutID)
 	if err != nil {
 		return domain.ExerciseLog{}, err
@@ -303,14 +444,18 @@ func (s *Service) LogExercise(ctx context.Context, userID, workoutID, exerciseID
 		return domain.ExerciseLog{}, fmt.Errorf("%w: workout %s is already finished", domain.ErrInvalidArgument, workoutID)
 	}
 
-	_, err = s.exerciseRepository.GetExerciseByID(ctx, exerciseID)
+	if workout.UserID != userID {
+		logger.Errorf("user %s tried to log exercise for workout %s", userID, workoutID)
+		return domain.ExerciseLog{}, domain.ErrNotFound
+	}
+
+	exercise, err := s.GetExerciseByID(ctx, userID, exerciseID)
 	if err != nil {
 		return domain.ExerciseLog{}, err
 	}
 
-	if workout.UserID != userID {
-		logger.Errorf("user %s tried to log exercise for workout %s", userID, workoutID)
-		return domain.ExerciseLog{}, domain.ErrNotFound
+	if exercise.IsArchived() && !allowArchived {
+		return domain.ExerciseLog{}, fmt.Errorf("%w: exercise %s is archived", domain.ErrInvalidArgument, exerciseID)
 	}
 
 	exerciseLog := domain.NewExerciseLog(workoutID, exerciseID)
@@ -337,16 +482,17 @@ func (s *Service) LogSet(ctx context.Context, userID, workoutID, exerciseLogID d
 		return domain.ExerciseSetLog{}, err
 	}
 
-	if !workout.FinishedAt.IsZero() {
-		logger.Errorf("user %s tried to log exercise for finished workout %s", userID, workoutID)
-		return domain.ExerciseSetLog{}, fmt.Errorf("%w: workout %s is already finished", domain.ErrInvalidArgument, workoutID)
-	}
-
 	if workout.UserID != userID {
 		logger.Errorf("user %s tried to log set for workout %s", userID, workoutID)
 		return domain.ExerciseSetLog{}, domain.ErrNotFound
 	}
 
+	amending, err := s.checkWorkoutEditable(workout, setlogDTO.Amend)
+	if err != nil {
+		logger.Errorf("user %s tried to log set for finished workout %s", userID, workoutID)
+		return domain.ExerciseSetLog{}, err
+	}
+
 	if exerciseLog.WorkoutID != workoutID {
 		logger.Errorf("user %s tried to log set for exercise log %s for workout %s", userID, exerciseLogID, workoutID)
 		return domain.ExerciseSetLog{}, domain.ErrNotFound
@@ -358,8 +504,53 @@ func (s *Service) LogSet(ctx context.Context, userID, workoutID, exerciseLogID d
 		setlogDTO.Weight,
 		time.Duration(0),
 	)
+	setLog.CompletedAt = setLog.CreatedAt
+	// A set added to a finished workout is completed by the end of it
+	if amending {
+		setLog.CompletedAt = workout.FinishedAt
+	}
+
+	if setlogDTO.StartedAt.IsValid {
+		startedAt := setlogDTO.StartedAt.V
+		if startedAt.Before(workout.CreatedAt) || startedAt.After(setLog.CompletedAt) {
+			return domain.ExerciseSetLog{}, fmt.Errorf("%w: set must start during the workout", domain.ErrInvalidArgument)
+		}
+		setLog.StartedAt = startedAt
+	}
 
-	setLog, err = s.setLogRepository.CreateSetLog(ctx, setLog)
+	setLogs, err := s.setLogRepository.GetSetLogsByExerciseLogID(ctx, exerciseLogID)
+	if err != nil {
+		return domain.ExerciseSetLog{}, err
+	}
+
+	positions := make([]int, 0, len(setLogs))
+	for _, existing := range setLogs {
+		positions = append(positions, existing.Position)
+	}
+
+	index := len(setLogs)
+	if setlogDTO.Position.IsValid {
+		index = min(setlogDTO.Position.V, len(setLogs))
+	}
+	setLog.Position = insertPosition(positions, index)
+
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		if index < len(setLogs) {
+			if err := s.setLogRepository.ShiftSetLogPositions(ctx, exerciseLogID, setLog.Position); err != nil {
+				return err
+			}
+		}
+
+		setLog, err = s.setLogRepository.CreateSetLog(ctx, setLog)
+		if err != nil || !amending {
+			return err
+		}
+
+		return s.workoutRepository.CreateWorkoutAmendment(ctx, domain.NewWorkoutAmendment(
+			workoutID, userID, exerciseLogID, setLog.ID, domain.WorkoutAmendmentActionSetLogged,
+			domain.DiffSetLogs(domain.ExerciseSetLog{}, setLog),
+		))
+	})
 	if err != nil {
 		return domain.ExerciseSetLog{}, err
 	}
@@ -459,15 +650,20 @@ func (s *Service) DeleteExerciseLog(ctx context.Context, userID, workoutID, exer
 		return domain.ErrNotFound
 	}
 
-	err = s.exerciseLogRepository.DeleteExerciseLog(ctx, exerciseLogID)
-	if err != nil {
-		return err
-	}
+	return s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		if err := s.exerciseLogRepository.DeleteExerciseLog(ctx, exerciseLogID); err != nil {
+			return err
+		}
 
-	return nil
+		if !exerciseLog.Group.IsValid {
+			return nil
+		}
+
+		return s.releaseExerciseLogGroup(ctx, workoutID, exerciseLog.Group.V)
+	})
 }
 
-func (s *Service) DeleteSetLog(ctx context.Context, userID, workoutID, exerciseLogID domain.ID, setLogID domain.ID) error {
+func (s *Service) DeleteSetLog(ctx context.Context, userID, workoutID, exerciseLogID domain.ID, setLogID domain.ID, amend bool) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "service.DeleteSetLog")
 	defer span.Finish()
 
@@ -481,9 +677,10 @@ func (s *Service) DeleteSetLog(ctx context.Context, userID, workoutID, exerciseL
 		return domain.ErrNotFound
 	}
 
-	if !workout.FinishedAt.IsZero() {
+	amending, err := s.checkWorkoutEditable(workout, amend)
+	if err != nil {
 		logger.Errorf("user %s tried to delete set log %s for exercise log %s in finished workout %s", userID, setLogID, exerciseLogID, workoutID)
-		return fmt.Errorf("%w: workout %s is already finished", domain.ErrInvalidArgument, workoutID)
+		return err
 	}
 
 	exerciseLog, err := s.exerciseLogRepository.GetExerciseLogByID(ctx, exerciseLogID)
@@ -506,12 +703,16 @@ func (s *Service) DeleteSetLog(ctx context.Context, userID, workoutID, exerciseL
 		return domain.ErrNotFound
 	}
 
-	err = s.setLogRepository.DeleteSetLog(ctx, setLogID)
-	if err != nil {
-		return err
-	}
+	return s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		if err := s.setLogRepository.DeleteSetLog(ctx, setLogID); err != nil || !amending {
+			return err
+		}
 
-	return nil
+		return s.workoutRepository.CreateWorkoutAmendment(ctx, domain.NewWorkoutAmendment(
+			workoutID, userID, exerciseLogID, setLogID, domain.WorkoutAmendmentActionSetDeleted,
+			domain.DiffSetLogs(setLog, domain.ExerciseSetLog{}),
+		))
+	})
 }
 
 func (s *Service) UpdateSetLog(ctx context.Context, userID, workoutID, exerciseLogID, setLogID domain.ID, setlogDTO dto.UpdateSetLogDTO) (domain.ExerciseSetLog, error) {
@@ -528,9 +729,10 @@ func (s *Service) UpdateSetLog(ctx context.Context, userID, workoutID, exerciseL
 		return domain.ExerciseSetLog{}, domain.ErrNotFound
 	}
 
-	if !workout.FinishedAt.IsZero() {
+	amending, err := s.checkWorkoutEditable(workout, setlogDTO.Amend)
+	if err != nil {
 		logger.Errorf("user %s tried to update set log %s for exercise log %s in finished workout %s", userID, setLogID, exerciseLogID, workoutID)
-		return domain.ExerciseSetLog{}, fmt.Errorf("%w: workout %s is already finished", domain.ErrInvalidArgument, workoutID)
+		return domain.ExerciseSetLog{}, err
 	}
 
 	exerciseLog, err := s.exerciseLogRepository.GetExerciseLogByID(ctx, exerciseLogID)
@@ -553,6 +755,8 @@ func (s *Service) UpdateSetLog(ctx context.Context, userID, workoutID, exerciseL
 		return domain.ExerciseSetLog{}, domain.ErrNotFound
 	}
 
+	before := setLog
+
 	if setlogDTO.Reps.IsValid {
 		setLog.Reps = setlogDTO.Reps.V
 	}
@@ -563,7 +767,21 @@ func (s *Service) UpdateSetLog(ctx context.Context, userID, workoutID, exerciseL
 
 	setLog.UpdatedAt = time.Now()
 
-	setLog, err = s.setLogRepository.UpdateSetLog(ctx, setLogID, setLog)
+	err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		setLog, err = s.setLogRepository.UpdateSetLog(ctx, setLogID, setLog)
+		if err != nil || !amending {
+			return err
+		}
+
+		changes := domain.DiffSetLogs(before, setLog)
+		if len(changes) == 0 {
+			return nil
+		}
+
+		return s.workoutRepository.CreateWorkoutAmendment(ctx, domain.NewWorkoutAmendment(
+			workoutID, userID, exerciseLogID, setLogID, domain.WorkoutAmendmentActionSetUpdated, changes,
+		))
+	})
 	if err != nil {
 		return domain.ExerciseSetLog{}, err
 	}
@@ -654,7 +872,7 @@ func (s *Service) GetWorkouts(ctx context.Context, userID domain.ID, limit, offs
 	return workoutsDTO, nil
 }
 
-func (s *Service) AddNotesToExerciseLog(ctx context.Context, userID, workoutID, exerciseLogID domain.ID, notes string) error {
+func (s *Service) AddNotesToExerciseLog(ctx context.Context, userID, workoutID, exerciseLogID domain.ID, notes string, amend bool) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "service.AddNotesToExerciseLog")
 	defer span.Finish()
 
@@ -668,9 +886,10 @@ func (s *Service) AddNotesToExerciseLog(ctx context.Context, userID, workoutID,
 		return domain.ErrNotFound
 	}
 
-	if !workout.FinishedAt.IsZero() {
+	amending, err := s.checkWorkoutEditable(workout, amend)
+	if err != nil {
 		logger.Errorf("user %s tried to add notes to exercise instance of finished workout %s", userID, workoutID)
-		return fmt.Errorf("%w: workout %s is already finished", domain.ErrInvalidArgument, workoutID)
+		return err
 	}
 
 	exerciseLog, err := s.exerciseLogRepository.GetExerciseLogByID(ctx, exerciseLogID)
@@ -683,14 +902,20 @@ func (s *Service) AddNotesToExerciseLog(ctx context.Context, userID, workoutID,
 		return domain.ErrNotFound
 	}
 
+	before := exerciseLog.Notes
 	exerciseLog.Notes = notes
 
-	_, err = s.exerciseLogRepository.UpdateExerciseLog(ctx, exerciseLogID, exerciseLog)
-	if err != nil {
-		return err
-	}
+	return s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+		_, err := s.exerciseLogRepository.UpdateExerciseLog(ctx, exerciseLogID, exerciseLog)
+		if err != nil || !amending || before == notes {
+			return err
+		}
 
-	return nil
+		return s.workoutRepository.CreateWorkoutAmendment(ctx, domain.NewWorkoutAmendment(
+			workoutID, userID, exerciseLogID, domain.ID{}, domain.WorkoutAmendmentActionNotesChanged,
+			map[string]domain.FieldChange{"notes": {From: before, To: notes}},
+		))
+	})
 }
 
 func (s *Service) AddPowerRatingToExerciseLog(ctx context.Context, userID, workoutID, exerciseLogID domain.ID, powerRating int) error {
@@ -731,3 +956,83 @@ func (s *Service) AddPowerRatingToExerciseLog(ctx context.Context, userID, worko
 
 	return nil
 }
+
+func (s *Service) SetExerciseLogOrder(ctx context.Context, userID, workoutID domain.ID, exerciseLogIDs []domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.SetExerciseLogOrder")
+	defer span.Finish()
+
+	workout, err := s.workoutRepository.GetWorkoutByID(ctx, workoutID)
+	if err != nil {
+		return err
+	}
+
+	if workout.UserID != userID {
+		logger.Errorf("user %s tried to update exercise order of workout %s", userID, workoutID)
+		return domain.ErrNotFound
+	}
+
+	if !workout.FinishedAt.IsZero() {
+		return fmt.Errorf("%w: workout %s is already finished", domain.ErrInvalidArgument, workoutID)
+	}
+
+	exerciseLogs, err := s.exerciseLogRepository.GetExerciseLogsByWorkoutID(ctx, workoutID)
+	if err != nil {
+		return err
+	}
+
+	current := make([]domain.ID, 0, len(exerciseLogs))
+	for _, exerciseLog := range exerciseLogs {
+		current = append(current, exerciseLog.ID)
+	}
+
+	if err := checkOrder("exercise log", current, exerciseLogIDs); err != nil {
+		return err
+	}
+
+	return s.exerciseLogRepository.SetExerciseLogOrder(ctx, workoutID, exerciseLogIDs)
+}
+
+func (s *Service) SetSetLogOrder(ctx context.Context, userID, workoutID, exerciseLogID domain.ID, setLogIDs []domain.ID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.SetSetLogOrder")
+	defer span.Finish()
+
+	workout, err := s.workoutRepository.GetWorkoutByID(ctx, workoutID)
+	if err != nil {
+		return err
+	}
+
+	if workout.UserID != userID {
+		logger.Errorf("user %s tried to update set order of exercise log %s in workout %s", userID, exerciseLogID, workoutID)
+		return domain.ErrNotFound
+	}
+
+	if !workout.FinishedAt.IsZero() {
+		return fmt.Errorf("%w: workout %s is already finished", domain.ErrInvalidArgument, workoutID)
+	}
+
+	exerciseLog, err := s.exerciseLogRepository.GetExerciseLogByID(ctx, exerciseLogID)
+	if err != nil {
+		return err
+	}
+
+	if exerciseLog.WorkoutID != workoutID {
+		logger.Errorf("user %s tried to update set order of exercise log %s in workout %s", userID, exerciseLogID, workoutID)
+		return domain.ErrNotFound
+	}
+
+	setLogs, err := s.setLogRepository.GetSetLogsByExerciseLogID(ctx, exerciseLogID)
+	if err != nil {
+		return err
+	}
+
+	current := make([]domain.ID, 0, len(setLogs))
+	for _, setLog := range setLogs {
+		current = append(current, setLog.ID)
+	}
+
+	if err := checkOrder("set log", current, setLogIDs); err != nil {
+		return err
+	}
+
+	return s.setLogRepository.SetSetLogOrder(ctx, exerciseLogID, setLogIDs)
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// checkWorkoutEditable reports whether a change of the workout amends a finished workout.
+// Finished workouts can be changed within the amend window after they finish, or later
+// with amend set. Reports, records and analytics are derived from the logs on read, so
+// they reflect amendments right away.
+func (s *Service) checkWorkoutEditable(workout domain.Workout, amend bool) (bool, error) {
+	if workout.FinishedAt.IsZero() {
+		return false, nil
+	}
+
+	if !amend && time.Since(workout.FinishedAt) > s.amendWindow {
+		return false, fmt.Errorf("%w: workout %s is already finished, set amend to change it", domain.ErrInvalidArgument, workout.ID)
+	}
+
+	return true, nil
+}
+
+func (s *Service) GetWorkoutAmendments(ctx context.Context, userID, workoutID domain.ID) ([]domain.WorkoutAmendment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetWorkoutAmendments")
+	defer span.Finish()
+
+	workout, err := s.workoutRepository.GetWorkoutByID(ctx, workoutID)
+	if err != nil {
+		return nil, err
+	}
+
+	if workout.UserID != userID {
+		logger.Errorf("user %s tried to access amendments of workout %s", userID, workoutID)
+		return nil, domain.ErrNotFound
+	}
+
+	return s.workoutRepository.GetWorkoutAmendments(ctx, workoutID)
+}
@@ -16,7 +16,7 @@ const (
 Обязательные условия: Мысленно сделай список упражнений, которые делал пользователь на предыдущих тренировках. В своих суждениях используй только этот список и не придумывай дополнительные упражнения. Используй только упражнения из предоставленного списка. Обеспечь проработку всех групп мышц тела, уделяя особое внимание тем группам, которые могли быть недостаточно проработаны в предыдущих тренировках. Включай в тренировочный план как упражнения со свободными весами, так и на тренажерах, отдавая предпочтение свободным весам в начале тренировки, так как они более энергозатратны. В тренировке должно быть 1, в редких случаях 2, основных упражнения со свободными весами, которые задействуют несколько групп мышц. Остальные упражнения должны быть изолированными, направленными на проработку конкретных мышц. Количество упражнений в тренировке должно быть не менее 5 и не более 8. Учитывай любые дополнительные пожелания клиента, отдавая им более высокий приоритет при выборе упражнений. 
 Variety_level определяет уровень разнообразия тренировок: 1 - минимальное разнообразие, повторяющиеся тренировки; 2 - умеренное разнообразие; 3 - максимальное разнообразие упражнений, но сбалансированное по нагрузке. Base_user_prompt содержит цель, общие пожелания и возможные противопоказания пользователя.
 Стремись к разнообразию в тренировках в соответствии с уровнем variety_level, сохраняя при этом их эффективность и безопасность. При составлении тренировочного плана также учитывай общие пожелания, цели клиента и возможные противопоказания, указанные в base_user_prompt.
-В ответ так же включи пояснение к итоговому результату, объясни, почему ты выбрал именно эти упражнения и как они помогут клиенту достичь его целей.
+В ответ так же включи пояснение к итоговому результату, объясни, почему ты выбрал именно эти упражнения и как они помогут клиенту достичь его целей. Пояснение пиши на %s языке.
 <exercise_list>%s</exercise_list>
 `
 	userPromptTemplate = `
@@ -27,6 +27,12 @@ Variety_level определяет уровень разнообразия тр
 `
 )
 
+// reasoningLanguages names the language of the reasoning for each locale
+var reasoningLanguages = map[string]string{
+	domain.LocaleRussian: "русском",
+	domain.LocaleEnglish: "английском",
+}
+
 type CompletionProvider interface {
 	CreateCompletion(ctx context.Context, userID domain.ID, systemPrompt, prompt string) (string, error)
 }
@@ -56,7 +62,11 @@ func (s *Service) GenerateWorkout(ctx context.Context, options *dto.GenerateWork
 	}
 
 	innerUserPrompt := fmt.Sprintf(userPromptTemplate, marshaledWorkouts, options.VarietyLevel, options.BaseUserPrompt, options.UserPrompt)
-	systemPrompt := fmt.Sprintf(systemPromptTemplate, marshaledExercises)
+	language, ok := reasoningLanguages[options.Locale]
+	if !ok {
+		language = reasoningLanguages[domain.LocaleRussian]
+	}
+	systemPrompt := fmt.Sprintf(systemPromptTemplate, marshaledExercises, language)
 
 	completion, err := s.completionProvider.CreateCompletion(ctx, options.UserID, systemPrompt, innerUserPrompt)
 	if err != nil {
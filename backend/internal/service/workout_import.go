@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/logger"
+	"fitness-trainer/internal/utils"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	workoutImportBatchSize   = 2
+	workoutImportLease       = 10 * time.Minute
+	workoutImportMaxAttempts = 3
+	// workoutImportMatchSimilarity is the least similarity of an exercise name which is
+	// mapped without asking the user
+	workoutImportMatchSimilarity = 0.5
+)
+
+// CreateWorkoutImport parses an uploaded export of another app and maps its exercise names
+// to the catalog. The import waits for review until every name is mapped or skipped.
+func (s *Service) CreateWorkoutImport(ctx context.Context, userID domain.ID, source domain.WorkoutImportSource, fileID domain.ID, weightUnit utils.Nullable[domain.MeasurementUnit]) (domain.WorkoutImport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.CreateWorkoutImport")
+	defer span.Finish()
+
+	file, err := s.getConfirmedFile(ctx, userID, fileID, domain.FilePurposeWorkoutImport)
+	if err != nil {
+		return domain.WorkoutImport{}, err
+	}
+
+	data, err := s.s3Client.GetObject(ctx, file.Key, domain.FilePurposeWorkoutImport.MaxSize())
+	if err != nil {
+		logger.Errorf("failed to download workout import file %s: %v", fileID, err)
+		return domain.WorkoutImport{}, domain.ErrInternal
+	}
+
+	preferences, err := s.GetUserPreferences(ctx, userID)
+	if err != nil {
+		return domain.WorkoutImport{}, err
+	}
+
+	location, err := time.LoadLocation(preferences.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	if !weightUnit.IsValid {
+		weightUnit = utils.NewNullable(preferences.WeightUnit, true)
+	}
+
+	workouts, err := parseWorkoutImport(source, data, location, weightUnit.V)
+	if err != nil {
+		return domain.WorkoutImport{}, err
+	}
+
+	mappings, err := s.matchImportedExercises(ctx, userID, workouts)
+	if err != nil {
+		return domain.WorkoutImport{}, err
+	}
+
+	return s.workoutImportRepository.CreateWorkoutImport(ctx, domain.NewWorkoutImport(userID, source, fileID, workouts, mappings))
+}
+
+// matchImportedExercises maps every exercise name of the workouts to the most similar
+// exercise of the catalog, names without a close enough match are left for review.
+func (s *Service) matchImportedExercises(ctx context.Context, userID domain.ID, workouts []domain.ImportedWorkout) ([]domain.ImportExerciseMapping, error) {
+	indexes := make(map[string]int)
+	var mappings []domain.ImportExerciseMapping
+	for _, workout := range workouts {
+		for _, exercise := range workout.Exercises {
+			i, ok := indexes[exercise.Name]
+			if !ok {
+				i = len(mappings)
+				indexes[exercise.Name] = i
+				mappings = append(mappings, domain.ImportExerciseMapping{Name: exercise.Name})
+			}
+			mappings[i].SetCount += len(exercise.Sets)
+		}
+	}
+
+	for i := range mappings {
+		exercise, similarity, err := s.exerciseRepository.MatchExerciseByName(ctx, userID, mappings[i].Name)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		if similarity >= workoutImportMatchSimilarity {
+			mappings[i].ExerciseID = utils.NewNullable(exercise.ID, true)
+			mappings[i].ExerciseName = exercise.Name
+			mappings[i].Similarity = similarity
+		}
+	}
+
+	return mappings, nil
+}
+
+// GetWorkoutImports returns imports of the user without their parsed workouts.
+func (s *Service) GetWorkoutImports(ctx context.Context, userID domain.ID) ([]domain.WorkoutImport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetWorkoutImports")
+	defer span.Finish()
+
+	return s.workoutImportRepository.GetWorkoutImports(ctx, userID)
+}
+
+func (s *Service) GetWorkoutImport(ctx context.Context, userID, importID domain.ID) (domain.WorkoutImport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetWorkoutImport")
+	defer span.Finish()
+
+	workoutImport, err := s.workoutImportRepository.GetWorkoutImportByID(ctx, importID)
+	if err != nil {
+		return domain.WorkoutImport{}, err
+	}
+
+	if workoutImport.UserID != userID {
+		logger.Errorf("user %s tried to access workout import %s", userID, importID)
+		return domain.WorkoutImport{}, domain.ErrNotFound
+	}
+
+	return workoutImport, nil
+}
+
+// ResolveWorkoutImport maps exercise names of an import under review to exercises of the
+// catalog or skips them. A confirmed import is queued once every name is resolved.
+func (s *Service) ResolveWorkoutImport(ctx context.Context, userID, importID domain.ID, mappings []domain.ImportExerciseMapping, confirm bool) (domain.WorkoutImport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.ResolveWorkoutImport")
+	defer span.Finish()
+
+	workoutImport, err := s.GetWorkoutImport(ctx, userID, importID)
+	if err != nil {
+		return domain.WorkoutImport{}, err
+	}
+
+	if workoutImport.Status != domain.WorkoutImportStatusReview {
+		return domain.WorkoutImport{}, fmt.Errorf("%w: workout import %s is already %s", domain.ErrInvalidArgument, importID, workoutImport.Status)
+	}
+
+	indexes := make(map[string]int, len(workoutImport.Mappings))
+	for i, mapping := range workoutImport.Mappings {
+		indexes[mapping.Name] = i
+	}
+
+	for _, mapping := range mappings {
+		i, ok := indexes[mapping.Name]
+		if !ok {
+			return domain.WorkoutImport{}, fmt.Errorf("%w: workout import %s has no exercise %q", domain.ErrInvalidArgument, importID, mapping.Name)
+		}
+
+		resolved := workoutImport.Mappings[i]
+		resolved.Skip = mapping.Skip
+		if mapping.ExerciseID.IsValid && !mapping.Skip {
+			exercise, err := s.GetExerciseByID(ctx, userID, mapping.ExerciseID.V)
+			if err != nil {
+				if errors.Is(err, domain.ErrNotFound) {
+					return domain.WorkoutImport{}, fmt.Errorf("%w: exercise %s not found", domain.ErrInvalidArgument, mapping.ExerciseID.V)
+				}
+				return domain.WorkoutImport{}, err
+			}
+
+			if exercise.IsArchived() {
+				return domain.WorkoutImport{}, fmt.Errorf("%w: exercise %s is archived", domain.ErrInvalidArgument, exercise.ID)
+			}
+
+			resolved.ExerciseID = utils.NewNullable(exercise.ID, true)
+			resolved.ExerciseName = exercise.Name
+			resolved.Similarity = 1
+		}
+		workoutImport.Mappings[i] = resolved
+	}
+
+	if confirm {
+		if !workoutImport.IsResolved() {
+			return domain.WorkoutImport{}, fmt.Errorf("%w: every exercise must be mapped or skipped", domain.ErrInvalidArgument)
+		}
+
+		workoutImport.Status = domain.WorkoutImportStatusPending
+		workoutImport.RunAfter = time.Now()
+	}
+
+	if err := s.workoutImportRepository.UpdateWorkoutImport(ctx, importID, workoutImport); err != nil {
+		return domain.WorkoutImport{}, err
+	}
+
+	return workoutImport, nil
+}
+
+// RunWorkoutImports creates workouts of confirmed imports every interval until the context
+// is done.
+func (s *Service) RunWorkoutImports(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			processed, err := s.ProcessWorkoutImports(ctx)
+			if err != nil {
+				logger.Errorf("failed to process workout imports: %v", err)
+				break
+			}
+			// A full batch means there may be more due imports
+			if processed < workoutImportBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessWorkoutImports creates workouts of a batch of due imports and returns the number
+// of processed imports. Failed imports are retried with a backoff.
+func (s *Service) ProcessWorkoutImports(ctx context.Context) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.ProcessWorkoutImports")
+	defer span.Finish()
+
+	imports, err := s.workoutImportRepository.ClaimWorkoutImports(ctx, workoutImportBatchSize, workoutImportLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, workoutImport := range imports {
+		if err := s.processWorkoutImport(ctx, &workoutImport); err != nil {
+			logger.Errorf("workout import %s failed on attempt %d: %v", workoutImport.ID, workoutImport.Attempts, err)
+
+			workoutImport.LastError = err.Error()
+			workoutImport.Status = domain.WorkoutImportStatusPending
+			workoutImport.RunAfter = time.Now().Add(time.Duration(workoutImport.Attempts*workoutImport.Attempts) * time.Minute)
+			if workoutImport.Attempts >= workoutImportMaxAttempts {
+				workoutImport.Status = domain.WorkoutImportStatusFailed
+			}
+		} else {
+			workoutImport.LastError = ""
+			workoutImport.Status = domain.WorkoutImportStatusDone
+		}
+
+		if err := s.workoutImportRepository.UpdateWorkoutImport(ctx, workoutImport.ID, workoutImport); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(imports), nil
+}
+
+// processWorkoutImport creates every workout of the import in its own transaction, so a
+// retry skips workouts created by the failed attempt by their external IDs.
+func (s *Service) processWorkoutImport(ctx context.Context, workoutImport *domain.WorkoutImport) error {
+	mappings := make(map[string]domain.ImportExerciseMapping, len(workoutImport.Mappings))
+	for _, mapping := range workoutImport.Mappings {
+		mappings[mapping.Name] = mapping
+	}
+
+	workoutImport.Created = 0
+	workoutImport.Skipped = 0
+	for _, imported := range workoutImport.Workouts {
+		exists, err := s.workoutRepository.HasWorkoutWithExternalID(ctx, workoutImport.UserID, imported.ExternalID)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			workoutImport.Skipped++
+			continue
+		}
+
+		created := false
+		err = s.unitOfWork.InTransaction(ctx, func(ctx context.Context) error {
+			var err error
+			created, err = s.createImportedWorkout(ctx, workoutImport.UserID, imported, mappings)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to import workout %s: %w", imported.ExternalID, err)
+		}
+
+		if created {
+			workoutImport.Created++
+		} else {
+			workoutImport.Skipped++
+		}
+	}
+
+	return nil
+}
+
+// createImportedWorkout creates a finished workout with the original times. Workouts whose
+// exercises are all skipped aren't created.
+func (s *Service) createImportedWorkout(ctx context.Context, userID domain.ID, imported domain.ImportedWorkout, mappings map[string]domain.ImportExerciseMapping) (bool, error) {
+	var exercises []domain.ImportedExercise
+	for _, exercise := range imported.Exercises {
+		if mapping := mappings[exercise.Name]; mapping.ExerciseID.IsValid && !mapping.Skip {
+			exercises = append(exercises, exercise)
+		}
+	}
+
+	if len(exercises) == 0 {
+		return false, nil
+	}
+
+	workout := domain.NewWorkout(userID, utils.Nullable[domain.ID]{}, false)
+	workout.CreatedAt = imported.StartedAt
+	workout.FinishedAt = imported.FinishedAt
+	workout.Notes = strings.TrimSpace(imported.Title + "\n" + imported.Notes)
+	workout.ExternalID = imported.ExternalID
+
+	workout, err := s.workoutRepository.CreateWorkout(ctx, workout)
+	if err != nil {
+		return false, err
+	}
+
+	for _, exercise := range exercises {
+		exerciseLog := domain.NewExerciseLog(workout.ID, mappings[exercise.Name].ExerciseID.V)
+		exerciseLog.CreatedAt = imported.StartedAt
+		exerciseLog.Notes = exercise.Notes
+
+		exerciseLog, err := s.exerciseLogRepository.CreateExerciseLog(ctx, exerciseLog)
+		if err != nil {
+			return false, err
+		}
+
+		for i, set := range exercise.Sets {
+			setLog := domain.NewExerciseSetLog(exerciseLog.ID, set.Reps, set.Weight, set.Time)
+			setLog.CreatedAt = imported.StartedAt
+			// The sources don't keep when each set was done
+			setLog.CompletedAt = imported.FinishedAt
+			setLog.Position = i
+
+			if _, err := s.setLogRepository.CreateSetLog(ctx, setLog); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	return true, nil
+}
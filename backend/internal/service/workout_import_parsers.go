@@ -0,0 +1,464 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"fitness-trainer/internal/domain"
+)
+
+// importRow is a CSV row addressed by lowercase column names.
+type importRow struct {
+	line    int
+	columns map[string]int
+	fields  []string
+	// decimalComma is set for exports of locales with a decimal comma, which use semicolons
+	// as the delimiter
+	decimalComma bool
+}
+
+func (r importRow) has(column string) bool {
+	_, ok := r.columns[column]
+	return ok
+}
+
+func (r importRow) get(column string) string {
+	i, ok := r.columns[column]
+	if !ok || i >= len(r.fields) {
+		return ""
+	}
+
+	return strings.TrimSpace(r.fields[i])
+}
+
+func (r importRow) float(column string) (float64, error) {
+	value := r.get(column)
+	if value == "" {
+		return 0, nil
+	}
+
+	result, err := parseImportNumber(value, r.decimalComma)
+	if err != nil {
+		return 0, r.errorf("invalid %s %q", column, value)
+	}
+
+	return result, nil
+}
+
+// parseImportNumber parses numbers with thousands separators like "1,234.5", "1.234,5" or
+// "1 234,5". A lone comma is the decimal separator only if decimalComma is set.
+func parseImportNumber(value string, decimalComma bool) (float64, error) {
+	value = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\u00a0', '\u202f', '\'':
+			return -1
+		}
+		return r
+	}, value)
+
+	comma, dot := strings.LastIndex(value, ","), strings.LastIndex(value, ".")
+	switch {
+	case comma >= 0 && dot >= 0:
+		// The last separator is the decimal one
+		if comma > dot {
+			value = strings.ReplaceAll(value, ".", "")
+			value = strings.Replace(value, ",", ".", 1)
+		} else {
+			value = strings.ReplaceAll(value, ",", "")
+		}
+	case comma >= 0:
+		if decimalComma && strings.Count(value, ",") == 1 {
+			value = strings.Replace(value, ",", ".", 1)
+		} else {
+			value = strings.ReplaceAll(value, ",", "")
+		}
+	case strings.Count(value, ".") > 1:
+		value = strings.ReplaceAll(value, ".", "")
+	}
+
+	return strconv.ParseFloat(value, 64)
+}
+
+func (r importRow) int(column string) (int, error) {
+	value, err := r.float(column)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(value), nil
+}
+
+func (r importRow) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: line %d: %s", domain.ErrInvalidArgument, r.line, fmt.Sprintf(format, args...))
+}
+
+// readImportRows reads a CSV export and checks that it has the required columns.
+func readImportRows(data []byte, required ...string) ([]importRow, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	firstLine, _, _ := bytes.Cut(data, []byte("\n"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	decimalComma := bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(","))
+	if decimalComma {
+		reader.Comma = ';'
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: file is empty", domain.ErrInvalidArgument)
+		}
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, column := range required {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("%w: column %q is missing", domain.ErrInvalidArgument, column)
+		}
+	}
+
+	var rows []importRow
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, importRow{line: line, columns: columns, fields: fields, decimalComma: decimalComma})
+	}
+
+	return rows, nil
+}
+
+// importBuilder groups parsed sets into workouts and exercises in the order they appear.
+type importBuilder struct {
+	source   domain.WorkoutImportSource
+	workouts []domain.ImportedWorkout
+	indexes  map[string]int
+}
+
+func newImportBuilder(source domain.WorkoutImportSource) *importBuilder {
+	return &importBuilder{source: source, indexes: make(map[string]int)}
+}
+
+// workout returns the workout started at the time, creating it on first use. The workout
+// is identified by the start time as written in the file, so uploading the file again
+// after a change of the time zone finds the same workouts.
+func (b *importBuilder) workout(rawStartedAt string, startedAt time.Time) *domain.ImportedWorkout {
+	externalID := fmt.Sprintf("%s:%s", b.source, rawStartedAt)
+
+	i, ok := b.indexes[externalID]
+	if !ok {
+		i = len(b.workouts)
+		b.indexes[externalID] = i
+		b.workouts = append(b.workouts, domain.ImportedWorkout{
+			ExternalID: externalID,
+			StartedAt:  startedAt,
+			FinishedAt: startedAt,
+		})
+	}
+
+	return &b.workouts[i]
+}
+
+// setDetails keeps the first non-empty title and notes, exports repeat them on every row
+// of the workout or only on the first one.
+func (b *importBuilder) setDetails(workout *domain.ImportedWorkout, title, notes string) {
+	if workout.Title == "" {
+		workout.Title = title
+	}
+	if workout.Notes == "" {
+		workout.Notes = notes
+	}
+}
+
+// addSet appends the set to the last exercise of the workout with the name. Sets without
+// reps, weight and time, like cardio logged as a distance, are dropped.
+func (b *importBuilder) addSet(workout *domain.ImportedWorkout, name, notes string, set domain.ImportedSet) {
+	if set.Reps <= 0 && set.Weight <= 0 && set.Time <= 0 {
+		return
+	}
+
+	last := len(workout.Exercises) - 1
+	if last < 0 || workout.Exercises[last].Name != name {
+		workout.Exercises = append(workout.Exercises, domain.ImportedExercise{Name: name})
+		last++
+	}
+
+	exercise := &workout.Exercises[last]
+	if exercise.Notes == "" {
+		exercise.Notes = notes
+	}
+	exercise.Sets = append(exercise.Sets, set)
+}
+
+// result returns workouts which have at least one set.
+func (b *importBuilder) result() ([]domain.ImportedWorkout, error) {
+	result := make([]domain.ImportedWorkout, 0, len(b.workouts))
+	for _, workout := range b.workouts {
+		if len(workout.Exercises) > 0 {
+			result = append(result, workout)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("%w: file has no workouts", domain.ErrInvalidArgument)
+	}
+
+	return result, nil
+}
+
+// parseWorkoutImport parses the CSV export of the source app. Times without a zone are in
+// the location and weights are in weightUnit unless the file names their unit.
+func parseWorkoutImport(source domain.WorkoutImportSource, data []byte, location *time.Location, weightUnit domain.MeasurementUnit) ([]domain.ImportedWorkout, error) {
+	switch source {
+	case domain.WorkoutImportSourceStrong:
+		return parseStrongImport(data, location, weightUnit)
+	case domain.WorkoutImportSourceHevy:
+		return parseHevyImport(data, location)
+	case domain.WorkoutImportSourceFitNotes:
+		return parseFitNotesImport(data, location)
+	default:
+		return nil, fmt.Errorf("%w: unknown import source %q", domain.ErrInvalidArgument, source)
+	}
+}
+
+// parseStrongImport parses the export of Strong, which has a row per set and doesn't name
+// the weight unit in older versions.
+func parseStrongImport(data []byte, location *time.Location, weightUnit domain.MeasurementUnit) ([]domain.ImportedWorkout, error) {
+	rows, err := readImportRows(data, "date", "exercise name", "weight", "reps")
+	if err != nil {
+		return nil, err
+	}
+
+	builder := newImportBuilder(domain.WorkoutImportSourceStrong)
+	for _, row := range rows {
+		if strings.EqualFold(row.get("set order"), "rest timer") {
+			continue
+		}
+
+		startedAt, err := time.ParseInLocation("2006-01-02 15:04:05", row.get("date"), location)
+		if err != nil {
+			return nil, row.errorf("invalid date %q", row.get("date"))
+		}
+
+		name := row.get("exercise name")
+		if name == "" {
+			return nil, row.errorf("exercise name is empty")
+		}
+
+		unit := weightUnit
+		if value := row.get("weight unit"); value != "" {
+			if unit, err = parseImportWeightUnit(value); err != nil {
+				return nil, row.errorf("%v", err)
+			}
+		}
+
+		set, err := parseImportSet(row, "reps", "weight", unit)
+		if err != nil {
+			return nil, err
+		}
+
+		seconds, err := row.float("seconds")
+		if err != nil {
+			return nil, err
+		}
+		set.Time = time.Duration(seconds * float64(time.Second))
+
+		workout := builder.workout(row.get("date"), startedAt)
+		builder.setDetails(workout, row.get("workout name"), row.get("workout notes"))
+		if duration := parseStrongDuration(row.get("duration")); duration > 0 {
+			workout.FinishedAt = startedAt.Add(duration)
+		}
+
+		builder.addSet(workout, name, row.get("notes"), set)
+	}
+
+	return builder.result()
+}
+
+// parseStrongDuration parses durations like "1h 5m", unknown values are zero.
+func parseStrongDuration(value string) time.Duration {
+	var result time.Duration
+	for _, part := range strings.Fields(value) {
+		duration, err := time.ParseDuration(part)
+		if err != nil {
+			return 0
+		}
+		result += duration
+	}
+
+	return result
+}
+
+// hevyTimeLayouts are the time formats used by Hevy exports of different versions.
+var hevyTimeLayouts = []string{"2 Jan 2006, 15:04", "2006-01-02 15:04:05", time.RFC3339}
+
+func parseHevyTime(value string, location *time.Location) (time.Time, error) {
+	for _, layout := range hevyTimeLayouts {
+		if result, err := time.ParseInLocation(layout, value, location); err == nil {
+			return result, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q", value)
+}
+
+// parseHevyImport parses the export of Hevy, which has a row per set and names the weight
+// unit in the column name.
+func parseHevyImport(data []byte, location *time.Location) ([]domain.ImportedWorkout, error) {
+	rows, err := readImportRows(data, "start_time", "exercise_title", "reps")
+	if err != nil {
+		return nil, err
+	}
+
+	builder := newImportBuilder(domain.WorkoutImportSourceHevy)
+	for _, row := range rows {
+		startedAt, err := parseHevyTime(row.get("start_time"), location)
+		if err != nil {
+			return nil, row.errorf("%v", err)
+		}
+
+		name := row.get("exercise_title")
+		if name == "" {
+			return nil, row.errorf("exercise title is empty")
+		}
+
+		weightColumn, unit := "weight_kg", domain.MeasurementUnitKilogram
+		if !row.has(weightColumn) {
+			weightColumn, unit = "weight_lbs", domain.MeasurementUnitPound
+		}
+
+		set, err := parseImportSet(row, "reps", weightColumn, unit)
+		if err != nil {
+			return nil, err
+		}
+
+		seconds, err := row.float("duration_seconds")
+		if err != nil {
+			return nil, err
+		}
+		set.Time = time.Duration(seconds * float64(time.Second))
+
+		workout := builder.workout(row.get("start_time"), startedAt)
+		builder.setDetails(workout, row.get("title"), row.get("description"))
+		if value := row.get("end_time"); value != "" {
+			finishedAt, err := parseHevyTime(value, location)
+			if err != nil {
+				return nil, row.errorf("%v", err)
+			}
+			if finishedAt.After(startedAt) {
+				workout.FinishedAt = finishedAt
+			}
+		}
+
+		builder.addSet(workout, name, row.get("exercise_notes"), set)
+	}
+
+	return builder.result()
+}
+
+// parseFitNotesImport parses the export of FitNotes, which only has dates, so every day
+// becomes a workout starting at midnight.
+func parseFitNotesImport(data []byte, location *time.Location) ([]domain.ImportedWorkout, error) {
+	rows, err := readImportRows(data, "date", "exercise", "reps")
+	if err != nil {
+		return nil, err
+	}
+
+	builder := newImportBuilder(domain.WorkoutImportSourceFitNotes)
+	for _, row := range rows {
+		startedAt, err := time.ParseInLocation("2006-01-02", row.get("date"), location)
+		if err != nil {
+			return nil, row.errorf("invalid date %q", row.get("date"))
+		}
+
+		name := row.get("exercise")
+		if name == "" {
+			return nil, row.errorf("exercise is empty")
+		}
+
+		weightColumn, unit := "weight (kgs)", domain.MeasurementUnitKilogram
+		if !row.has(weightColumn) {
+			weightColumn, unit = "weight (lbs)", domain.MeasurementUnitPound
+		}
+
+		set, err := parseImportSet(row, "reps", weightColumn, unit)
+		if err != nil {
+			return nil, err
+		}
+
+		if set.Time, err = parseFitNotesTime(row.get("time")); err != nil {
+			return nil, row.errorf("%v", err)
+		}
+
+		builder.addSet(builder.workout(row.get("date"), startedAt), name, row.get("comment"), set)
+	}
+
+	return builder.result()
+}
+
+// parseFitNotesTime parses times like "0:01:30" or "01:30".
+func parseFitNotesTime(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	var result time.Duration
+	for _, part := range strings.Split(value, ":") {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return 0, fmt.Errorf("invalid time %q", value)
+		}
+		result = result*60 + time.Duration(number)
+	}
+
+	return result * time.Second, nil
+}
+
+func parseImportSet(row importRow, repsColumn, weightColumn string, unit domain.MeasurementUnit) (domain.ImportedSet, error) {
+	reps, err := row.int(repsColumn)
+	if err != nil {
+		return domain.ImportedSet{}, err
+	}
+
+	weight, err := row.float(weightColumn)
+	if err != nil {
+		return domain.ImportedSet{}, err
+	}
+
+	if reps < 0 || weight < 0 {
+		return domain.ImportedSet{}, row.errorf("reps and weight can't be negative")
+	}
+
+	return domain.ImportedSet{Reps: reps, Weight: float32(unit.ToBase(weight))}, nil
+}
+
+func parseImportWeightUnit(value string) (domain.MeasurementUnit, error) {
+	switch strings.ToLower(value) {
+	case "kg", "kgs":
+		return domain.MeasurementUnitKilogram, nil
+	case "lb", "lbs":
+		return domain.MeasurementUnitPound, nil
+	default:
+		return domain.MeasurementUnitUnknown, fmt.Errorf("unknown weight unit %q", value)
+	}
+}
@@ -0,0 +1,309 @@
+package service
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"fitness-trainer/internal/domain"
+)
+
+func TestParseWorkoutImport(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	pounds := func(weight float64) float32 {
+		return float32(domain.MeasurementUnitPound.ToBase(weight))
+	}
+
+	tests := []struct {
+		name       string
+		source     domain.WorkoutImportSource
+		file       string
+		weightUnit domain.MeasurementUnit
+		want       []domain.ImportedWorkout
+	}{
+		{
+			name:       "strong with semicolons, decimal commas, bom and rest timers",
+			source:     domain.WorkoutImportSourceStrong,
+			file:       "strong_semicolon.csv",
+			weightUnit: domain.MeasurementUnitKilogram,
+			want: []domain.ImportedWorkout{
+				{
+					ExternalID: "strong:2024-01-15 18:30:00",
+					Title:      "Legs",
+					Notes:      "Felt strong",
+					StartedAt:  time.Date(2024, 1, 15, 18, 30, 0, 0, moscow),
+					FinishedAt: time.Date(2024, 1, 15, 19, 35, 0, 0, moscow),
+					Exercises: []domain.ImportedExercise{
+						{
+							Name:  "Squat (Barbell)",
+							Notes: "Too heavy",
+							Sets:  []domain.ImportedSet{{Reps: 5, Weight: 102.5}, {Reps: 1, Weight: 1002.5}},
+						},
+						{
+							Name: "Plank",
+							Sets: []domain.ImportedSet{{Time: 60500 * time.Millisecond}},
+						},
+					},
+				},
+				{
+					ExternalID: "strong:2024-01-17 07:00:00",
+					Title:      "Push",
+					StartedAt:  time.Date(2024, 1, 17, 7, 0, 0, 0, moscow),
+					FinishedAt: time.Date(2024, 1, 17, 7, 45, 0, 0, moscow),
+					Exercises: []domain.ImportedExercise{
+						{
+							Name: "Bench Press (Barbell)",
+							Sets: []domain.ImportedSet{{Reps: 5, Weight: pounds(225)}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:       "strong with thousands separators in the unit of the user",
+			source:     domain.WorkoutImportSourceStrong,
+			file:       "strong_comma.csv",
+			weightUnit: domain.MeasurementUnitPound,
+			want: []domain.ImportedWorkout{
+				{
+					ExternalID: "strong:2024-01-15 18:30:00",
+					Title:      "Legs",
+					StartedAt:  time.Date(2024, 1, 15, 18, 30, 0, 0, moscow),
+					FinishedAt: time.Date(2024, 1, 15, 19, 30, 0, 0, moscow),
+					Exercises: []domain.ImportedExercise{
+						{
+							Name: "Squat (Barbell)",
+							Sets: []domain.ImportedSet{{Reps: 2, Weight: pounds(1234.5)}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "hevy in kilograms with every time layout",
+			source: domain.WorkoutImportSourceHevy,
+			file:   "hevy_kg.csv",
+			want: []domain.ImportedWorkout{
+				{
+					ExternalID: "hevy:15 Jan 2024, 07:00",
+					Title:      "Morning",
+					Notes:      "Fasted",
+					StartedAt:  time.Date(2024, 1, 15, 7, 0, 0, 0, moscow),
+					FinishedAt: time.Date(2024, 1, 15, 8, 10, 0, 0, moscow),
+					Exercises: []domain.ImportedExercise{
+						{
+							Name:  "Deadlift (Barbell)",
+							Notes: "Hook grip",
+							Sets:  []domain.ImportedSet{{Reps: 3, Weight: 140}, {Reps: 1, Weight: 150}},
+						},
+					},
+				},
+				{
+					ExternalID: "hevy:2024-01-16 19:00:00",
+					Title:      "Evening",
+					StartedAt:  time.Date(2024, 1, 16, 19, 0, 0, 0, moscow),
+					FinishedAt: time.Date(2024, 1, 16, 19, 45, 0, 0, moscow),
+					Exercises: []domain.ImportedExercise{
+						{
+							Name: "Plank",
+							Sets: []domain.ImportedSet{{Time: 45 * time.Second}},
+						},
+					},
+				},
+				{
+					ExternalID: "hevy:2024-01-17T21:00:00Z",
+					Title:      "Late",
+					StartedAt:  time.Date(2024, 1, 17, 21, 0, 0, 0, time.UTC),
+					FinishedAt: time.Date(2024, 1, 17, 21, 0, 0, 0, time.UTC),
+					Exercises: []domain.ImportedExercise{
+						{
+							Name: "Pull Up",
+							Sets: []domain.ImportedSet{{Reps: 8}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "hevy in pounds with semicolons",
+			source: domain.WorkoutImportSourceHevy,
+			file:   "hevy_lbs.csv",
+			want: []domain.ImportedWorkout{
+				{
+					ExternalID: "hevy:15 Jan 2024, 07:00",
+					Title:      "Upper",
+					StartedAt:  time.Date(2024, 1, 15, 7, 0, 0, 0, moscow),
+					FinishedAt: time.Date(2024, 1, 15, 8, 0, 0, 0, moscow),
+					Exercises: []domain.ImportedExercise{
+						{
+							Name: "Bench Press (Barbell)",
+							Sets: []domain.ImportedSet{{Reps: 10, Weight: pounds(135.5)}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "fitnotes in kilograms",
+			source: domain.WorkoutImportSourceFitNotes,
+			file:   "fitnotes_kg.csv",
+			want: []domain.ImportedWorkout{
+				{
+					ExternalID: "fitnotes:2024-01-15",
+					StartedAt:  time.Date(2024, 1, 15, 0, 0, 0, 0, moscow),
+					FinishedAt: time.Date(2024, 1, 15, 0, 0, 0, 0, moscow),
+					Exercises: []domain.ImportedExercise{
+						{
+							Name:  "Flat Barbell Bench Press",
+							Notes: "Paused",
+							Sets:  []domain.ImportedSet{{Reps: 8, Weight: 80}, {Reps: 6, Weight: 82.5}},
+						},
+						{
+							Name: "Plank",
+							Sets: []domain.ImportedSet{{Time: 90 * time.Second}},
+						},
+					},
+				},
+				{
+					ExternalID: "fitnotes:2024-01-16",
+					StartedAt:  time.Date(2024, 1, 16, 0, 0, 0, 0, moscow),
+					FinishedAt: time.Date(2024, 1, 16, 0, 0, 0, 0, moscow),
+					Exercises: []domain.ImportedExercise{
+						{
+							Name: "Deadlift",
+							Sets: []domain.ImportedSet{{Reps: 5, Weight: 140}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "fitnotes in pounds with thousands separators",
+			source: domain.WorkoutImportSourceFitNotes,
+			file:   "fitnotes_lbs.csv",
+			want: []domain.ImportedWorkout{
+				{
+					ExternalID: "fitnotes:2024-01-15",
+					StartedAt:  time.Date(2024, 1, 15, 0, 0, 0, 0, moscow),
+					FinishedAt: time.Date(2024, 1, 15, 0, 0, 0, 0, moscow),
+					Exercises: []domain.ImportedExercise{
+						{
+							Name: "Squat",
+							Sets: []domain.ImportedSet{{Reps: 1, Weight: pounds(1000)}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join("testdata", "workout_imports", tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := parseWorkoutImport(tt.source, data, moscow, tt.weightUnit)
+			if err != nil {
+				t.Fatalf("parseWorkoutImport() error = %v", err)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d workouts, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if !got[i].StartedAt.Equal(tt.want[i].StartedAt) || !got[i].FinishedAt.Equal(tt.want[i].FinishedAt) {
+					t.Errorf("workout %d runs %v - %v, want %v - %v", i,
+						got[i].StartedAt, got[i].FinishedAt, tt.want[i].StartedAt, tt.want[i].FinishedAt)
+				}
+
+				got[i].StartedAt, got[i].FinishedAt = tt.want[i].StartedAt, tt.want[i].FinishedAt
+				if !reflect.DeepEqual(got[i], tt.want[i]) {
+					t.Errorf("workout %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseWorkoutImportExternalIDsIgnoreTimeZone(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "workout_imports", "strong_semicolon.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inUTC, err := parseWorkoutImport(domain.WorkoutImportSourceStrong, data, time.UTC, domain.MeasurementUnitKilogram)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inMoscow, err := parseWorkoutImport(domain.WorkoutImportSourceStrong, data, time.FixedZone("MSK", 3*60*60), domain.MeasurementUnitKilogram)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range inUTC {
+		if inUTC[i].ExternalID != inMoscow[i].ExternalID {
+			t.Errorf("external id = %q in UTC and %q in Moscow", inUTC[i].ExternalID, inMoscow[i].ExternalID)
+		}
+	}
+}
+
+func TestParseWorkoutImportErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		source domain.WorkoutImportSource
+		data   string
+	}{
+		{name: "empty file", source: domain.WorkoutImportSourceStrong, data: ""},
+		{name: "missing column", source: domain.WorkoutImportSourceStrong, data: "Date,Exercise Name,Reps\n"},
+		{name: "no workouts", source: domain.WorkoutImportSourceFitNotes, data: "Date,Exercise,Reps\n"},
+		{name: "invalid date", source: domain.WorkoutImportSourceFitNotes, data: "Date,Exercise,Reps\n15.01.2024,Squat,5\n"},
+		{name: "invalid weight", source: domain.WorkoutImportSourceFitNotes, data: "Date,Exercise,Weight (kgs),Reps\n2024-01-15,Squat,heavy,5\n"},
+		{name: "unknown weight unit", source: domain.WorkoutImportSourceStrong, data: "Date,Exercise Name,Weight,Weight Unit,Reps\n2024-01-15 18:30:00,Squat,100,stone,5\n"},
+		{name: "unknown source", source: domain.WorkoutImportSource("jefit"), data: "Date\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseWorkoutImport(tt.source, []byte(tt.data), time.UTC, domain.MeasurementUnitKilogram)
+			if !errors.Is(err, domain.ErrInvalidArgument) {
+				t.Errorf("error = %v, want %v", err, domain.ErrInvalidArgument)
+			}
+		})
+	}
+}
+
+func TestParseImportNumber(t *testing.T) {
+	tests := []struct {
+		value        string
+		decimalComma bool
+		want         float64
+	}{
+		{value: "102.5", want: 102.5},
+		{value: "102,5", decimalComma: true, want: 102.5},
+		{value: "1,234.5", want: 1234.5},
+		{value: "1.234,5", decimalComma: true, want: 1234.5},
+		{value: "1 234,5", decimalComma: true, want: 1234.5},
+		{value: "1\u00a0234,5", decimalComma: true, want: 1234.5},
+		{value: "1,000", want: 1000},
+		{value: "1.234.567", decimalComma: true, want: 1234567},
+		{value: "1,234,567.5", want: 1234567.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseImportNumber(tt.value, tt.decimalComma)
+			if err != nil {
+				t.Fatalf("parseImportNumber(%q) error = %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseImportNumber(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
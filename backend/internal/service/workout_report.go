@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/domain/dto"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+func (s *Service) GetWorkoutReport(ctx context.Context, userID, workoutID domain.ID) (dto.WorkoutReportDTO, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetWorkoutReport")
+	defer span.Finish()
+
+	details, err := s.GetWorkout(ctx, userID, workoutID)
+	if err != nil {
+		return dto.WorkoutReportDTO{}, err
+	}
+
+	report := dto.WorkoutReportDTO{
+		Workout:      details.Workout,
+		ExerciseLogs: make([]domain.ExerciseLog, 0, len(details.ExerciseLogs)),
+	}
+
+	finishedAt := details.Workout.FinishedAt
+	if finishedAt.IsZero() {
+		finishedAt = time.Now()
+	}
+	report.TotalTime = finishedAt.Sub(details.Workout.CreatedAt)
+
+	groups := make(map[domain.ID]int)
+	for _, exerciseLog := range details.ExerciseLogs {
+		report.ExerciseLogs = append(report.ExerciseLogs, exerciseLog.ExerciseLog)
+
+		for _, setLog := range exerciseLog.SetLogs {
+			report.TotalSets++
+			report.TotalReps += setLog.Reps
+			report.TotalWeight += setLog.Weight * float32(setLog.Reps)
+		}
+
+		if !exerciseLog.ExerciseLog.Group.IsValid {
+			continue
+		}
+
+		group := exerciseLog.ExerciseLog.Group.V
+		i, ok := groups[group.ID]
+		if !ok {
+			i = len(report.Groups)
+			groups[group.ID] = i
+			report.Groups = append(report.Groups, dto.ExerciseGroupReportDTO{
+				Group:           group,
+				CompletedRounds: len(exerciseLog.SetLogs),
+			})
+		}
+
+		report.Groups[i].ExerciseLogIDs = append(report.Groups[i].ExerciseLogIDs, exerciseLog.ExerciseLog.ID)
+		report.Groups[i].CompletedRounds = min(report.Groups[i].CompletedRounds, len(exerciseLog.SetLogs))
+	}
+
+	return report, nil
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"fitness-trainer/internal/domain"
+	"fitness-trainer/internal/utils"
+)
+
+func TestSetExerciseLogOrder(t *testing.T) {
+	userID := domain.NewID()
+
+	tests := []struct {
+		name     string
+		owner    domain.ID
+		finished bool
+		// order lists indexes of the exercise logs of the workout
+		order   []int
+		wantErr error
+	}{
+		{
+			name:  "reversed",
+			owner: userID,
+			order: []int{2, 1, 0},
+		},
+		{
+			name:    "workout of another user",
+			owner:   domain.NewID(),
+			order:   []int{2, 1, 0},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name:     "finished workout",
+			owner:    userID,
+			finished: true,
+			order:    []int{2, 1, 0},
+			wantErr:  domain.ErrInvalidArgument,
+		},
+		{
+			name:    "exercise log left out",
+			owner:   userID,
+			order:   []int{2, 1},
+			wantErr: domain.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workout := domain.NewWorkout(tt.owner, utils.Nullable[domain.ID]{}, false)
+			if tt.finished {
+				workout.FinishedAt = time.Now()
+			}
+
+			exerciseLogs := []domain.ExerciseLog{
+				domain.NewExerciseLog(workout.ID, domain.NewID()),
+				domain.NewExerciseLog(workout.ID, domain.NewID()),
+				domain.NewExerciseLog(workout.ID, domain.NewID()),
+			}
+			repository := &fakeExerciseLogRepository{exerciseLogs: append([]domain.ExerciseLog(nil), exerciseLogs...)}
+			s := &Service{
+				workoutRepository:     &fakeWorkoutRepository{workout: workout},
+				exerciseLogRepository: repository,
+			}
+
+			order := make([]domain.ID, 0, len(tt.order))
+			for _, i := range tt.order {
+				order = append(order, exerciseLogs[i].ID)
+			}
+
+			err := s.SetExerciseLogOrder(context.Background(), userID, workout.ID, order)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+
+			want := order
+			if tt.wantErr != nil {
+				want = []domain.ID{exerciseLogs[0].ID, exerciseLogs[1].ID, exerciseLogs[2].ID}
+			}
+			if len(repository.exerciseLogs) != len(want) {
+				t.Fatalf("got %d exercise logs, want %d", len(repository.exerciseLogs), len(want))
+			}
+			for i, exerciseLog := range repository.exerciseLogs {
+				if exerciseLog.ID != want[i] {
+					t.Errorf("exercise log %d = %s, want %s", i, exerciseLog.ID, want[i])
+				}
+			}
+		})
+	}
+}
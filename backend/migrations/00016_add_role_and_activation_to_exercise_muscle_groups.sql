@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE exercise_muscle_groups
+    ADD COLUMN role VARCHAR(50) NOT NULL DEFAULT 'primary' CHECK (
+        role IN ('primary', 'secondary', 'stabilizer')
+    ),
+    ADD COLUMN activation REAL NOT NULL DEFAULT 1.0 CHECK (
+        activation > 0
+        AND activation <= 1
+    );
+
+-- +goose Down
+ALTER TABLE exercise_muscle_groups
+    DROP COLUMN activation,
+    DROP COLUMN role;
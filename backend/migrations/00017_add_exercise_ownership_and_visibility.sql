@@ -0,0 +1,43 @@
+-- +goose Up
+ALTER TABLE users
+    ADD COLUMN role VARCHAR(50) NOT NULL DEFAULT 'user' CHECK (role IN ('user', 'admin'));
+
+ALTER TABLE exercises
+    ADD COLUMN owner_id UUID NULL REFERENCES users (id) ON DELETE SET NULL,
+    ADD COLUMN visibility VARCHAR(50) NOT NULL DEFAULT 'global' CHECK (
+        visibility IN ('global', 'private', 'shared')
+    ),
+    ADD COLUMN share_token VARCHAR(64) NULL UNIQUE,
+    ADD COLUMN moderation_status VARCHAR(50) NOT NULL DEFAULT 'none' CHECK (
+        moderation_status IN ('none', 'pending', 'approved', 'rejected')
+    ),
+    ADD COLUMN moderation_comment TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX exercises_owner_id_idx ON exercises (owner_id);
+
+CREATE INDEX exercises_moderation_status_idx ON exercises (moderation_status)
+WHERE moderation_status = 'pending';
+
+-- Users who opened a share link of a shared exercise
+CREATE TABLE exercise_shares (
+    exercise_id UUID NOT NULL REFERENCES exercises (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (exercise_id, user_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS exercise_shares;
+
+DROP INDEX IF EXISTS exercises_moderation_status_idx;
+
+DROP INDEX IF EXISTS exercises_owner_id_idx;
+
+ALTER TABLE exercises
+    DROP COLUMN moderation_comment,
+    DROP COLUMN moderation_status,
+    DROP COLUMN share_token,
+    DROP COLUMN visibility,
+    DROP COLUMN owner_id;
+
+ALTER TABLE users DROP COLUMN role;
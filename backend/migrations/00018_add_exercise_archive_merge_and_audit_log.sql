@@ -0,0 +1,23 @@
+-- +goose Up
+ALTER TABLE exercises
+    ADD COLUMN archived_at TIMESTAMPTZ NULL,
+    ADD COLUMN merged_into_id UUID NULL REFERENCES exercises (id) ON DELETE SET NULL;
+
+CREATE TABLE exercise_audit_log (
+    id UUID PRIMARY KEY,
+    exercise_id UUID NOT NULL REFERENCES exercises (id) ON DELETE CASCADE,
+    user_id UUID NULL REFERENCES users (id) ON DELETE SET NULL,
+    action VARCHAR(50) NOT NULL,
+    changes JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX exercise_audit_log_exercise_id_idx ON exercise_audit_log (exercise_id, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS exercise_audit_log;
+
+ALTER TABLE exercises
+    DROP COLUMN merged_into_id,
+    DROP COLUMN archived_at;
@@ -0,0 +1,44 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE exercises
+    ADD COLUMN equipment VARCHAR(32) NOT NULL DEFAULT 'other'
+        CHECK (equipment IN ('barbell', 'dumbbell', 'kettlebell', 'machine', 'cable', 'bodyweight', 'band', 'other')),
+    -- The russian configuration stems latin words with the english stemmer,
+    -- so it covers both languages of the catalog
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', COALESCE(name, '')), 'A') ||
+        setweight(to_tsvector('russian', COALESCE(description, '')), 'C')
+    ) STORED;
+
+CREATE INDEX exercises_search_vector_idx ON exercises USING GIN (search_vector);
+CREATE INDEX exercises_name_trgm_idx ON exercises USING GIN (name gin_trgm_ops);
+CREATE INDEX exercises_description_trgm_idx ON exercises USING GIN (description gin_trgm_ops);
+CREATE INDEX exercises_equipment_idx ON exercises (equipment);
+
+CREATE TABLE exercise_aliases (
+    exercise_id UUID NOT NULL REFERENCES exercises (id) ON DELETE CASCADE,
+    language VARCHAR(8) NOT NULL,
+    alias VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (exercise_id, language, alias)
+);
+
+CREATE INDEX exercise_aliases_alias_trgm_idx ON exercise_aliases USING GIN (alias gin_trgm_ops);
+CREATE INDEX exercise_aliases_search_idx ON exercise_aliases USING GIN (to_tsvector('russian', alias));
+
+CREATE INDEX exercise_logs_exercise_id_created_at_idx ON exercise_logs (exercise_id, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS exercise_logs_exercise_id_created_at_idx;
+
+DROP TABLE IF EXISTS exercise_aliases;
+
+DROP INDEX IF EXISTS exercises_equipment_idx;
+DROP INDEX IF EXISTS exercises_description_trgm_idx;
+DROP INDEX IF EXISTS exercises_name_trgm_idx;
+DROP INDEX IF EXISTS exercises_search_vector_idx;
+
+ALTER TABLE exercises
+    DROP COLUMN search_vector,
+    DROP COLUMN equipment;
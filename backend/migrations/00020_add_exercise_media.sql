@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE exercise_media (
+    id UUID PRIMARY KEY,
+    exercise_id UUID NOT NULL REFERENCES exercises (id) ON DELETE CASCADE,
+    uploaded_by UUID NULL REFERENCES users (id) ON DELETE SET NULL,
+    kind VARCHAR(16) NOT NULL CHECK (kind IN ('image', 'gif', 'video')),
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'ready')),
+    key VARCHAR(1024) NOT NULL UNIQUE,
+    url VARCHAR(2048) NOT NULL DEFAULT '',
+    content_type VARCHAR(255) NOT NULL,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    caption TEXT NOT NULL DEFAULT '',
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX exercise_media_exercise_id_idx ON exercise_media (exercise_id, position);
+
+-- +goose Down
+DROP TABLE IF EXISTS exercise_media;
@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE files (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    purpose VARCHAR(32) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'confirmed')),
+    key VARCHAR(1024) NOT NULL UNIQUE,
+    url VARCHAR(2048) NOT NULL,
+    original_name VARCHAR(255) NOT NULL DEFAULT '',
+    content_type VARCHAR(255) NOT NULL,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    confirmed_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX files_user_id_idx ON files (user_id, purpose);
+
+ALTER TABLE users
+    ADD COLUMN profile_picture_file_id UUID NULL REFERENCES files (id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE users
+    DROP COLUMN profile_picture_file_id;
+
+DROP TABLE IF EXISTS files;
@@ -0,0 +1,38 @@
+-- +goose Up
+ALTER TABLE files
+    ADD COLUMN variants JSONB NOT NULL DEFAULT '[]';
+
+ALTER TABLE users
+    ADD COLUMN profile_picture_variants JSONB NOT NULL DEFAULT '[]';
+
+ALTER TABLE exercise_media
+    ADD COLUMN variants JSONB NOT NULL DEFAULT '[]';
+
+CREATE TABLE image_processing_jobs (
+    id UUID PRIMARY KEY,
+    source_type VARCHAR(32) NOT NULL CHECK (source_type IN ('file', 'exercise_media')),
+    source_id UUID NOT NULL,
+    source_key VARCHAR(1024) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'done', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    -- Pending jobs wait until run_after; for running jobs it is the end of the lease
+    run_after TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX image_processing_jobs_run_after_idx ON image_processing_jobs (run_after)
+    WHERE status IN ('pending', 'running');
+
+-- +goose Down
+DROP TABLE IF EXISTS image_processing_jobs;
+
+ALTER TABLE exercise_media
+    DROP COLUMN variants;
+
+ALTER TABLE users
+    DROP COLUMN profile_picture_variants;
+
+ALTER TABLE files
+    DROP COLUMN variants;
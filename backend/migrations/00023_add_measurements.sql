@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE measurements (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL CHECK (kind IN (
+        'bodyweight', 'body_fat', 'neck', 'shoulders', 'chest', 'waist', 'hips', 'arm', 'forearm', 'thigh', 'calf'
+    )),
+    value DOUBLE PRECISION NOT NULL CHECK (value > 0),
+    unit VARCHAR(16) NOT NULL CHECK (unit IN ('kg', 'lb', 'percent', 'cm', 'in')),
+    measured_at TIMESTAMPTZ NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX measurements_user_id_kind_measured_at_idx ON measurements (user_id, kind, measured_at DESC);
+
+-- The weight users already entered becomes the first entry of their bodyweight history
+INSERT INTO measurements (id, user_id, kind, value, unit, measured_at)
+SELECT GEN_RANDOM_UUID(), id, 'bodyweight', weight, 'kg', updated_at
+FROM users
+WHERE weight > 0;
+
+-- +goose Down
+DROP TABLE IF EXISTS measurements;
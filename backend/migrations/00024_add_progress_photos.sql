@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE progress_photos (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    file_id UUID NOT NULL UNIQUE REFERENCES files (id),
+    pose VARCHAR(16) NOT NULL CHECK (pose IN ('front', 'side', 'back')),
+    taken_at TIMESTAMPTZ NOT NULL,
+    measurement_id UUID NULL REFERENCES measurements (id) ON DELETE SET NULL,
+    is_private BOOLEAN NOT NULL DEFAULT TRUE,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX progress_photos_user_id_taken_at_idx ON progress_photos (user_id, pose, taken_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS progress_photos;
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE user_preferences (
+    user_id UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    weight_unit VARCHAR(8) NOT NULL DEFAULT 'kg' CHECK (weight_unit IN ('kg', 'lb')),
+    length_unit VARCHAR(8) NOT NULL DEFAULT 'cm' CHECK (length_unit IN ('cm', 'in')),
+    locale VARCHAR(8) NOT NULL DEFAULT 'ru',
+    -- 0 is Sunday
+    first_day_of_week SMALLINT NOT NULL DEFAULT 1 CHECK (first_day_of_week BETWEEN 0 AND 6),
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_preferences;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE gym_profiles (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    bar_weight REAL NOT NULL CHECK (bar_weight >= 0),
+    -- Array of {"weight": kilograms, "count": plates of both sides}
+    plates JSONB NOT NULL DEFAULT '[]',
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX gym_profiles_user_id_idx ON gym_profiles (user_id);
+CREATE UNIQUE INDEX gym_profiles_user_id_default_idx ON gym_profiles (user_id) WHERE is_default;
+
+-- +goose Down
+DROP TABLE IF EXISTS gym_profiles;
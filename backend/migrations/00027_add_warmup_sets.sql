@@ -0,0 +1,10 @@
+-- +goose Up
+-- Array of {"percent": of the working weight, "reps": reps}, empty if the routine has no warm-ups
+ALTER TABLE routines ADD COLUMN warmup_steps JSONB NOT NULL DEFAULT '[]';
+
+ALTER TABLE expected_sets ADD COLUMN is_warmup BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE expected_sets DROP COLUMN IF EXISTS is_warmup;
+
+ALTER TABLE routines DROP COLUMN IF EXISTS warmup_steps;
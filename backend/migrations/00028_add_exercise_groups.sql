@@ -0,0 +1,32 @@
+-- +goose Up
+-- Exercises sharing a group_id are performed back to back group_rounds times
+ALTER TABLE exercise_instances
+    ADD COLUMN group_id UUID,
+    ADD COLUMN group_type TEXT,
+    ADD COLUMN group_rounds INT,
+    ADD CONSTRAINT exercise_instances_group_check CHECK (
+        (group_id IS NULL AND group_type IS NULL AND group_rounds IS NULL)
+        OR (group_id IS NOT NULL AND group_type IN ('superset', 'giant_set', 'circuit') AND group_rounds > 0)
+    );
+
+ALTER TABLE exercise_logs
+    ADD COLUMN group_id UUID,
+    ADD COLUMN group_type TEXT,
+    ADD COLUMN group_rounds INT,
+    ADD CONSTRAINT exercise_logs_group_check CHECK (
+        (group_id IS NULL AND group_type IS NULL AND group_rounds IS NULL)
+        OR (group_id IS NOT NULL AND group_type IN ('superset', 'giant_set', 'circuit') AND group_rounds > 0)
+    );
+
+-- +goose Down
+ALTER TABLE exercise_logs
+    DROP CONSTRAINT IF EXISTS exercise_logs_group_check,
+    DROP COLUMN IF EXISTS group_rounds,
+    DROP COLUMN IF EXISTS group_type,
+    DROP COLUMN IF EXISTS group_id;
+
+ALTER TABLE exercise_instances
+    DROP CONSTRAINT IF EXISTS exercise_instances_group_check,
+    DROP COLUMN IF EXISTS group_rounds,
+    DROP COLUMN IF EXISTS group_type,
+    DROP COLUMN IF EXISTS group_id;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- Target rest after the set
+ALTER TABLE sets ADD COLUMN rest INTERVAL;
+
+ALTER TABLE expected_sets ADD COLUMN rest INTERVAL;
+
+-- started_at is reported by the client, completed_at is set by the server when the set is logged
+ALTER TABLE set_logs
+    ADD COLUMN started_at TIMESTAMPTZ,
+    ADD COLUMN completed_at TIMESTAMPTZ;
+
+UPDATE set_logs SET completed_at = created_at;
+
+ALTER TABLE set_logs
+    ALTER COLUMN completed_at SET NOT NULL,
+    ALTER COLUMN completed_at SET DEFAULT NOW();
+
+-- +goose Down
+ALTER TABLE set_logs
+    DROP COLUMN IF EXISTS completed_at,
+    DROP COLUMN IF EXISTS started_at;
+
+ALTER TABLE expected_sets DROP COLUMN IF EXISTS rest;
+
+ALTER TABLE sets DROP COLUMN IF EXISTS rest;
@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE exercise_logs ADD COLUMN position INT;
+
+UPDATE exercise_logs el
+SET position = ordered.idx
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY workout_id ORDER BY created_at) - 1 AS idx
+    FROM exercise_logs
+) AS ordered
+WHERE el.id = ordered.id;
+
+ALTER TABLE exercise_logs
+    ALTER COLUMN position SET NOT NULL,
+    ALTER COLUMN position SET DEFAULT 0;
+
+CREATE INDEX exercise_logs_workout_id_position_idx ON exercise_logs (workout_id, position);
+
+-- +goose Down
+DROP INDEX IF EXISTS exercise_logs_workout_id_position_idx;
+
+ALTER TABLE exercise_logs DROP COLUMN IF EXISTS position;
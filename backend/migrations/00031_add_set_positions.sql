@@ -0,0 +1,37 @@
+-- +goose Up
+ALTER TABLE sets ADD COLUMN position INT;
+ALTER TABLE expected_sets ADD COLUMN position INT;
+ALTER TABLE set_logs ADD COLUMN position INT;
+
+UPDATE sets s
+SET position = ordered.idx
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY exercise_instance_id ORDER BY created_at) - 1 AS idx
+    FROM sets
+) AS ordered
+WHERE s.id = ordered.id;
+
+UPDATE expected_sets es
+SET position = ordered.idx
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY exercise_log_id ORDER BY created_at) - 1 AS idx
+    FROM expected_sets
+) AS ordered
+WHERE es.id = ordered.id;
+
+UPDATE set_logs sl
+SET position = ordered.idx
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY exercise_log_id ORDER BY created_at) - 1 AS idx
+    FROM set_logs
+) AS ordered
+WHERE sl.id = ordered.id;
+
+ALTER TABLE sets ALTER COLUMN position SET NOT NULL, ALTER COLUMN position SET DEFAULT 0;
+ALTER TABLE expected_sets ALTER COLUMN position SET NOT NULL, ALTER COLUMN position SET DEFAULT 0;
+ALTER TABLE set_logs ALTER COLUMN position SET NOT NULL, ALTER COLUMN position SET DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE set_logs DROP COLUMN IF EXISTS position;
+ALTER TABLE expected_sets DROP COLUMN IF EXISTS position;
+ALTER TABLE sets DROP COLUMN IF EXISTS position;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE routine_versions (
+    id UUID PRIMARY KEY,
+    -- Versions outlive deleted routines, workouts started from them still point to them
+    routine_id UUID REFERENCES routines(id) ON DELETE SET NULL,
+    version INT NOT NULL,
+    snapshot JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (routine_id, version)
+);
+
+-- Existing routines get their first version right before the next edit or workout started from them
+ALTER TABLE workouts ADD COLUMN routine_version_id UUID REFERENCES routine_versions(id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE workouts DROP COLUMN IF EXISTS routine_version_id;
+DROP TABLE IF EXISTS routine_versions;
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE routine_share_links (
+    id UUID PRIMARY KEY,
+    routine_id UUID NOT NULL REFERENCES routines (id) ON DELETE CASCADE,
+    token VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX routine_share_links_routine_id_idx ON routine_share_links (routine_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS routine_share_links;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Templates are routines without an owner, they are loaded from seed files
+ALTER TABLE routines ALTER COLUMN user_id DROP NOT NULL;
+
+ALTER TABLE routines
+    ADD COLUMN template_key VARCHAR(100) NULL UNIQUE,
+    ADD COLUMN template_version INT NOT NULL DEFAULT 0,
+    ADD COLUMN program VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE routines ADD CONSTRAINT routines_template_owner_check
+    CHECK ((user_id IS NULL) = (template_key IS NOT NULL));
+
+-- +goose Down
+DELETE FROM routines WHERE user_id IS NULL;
+
+ALTER TABLE routines DROP CONSTRAINT IF EXISTS routines_template_owner_check;
+ALTER TABLE routines
+    DROP COLUMN IF EXISTS program,
+    DROP COLUMN IF EXISTS template_version,
+    DROP COLUMN IF EXISTS template_key;
+
+ALTER TABLE routines ALTER COLUMN user_id SET NOT NULL;
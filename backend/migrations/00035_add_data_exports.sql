@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE data_exports (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'done', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    -- Pending exports wait until run_after; for running exports it is the end of the lease
+    run_after TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    key VARCHAR(1024) NOT NULL DEFAULT '',
+    size BIGINT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX data_exports_run_after_idx ON data_exports (run_after)
+    WHERE status IN ('pending', 'running');
+
+CREATE INDEX data_exports_user_id_created_at_idx ON data_exports (user_id, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS data_exports;
@@ -0,0 +1,39 @@
+-- +goose Up
+ALTER TABLE workouts
+    ADD COLUMN external_id VARCHAR(255) NULL;
+
+-- Uploading the same history again skips the workouts imported before
+CREATE UNIQUE INDEX workouts_user_id_external_id_idx ON workouts (user_id, external_id)
+    WHERE external_id IS NOT NULL;
+
+CREATE TABLE workout_imports (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    source VARCHAR(16) NOT NULL CHECK (source IN ('strong', 'hevy', 'fitnotes')),
+    file_id UUID NULL REFERENCES files(id) ON DELETE SET NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'review' CHECK (status IN ('review', 'pending', 'running', 'done', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    -- Pending imports wait until run_after; for running imports it is the end of the lease
+    run_after TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- Workouts parsed from the file and the mapping of their exercise names to the catalog
+    workouts JSONB NOT NULL DEFAULT '[]',
+    mappings JSONB NOT NULL DEFAULT '[]',
+    created_count INT NOT NULL DEFAULT 0,
+    skipped_count INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX workout_imports_run_after_idx ON workout_imports (run_after)
+    WHERE status IN ('pending', 'running');
+
+CREATE INDEX workout_imports_user_id_created_at_idx ON workout_imports (user_id, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS workout_imports;
+
+DROP INDEX IF EXISTS workouts_user_id_external_id_idx;
+
+ALTER TABLE workouts
+    DROP COLUMN external_id;
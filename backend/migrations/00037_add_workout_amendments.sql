@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE workout_amendments (
+    id UUID PRIMARY KEY,
+    workout_id UUID NOT NULL REFERENCES workouts (id) ON DELETE CASCADE,
+    user_id UUID NULL REFERENCES users (id) ON DELETE SET NULL,
+    -- Not references, the amendment outlives the exercise and set logs it changed
+    exercise_log_id UUID NOT NULL,
+    set_log_id UUID NULL,
+    action VARCHAR(50) NOT NULL,
+    changes JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX workout_amendments_workout_id_idx ON workout_amendments (workout_id, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS workout_amendments;
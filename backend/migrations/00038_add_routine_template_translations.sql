@@ -0,0 +1,6 @@
+-- +goose Up
+-- Object of locale to {"name": ..., "description": ...}, templates are written in English
+ALTER TABLE routines ADD COLUMN translations JSONB NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE routines DROP COLUMN IF EXISTS translations;
@@ -20,12 +20,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ExerciseService_GetExercises_FullMethodName            = "/fitness_trainer.api.workout.ExerciseService/GetExercises"
-	ExerciseService_CreateExercise_FullMethodName          = "/fitness_trainer.api.workout.ExerciseService/CreateExercise"
-	ExerciseService_GetExerciseDetail_FullMethodName       = "/fitness_trainer.api.workout.ExerciseService/GetExerciseDetail"
-	ExerciseService_GetExerciseAlternatives_FullMethodName = "/fitness_trainer.api.workout.ExerciseService/GetExerciseAlternatives"
-	ExerciseService_GetExerciseHistory_FullMethodName      = "/fitness_trainer.api.workout.ExerciseService/GetExerciseHistory"
-	ExerciseService_GetMuscleGroups_FullMethodName         = "/fitness_trainer.api.workout.ExerciseService/GetMuscleGroups"
+	ExerciseService_GetExercises_FullMethodName              = "/fitness_trainer.api.workout.ExerciseService/GetExercises"
+	ExerciseService_CreateExercise_FullMethodName            = "/fitness_trainer.api.workout.ExerciseService/CreateExercise"
+	ExerciseService_GetExerciseDetail_FullMethodName         = "/fitness_trainer.api.workout.ExerciseService/GetExerciseDetail"
+	ExerciseService_GetExerciseAlternatives_FullMethodName   = "/fitness_trainer.api.workout.ExerciseService/GetExerciseAlternatives"
+	ExerciseService_GetExerciseHistory_FullMethodName        = "/fitness_trainer.api.workout.ExerciseService/GetExerciseHistory"
+	ExerciseService_GetMuscleGroups_FullMethodName           = "/fitness_trainer.api.workout.ExerciseService/GetMuscleGroups"
+	ExerciseService_SetExerciseVisibility_FullMethodName     = "/fitness_trainer.api.workout.ExerciseService/SetExerciseVisibility"
+	ExerciseService_AddSharedExercise_FullMethodName         = "/fitness_trainer.api.workout.ExerciseService/AddSharedExercise"
+	ExerciseService_RequestExercisePromotion_FullMethodName  = "/fitness_trainer.api.workout.ExerciseService/RequestExercisePromotion"
+	ExerciseService_GetExercisesForModeration_FullMethodName = "/fitness_trainer.api.workout.ExerciseService/GetExercisesForModeration"
+	ExerciseService_ModerateExercise_FullMethodName          = "/fitness_trainer.api.workout.ExerciseService/ModerateExercise"
+	ExerciseService_UpdateExercise_FullMethodName            = "/fitness_trainer.api.workout.ExerciseService/UpdateExercise"
+	ExerciseService_ArchiveExercise_FullMethodName           = "/fitness_trainer.api.workout.ExerciseService/ArchiveExercise"
+	ExerciseService_RestoreExercise_FullMethodName           = "/fitness_trainer.api.workout.ExerciseService/RestoreExercise"
+	ExerciseService_MergeExercises_FullMethodName            = "/fitness_trainer.api.workout.ExerciseService/MergeExercises"
+	ExerciseService_CreateExerciseMediaUpload_FullMethodName = "/fitness_trainer.api.workout.ExerciseService/CreateExerciseMediaUpload"
+	ExerciseService_ConfirmExerciseMedia_FullMethodName      = "/fitness_trainer.api.workout.ExerciseService/ConfirmExerciseMedia"
+	ExerciseService_UpdateExerciseMedia_FullMethodName       = "/fitness_trainer.api.workout.ExerciseService/UpdateExerciseMedia"
+	ExerciseService_DeleteExerciseMedia_FullMethodName       = "/fitness_trainer.api.workout.ExerciseService/DeleteExerciseMedia"
+	ExerciseService_SetExerciseMediaOrder_FullMethodName     = "/fitness_trainer.api.workout.ExerciseService/SetExerciseMediaOrder"
+	ExerciseService_GetExerciseAuditLog_FullMethodName       = "/fitness_trainer.api.workout.ExerciseService/GetExerciseAuditLog"
 )
 
 // ExerciseServiceClient is the client API for ExerciseService service.
@@ -44,6 +59,36 @@ type ExerciseServiceClient interface {
 	GetExerciseHistory(ctx context.Context, in *GetExerciseHistoryRequest, opts ...grpc.CallOption) (*ExerciseHistoryResponse, error)
 	// Метод для получения списка групп мышц
 	GetMuscleGroups(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetMuscleGroupsResponse, error)
+	// Метод для изменения видимости собственного упражнения
+	SetExerciseVisibility(ctx context.Context, in *SetExerciseVisibilityRequest, opts ...grpc.CallOption) (*ExerciseResponse, error)
+	// Метод для добавления упражнения по ссылке
+	AddSharedExercise(ctx context.Context, in *AddSharedExerciseRequest, opts ...grpc.CallOption) (*ExerciseResponse, error)
+	// Метод для отправки собственного упражнения на модерацию в общий каталог
+	RequestExercisePromotion(ctx context.Context, in *RequestExercisePromotionRequest, opts ...grpc.CallOption) (*ExerciseResponse, error)
+	// Метод для получения упражнений, ожидающих модерации (только для администраторов)
+	GetExercisesForModeration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExercisesResponse, error)
+	// Метод для принятия решения по модерации упражнения (только для администраторов)
+	ModerateExercise(ctx context.Context, in *ModerateExerciseRequest, opts ...grpc.CallOption) (*ExerciseResponse, error)
+	// Метод для редактирования упражнения (владелец или администратор)
+	UpdateExercise(ctx context.Context, in *UpdateExerciseRequest, opts ...grpc.CallOption) (*ExerciseResponse, error)
+	// Метод для архивации упражнения; история тренировок сохраняется
+	ArchiveExercise(ctx context.Context, in *ArchiveExerciseRequest, opts ...grpc.CallOption) (*ExerciseResponse, error)
+	// Метод для восстановления упражнения из архива
+	RestoreExercise(ctx context.Context, in *RestoreExerciseRequest, opts ...grpc.CallOption) (*ExerciseResponse, error)
+	// Метод для объединения дубликата с основным упражнением
+	MergeExercises(ctx context.Context, in *MergeExercisesRequest, opts ...grpc.CallOption) (*ExerciseResponse, error)
+	// Метод для получения ссылки на загрузку медиафайла упражнения
+	CreateExerciseMediaUpload(ctx context.Context, in *CreateExerciseMediaUploadRequest, opts ...grpc.CallOption) (*CreateExerciseMediaUploadResponse, error)
+	// Метод для подтверждения загрузки медиафайла и прикрепления его к упражнению
+	ConfirmExerciseMedia(ctx context.Context, in *ConfirmExerciseMediaRequest, opts ...grpc.CallOption) (*ExerciseMediaResponse, error)
+	// Метод для изменения подписи к медиафайлу
+	UpdateExerciseMedia(ctx context.Context, in *UpdateExerciseMediaRequest, opts ...grpc.CallOption) (*ExerciseMediaResponse, error)
+	// Метод для удаления медиафайла упражнения
+	DeleteExerciseMedia(ctx context.Context, in *DeleteExerciseMediaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для изменения порядка медиафайлов упражнения
+	SetExerciseMediaOrder(ctx context.Context, in *SetExerciseMediaOrderRequest, opts ...grpc.CallOption) (*ExerciseResponse, error)
+	// Метод для получения истории изменений упражнения
+	GetExerciseAuditLog(ctx context.Context, in *GetExerciseAuditLogRequest, opts ...grpc.CallOption) (*GetExerciseAuditLogResponse, error)
 }
 
 type exerciseServiceClient struct {
@@ -114,6 +159,156 @@ func (c *exerciseServiceClient) GetMuscleGroups(ctx context.Context, in *emptypb
 	return out, nil
 }
 
+func (c *exerciseServiceClient) SetExerciseVisibility(ctx context.Context, in *SetExerciseVisibilityRequest, opts ...grpc.CallOption) (*ExerciseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseResponse)
+	err := c.cc.Invoke(ctx, ExerciseService_SetExerciseVisibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) AddSharedExercise(ctx context.Context, in *AddSharedExerciseRequest, opts ...grpc.CallOption) (*ExerciseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseResponse)
+	err := c.cc.Invoke(ctx, ExerciseService_AddSharedExercise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) RequestExercisePromotion(ctx context.Context, in *RequestExercisePromotionRequest, opts ...grpc.CallOption) (*ExerciseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseResponse)
+	err := c.cc.Invoke(ctx, ExerciseService_RequestExercisePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) GetExercisesForModeration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExercisesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExercisesResponse)
+	err := c.cc.Invoke(ctx, ExerciseService_GetExercisesForModeration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) ModerateExercise(ctx context.Context, in *ModerateExerciseRequest, opts ...grpc.CallOption) (*ExerciseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseResponse)
+	err := c.cc.Invoke(ctx, ExerciseService_ModerateExercise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) UpdateExercise(ctx context.Context, in *UpdateExerciseRequest, opts ...grpc.CallOption) (*ExerciseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseResponse)
+	err := c.cc.Invoke(ctx, ExerciseService_UpdateExercise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) ArchiveExercise(ctx context.Context, in *ArchiveExerciseRequest, opts ...grpc.CallOption) (*ExerciseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseResponse)
+	err := c.cc.Invoke(ctx, ExerciseService_ArchiveExercise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) RestoreExercise(ctx context.Context, in *RestoreExerciseRequest, opts ...grpc.CallOption) (*ExerciseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseResponse)
+	err := c.cc.Invoke(ctx, ExerciseService_RestoreExercise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) MergeExercises(ctx context.Context, in *MergeExercisesRequest, opts ...grpc.CallOption) (*ExerciseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseResponse)
+	err := c.cc.Invoke(ctx, ExerciseService_MergeExercises_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) CreateExerciseMediaUpload(ctx context.Context, in *CreateExerciseMediaUploadRequest, opts ...grpc.CallOption) (*CreateExerciseMediaUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateExerciseMediaUploadResponse)
+	err := c.cc.Invoke(ctx, ExerciseService_CreateExerciseMediaUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) ConfirmExerciseMedia(ctx context.Context, in *ConfirmExerciseMediaRequest, opts ...grpc.CallOption) (*ExerciseMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseMediaResponse)
+	err := c.cc.Invoke(ctx, ExerciseService_ConfirmExerciseMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) UpdateExerciseMedia(ctx context.Context, in *UpdateExerciseMediaRequest, opts ...grpc.CallOption) (*ExerciseMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseMediaResponse)
+	err := c.cc.Invoke(ctx, ExerciseService_UpdateExerciseMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) DeleteExerciseMedia(ctx context.Context, in *DeleteExerciseMediaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ExerciseService_DeleteExerciseMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) SetExerciseMediaOrder(ctx context.Context, in *SetExerciseMediaOrderRequest, opts ...grpc.CallOption) (*ExerciseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseResponse)
+	err := c.cc.Invoke(ctx, ExerciseService_SetExerciseMediaOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) GetExerciseAuditLog(ctx context.Context, in *GetExerciseAuditLogRequest, opts ...grpc.CallOption) (*GetExerciseAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExerciseAuditLogResponse)
+	err := c.cc.Invoke(ctx, ExerciseService_GetExerciseAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExerciseServiceServer is the server API for ExerciseService service.
 // All implementations must embed UnimplementedExerciseServiceServer
 // for forward compatibility.
@@ -130,6 +325,36 @@ type ExerciseServiceServer interface {
 	GetExerciseHistory(context.Context, *GetExerciseHistoryRequest) (*ExerciseHistoryResponse, error)
 	// Метод для получения списка групп мышц
 	GetMuscleGroups(context.Context, *emptypb.Empty) (*GetMuscleGroupsResponse, error)
+	// Метод для изменения видимости собственного упражнения
+	SetExerciseVisibility(context.Context, *SetExerciseVisibilityRequest) (*ExerciseResponse, error)
+	// Метод для добавления упражнения по ссылке
+	AddSharedExercise(context.Context, *AddSharedExerciseRequest) (*ExerciseResponse, error)
+	// Метод для отправки собственного упражнения на модерацию в общий каталог
+	RequestExercisePromotion(context.Context, *RequestExercisePromotionRequest) (*ExerciseResponse, error)
+	// Метод для получения упражнений, ожидающих модерации (только для администраторов)
+	GetExercisesForModeration(context.Context, *emptypb.Empty) (*GetExercisesResponse, error)
+	// Метод для принятия решения по модерации упражнения (только для администраторов)
+	ModerateExercise(context.Context, *ModerateExerciseRequest) (*ExerciseResponse, error)
+	// Метод для редактирования упражнения (владелец или администратор)
+	UpdateExercise(context.Context, *UpdateExerciseRequest) (*ExerciseResponse, error)
+	// Метод для архивации упражнения; история тренировок сохраняется
+	ArchiveExercise(context.Context, *ArchiveExerciseRequest) (*ExerciseResponse, error)
+	// Метод для восстановления упражнения из архива
+	RestoreExercise(context.Context, *RestoreExerciseRequest) (*ExerciseResponse, error)
+	// Метод для объединения дубликата с основным упражнением
+	MergeExercises(context.Context, *MergeExercisesRequest) (*ExerciseResponse, error)
+	// Метод для получения ссылки на загрузку медиафайла упражнения
+	CreateExerciseMediaUpload(context.Context, *CreateExerciseMediaUploadRequest) (*CreateExerciseMediaUploadResponse, error)
+	// Метод для подтверждения загрузки медиафайла и прикрепления его к упражнению
+	ConfirmExerciseMedia(context.Context, *ConfirmExerciseMediaRequest) (*ExerciseMediaResponse, error)
+	// Метод для изменения подписи к медиафайлу
+	UpdateExerciseMedia(context.Context, *UpdateExerciseMediaRequest) (*ExerciseMediaResponse, error)
+	// Метод для удаления медиафайла упражнения
+	DeleteExerciseMedia(context.Context, *DeleteExerciseMediaRequest) (*emptypb.Empty, error)
+	// Метод для изменения порядка медиафайлов упражнения
+	SetExerciseMediaOrder(context.Context, *SetExerciseMediaOrderRequest) (*ExerciseResponse, error)
+	// Метод для получения истории изменений упражнения
+	GetExerciseAuditLog(context.Context, *GetExerciseAuditLogRequest) (*GetExerciseAuditLogResponse, error)
 	mustEmbedUnimplementedExerciseServiceServer()
 }
 
@@ -158,6 +383,51 @@ func (UnimplementedExerciseServiceServer) GetExerciseHistory(context.Context, *G
 func (UnimplementedExerciseServiceServer) GetMuscleGroups(context.Context, *emptypb.Empty) (*GetMuscleGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMuscleGroups not implemented")
 }
+func (UnimplementedExerciseServiceServer) SetExerciseVisibility(context.Context, *SetExerciseVisibilityRequest) (*ExerciseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExerciseVisibility not implemented")
+}
+func (UnimplementedExerciseServiceServer) AddSharedExercise(context.Context, *AddSharedExerciseRequest) (*ExerciseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSharedExercise not implemented")
+}
+func (UnimplementedExerciseServiceServer) RequestExercisePromotion(context.Context, *RequestExercisePromotionRequest) (*ExerciseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestExercisePromotion not implemented")
+}
+func (UnimplementedExerciseServiceServer) GetExercisesForModeration(context.Context, *emptypb.Empty) (*GetExercisesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExercisesForModeration not implemented")
+}
+func (UnimplementedExerciseServiceServer) ModerateExercise(context.Context, *ModerateExerciseRequest) (*ExerciseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateExercise not implemented")
+}
+func (UnimplementedExerciseServiceServer) UpdateExercise(context.Context, *UpdateExerciseRequest) (*ExerciseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExercise not implemented")
+}
+func (UnimplementedExerciseServiceServer) ArchiveExercise(context.Context, *ArchiveExerciseRequest) (*ExerciseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveExercise not implemented")
+}
+func (UnimplementedExerciseServiceServer) RestoreExercise(context.Context, *RestoreExerciseRequest) (*ExerciseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreExercise not implemented")
+}
+func (UnimplementedExerciseServiceServer) MergeExercises(context.Context, *MergeExercisesRequest) (*ExerciseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeExercises not implemented")
+}
+func (UnimplementedExerciseServiceServer) CreateExerciseMediaUpload(context.Context, *CreateExerciseMediaUploadRequest) (*CreateExerciseMediaUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExerciseMediaUpload not implemented")
+}
+func (UnimplementedExerciseServiceServer) ConfirmExerciseMedia(context.Context, *ConfirmExerciseMediaRequest) (*ExerciseMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmExerciseMedia not implemented")
+}
+func (UnimplementedExerciseServiceServer) UpdateExerciseMedia(context.Context, *UpdateExerciseMediaRequest) (*ExerciseMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExerciseMedia not implemented")
+}
+func (UnimplementedExerciseServiceServer) DeleteExerciseMedia(context.Context, *DeleteExerciseMediaRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExerciseMedia not implemented")
+}
+func (UnimplementedExerciseServiceServer) SetExerciseMediaOrder(context.Context, *SetExerciseMediaOrderRequest) (*ExerciseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExerciseMediaOrder not implemented")
+}
+func (UnimplementedExerciseServiceServer) GetExerciseAuditLog(context.Context, *GetExerciseAuditLogRequest) (*GetExerciseAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExerciseAuditLog not implemented")
+}
 func (UnimplementedExerciseServiceServer) mustEmbedUnimplementedExerciseServiceServer() {}
 func (UnimplementedExerciseServiceServer) testEmbeddedByValue()                         {}
 
@@ -287,580 +557,366 @@ func _ExerciseService_GetMuscleGroups_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-// ExerciseService_ServiceDesc is the grpc.ServiceDesc for ExerciseService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ExerciseService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "fitness_trainer.api.workout.ExerciseService",
-	HandlerType: (*ExerciseServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetExercises",
-			Handler:    _ExerciseService_GetExercises_Handler,
-		},
-		{
-			MethodName: "CreateExercise",
-			Handler:    _ExerciseService_CreateExercise_Handler,
-		},
-		{
-			MethodName: "GetExerciseDetail",
-			Handler:    _ExerciseService_GetExerciseDetail_Handler,
-		},
-		{
-			MethodName: "GetExerciseAlternatives",
-			Handler:    _ExerciseService_GetExerciseAlternatives_Handler,
-		},
-		{
-			MethodName: "GetExerciseHistory",
-			Handler:    _ExerciseService_GetExerciseHistory_Handler,
-		},
-		{
-			MethodName: "GetMuscleGroups",
-			Handler:    _ExerciseService_GetMuscleGroups_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "workouts/workouts.proto",
-}
-
-const (
-	RoutineService_GetRoutines_FullMethodName                       = "/fitness_trainer.api.workout.RoutineService/GetRoutines"
-	RoutineService_CreateRoutine_FullMethodName                     = "/fitness_trainer.api.workout.RoutineService/CreateRoutine"
-	RoutineService_UpdateRoutine_FullMethodName                     = "/fitness_trainer.api.workout.RoutineService/UpdateRoutine"
-	RoutineService_GetRoutineDetail_FullMethodName                  = "/fitness_trainer.api.workout.RoutineService/GetRoutineDetail"
-	RoutineService_DeleteRoutine_FullMethodName                     = "/fitness_trainer.api.workout.RoutineService/DeleteRoutine"
-	RoutineService_AddExerciseToRoutine_FullMethodName              = "/fitness_trainer.api.workout.RoutineService/AddExerciseToRoutine"
-	RoutineService_GetExerciseInstanceDetails_FullMethodName        = "/fitness_trainer.api.workout.RoutineService/GetExerciseInstanceDetails"
-	RoutineService_RemoveExerciseInstanceFromRoutine_FullMethodName = "/fitness_trainer.api.workout.RoutineService/RemoveExerciseInstanceFromRoutine"
-	RoutineService_AddSetToExerciseInstance_FullMethodName          = "/fitness_trainer.api.workout.RoutineService/AddSetToExerciseInstance"
-	RoutineService_UpdateSetInExerciseInstance_FullMethodName       = "/fitness_trainer.api.workout.RoutineService/UpdateSetInExerciseInstance"
-	RoutineService_RemoveSetFromExerciseInstance_FullMethodName     = "/fitness_trainer.api.workout.RoutineService/RemoveSetFromExerciseInstance"
-	RoutineService_SetExerciseOrder_FullMethodName                  = "/fitness_trainer.api.workout.RoutineService/SetExerciseOrder"
-)
-
-// RoutineServiceClient is the client API for RoutineService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type RoutineServiceClient interface {
-	// Метод для получения списка доступных пользователю рутин
-	GetRoutines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RoutineListResponse, error)
-	// Создание новой рутины
-	CreateRoutine(ctx context.Context, in *CreateRoutineRequest, opts ...grpc.CallOption) (*RoutineResponse, error)
-	// Обновление рутины по ID
-	UpdateRoutine(ctx context.Context, in *UpdateRoutineRequest, opts ...grpc.CallOption) (*RoutineResponse, error)
-	// Получение информации о рутине по ID
-	GetRoutineDetail(ctx context.Context, in *GetRoutineDetailRequest, opts ...grpc.CallOption) (*RoutineDetailResponse, error)
-	// Удаление рутины по ID
-	DeleteRoutine(ctx context.Context, in *DeleteRoutineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Добавление упражнения в рутину
-	AddExerciseToRoutine(ctx context.Context, in *AddExerciseToRoutineRequest, opts ...grpc.CallOption) (*RoutineInstanceResponse, error)
-	// Получить информацию об упражнении в рутине
-	GetExerciseInstanceDetails(ctx context.Context, in *GetExerciseInstanceDetailsRequest, opts ...grpc.CallOption) (*GetExerciseInstanceDetailsResponse, error)
-	// Удаление упражнения из рутины
-	RemoveExerciseInstanceFromRoutine(ctx context.Context, in *RemoveExerciseInstanceFromRoutineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Метод для добавления сета в упражнение
-	AddSetToExerciseInstance(ctx context.Context, in *AddSetToExerciseInstanceRequest, opts ...grpc.CallOption) (*SetResponse, error)
-	// Метод для обновления сета в упражнении
-	UpdateSetInExerciseInstance(ctx context.Context, in *UpdateSetInExerciseInstanceRequest, opts ...grpc.CallOption) (*SetResponse, error)
-	// Метод для удаления сета из упражнения
-	RemoveSetFromExerciseInstance(ctx context.Context, in *RemoveSetFromExerciseInstanceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Метод для установки порядка упражнений в рутине
-	SetExerciseOrder(ctx context.Context, in *SetExerciseOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-}
-
-type routineServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewRoutineServiceClient(cc grpc.ClientConnInterface) RoutineServiceClient {
-	return &routineServiceClient{cc}
-}
-
-func (c *routineServiceClient) GetRoutines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RoutineListResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RoutineListResponse)
-	err := c.cc.Invoke(ctx, RoutineService_GetRoutines_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ExerciseService_SetExerciseVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExerciseVisibilityRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ExerciseServiceServer).SetExerciseVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExerciseService_SetExerciseVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExerciseServiceServer).SetExerciseVisibility(ctx, req.(*SetExerciseVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *routineServiceClient) CreateRoutine(ctx context.Context, in *CreateRoutineRequest, opts ...grpc.CallOption) (*RoutineResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RoutineResponse)
-	err := c.cc.Invoke(ctx, RoutineService_CreateRoutine_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ExerciseService_AddSharedExercise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSharedExerciseRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ExerciseServiceServer).AddSharedExercise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExerciseService_AddSharedExercise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExerciseServiceServer).AddSharedExercise(ctx, req.(*AddSharedExerciseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *routineServiceClient) UpdateRoutine(ctx context.Context, in *UpdateRoutineRequest, opts ...grpc.CallOption) (*RoutineResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RoutineResponse)
-	err := c.cc.Invoke(ctx, RoutineService_UpdateRoutine_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ExerciseService_RequestExercisePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestExercisePromotionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *routineServiceClient) GetRoutineDetail(ctx context.Context, in *GetRoutineDetailRequest, opts ...grpc.CallOption) (*RoutineDetailResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RoutineDetailResponse)
-	err := c.cc.Invoke(ctx, RoutineService_GetRoutineDetail_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *routineServiceClient) DeleteRoutine(ctx context.Context, in *DeleteRoutineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, RoutineService_DeleteRoutine_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *routineServiceClient) AddExerciseToRoutine(ctx context.Context, in *AddExerciseToRoutineRequest, opts ...grpc.CallOption) (*RoutineInstanceResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RoutineInstanceResponse)
-	err := c.cc.Invoke(ctx, RoutineService_AddExerciseToRoutine_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *routineServiceClient) GetExerciseInstanceDetails(ctx context.Context, in *GetExerciseInstanceDetailsRequest, opts ...grpc.CallOption) (*GetExerciseInstanceDetailsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetExerciseInstanceDetailsResponse)
-	err := c.cc.Invoke(ctx, RoutineService_GetExerciseInstanceDetails_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *routineServiceClient) RemoveExerciseInstanceFromRoutine(ctx context.Context, in *RemoveExerciseInstanceFromRoutineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, RoutineService_RemoveExerciseInstanceFromRoutine_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *routineServiceClient) AddSetToExerciseInstance(ctx context.Context, in *AddSetToExerciseInstanceRequest, opts ...grpc.CallOption) (*SetResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SetResponse)
-	err := c.cc.Invoke(ctx, RoutineService_AddSetToExerciseInstance_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *routineServiceClient) UpdateSetInExerciseInstance(ctx context.Context, in *UpdateSetInExerciseInstanceRequest, opts ...grpc.CallOption) (*SetResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SetResponse)
-	err := c.cc.Invoke(ctx, RoutineService_UpdateSetInExerciseInstance_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *routineServiceClient) RemoveSetFromExerciseInstance(ctx context.Context, in *RemoveSetFromExerciseInstanceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, RoutineService_RemoveSetFromExerciseInstance_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(ExerciseServiceServer).RequestExercisePromotion(ctx, in)
 	}
-	return out, nil
-}
-
-func (c *routineServiceClient) SetExerciseOrder(ctx context.Context, in *SetExerciseOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, RoutineService_SetExerciseOrder_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExerciseService_RequestExercisePromotion_FullMethodName,
 	}
-	return out, nil
-}
-
-// RoutineServiceServer is the server API for RoutineService service.
-// All implementations must embed UnimplementedRoutineServiceServer
-// for forward compatibility.
-type RoutineServiceServer interface {
-	// Метод для получения списка доступных пользователю рутин
-	GetRoutines(context.Context, *emptypb.Empty) (*RoutineListResponse, error)
-	// Создание новой рутины
-	CreateRoutine(context.Context, *CreateRoutineRequest) (*RoutineResponse, error)
-	// Обновление рутины по ID
-	UpdateRoutine(context.Context, *UpdateRoutineRequest) (*RoutineResponse, error)
-	// Получение информации о рутине по ID
-	GetRoutineDetail(context.Context, *GetRoutineDetailRequest) (*RoutineDetailResponse, error)
-	// Удаление рутины по ID
-	DeleteRoutine(context.Context, *DeleteRoutineRequest) (*emptypb.Empty, error)
-	// Добавление упражнения в рутину
-	AddExerciseToRoutine(context.Context, *AddExerciseToRoutineRequest) (*RoutineInstanceResponse, error)
-	// Получить информацию об упражнении в рутине
-	GetExerciseInstanceDetails(context.Context, *GetExerciseInstanceDetailsRequest) (*GetExerciseInstanceDetailsResponse, error)
-	// Удаление упражнения из рутины
-	RemoveExerciseInstanceFromRoutine(context.Context, *RemoveExerciseInstanceFromRoutineRequest) (*emptypb.Empty, error)
-	// Метод для добавления сета в упражнение
-	AddSetToExerciseInstance(context.Context, *AddSetToExerciseInstanceRequest) (*SetResponse, error)
-	// Метод для обновления сета в упражнении
-	UpdateSetInExerciseInstance(context.Context, *UpdateSetInExerciseInstanceRequest) (*SetResponse, error)
-	// Метод для удаления сета из упражнения
-	RemoveSetFromExerciseInstance(context.Context, *RemoveSetFromExerciseInstanceRequest) (*emptypb.Empty, error)
-	// Метод для установки порядка упражнений в рутине
-	SetExerciseOrder(context.Context, *SetExerciseOrderRequest) (*emptypb.Empty, error)
-	mustEmbedUnimplementedRoutineServiceServer()
-}
-
-// UnimplementedRoutineServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedRoutineServiceServer struct{}
-
-func (UnimplementedRoutineServiceServer) GetRoutines(context.Context, *emptypb.Empty) (*RoutineListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRoutines not implemented")
-}
-func (UnimplementedRoutineServiceServer) CreateRoutine(context.Context, *CreateRoutineRequest) (*RoutineResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRoutine not implemented")
-}
-func (UnimplementedRoutineServiceServer) UpdateRoutine(context.Context, *UpdateRoutineRequest) (*RoutineResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoutine not implemented")
-}
-func (UnimplementedRoutineServiceServer) GetRoutineDetail(context.Context, *GetRoutineDetailRequest) (*RoutineDetailResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRoutineDetail not implemented")
-}
-func (UnimplementedRoutineServiceServer) DeleteRoutine(context.Context, *DeleteRoutineRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoutine not implemented")
-}
-func (UnimplementedRoutineServiceServer) AddExerciseToRoutine(context.Context, *AddExerciseToRoutineRequest) (*RoutineInstanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddExerciseToRoutine not implemented")
-}
-func (UnimplementedRoutineServiceServer) GetExerciseInstanceDetails(context.Context, *GetExerciseInstanceDetailsRequest) (*GetExerciseInstanceDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExerciseInstanceDetails not implemented")
-}
-func (UnimplementedRoutineServiceServer) RemoveExerciseInstanceFromRoutine(context.Context, *RemoveExerciseInstanceFromRoutineRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveExerciseInstanceFromRoutine not implemented")
-}
-func (UnimplementedRoutineServiceServer) AddSetToExerciseInstance(context.Context, *AddSetToExerciseInstanceRequest) (*SetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddSetToExerciseInstance not implemented")
-}
-func (UnimplementedRoutineServiceServer) UpdateSetInExerciseInstance(context.Context, *UpdateSetInExerciseInstanceRequest) (*SetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSetInExerciseInstance not implemented")
-}
-func (UnimplementedRoutineServiceServer) RemoveSetFromExerciseInstance(context.Context, *RemoveSetFromExerciseInstanceRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveSetFromExerciseInstance not implemented")
-}
-func (UnimplementedRoutineServiceServer) SetExerciseOrder(context.Context, *SetExerciseOrderRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetExerciseOrder not implemented")
-}
-func (UnimplementedRoutineServiceServer) mustEmbedUnimplementedRoutineServiceServer() {}
-func (UnimplementedRoutineServiceServer) testEmbeddedByValue()                        {}
-
-// UnsafeRoutineServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RoutineServiceServer will
-// result in compilation errors.
-type UnsafeRoutineServiceServer interface {
-	mustEmbedUnimplementedRoutineServiceServer()
-}
-
-func RegisterRoutineServiceServer(s grpc.ServiceRegistrar, srv RoutineServiceServer) {
-	// If the following call pancis, it indicates UnimplementedRoutineServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExerciseServiceServer).RequestExercisePromotion(ctx, req.(*RequestExercisePromotionRequest))
 	}
-	s.RegisterService(&RoutineService_ServiceDesc, srv)
+	return interceptor(ctx, in, info, handler)
 }
 
-func _RoutineService_GetRoutines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ExerciseService_GetExercisesForModeration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoutineServiceServer).GetRoutines(ctx, in)
+		return srv.(ExerciseServiceServer).GetExercisesForModeration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoutineService_GetRoutines_FullMethodName,
+		FullMethod: ExerciseService_GetExercisesForModeration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoutineServiceServer).GetRoutines(ctx, req.(*emptypb.Empty))
+		return srv.(ExerciseServiceServer).GetExercisesForModeration(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoutineService_CreateRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRoutineRequest)
+func _ExerciseService_ModerateExercise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateExerciseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoutineServiceServer).CreateRoutine(ctx, in)
+		return srv.(ExerciseServiceServer).ModerateExercise(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoutineService_CreateRoutine_FullMethodName,
+		FullMethod: ExerciseService_ModerateExercise_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoutineServiceServer).CreateRoutine(ctx, req.(*CreateRoutineRequest))
+		return srv.(ExerciseServiceServer).ModerateExercise(ctx, req.(*ModerateExerciseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoutineService_UpdateRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRoutineRequest)
+func _ExerciseService_UpdateExercise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExerciseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoutineServiceServer).UpdateRoutine(ctx, in)
+		return srv.(ExerciseServiceServer).UpdateExercise(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoutineService_UpdateRoutine_FullMethodName,
+		FullMethod: ExerciseService_UpdateExercise_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoutineServiceServer).UpdateRoutine(ctx, req.(*UpdateRoutineRequest))
+		return srv.(ExerciseServiceServer).UpdateExercise(ctx, req.(*UpdateExerciseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoutineService_GetRoutineDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRoutineDetailRequest)
+func _ExerciseService_ArchiveExercise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveExerciseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoutineServiceServer).GetRoutineDetail(ctx, in)
+		return srv.(ExerciseServiceServer).ArchiveExercise(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoutineService_GetRoutineDetail_FullMethodName,
+		FullMethod: ExerciseService_ArchiveExercise_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoutineServiceServer).GetRoutineDetail(ctx, req.(*GetRoutineDetailRequest))
+		return srv.(ExerciseServiceServer).ArchiveExercise(ctx, req.(*ArchiveExerciseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoutineService_DeleteRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRoutineRequest)
+func _ExerciseService_RestoreExercise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreExerciseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoutineServiceServer).DeleteRoutine(ctx, in)
+		return srv.(ExerciseServiceServer).RestoreExercise(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoutineService_DeleteRoutine_FullMethodName,
+		FullMethod: ExerciseService_RestoreExercise_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoutineServiceServer).DeleteRoutine(ctx, req.(*DeleteRoutineRequest))
+		return srv.(ExerciseServiceServer).RestoreExercise(ctx, req.(*RestoreExerciseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoutineService_AddExerciseToRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddExerciseToRoutineRequest)
+func _ExerciseService_MergeExercises_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeExercisesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoutineServiceServer).AddExerciseToRoutine(ctx, in)
+		return srv.(ExerciseServiceServer).MergeExercises(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoutineService_AddExerciseToRoutine_FullMethodName,
+		FullMethod: ExerciseService_MergeExercises_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoutineServiceServer).AddExerciseToRoutine(ctx, req.(*AddExerciseToRoutineRequest))
+		return srv.(ExerciseServiceServer).MergeExercises(ctx, req.(*MergeExercisesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoutineService_GetExerciseInstanceDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExerciseInstanceDetailsRequest)
+func _ExerciseService_CreateExerciseMediaUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExerciseMediaUploadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoutineServiceServer).GetExerciseInstanceDetails(ctx, in)
+		return srv.(ExerciseServiceServer).CreateExerciseMediaUpload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoutineService_GetExerciseInstanceDetails_FullMethodName,
+		FullMethod: ExerciseService_CreateExerciseMediaUpload_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoutineServiceServer).GetExerciseInstanceDetails(ctx, req.(*GetExerciseInstanceDetailsRequest))
+		return srv.(ExerciseServiceServer).CreateExerciseMediaUpload(ctx, req.(*CreateExerciseMediaUploadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoutineService_RemoveExerciseInstanceFromRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveExerciseInstanceFromRoutineRequest)
+func _ExerciseService_ConfirmExerciseMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmExerciseMediaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoutineServiceServer).RemoveExerciseInstanceFromRoutine(ctx, in)
+		return srv.(ExerciseServiceServer).ConfirmExerciseMedia(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoutineService_RemoveExerciseInstanceFromRoutine_FullMethodName,
+		FullMethod: ExerciseService_ConfirmExerciseMedia_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoutineServiceServer).RemoveExerciseInstanceFromRoutine(ctx, req.(*RemoveExerciseInstanceFromRoutineRequest))
+		return srv.(ExerciseServiceServer).ConfirmExerciseMedia(ctx, req.(*ConfirmExerciseMediaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoutineService_AddSetToExerciseInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddSetToExerciseInstanceRequest)
+func _ExerciseService_UpdateExerciseMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExerciseMediaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoutineServiceServer).AddSetToExerciseInstance(ctx, in)
+		return srv.(ExerciseServiceServer).UpdateExerciseMedia(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoutineService_AddSetToExerciseInstance_FullMethodName,
+		FullMethod: ExerciseService_UpdateExerciseMedia_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoutineServiceServer).AddSetToExerciseInstance(ctx, req.(*AddSetToExerciseInstanceRequest))
+		return srv.(ExerciseServiceServer).UpdateExerciseMedia(ctx, req.(*UpdateExerciseMediaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoutineService_UpdateSetInExerciseInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSetInExerciseInstanceRequest)
+func _ExerciseService_DeleteExerciseMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExerciseMediaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoutineServiceServer).UpdateSetInExerciseInstance(ctx, in)
+		return srv.(ExerciseServiceServer).DeleteExerciseMedia(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoutineService_UpdateSetInExerciseInstance_FullMethodName,
+		FullMethod: ExerciseService_DeleteExerciseMedia_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoutineServiceServer).UpdateSetInExerciseInstance(ctx, req.(*UpdateSetInExerciseInstanceRequest))
+		return srv.(ExerciseServiceServer).DeleteExerciseMedia(ctx, req.(*DeleteExerciseMediaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoutineService_RemoveSetFromExerciseInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveSetFromExerciseInstanceRequest)
+func _ExerciseService_SetExerciseMediaOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExerciseMediaOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoutineServiceServer).RemoveSetFromExerciseInstance(ctx, in)
+		return srv.(ExerciseServiceServer).SetExerciseMediaOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoutineService_RemoveSetFromExerciseInstance_FullMethodName,
+		FullMethod: ExerciseService_SetExerciseMediaOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoutineServiceServer).RemoveSetFromExerciseInstance(ctx, req.(*RemoveSetFromExerciseInstanceRequest))
+		return srv.(ExerciseServiceServer).SetExerciseMediaOrder(ctx, req.(*SetExerciseMediaOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoutineService_SetExerciseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetExerciseOrderRequest)
+func _ExerciseService_GetExerciseAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExerciseAuditLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoutineServiceServer).SetExerciseOrder(ctx, in)
+		return srv.(ExerciseServiceServer).GetExerciseAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoutineService_SetExerciseOrder_FullMethodName,
+		FullMethod: ExerciseService_GetExerciseAuditLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoutineServiceServer).SetExerciseOrder(ctx, req.(*SetExerciseOrderRequest))
+		return srv.(ExerciseServiceServer).GetExerciseAuditLog(ctx, req.(*GetExerciseAuditLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// RoutineService_ServiceDesc is the grpc.ServiceDesc for RoutineService service.
+// ExerciseService_ServiceDesc is the grpc.ServiceDesc for ExerciseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var RoutineService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "fitness_trainer.api.workout.RoutineService",
-	HandlerType: (*RoutineServiceServer)(nil),
+var ExerciseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_trainer.api.workout.ExerciseService",
+	HandlerType: (*ExerciseServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetRoutines",
-			Handler:    _RoutineService_GetRoutines_Handler,
+			MethodName: "GetExercises",
+			Handler:    _ExerciseService_GetExercises_Handler,
 		},
 		{
-			MethodName: "CreateRoutine",
-			Handler:    _RoutineService_CreateRoutine_Handler,
+			MethodName: "CreateExercise",
+			Handler:    _ExerciseService_CreateExercise_Handler,
 		},
 		{
-			MethodName: "UpdateRoutine",
-			Handler:    _RoutineService_UpdateRoutine_Handler,
+			MethodName: "GetExerciseDetail",
+			Handler:    _ExerciseService_GetExerciseDetail_Handler,
 		},
 		{
-			MethodName: "GetRoutineDetail",
-			Handler:    _RoutineService_GetRoutineDetail_Handler,
+			MethodName: "GetExerciseAlternatives",
+			Handler:    _ExerciseService_GetExerciseAlternatives_Handler,
 		},
 		{
-			MethodName: "DeleteRoutine",
-			Handler:    _RoutineService_DeleteRoutine_Handler,
+			MethodName: "GetExerciseHistory",
+			Handler:    _ExerciseService_GetExerciseHistory_Handler,
 		},
 		{
-			MethodName: "AddExerciseToRoutine",
-			Handler:    _RoutineService_AddExerciseToRoutine_Handler,
+			MethodName: "GetMuscleGroups",
+			Handler:    _ExerciseService_GetMuscleGroups_Handler,
 		},
 		{
-			MethodName: "GetExerciseInstanceDetails",
-			Handler:    _RoutineService_GetExerciseInstanceDetails_Handler,
+			MethodName: "SetExerciseVisibility",
+			Handler:    _ExerciseService_SetExerciseVisibility_Handler,
 		},
 		{
-			MethodName: "RemoveExerciseInstanceFromRoutine",
-			Handler:    _RoutineService_RemoveExerciseInstanceFromRoutine_Handler,
+			MethodName: "AddSharedExercise",
+			Handler:    _ExerciseService_AddSharedExercise_Handler,
 		},
 		{
-			MethodName: "AddSetToExerciseInstance",
-			Handler:    _RoutineService_AddSetToExerciseInstance_Handler,
+			MethodName: "RequestExercisePromotion",
+			Handler:    _ExerciseService_RequestExercisePromotion_Handler,
 		},
 		{
-			MethodName: "UpdateSetInExerciseInstance",
-			Handler:    _RoutineService_UpdateSetInExerciseInstance_Handler,
+			MethodName: "GetExercisesForModeration",
+			Handler:    _ExerciseService_GetExercisesForModeration_Handler,
 		},
 		{
-			MethodName: "RemoveSetFromExerciseInstance",
-			Handler:    _RoutineService_RemoveSetFromExerciseInstance_Handler,
+			MethodName: "ModerateExercise",
+			Handler:    _ExerciseService_ModerateExercise_Handler,
 		},
 		{
-			MethodName: "SetExerciseOrder",
-			Handler:    _RoutineService_SetExerciseOrder_Handler,
+			MethodName: "UpdateExercise",
+			Handler:    _ExerciseService_UpdateExercise_Handler,
+		},
+		{
+			MethodName: "ArchiveExercise",
+			Handler:    _ExerciseService_ArchiveExercise_Handler,
+		},
+		{
+			MethodName: "RestoreExercise",
+			Handler:    _ExerciseService_RestoreExercise_Handler,
+		},
+		{
+			MethodName: "MergeExercises",
+			Handler:    _ExerciseService_MergeExercises_Handler,
+		},
+		{
+			MethodName: "CreateExerciseMediaUpload",
+			Handler:    _ExerciseService_CreateExerciseMediaUpload_Handler,
+		},
+		{
+			MethodName: "ConfirmExerciseMedia",
+			Handler:    _ExerciseService_ConfirmExerciseMedia_Handler,
+		},
+		{
+			MethodName: "UpdateExerciseMedia",
+			Handler:    _ExerciseService_UpdateExerciseMedia_Handler,
+		},
+		{
+			MethodName: "DeleteExerciseMedia",
+			Handler:    _ExerciseService_DeleteExerciseMedia_Handler,
+		},
+		{
+			MethodName: "SetExerciseMediaOrder",
+			Handler:    _ExerciseService_SetExerciseMediaOrder_Handler,
+		},
+		{
+			MethodName: "GetExerciseAuditLog",
+			Handler:    _ExerciseService_GetExerciseAuditLog_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -868,1013 +924,3407 @@ var RoutineService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	WorkoutService_StartWorkout_FullMethodName                = "/fitness_trainer.api.workout.WorkoutService/StartWorkout"
-	WorkoutService_GetWorkout_FullMethodName                  = "/fitness_trainer.api.workout.WorkoutService/GetWorkout"
-	WorkoutService_DeleteWorkout_FullMethodName               = "/fitness_trainer.api.workout.WorkoutService/DeleteWorkout"
-	WorkoutService_GetActiveWorkouts_FullMethodName           = "/fitness_trainer.api.workout.WorkoutService/GetActiveWorkouts"
-	WorkoutService_GetWorkouts_FullMethodName                 = "/fitness_trainer.api.workout.WorkoutService/GetWorkouts"
-	WorkoutService_LogExercise_FullMethodName                 = "/fitness_trainer.api.workout.WorkoutService/LogExercise"
-	WorkoutService_GetExerciseLogDetails_FullMethodName       = "/fitness_trainer.api.workout.WorkoutService/GetExerciseLogDetails"
-	WorkoutService_DeleteExerciseLog_FullMethodName           = "/fitness_trainer.api.workout.WorkoutService/DeleteExerciseLog"
-	WorkoutService_AddPowerRatingToExerciseLog_FullMethodName = "/fitness_trainer.api.workout.WorkoutService/AddPowerRatingToExerciseLog"
-	WorkoutService_AddNotesToExerciseLog_FullMethodName       = "/fitness_trainer.api.workout.WorkoutService/AddNotesToExerciseLog"
-	WorkoutService_LogSet_FullMethodName                      = "/fitness_trainer.api.workout.WorkoutService/LogSet"
-	WorkoutService_UpdateSetLog_FullMethodName                = "/fitness_trainer.api.workout.WorkoutService/UpdateSetLog"
-	WorkoutService_DeleteSetLog_FullMethodName                = "/fitness_trainer.api.workout.WorkoutService/DeleteSetLog"
-	WorkoutService_CompleteWorkout_FullMethodName             = "/fitness_trainer.api.workout.WorkoutService/CompleteWorkout"
-	WorkoutService_GetWorkoutReport_FullMethodName            = "/fitness_trainer.api.workout.WorkoutService/GetWorkoutReport"
-	WorkoutService_RateWorkout_FullMethodName                 = "/fitness_trainer.api.workout.WorkoutService/RateWorkout"
-	WorkoutService_AddCommentToWorkout_FullMethodName         = "/fitness_trainer.api.workout.WorkoutService/AddCommentToWorkout"
+	RoutineService_GetRoutines_FullMethodName                       = "/fitness_trainer.api.workout.RoutineService/GetRoutines"
+	RoutineService_CreateRoutine_FullMethodName                     = "/fitness_trainer.api.workout.RoutineService/CreateRoutine"
+	RoutineService_UpdateRoutine_FullMethodName                     = "/fitness_trainer.api.workout.RoutineService/UpdateRoutine"
+	RoutineService_GetRoutineDetail_FullMethodName                  = "/fitness_trainer.api.workout.RoutineService/GetRoutineDetail"
+	RoutineService_DeleteRoutine_FullMethodName                     = "/fitness_trainer.api.workout.RoutineService/DeleteRoutine"
+	RoutineService_AddExerciseToRoutine_FullMethodName              = "/fitness_trainer.api.workout.RoutineService/AddExerciseToRoutine"
+	RoutineService_GetExerciseInstanceDetails_FullMethodName        = "/fitness_trainer.api.workout.RoutineService/GetExerciseInstanceDetails"
+	RoutineService_RemoveExerciseInstanceFromRoutine_FullMethodName = "/fitness_trainer.api.workout.RoutineService/RemoveExerciseInstanceFromRoutine"
+	RoutineService_AddSetToExerciseInstance_FullMethodName          = "/fitness_trainer.api.workout.RoutineService/AddSetToExerciseInstance"
+	RoutineService_UpdateSetInExerciseInstance_FullMethodName       = "/fitness_trainer.api.workout.RoutineService/UpdateSetInExerciseInstance"
+	RoutineService_RemoveSetFromExerciseInstance_FullMethodName     = "/fitness_trainer.api.workout.RoutineService/RemoveSetFromExerciseInstance"
+	RoutineService_SetSetOrder_FullMethodName                       = "/fitness_trainer.api.workout.RoutineService/SetSetOrder"
+	RoutineService_SetExerciseOrder_FullMethodName                  = "/fitness_trainer.api.workout.RoutineService/SetExerciseOrder"
+	RoutineService_GroupExerciseInstances_FullMethodName            = "/fitness_trainer.api.workout.RoutineService/GroupExerciseInstances"
+	RoutineService_UngroupExerciseInstances_FullMethodName          = "/fitness_trainer.api.workout.RoutineService/UngroupExerciseInstances"
+	RoutineService_DuplicateRoutine_FullMethodName                  = "/fitness_trainer.api.workout.RoutineService/DuplicateRoutine"
+	RoutineService_GetRoutineVersions_FullMethodName                = "/fitness_trainer.api.workout.RoutineService/GetRoutineVersions"
+	RoutineService_GetRoutineVersion_FullMethodName                 = "/fitness_trainer.api.workout.RoutineService/GetRoutineVersion"
+	RoutineService_DiffRoutineVersions_FullMethodName               = "/fitness_trainer.api.workout.RoutineService/DiffRoutineVersions"
+	RoutineService_CreateRoutineShareLink_FullMethodName            = "/fitness_trainer.api.workout.RoutineService/CreateRoutineShareLink"
+	RoutineService_GetRoutineShareLinks_FullMethodName              = "/fitness_trainer.api.workout.RoutineService/GetRoutineShareLinks"
+	RoutineService_DeleteRoutineShareLink_FullMethodName            = "/fitness_trainer.api.workout.RoutineService/DeleteRoutineShareLink"
+	RoutineService_GetSharedRoutine_FullMethodName                  = "/fitness_trainer.api.workout.RoutineService/GetSharedRoutine"
+	RoutineService_ImportRoutine_FullMethodName                     = "/fitness_trainer.api.workout.RoutineService/ImportRoutine"
+	RoutineService_GetRoutineTemplates_FullMethodName               = "/fitness_trainer.api.workout.RoutineService/GetRoutineTemplates"
+	RoutineService_CloneRoutineTemplate_FullMethodName              = "/fitness_trainer.api.workout.RoutineService/CloneRoutineTemplate"
 )
 
-// WorkoutServiceClient is the client API for WorkoutService service.
+// RoutineServiceClient is the client API for RoutineService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type WorkoutServiceClient interface {
-	// Метод для начала новой тренировки
-	StartWorkout(ctx context.Context, in *StartWorkoutRequest, opts ...grpc.CallOption) (*WorkoutResponse, error)
-	// Метод для получения состояния тренировки
-	GetWorkout(ctx context.Context, in *GetWorkoutRequest, opts ...grpc.CallOption) (*GetWorkoutResponse, error)
-	// Удалить тренировку
-	DeleteWorkout(ctx context.Context, in *DeleteWorkoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Метод для получения списка активных тренировок
-	GetActiveWorkouts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WorkoutsListResponse, error)
-	// Метод для получения списка всех тренировок
-	GetWorkouts(ctx context.Context, in *GetWorkoutsRequest, opts ...grpc.CallOption) (*GetWorkoutsResponse, error)
-	// Метод для создания записи о выполнении упражнения
-	LogExercise(ctx context.Context, in *LogExerciseRequest, opts ...grpc.CallOption) (*ExerciseLog, error)
-	// Метод для получения записи о выполнении упражнения
-	GetExerciseLogDetails(ctx context.Context, in *GetExerciseLogDetailRequest, opts ...grpc.CallOption) (*ExerciseLogResponse, error)
-	// Удалить запись о выполнении упражнения
-	DeleteExerciseLog(ctx context.Context, in *DeleteExerciseLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Добавить оценку усилий при выполнении упражнения
-	AddPowerRatingToExerciseLog(ctx context.Context, in *AddPowerRatingToExerciseLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Добавление заметки к выполнению упражнения
-	AddNotesToExerciseLog(ctx context.Context, in *AddNotesToExerciseLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Метод для создания записи о выполнении подхода
-	LogSet(ctx context.Context, in *LogSetRequest, opts ...grpc.CallOption) (*SetLogResponse, error)
-	// Метод для изменения записи о выполнении подхода
-	UpdateSetLog(ctx context.Context, in *UpdateSetLogRequest, opts ...grpc.CallOption) (*SetLogResponse, error)
-	// Удалить запись о выполнении подхода
-	DeleteSetLog(ctx context.Context, in *DeleteSetLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Метод для завершения тренировки
-	CompleteWorkout(ctx context.Context, in *CompleteWorkoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Метод для получения отчета о тренировке
-	GetWorkoutReport(ctx context.Context, in *GetWorkoutReportRequest, opts ...grpc.CallOption) (*WorkoutReportResponse, error)
-	// Метод для установки оценки тренировки
-	RateWorkout(ctx context.Context, in *RateWorkoutRequest, opts ...grpc.CallOption) (*WorkoutResponse, error)
-	// Метод для добавления комментария к тренировке
-	AddCommentToWorkout(ctx context.Context, in *AddCommentToWorkoutRequest, opts ...grpc.CallOption) (*WorkoutResponse, error)
+type RoutineServiceClient interface {
+	// Метод для получения списка доступных пользователю рутин
+	GetRoutines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RoutineListResponse, error)
+	// Создание новой рутины
+	CreateRoutine(ctx context.Context, in *CreateRoutineRequest, opts ...grpc.CallOption) (*RoutineResponse, error)
+	// Обновление рутины по ID
+	UpdateRoutine(ctx context.Context, in *UpdateRoutineRequest, opts ...grpc.CallOption) (*RoutineResponse, error)
+	// Получение информации о рутине по ID
+	GetRoutineDetail(ctx context.Context, in *GetRoutineDetailRequest, opts ...grpc.CallOption) (*RoutineDetailResponse, error)
+	// Удаление рутины по ID
+	DeleteRoutine(ctx context.Context, in *DeleteRoutineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Добавление упражнения в рутину
+	AddExerciseToRoutine(ctx context.Context, in *AddExerciseToRoutineRequest, opts ...grpc.CallOption) (*RoutineInstanceResponse, error)
+	// Получить информацию об упражнении в рутине
+	GetExerciseInstanceDetails(ctx context.Context, in *GetExerciseInstanceDetailsRequest, opts ...grpc.CallOption) (*GetExerciseInstanceDetailsResponse, error)
+	// Удаление упражнения из рутины
+	RemoveExerciseInstanceFromRoutine(ctx context.Context, in *RemoveExerciseInstanceFromRoutineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для добавления сета в упражнение
+	AddSetToExerciseInstance(ctx context.Context, in *AddSetToExerciseInstanceRequest, opts ...grpc.CallOption) (*SetResponse, error)
+	// Метод для обновления сета в упражнении
+	UpdateSetInExerciseInstance(ctx context.Context, in *UpdateSetInExerciseInstanceRequest, opts ...grpc.CallOption) (*SetResponse, error)
+	// Метод для удаления сета из упражнения
+	RemoveSetFromExerciseInstance(ctx context.Context, in *RemoveSetFromExerciseInstanceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для установки порядка сетов упражнения в рутине
+	SetSetOrder(ctx context.Context, in *SetSetOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для установки порядка упражнений в рутине
+	SetExerciseOrder(ctx context.Context, in *SetExerciseOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для объединения упражнений рутины в суперсет, гигантский сет или круг
+	GroupExerciseInstances(ctx context.Context, in *GroupExerciseInstancesRequest, opts ...grpc.CallOption) (*GroupExerciseInstancesResponse, error)
+	// Метод для расформирования группы упражнений рутины
+	UngroupExerciseInstances(ctx context.Context, in *UngroupExerciseInstancesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для создания копии рутины или одной из её версий
+	DuplicateRoutine(ctx context.Context, in *DuplicateRoutineRequest, opts ...grpc.CallOption) (*RoutineResponse, error)
+	// Метод для получения истории версий рутины
+	GetRoutineVersions(ctx context.Context, in *GetRoutineVersionsRequest, opts ...grpc.CallOption) (*GetRoutineVersionsResponse, error)
+	// Метод для получения содержимого версии рутины, в том числе удалённой
+	GetRoutineVersion(ctx context.Context, in *GetRoutineVersionRequest, opts ...grpc.CallOption) (*GetRoutineVersionResponse, error)
+	// Метод для сравнения двух версий рутины
+	DiffRoutineVersions(ctx context.Context, in *DiffRoutineVersionsRequest, opts ...grpc.CallOption) (*DiffRoutineVersionsResponse, error)
+	// Метод для создания ссылки на просмотр рутины без авторизации
+	CreateRoutineShareLink(ctx context.Context, in *CreateRoutineShareLinkRequest, opts ...grpc.CallOption) (*RoutineShareLinkResponse, error)
+	// Метод для получения ссылок на рутину
+	GetRoutineShareLinks(ctx context.Context, in *GetRoutineShareLinksRequest, opts ...grpc.CallOption) (*GetRoutineShareLinksResponse, error)
+	// Метод для отзыва ссылки на рутину
+	DeleteRoutineShareLink(ctx context.Context, in *DeleteRoutineShareLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для просмотра рутины по ссылке, не требует авторизации
+	GetSharedRoutine(ctx context.Context, in *GetSharedRoutineRequest, opts ...grpc.CallOption) (*RoutineDetailResponse, error)
+	// Метод для копирования рутины по ссылке в свой аккаунт
+	ImportRoutine(ctx context.Context, in *ImportRoutineRequest, opts ...grpc.CallOption) (*RoutineResponse, error)
+	// Метод для поиска по библиотеке шаблонов рутин
+	GetRoutineTemplates(ctx context.Context, in *GetRoutineTemplatesRequest, opts ...grpc.CallOption) (*GetRoutineTemplatesResponse, error)
+	// Копирование шаблона в рутины пользователя
+	CloneRoutineTemplate(ctx context.Context, in *CloneRoutineTemplateRequest, opts ...grpc.CallOption) (*RoutineResponse, error)
 }
 
-type workoutServiceClient struct {
+type routineServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewWorkoutServiceClient(cc grpc.ClientConnInterface) WorkoutServiceClient {
-	return &workoutServiceClient{cc}
+func NewRoutineServiceClient(cc grpc.ClientConnInterface) RoutineServiceClient {
+	return &routineServiceClient{cc}
 }
 
-func (c *workoutServiceClient) StartWorkout(ctx context.Context, in *StartWorkoutRequest, opts ...grpc.CallOption) (*WorkoutResponse, error) {
+func (c *routineServiceClient) GetRoutines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RoutineListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(WorkoutResponse)
-	err := c.cc.Invoke(ctx, WorkoutService_StartWorkout_FullMethodName, in, out, cOpts...)
+	out := new(RoutineListResponse)
+	err := c.cc.Invoke(ctx, RoutineService_GetRoutines_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) GetWorkout(ctx context.Context, in *GetWorkoutRequest, opts ...grpc.CallOption) (*GetWorkoutResponse, error) {
+func (c *routineServiceClient) CreateRoutine(ctx context.Context, in *CreateRoutineRequest, opts ...grpc.CallOption) (*RoutineResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetWorkoutResponse)
-	err := c.cc.Invoke(ctx, WorkoutService_GetWorkout_FullMethodName, in, out, cOpts...)
+	out := new(RoutineResponse)
+	err := c.cc.Invoke(ctx, RoutineService_CreateRoutine_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) DeleteWorkout(ctx context.Context, in *DeleteWorkoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *routineServiceClient) UpdateRoutine(ctx context.Context, in *UpdateRoutineRequest, opts ...grpc.CallOption) (*RoutineResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, WorkoutService_DeleteWorkout_FullMethodName, in, out, cOpts...)
+	out := new(RoutineResponse)
+	err := c.cc.Invoke(ctx, RoutineService_UpdateRoutine_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) GetActiveWorkouts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WorkoutsListResponse, error) {
+func (c *routineServiceClient) GetRoutineDetail(ctx context.Context, in *GetRoutineDetailRequest, opts ...grpc.CallOption) (*RoutineDetailResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(WorkoutsListResponse)
-	err := c.cc.Invoke(ctx, WorkoutService_GetActiveWorkouts_FullMethodName, in, out, cOpts...)
+	out := new(RoutineDetailResponse)
+	err := c.cc.Invoke(ctx, RoutineService_GetRoutineDetail_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) GetWorkouts(ctx context.Context, in *GetWorkoutsRequest, opts ...grpc.CallOption) (*GetWorkoutsResponse, error) {
+func (c *routineServiceClient) DeleteRoutine(ctx context.Context, in *DeleteRoutineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetWorkoutsResponse)
-	err := c.cc.Invoke(ctx, WorkoutService_GetWorkouts_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RoutineService_DeleteRoutine_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) LogExercise(ctx context.Context, in *LogExerciseRequest, opts ...grpc.CallOption) (*ExerciseLog, error) {
+func (c *routineServiceClient) AddExerciseToRoutine(ctx context.Context, in *AddExerciseToRoutineRequest, opts ...grpc.CallOption) (*RoutineInstanceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ExerciseLog)
-	err := c.cc.Invoke(ctx, WorkoutService_LogExercise_FullMethodName, in, out, cOpts...)
+	out := new(RoutineInstanceResponse)
+	err := c.cc.Invoke(ctx, RoutineService_AddExerciseToRoutine_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) GetExerciseLogDetails(ctx context.Context, in *GetExerciseLogDetailRequest, opts ...grpc.CallOption) (*ExerciseLogResponse, error) {
+func (c *routineServiceClient) GetExerciseInstanceDetails(ctx context.Context, in *GetExerciseInstanceDetailsRequest, opts ...grpc.CallOption) (*GetExerciseInstanceDetailsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ExerciseLogResponse)
-	err := c.cc.Invoke(ctx, WorkoutService_GetExerciseLogDetails_FullMethodName, in, out, cOpts...)
+	out := new(GetExerciseInstanceDetailsResponse)
+	err := c.cc.Invoke(ctx, RoutineService_GetExerciseInstanceDetails_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) DeleteExerciseLog(ctx context.Context, in *DeleteExerciseLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *routineServiceClient) RemoveExerciseInstanceFromRoutine(ctx context.Context, in *RemoveExerciseInstanceFromRoutineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, WorkoutService_DeleteExerciseLog_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, RoutineService_RemoveExerciseInstanceFromRoutine_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) AddPowerRatingToExerciseLog(ctx context.Context, in *AddPowerRatingToExerciseLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *routineServiceClient) AddSetToExerciseInstance(ctx context.Context, in *AddSetToExerciseInstanceRequest, opts ...grpc.CallOption) (*SetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetResponse)
+	err := c.cc.Invoke(ctx, RoutineService_AddSetToExerciseInstance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routineServiceClient) UpdateSetInExerciseInstance(ctx context.Context, in *UpdateSetInExerciseInstanceRequest, opts ...grpc.CallOption) (*SetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetResponse)
+	err := c.cc.Invoke(ctx, RoutineService_UpdateSetInExerciseInstance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routineServiceClient) RemoveSetFromExerciseInstance(ctx context.Context, in *RemoveSetFromExerciseInstanceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, WorkoutService_AddPowerRatingToExerciseLog_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, RoutineService_RemoveSetFromExerciseInstance_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) AddNotesToExerciseLog(ctx context.Context, in *AddNotesToExerciseLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *routineServiceClient) SetSetOrder(ctx context.Context, in *SetSetOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, WorkoutService_AddNotesToExerciseLog_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, RoutineService_SetSetOrder_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) LogSet(ctx context.Context, in *LogSetRequest, opts ...grpc.CallOption) (*SetLogResponse, error) {
+func (c *routineServiceClient) SetExerciseOrder(ctx context.Context, in *SetExerciseOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SetLogResponse)
-	err := c.cc.Invoke(ctx, WorkoutService_LogSet_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RoutineService_SetExerciseOrder_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) UpdateSetLog(ctx context.Context, in *UpdateSetLogRequest, opts ...grpc.CallOption) (*SetLogResponse, error) {
+func (c *routineServiceClient) GroupExerciseInstances(ctx context.Context, in *GroupExerciseInstancesRequest, opts ...grpc.CallOption) (*GroupExerciseInstancesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SetLogResponse)
-	err := c.cc.Invoke(ctx, WorkoutService_UpdateSetLog_FullMethodName, in, out, cOpts...)
+	out := new(GroupExerciseInstancesResponse)
+	err := c.cc.Invoke(ctx, RoutineService_GroupExerciseInstances_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) DeleteSetLog(ctx context.Context, in *DeleteSetLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *routineServiceClient) UngroupExerciseInstances(ctx context.Context, in *UngroupExerciseInstancesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, WorkoutService_DeleteSetLog_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, RoutineService_UngroupExerciseInstances_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) CompleteWorkout(ctx context.Context, in *CompleteWorkoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *routineServiceClient) DuplicateRoutine(ctx context.Context, in *DuplicateRoutineRequest, opts ...grpc.CallOption) (*RoutineResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, WorkoutService_CompleteWorkout_FullMethodName, in, out, cOpts...)
+	out := new(RoutineResponse)
+	err := c.cc.Invoke(ctx, RoutineService_DuplicateRoutine_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) GetWorkoutReport(ctx context.Context, in *GetWorkoutReportRequest, opts ...grpc.CallOption) (*WorkoutReportResponse, error) {
+func (c *routineServiceClient) GetRoutineVersions(ctx context.Context, in *GetRoutineVersionsRequest, opts ...grpc.CallOption) (*GetRoutineVersionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(WorkoutReportResponse)
-	err := c.cc.Invoke(ctx, WorkoutService_GetWorkoutReport_FullMethodName, in, out, cOpts...)
+	out := new(GetRoutineVersionsResponse)
+	err := c.cc.Invoke(ctx, RoutineService_GetRoutineVersions_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) RateWorkout(ctx context.Context, in *RateWorkoutRequest, opts ...grpc.CallOption) (*WorkoutResponse, error) {
+func (c *routineServiceClient) GetRoutineVersion(ctx context.Context, in *GetRoutineVersionRequest, opts ...grpc.CallOption) (*GetRoutineVersionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(WorkoutResponse)
-	err := c.cc.Invoke(ctx, WorkoutService_RateWorkout_FullMethodName, in, out, cOpts...)
+	out := new(GetRoutineVersionResponse)
+	err := c.cc.Invoke(ctx, RoutineService_GetRoutineVersion_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workoutServiceClient) AddCommentToWorkout(ctx context.Context, in *AddCommentToWorkoutRequest, opts ...grpc.CallOption) (*WorkoutResponse, error) {
+func (c *routineServiceClient) DiffRoutineVersions(ctx context.Context, in *DiffRoutineVersionsRequest, opts ...grpc.CallOption) (*DiffRoutineVersionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(WorkoutResponse)
-	err := c.cc.Invoke(ctx, WorkoutService_AddCommentToWorkout_FullMethodName, in, out, cOpts...)
+	out := new(DiffRoutineVersionsResponse)
+	err := c.cc.Invoke(ctx, RoutineService_DiffRoutineVersions_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// WorkoutServiceServer is the server API for WorkoutService service.
-// All implementations must embed UnimplementedWorkoutServiceServer
-// for forward compatibility.
-type WorkoutServiceServer interface {
-	// Метод для начала новой тренировки
-	StartWorkout(context.Context, *StartWorkoutRequest) (*WorkoutResponse, error)
-	// Метод для получения состояния тренировки
-	GetWorkout(context.Context, *GetWorkoutRequest) (*GetWorkoutResponse, error)
-	// Удалить тренировку
-	DeleteWorkout(context.Context, *DeleteWorkoutRequest) (*emptypb.Empty, error)
-	// Метод для получения списка активных тренировок
-	GetActiveWorkouts(context.Context, *emptypb.Empty) (*WorkoutsListResponse, error)
-	// Метод для получения списка всех тренировок
-	GetWorkouts(context.Context, *GetWorkoutsRequest) (*GetWorkoutsResponse, error)
-	// Метод для создания записи о выполнении упражнения
-	LogExercise(context.Context, *LogExerciseRequest) (*ExerciseLog, error)
-	// Метод для получения записи о выполнении упражнения
-	GetExerciseLogDetails(context.Context, *GetExerciseLogDetailRequest) (*ExerciseLogResponse, error)
-	// Удалить запись о выполнении упражнения
-	DeleteExerciseLog(context.Context, *DeleteExerciseLogRequest) (*emptypb.Empty, error)
-	// Добавить оценку усилий при выполнении упражнения
-	AddPowerRatingToExerciseLog(context.Context, *AddPowerRatingToExerciseLogRequest) (*emptypb.Empty, error)
-	// Добавление заметки к выполнению упражнения
-	AddNotesToExerciseLog(context.Context, *AddNotesToExerciseLogRequest) (*emptypb.Empty, error)
-	// Метод для создания записи о выполнении подхода
-	LogSet(context.Context, *LogSetRequest) (*SetLogResponse, error)
-	// Метод для изменения записи о выполнении подхода
-	UpdateSetLog(context.Context, *UpdateSetLogRequest) (*SetLogResponse, error)
-	// Удалить запись о выполнении подхода
-	DeleteSetLog(context.Context, *DeleteSetLogRequest) (*emptypb.Empty, error)
-	// Метод для завершения тренировки
-	CompleteWorkout(context.Context, *CompleteWorkoutRequest) (*emptypb.Empty, error)
-	// Метод для получения отчета о тренировке
-	GetWorkoutReport(context.Context, *GetWorkoutReportRequest) (*WorkoutReportResponse, error)
-	// Метод для установки оценки тренировки
-	RateWorkout(context.Context, *RateWorkoutRequest) (*WorkoutResponse, error)
-	// Метод для добавления комментария к тренировке
-	AddCommentToWorkout(context.Context, *AddCommentToWorkoutRequest) (*WorkoutResponse, error)
-	mustEmbedUnimplementedWorkoutServiceServer()
+func (c *routineServiceClient) CreateRoutineShareLink(ctx context.Context, in *CreateRoutineShareLinkRequest, opts ...grpc.CallOption) (*RoutineShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoutineShareLinkResponse)
+	err := c.cc.Invoke(ctx, RoutineService_CreateRoutineShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedWorkoutServiceServer must be embedded to have
+func (c *routineServiceClient) GetRoutineShareLinks(ctx context.Context, in *GetRoutineShareLinksRequest, opts ...grpc.CallOption) (*GetRoutineShareLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoutineShareLinksResponse)
+	err := c.cc.Invoke(ctx, RoutineService_GetRoutineShareLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routineServiceClient) DeleteRoutineShareLink(ctx context.Context, in *DeleteRoutineShareLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RoutineService_DeleteRoutineShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routineServiceClient) GetSharedRoutine(ctx context.Context, in *GetSharedRoutineRequest, opts ...grpc.CallOption) (*RoutineDetailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoutineDetailResponse)
+	err := c.cc.Invoke(ctx, RoutineService_GetSharedRoutine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routineServiceClient) ImportRoutine(ctx context.Context, in *ImportRoutineRequest, opts ...grpc.CallOption) (*RoutineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoutineResponse)
+	err := c.cc.Invoke(ctx, RoutineService_ImportRoutine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routineServiceClient) GetRoutineTemplates(ctx context.Context, in *GetRoutineTemplatesRequest, opts ...grpc.CallOption) (*GetRoutineTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoutineTemplatesResponse)
+	err := c.cc.Invoke(ctx, RoutineService_GetRoutineTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routineServiceClient) CloneRoutineTemplate(ctx context.Context, in *CloneRoutineTemplateRequest, opts ...grpc.CallOption) (*RoutineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoutineResponse)
+	err := c.cc.Invoke(ctx, RoutineService_CloneRoutineTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoutineServiceServer is the server API for RoutineService service.
+// All implementations must embed UnimplementedRoutineServiceServer
+// for forward compatibility.
+type RoutineServiceServer interface {
+	// Метод для получения списка доступных пользователю рутин
+	GetRoutines(context.Context, *emptypb.Empty) (*RoutineListResponse, error)
+	// Создание новой рутины
+	CreateRoutine(context.Context, *CreateRoutineRequest) (*RoutineResponse, error)
+	// Обновление рутины по ID
+	UpdateRoutine(context.Context, *UpdateRoutineRequest) (*RoutineResponse, error)
+	// Получение информации о рутине по ID
+	GetRoutineDetail(context.Context, *GetRoutineDetailRequest) (*RoutineDetailResponse, error)
+	// Удаление рутины по ID
+	DeleteRoutine(context.Context, *DeleteRoutineRequest) (*emptypb.Empty, error)
+	// Добавление упражнения в рутину
+	AddExerciseToRoutine(context.Context, *AddExerciseToRoutineRequest) (*RoutineInstanceResponse, error)
+	// Получить информацию об упражнении в рутине
+	GetExerciseInstanceDetails(context.Context, *GetExerciseInstanceDetailsRequest) (*GetExerciseInstanceDetailsResponse, error)
+	// Удаление упражнения из рутины
+	RemoveExerciseInstanceFromRoutine(context.Context, *RemoveExerciseInstanceFromRoutineRequest) (*emptypb.Empty, error)
+	// Метод для добавления сета в упражнение
+	AddSetToExerciseInstance(context.Context, *AddSetToExerciseInstanceRequest) (*SetResponse, error)
+	// Метод для обновления сета в упражнении
+	UpdateSetInExerciseInstance(context.Context, *UpdateSetInExerciseInstanceRequest) (*SetResponse, error)
+	// Метод для удаления сета из упражнения
+	RemoveSetFromExerciseInstance(context.Context, *RemoveSetFromExerciseInstanceRequest) (*emptypb.Empty, error)
+	// Метод для установки порядка сетов упражнения в рутине
+	SetSetOrder(context.Context, *SetSetOrderRequest) (*emptypb.Empty, error)
+	// Метод для установки порядка упражнений в рутине
+	SetExerciseOrder(context.Context, *SetExerciseOrderRequest) (*emptypb.Empty, error)
+	// Метод для объединения упражнений рутины в суперсет, гигантский сет или круг
+	GroupExerciseInstances(context.Context, *GroupExerciseInstancesRequest) (*GroupExerciseInstancesResponse, error)
+	// Метод для расформирования группы упражнений рутины
+	UngroupExerciseInstances(context.Context, *UngroupExerciseInstancesRequest) (*emptypb.Empty, error)
+	// Метод для создания копии рутины или одной из её версий
+	DuplicateRoutine(context.Context, *DuplicateRoutineRequest) (*RoutineResponse, error)
+	// Метод для получения истории версий рутины
+	GetRoutineVersions(context.Context, *GetRoutineVersionsRequest) (*GetRoutineVersionsResponse, error)
+	// Метод для получения содержимого версии рутины, в том числе удалённой
+	GetRoutineVersion(context.Context, *GetRoutineVersionRequest) (*GetRoutineVersionResponse, error)
+	// Метод для сравнения двух версий рутины
+	DiffRoutineVersions(context.Context, *DiffRoutineVersionsRequest) (*DiffRoutineVersionsResponse, error)
+	// Метод для создания ссылки на просмотр рутины без авторизации
+	CreateRoutineShareLink(context.Context, *CreateRoutineShareLinkRequest) (*RoutineShareLinkResponse, error)
+	// Метод для получения ссылок на рутину
+	GetRoutineShareLinks(context.Context, *GetRoutineShareLinksRequest) (*GetRoutineShareLinksResponse, error)
+	// Метод для отзыва ссылки на рутину
+	DeleteRoutineShareLink(context.Context, *DeleteRoutineShareLinkRequest) (*emptypb.Empty, error)
+	// Метод для просмотра рутины по ссылке, не требует авторизации
+	GetSharedRoutine(context.Context, *GetSharedRoutineRequest) (*RoutineDetailResponse, error)
+	// Метод для копирования рутины по ссылке в свой аккаунт
+	ImportRoutine(context.Context, *ImportRoutineRequest) (*RoutineResponse, error)
+	// Метод для поиска по библиотеке шаблонов рутин
+	GetRoutineTemplates(context.Context, *GetRoutineTemplatesRequest) (*GetRoutineTemplatesResponse, error)
+	// Копирование шаблона в рутины пользователя
+	CloneRoutineTemplate(context.Context, *CloneRoutineTemplateRequest) (*RoutineResponse, error)
+	mustEmbedUnimplementedRoutineServiceServer()
+}
+
+// UnimplementedRoutineServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedWorkoutServiceServer struct{}
+type UnimplementedRoutineServiceServer struct{}
 
-func (UnimplementedWorkoutServiceServer) StartWorkout(context.Context, *StartWorkoutRequest) (*WorkoutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartWorkout not implemented")
+func (UnimplementedRoutineServiceServer) GetRoutines(context.Context, *emptypb.Empty) (*RoutineListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoutines not implemented")
 }
-func (UnimplementedWorkoutServiceServer) GetWorkout(context.Context, *GetWorkoutRequest) (*GetWorkoutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkout not implemented")
+func (UnimplementedRoutineServiceServer) CreateRoutine(context.Context, *CreateRoutineRequest) (*RoutineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoutine not implemented")
 }
-func (UnimplementedWorkoutServiceServer) DeleteWorkout(context.Context, *DeleteWorkoutRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkout not implemented")
+func (UnimplementedRoutineServiceServer) UpdateRoutine(context.Context, *UpdateRoutineRequest) (*RoutineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoutine not implemented")
 }
-func (UnimplementedWorkoutServiceServer) GetActiveWorkouts(context.Context, *emptypb.Empty) (*WorkoutsListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetActiveWorkouts not implemented")
+func (UnimplementedRoutineServiceServer) GetRoutineDetail(context.Context, *GetRoutineDetailRequest) (*RoutineDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoutineDetail not implemented")
 }
-func (UnimplementedWorkoutServiceServer) GetWorkouts(context.Context, *GetWorkoutsRequest) (*GetWorkoutsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkouts not implemented")
+func (UnimplementedRoutineServiceServer) DeleteRoutine(context.Context, *DeleteRoutineRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoutine not implemented")
 }
-func (UnimplementedWorkoutServiceServer) LogExercise(context.Context, *LogExerciseRequest) (*ExerciseLog, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LogExercise not implemented")
+func (UnimplementedRoutineServiceServer) AddExerciseToRoutine(context.Context, *AddExerciseToRoutineRequest) (*RoutineInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExerciseToRoutine not implemented")
 }
-func (UnimplementedWorkoutServiceServer) GetExerciseLogDetails(context.Context, *GetExerciseLogDetailRequest) (*ExerciseLogResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExerciseLogDetails not implemented")
+func (UnimplementedRoutineServiceServer) GetExerciseInstanceDetails(context.Context, *GetExerciseInstanceDetailsRequest) (*GetExerciseInstanceDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExerciseInstanceDetails not implemented")
 }
-func (UnimplementedWorkoutServiceServer) DeleteExerciseLog(context.Context, *DeleteExerciseLogRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteExerciseLog not implemented")
+func (UnimplementedRoutineServiceServer) RemoveExerciseInstanceFromRoutine(context.Context, *RemoveExerciseInstanceFromRoutineRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveExerciseInstanceFromRoutine not implemented")
 }
-func (UnimplementedWorkoutServiceServer) AddPowerRatingToExerciseLog(context.Context, *AddPowerRatingToExerciseLogRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPowerRatingToExerciseLog not implemented")
+func (UnimplementedRoutineServiceServer) AddSetToExerciseInstance(context.Context, *AddSetToExerciseInstanceRequest) (*SetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSetToExerciseInstance not implemented")
 }
-func (UnimplementedWorkoutServiceServer) AddNotesToExerciseLog(context.Context, *AddNotesToExerciseLogRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddNotesToExerciseLog not implemented")
+func (UnimplementedRoutineServiceServer) UpdateSetInExerciseInstance(context.Context, *UpdateSetInExerciseInstanceRequest) (*SetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSetInExerciseInstance not implemented")
 }
-func (UnimplementedWorkoutServiceServer) LogSet(context.Context, *LogSetRequest) (*SetLogResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LogSet not implemented")
+func (UnimplementedRoutineServiceServer) RemoveSetFromExerciseInstance(context.Context, *RemoveSetFromExerciseInstanceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSetFromExerciseInstance not implemented")
 }
-func (UnimplementedWorkoutServiceServer) UpdateSetLog(context.Context, *UpdateSetLogRequest) (*SetLogResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSetLog not implemented")
+func (UnimplementedRoutineServiceServer) SetSetOrder(context.Context, *SetSetOrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSetOrder not implemented")
 }
-func (UnimplementedWorkoutServiceServer) DeleteSetLog(context.Context, *DeleteSetLogRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSetLog not implemented")
+func (UnimplementedRoutineServiceServer) SetExerciseOrder(context.Context, *SetExerciseOrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExerciseOrder not implemented")
 }
-func (UnimplementedWorkoutServiceServer) CompleteWorkout(context.Context, *CompleteWorkoutRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteWorkout not implemented")
+func (UnimplementedRoutineServiceServer) GroupExerciseInstances(context.Context, *GroupExerciseInstancesRequest) (*GroupExerciseInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupExerciseInstances not implemented")
 }
-func (UnimplementedWorkoutServiceServer) GetWorkoutReport(context.Context, *GetWorkoutReportRequest) (*WorkoutReportResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkoutReport not implemented")
+func (UnimplementedRoutineServiceServer) UngroupExerciseInstances(context.Context, *UngroupExerciseInstancesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UngroupExerciseInstances not implemented")
 }
-func (UnimplementedWorkoutServiceServer) RateWorkout(context.Context, *RateWorkoutRequest) (*WorkoutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RateWorkout not implemented")
+func (UnimplementedRoutineServiceServer) DuplicateRoutine(context.Context, *DuplicateRoutineRequest) (*RoutineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DuplicateRoutine not implemented")
 }
-func (UnimplementedWorkoutServiceServer) AddCommentToWorkout(context.Context, *AddCommentToWorkoutRequest) (*WorkoutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddCommentToWorkout not implemented")
+func (UnimplementedRoutineServiceServer) GetRoutineVersions(context.Context, *GetRoutineVersionsRequest) (*GetRoutineVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoutineVersions not implemented")
 }
-func (UnimplementedWorkoutServiceServer) mustEmbedUnimplementedWorkoutServiceServer() {}
-func (UnimplementedWorkoutServiceServer) testEmbeddedByValue()                        {}
+func (UnimplementedRoutineServiceServer) GetRoutineVersion(context.Context, *GetRoutineVersionRequest) (*GetRoutineVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoutineVersion not implemented")
+}
+func (UnimplementedRoutineServiceServer) DiffRoutineVersions(context.Context, *DiffRoutineVersionsRequest) (*DiffRoutineVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRoutineVersions not implemented")
+}
+func (UnimplementedRoutineServiceServer) CreateRoutineShareLink(context.Context, *CreateRoutineShareLinkRequest) (*RoutineShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoutineShareLink not implemented")
+}
+func (UnimplementedRoutineServiceServer) GetRoutineShareLinks(context.Context, *GetRoutineShareLinksRequest) (*GetRoutineShareLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoutineShareLinks not implemented")
+}
+func (UnimplementedRoutineServiceServer) DeleteRoutineShareLink(context.Context, *DeleteRoutineShareLinkRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoutineShareLink not implemented")
+}
+func (UnimplementedRoutineServiceServer) GetSharedRoutine(context.Context, *GetSharedRoutineRequest) (*RoutineDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedRoutine not implemented")
+}
+func (UnimplementedRoutineServiceServer) ImportRoutine(context.Context, *ImportRoutineRequest) (*RoutineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportRoutine not implemented")
+}
+func (UnimplementedRoutineServiceServer) GetRoutineTemplates(context.Context, *GetRoutineTemplatesRequest) (*GetRoutineTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoutineTemplates not implemented")
+}
+func (UnimplementedRoutineServiceServer) CloneRoutineTemplate(context.Context, *CloneRoutineTemplateRequest) (*RoutineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneRoutineTemplate not implemented")
+}
+func (UnimplementedRoutineServiceServer) mustEmbedUnimplementedRoutineServiceServer() {}
+func (UnimplementedRoutineServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeWorkoutServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to WorkoutServiceServer will
+// UnsafeRoutineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoutineServiceServer will
 // result in compilation errors.
-type UnsafeWorkoutServiceServer interface {
-	mustEmbedUnimplementedWorkoutServiceServer()
+type UnsafeRoutineServiceServer interface {
+	mustEmbedUnimplementedRoutineServiceServer()
 }
 
-func RegisterWorkoutServiceServer(s grpc.ServiceRegistrar, srv WorkoutServiceServer) {
-	// If the following call pancis, it indicates UnimplementedWorkoutServiceServer was
+func RegisterRoutineServiceServer(s grpc.ServiceRegistrar, srv RoutineServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRoutineServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&WorkoutService_ServiceDesc, srv)
+	s.RegisterService(&RoutineService_ServiceDesc, srv)
 }
 
-func _WorkoutService_StartWorkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartWorkoutRequest)
+func _RoutineService_GetRoutines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).StartWorkout(ctx, in)
+		return srv.(RoutineServiceServer).GetRoutines(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_StartWorkout_FullMethodName,
+		FullMethod: RoutineService_GetRoutines_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).StartWorkout(ctx, req.(*StartWorkoutRequest))
+		return srv.(RoutineServiceServer).GetRoutines(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkoutService_GetWorkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkoutRequest)
+func _RoutineService_CreateRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoutineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).GetWorkout(ctx, in)
+		return srv.(RoutineServiceServer).CreateRoutine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_GetWorkout_FullMethodName,
+		FullMethod: RoutineService_CreateRoutine_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).GetWorkout(ctx, req.(*GetWorkoutRequest))
+		return srv.(RoutineServiceServer).CreateRoutine(ctx, req.(*CreateRoutineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkoutService_DeleteWorkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteWorkoutRequest)
+func _RoutineService_UpdateRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoutineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).DeleteWorkout(ctx, in)
+		return srv.(RoutineServiceServer).UpdateRoutine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_DeleteWorkout_FullMethodName,
+		FullMethod: RoutineService_UpdateRoutine_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).DeleteWorkout(ctx, req.(*DeleteWorkoutRequest))
+		return srv.(RoutineServiceServer).UpdateRoutine(ctx, req.(*UpdateRoutineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkoutService_GetActiveWorkouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _RoutineService_GetRoutineDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoutineDetailRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).GetActiveWorkouts(ctx, in)
+		return srv.(RoutineServiceServer).GetRoutineDetail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_GetActiveWorkouts_FullMethodName,
+		FullMethod: RoutineService_GetRoutineDetail_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).GetActiveWorkouts(ctx, req.(*emptypb.Empty))
+		return srv.(RoutineServiceServer).GetRoutineDetail(ctx, req.(*GetRoutineDetailRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkoutService_GetWorkouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkoutsRequest)
+func _RoutineService_DeleteRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoutineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).GetWorkouts(ctx, in)
+		return srv.(RoutineServiceServer).DeleteRoutine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_GetWorkouts_FullMethodName,
+		FullMethod: RoutineService_DeleteRoutine_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).GetWorkouts(ctx, req.(*GetWorkoutsRequest))
+		return srv.(RoutineServiceServer).DeleteRoutine(ctx, req.(*DeleteRoutineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkoutService_LogExercise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogExerciseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkoutServiceServer).LogExercise(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: WorkoutService_LogExercise_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).LogExercise(ctx, req.(*LogExerciseRequest))
+func _RoutineService_AddExerciseToRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExerciseToRoutineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).AddExerciseToRoutine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_AddExerciseToRoutine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).AddExerciseToRoutine(ctx, req.(*AddExerciseToRoutineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_GetExerciseInstanceDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExerciseInstanceDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).GetExerciseInstanceDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_GetExerciseInstanceDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).GetExerciseInstanceDetails(ctx, req.(*GetExerciseInstanceDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_RemoveExerciseInstanceFromRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveExerciseInstanceFromRoutineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).RemoveExerciseInstanceFromRoutine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_RemoveExerciseInstanceFromRoutine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).RemoveExerciseInstanceFromRoutine(ctx, req.(*RemoveExerciseInstanceFromRoutineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_AddSetToExerciseInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSetToExerciseInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).AddSetToExerciseInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_AddSetToExerciseInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).AddSetToExerciseInstance(ctx, req.(*AddSetToExerciseInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_UpdateSetInExerciseInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSetInExerciseInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).UpdateSetInExerciseInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_UpdateSetInExerciseInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).UpdateSetInExerciseInstance(ctx, req.(*UpdateSetInExerciseInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_RemoveSetFromExerciseInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSetFromExerciseInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).RemoveSetFromExerciseInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_RemoveSetFromExerciseInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).RemoveSetFromExerciseInstance(ctx, req.(*RemoveSetFromExerciseInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_SetSetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).SetSetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_SetSetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).SetSetOrder(ctx, req.(*SetSetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_SetExerciseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExerciseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).SetExerciseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_SetExerciseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).SetExerciseOrder(ctx, req.(*SetExerciseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_GroupExerciseInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupExerciseInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).GroupExerciseInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_GroupExerciseInstances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).GroupExerciseInstances(ctx, req.(*GroupExerciseInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_UngroupExerciseInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UngroupExerciseInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).UngroupExerciseInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_UngroupExerciseInstances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).UngroupExerciseInstances(ctx, req.(*UngroupExerciseInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_DuplicateRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateRoutineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).DuplicateRoutine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_DuplicateRoutine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).DuplicateRoutine(ctx, req.(*DuplicateRoutineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_GetRoutineVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoutineVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).GetRoutineVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_GetRoutineVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).GetRoutineVersions(ctx, req.(*GetRoutineVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_GetRoutineVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoutineVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).GetRoutineVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_GetRoutineVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).GetRoutineVersion(ctx, req.(*GetRoutineVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_DiffRoutineVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRoutineVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).DiffRoutineVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_DiffRoutineVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).DiffRoutineVersions(ctx, req.(*DiffRoutineVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_CreateRoutineShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoutineShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).CreateRoutineShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_CreateRoutineShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).CreateRoutineShareLink(ctx, req.(*CreateRoutineShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_GetRoutineShareLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoutineShareLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).GetRoutineShareLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_GetRoutineShareLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).GetRoutineShareLinks(ctx, req.(*GetRoutineShareLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_DeleteRoutineShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoutineShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).DeleteRoutineShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_DeleteRoutineShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).DeleteRoutineShareLink(ctx, req.(*DeleteRoutineShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_GetSharedRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedRoutineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).GetSharedRoutine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_GetSharedRoutine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).GetSharedRoutine(ctx, req.(*GetSharedRoutineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_ImportRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRoutineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).ImportRoutine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_ImportRoutine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).ImportRoutine(ctx, req.(*ImportRoutineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_GetRoutineTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoutineTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).GetRoutineTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_GetRoutineTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).GetRoutineTemplates(ctx, req.(*GetRoutineTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_CloneRoutineTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneRoutineTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).CloneRoutineTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_CloneRoutineTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).CloneRoutineTemplate(ctx, req.(*CloneRoutineTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoutineService_ServiceDesc is the grpc.ServiceDesc for RoutineService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoutineService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_trainer.api.workout.RoutineService",
+	HandlerType: (*RoutineServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRoutines",
+			Handler:    _RoutineService_GetRoutines_Handler,
+		},
+		{
+			MethodName: "CreateRoutine",
+			Handler:    _RoutineService_CreateRoutine_Handler,
+		},
+		{
+			MethodName: "UpdateRoutine",
+			Handler:    _RoutineService_UpdateRoutine_Handler,
+		},
+		{
+			MethodName: "GetRoutineDetail",
+			Handler:    _RoutineService_GetRoutineDetail_Handler,
+		},
+		{
+			MethodName: "DeleteRoutine",
+			Handler:    _RoutineService_DeleteRoutine_Handler,
+		},
+		{
+			MethodName: "AddExerciseToRoutine",
+			Handler:    _RoutineService_AddExerciseToRoutine_Handler,
+		},
+		{
+			MethodName: "GetExerciseInstanceDetails",
+			Handler:    _RoutineService_GetExerciseInstanceDetails_Handler,
+		},
+		{
+			MethodName: "RemoveExerciseInstanceFromRoutine",
+			Handler:    _RoutineService_RemoveExerciseInstanceFromRoutine_Handler,
+		},
+		{
+			MethodName: "AddSetToExerciseInstance",
+			Handler:    _RoutineService_AddSetToExerciseInstance_Handler,
+		},
+		{
+			MethodName: "UpdateSetInExerciseInstance",
+			Handler:    _RoutineService_UpdateSetInExerciseInstance_Handler,
+		},
+		{
+			MethodName: "RemoveSetFromExerciseInstance",
+			Handler:    _RoutineService_RemoveSetFromExerciseInstance_Handler,
+		},
+		{
+			MethodName: "SetSetOrder",
+			Handler:    _RoutineService_SetSetOrder_Handler,
+		},
+		{
+			MethodName: "SetExerciseOrder",
+			Handler:    _RoutineService_SetExerciseOrder_Handler,
+		},
+		{
+			MethodName: "GroupExerciseInstances",
+			Handler:    _RoutineService_GroupExerciseInstances_Handler,
+		},
+		{
+			MethodName: "UngroupExerciseInstances",
+			Handler:    _RoutineService_UngroupExerciseInstances_Handler,
+		},
+		{
+			MethodName: "DuplicateRoutine",
+			Handler:    _RoutineService_DuplicateRoutine_Handler,
+		},
+		{
+			MethodName: "GetRoutineVersions",
+			Handler:    _RoutineService_GetRoutineVersions_Handler,
+		},
+		{
+			MethodName: "GetRoutineVersion",
+			Handler:    _RoutineService_GetRoutineVersion_Handler,
+		},
+		{
+			MethodName: "DiffRoutineVersions",
+			Handler:    _RoutineService_DiffRoutineVersions_Handler,
+		},
+		{
+			MethodName: "CreateRoutineShareLink",
+			Handler:    _RoutineService_CreateRoutineShareLink_Handler,
+		},
+		{
+			MethodName: "GetRoutineShareLinks",
+			Handler:    _RoutineService_GetRoutineShareLinks_Handler,
+		},
+		{
+			MethodName: "DeleteRoutineShareLink",
+			Handler:    _RoutineService_DeleteRoutineShareLink_Handler,
+		},
+		{
+			MethodName: "GetSharedRoutine",
+			Handler:    _RoutineService_GetSharedRoutine_Handler,
+		},
+		{
+			MethodName: "ImportRoutine",
+			Handler:    _RoutineService_ImportRoutine_Handler,
+		},
+		{
+			MethodName: "GetRoutineTemplates",
+			Handler:    _RoutineService_GetRoutineTemplates_Handler,
+		},
+		{
+			MethodName: "CloneRoutineTemplate",
+			Handler:    _RoutineService_CloneRoutineTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "workouts/workouts.proto",
+}
+
+const (
+	WorkoutService_StartWorkout_FullMethodName                = "/fitness_trainer.api.workout.WorkoutService/StartWorkout"
+	WorkoutService_CreatePastWorkout_FullMethodName           = "/fitness_trainer.api.workout.WorkoutService/CreatePastWorkout"
+	WorkoutService_GetWorkout_FullMethodName                  = "/fitness_trainer.api.workout.WorkoutService/GetWorkout"
+	WorkoutService_DeleteWorkout_FullMethodName               = "/fitness_trainer.api.workout.WorkoutService/DeleteWorkout"
+	WorkoutService_GetActiveWorkouts_FullMethodName           = "/fitness_trainer.api.workout.WorkoutService/GetActiveWorkouts"
+	WorkoutService_GetWorkouts_FullMethodName                 = "/fitness_trainer.api.workout.WorkoutService/GetWorkouts"
+	WorkoutService_LogExercise_FullMethodName                 = "/fitness_trainer.api.workout.WorkoutService/LogExercise"
+	WorkoutService_GetExerciseLogDetails_FullMethodName       = "/fitness_trainer.api.workout.WorkoutService/GetExerciseLogDetails"
+	WorkoutService_DeleteExerciseLog_FullMethodName           = "/fitness_trainer.api.workout.WorkoutService/DeleteExerciseLog"
+	WorkoutService_AddPowerRatingToExerciseLog_FullMethodName = "/fitness_trainer.api.workout.WorkoutService/AddPowerRatingToExerciseLog"
+	WorkoutService_AddNotesToExerciseLog_FullMethodName       = "/fitness_trainer.api.workout.WorkoutService/AddNotesToExerciseLog"
+	WorkoutService_SetSetLogOrder_FullMethodName              = "/fitness_trainer.api.workout.WorkoutService/SetSetLogOrder"
+	WorkoutService_SetExerciseLogOrder_FullMethodName         = "/fitness_trainer.api.workout.WorkoutService/SetExerciseLogOrder"
+	WorkoutService_GroupExerciseLogs_FullMethodName           = "/fitness_trainer.api.workout.WorkoutService/GroupExerciseLogs"
+	WorkoutService_UngroupExerciseLogs_FullMethodName         = "/fitness_trainer.api.workout.WorkoutService/UngroupExerciseLogs"
+	WorkoutService_LogSet_FullMethodName                      = "/fitness_trainer.api.workout.WorkoutService/LogSet"
+	WorkoutService_UpdateSetLog_FullMethodName                = "/fitness_trainer.api.workout.WorkoutService/UpdateSetLog"
+	WorkoutService_DeleteSetLog_FullMethodName                = "/fitness_trainer.api.workout.WorkoutService/DeleteSetLog"
+	WorkoutService_CompleteWorkout_FullMethodName             = "/fitness_trainer.api.workout.WorkoutService/CompleteWorkout"
+	WorkoutService_GetWorkoutReport_FullMethodName            = "/fitness_trainer.api.workout.WorkoutService/GetWorkoutReport"
+	WorkoutService_GetWorkoutRestAnalytics_FullMethodName     = "/fitness_trainer.api.workout.WorkoutService/GetWorkoutRestAnalytics"
+	WorkoutService_GetMuscleGroupVolume_FullMethodName        = "/fitness_trainer.api.workout.WorkoutService/GetMuscleGroupVolume"
+	WorkoutService_GetWorkoutCalendar_FullMethodName          = "/fitness_trainer.api.workout.WorkoutService/GetWorkoutCalendar"
+	WorkoutService_GetWorkoutStreak_FullMethodName            = "/fitness_trainer.api.workout.WorkoutService/GetWorkoutStreak"
+	WorkoutService_CalculatePlates_FullMethodName             = "/fitness_trainer.api.workout.WorkoutService/CalculatePlates"
+	WorkoutService_RateWorkout_FullMethodName                 = "/fitness_trainer.api.workout.WorkoutService/RateWorkout"
+	WorkoutService_AddCommentToWorkout_FullMethodName         = "/fitness_trainer.api.workout.WorkoutService/AddCommentToWorkout"
+	WorkoutService_GetWorkoutAmendments_FullMethodName        = "/fitness_trainer.api.workout.WorkoutService/GetWorkoutAmendments"
+	WorkoutService_CreateWorkoutImport_FullMethodName         = "/fitness_trainer.api.workout.WorkoutService/CreateWorkoutImport"
+	WorkoutService_GetWorkoutImports_FullMethodName           = "/fitness_trainer.api.workout.WorkoutService/GetWorkoutImports"
+	WorkoutService_GetWorkoutImport_FullMethodName            = "/fitness_trainer.api.workout.WorkoutService/GetWorkoutImport"
+	WorkoutService_ResolveWorkoutImport_FullMethodName        = "/fitness_trainer.api.workout.WorkoutService/ResolveWorkoutImport"
+)
+
+// WorkoutServiceClient is the client API for WorkoutService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WorkoutServiceClient interface {
+	// Метод для начала новой тренировки
+	StartWorkout(ctx context.Context, in *StartWorkoutRequest, opts ...grpc.CallOption) (*WorkoutResponse, error)
+	// Метод для записи прошедшей тренировки с явным временем начала и окончания,
+	// упражнениями и подходами. Тренировка не должна пересекаться с другими тренировками
+	CreatePastWorkout(ctx context.Context, in *CreatePastWorkoutRequest, opts ...grpc.CallOption) (*GetWorkoutResponse, error)
+	// Метод для получения состояния тренировки
+	GetWorkout(ctx context.Context, in *GetWorkoutRequest, opts ...grpc.CallOption) (*GetWorkoutResponse, error)
+	// Удалить тренировку
+	DeleteWorkout(ctx context.Context, in *DeleteWorkoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для получения списка активных тренировок
+	GetActiveWorkouts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WorkoutsListResponse, error)
+	// Метод для получения списка всех тренировок
+	GetWorkouts(ctx context.Context, in *GetWorkoutsRequest, opts ...grpc.CallOption) (*GetWorkoutsResponse, error)
+	// Метод для создания записи о выполнении упражнения
+	LogExercise(ctx context.Context, in *LogExerciseRequest, opts ...grpc.CallOption) (*ExerciseLog, error)
+	// Метод для получения записи о выполнении упражнения
+	GetExerciseLogDetails(ctx context.Context, in *GetExerciseLogDetailRequest, opts ...grpc.CallOption) (*ExerciseLogResponse, error)
+	// Удалить запись о выполнении упражнения
+	DeleteExerciseLog(ctx context.Context, in *DeleteExerciseLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Добавить оценку усилий при выполнении упражнения
+	AddPowerRatingToExerciseLog(ctx context.Context, in *AddPowerRatingToExerciseLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Добавление заметки к выполнению упражнения
+	AddNotesToExerciseLog(ctx context.Context, in *AddNotesToExerciseLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для установки порядка подходов упражнения в тренировке
+	SetSetLogOrder(ctx context.Context, in *SetSetLogOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для установки порядка упражнений в тренировке
+	SetExerciseLogOrder(ctx context.Context, in *SetExerciseLogOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для объединения упражнений тренировки в суперсет, гигантский сет или круг
+	GroupExerciseLogs(ctx context.Context, in *GroupExerciseLogsRequest, opts ...grpc.CallOption) (*GroupExerciseLogsResponse, error)
+	// Метод для расформирования группы упражнений тренировки
+	UngroupExerciseLogs(ctx context.Context, in *UngroupExerciseLogsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для создания записи о выполнении подхода
+	LogSet(ctx context.Context, in *LogSetRequest, opts ...grpc.CallOption) (*SetLogResponse, error)
+	// Метод для изменения записи о выполнении подхода
+	UpdateSetLog(ctx context.Context, in *UpdateSetLogRequest, opts ...grpc.CallOption) (*SetLogResponse, error)
+	// Удалить запись о выполнении подхода
+	DeleteSetLog(ctx context.Context, in *DeleteSetLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для завершения тренировки
+	CompleteWorkout(ctx context.Context, in *CompleteWorkoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для получения отчета о тренировке
+	GetWorkoutReport(ctx context.Context, in *GetWorkoutReportRequest, opts ...grpc.CallOption) (*WorkoutReportResponse, error)
+	// Метод для получения фактического и планируемого отдыха между подходами тренировки
+	GetWorkoutRestAnalytics(ctx context.Context, in *GetWorkoutRestAnalyticsRequest, opts ...grpc.CallOption) (*GetWorkoutRestAnalyticsResponse, error)
+	// Метод для получения объема нагрузки по группам мышц
+	GetMuscleGroupVolume(ctx context.Context, in *GetMuscleGroupVolumeRequest, opts ...grpc.CallOption) (*GetMuscleGroupVolumeResponse, error)
+	// Метод для получения календаря тренировок по дням в часовом поясе пользователя
+	GetWorkoutCalendar(ctx context.Context, in *GetWorkoutCalendarRequest, opts ...grpc.CallOption) (*GetWorkoutCalendarResponse, error)
+	// Метод для получения серий тренировок по дням и неделям
+	GetWorkoutStreak(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetWorkoutStreakResponse, error)
+	// Метод для расчета дисков, которые нужно повесить на штангу для целевого веса
+	CalculatePlates(ctx context.Context, in *CalculatePlatesRequest, opts ...grpc.CallOption) (*PlateLoadout, error)
+	// Метод для установки оценки тренировки
+	RateWorkout(ctx context.Context, in *RateWorkoutRequest, opts ...grpc.CallOption) (*WorkoutResponse, error)
+	// Метод для добавления комментария к тренировке
+	AddCommentToWorkout(ctx context.Context, in *AddCommentToWorkoutRequest, opts ...grpc.CallOption) (*WorkoutResponse, error)
+	// Метод для получения истории правок завершённой тренировки
+	GetWorkoutAmendments(ctx context.Context, in *GetWorkoutAmendmentsRequest, opts ...grpc.CallOption) (*GetWorkoutAmendmentsResponse, error)
+	// Метод для импорта истории тренировок из CSV-выгрузки Strong, Hevy или FitNotes.
+	// Названия упражнений сопоставляются с каталогом, импорт ждёт проверки
+	CreateWorkoutImport(ctx context.Context, in *CreateWorkoutImportRequest, opts ...grpc.CallOption) (*WorkoutImportResponse, error)
+	// Метод для получения списка импортов истории тренировок
+	GetWorkoutImports(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetWorkoutImportsResponse, error)
+	// Метод для получения импорта истории тренировок
+	GetWorkoutImport(ctx context.Context, in *GetWorkoutImportRequest, opts ...grpc.CallOption) (*WorkoutImportResponse, error)
+	// Метод для сопоставления несопоставленных упражнений импорта с каталогом или их пропуска.
+	// С confirm импорт запускается, когда все упражнения сопоставлены или пропущены
+	ResolveWorkoutImport(ctx context.Context, in *ResolveWorkoutImportRequest, opts ...grpc.CallOption) (*WorkoutImportResponse, error)
+}
+
+type workoutServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkoutServiceClient(cc grpc.ClientConnInterface) WorkoutServiceClient {
+	return &workoutServiceClient{cc}
+}
+
+func (c *workoutServiceClient) StartWorkout(ctx context.Context, in *StartWorkoutRequest, opts ...grpc.CallOption) (*WorkoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkoutResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_StartWorkout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) CreatePastWorkout(ctx context.Context, in *CreatePastWorkoutRequest, opts ...grpc.CallOption) (*GetWorkoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkoutResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_CreatePastWorkout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetWorkout(ctx context.Context, in *GetWorkoutRequest, opts ...grpc.CallOption) (*GetWorkoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkoutResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetWorkout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) DeleteWorkout(ctx context.Context, in *DeleteWorkoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkoutService_DeleteWorkout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetActiveWorkouts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WorkoutsListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkoutsListResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetActiveWorkouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetWorkouts(ctx context.Context, in *GetWorkoutsRequest, opts ...grpc.CallOption) (*GetWorkoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkoutsResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetWorkouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) LogExercise(ctx context.Context, in *LogExerciseRequest, opts ...grpc.CallOption) (*ExerciseLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseLog)
+	err := c.cc.Invoke(ctx, WorkoutService_LogExercise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetExerciseLogDetails(ctx context.Context, in *GetExerciseLogDetailRequest, opts ...grpc.CallOption) (*ExerciseLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseLogResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetExerciseLogDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) DeleteExerciseLog(ctx context.Context, in *DeleteExerciseLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkoutService_DeleteExerciseLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) AddPowerRatingToExerciseLog(ctx context.Context, in *AddPowerRatingToExerciseLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkoutService_AddPowerRatingToExerciseLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) AddNotesToExerciseLog(ctx context.Context, in *AddNotesToExerciseLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkoutService_AddNotesToExerciseLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) SetSetLogOrder(ctx context.Context, in *SetSetLogOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkoutService_SetSetLogOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) SetExerciseLogOrder(ctx context.Context, in *SetExerciseLogOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkoutService_SetExerciseLogOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GroupExerciseLogs(ctx context.Context, in *GroupExerciseLogsRequest, opts ...grpc.CallOption) (*GroupExerciseLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupExerciseLogsResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GroupExerciseLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) UngroupExerciseLogs(ctx context.Context, in *UngroupExerciseLogsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkoutService_UngroupExerciseLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) LogSet(ctx context.Context, in *LogSetRequest, opts ...grpc.CallOption) (*SetLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLogResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_LogSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) UpdateSetLog(ctx context.Context, in *UpdateSetLogRequest, opts ...grpc.CallOption) (*SetLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLogResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_UpdateSetLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) DeleteSetLog(ctx context.Context, in *DeleteSetLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkoutService_DeleteSetLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) CompleteWorkout(ctx context.Context, in *CompleteWorkoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkoutService_CompleteWorkout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetWorkoutReport(ctx context.Context, in *GetWorkoutReportRequest, opts ...grpc.CallOption) (*WorkoutReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkoutReportResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetWorkoutReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetWorkoutRestAnalytics(ctx context.Context, in *GetWorkoutRestAnalyticsRequest, opts ...grpc.CallOption) (*GetWorkoutRestAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkoutRestAnalyticsResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetWorkoutRestAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetMuscleGroupVolume(ctx context.Context, in *GetMuscleGroupVolumeRequest, opts ...grpc.CallOption) (*GetMuscleGroupVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMuscleGroupVolumeResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetMuscleGroupVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetWorkoutCalendar(ctx context.Context, in *GetWorkoutCalendarRequest, opts ...grpc.CallOption) (*GetWorkoutCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkoutCalendarResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetWorkoutCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetWorkoutStreak(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetWorkoutStreakResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkoutStreakResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetWorkoutStreak_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) CalculatePlates(ctx context.Context, in *CalculatePlatesRequest, opts ...grpc.CallOption) (*PlateLoadout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlateLoadout)
+	err := c.cc.Invoke(ctx, WorkoutService_CalculatePlates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) RateWorkout(ctx context.Context, in *RateWorkoutRequest, opts ...grpc.CallOption) (*WorkoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkoutResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_RateWorkout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) AddCommentToWorkout(ctx context.Context, in *AddCommentToWorkoutRequest, opts ...grpc.CallOption) (*WorkoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkoutResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_AddCommentToWorkout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetWorkoutAmendments(ctx context.Context, in *GetWorkoutAmendmentsRequest, opts ...grpc.CallOption) (*GetWorkoutAmendmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkoutAmendmentsResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetWorkoutAmendments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) CreateWorkoutImport(ctx context.Context, in *CreateWorkoutImportRequest, opts ...grpc.CallOption) (*WorkoutImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkoutImportResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_CreateWorkoutImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetWorkoutImports(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetWorkoutImportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkoutImportsResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetWorkoutImports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetWorkoutImport(ctx context.Context, in *GetWorkoutImportRequest, opts ...grpc.CallOption) (*WorkoutImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkoutImportResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetWorkoutImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) ResolveWorkoutImport(ctx context.Context, in *ResolveWorkoutImportRequest, opts ...grpc.CallOption) (*WorkoutImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkoutImportResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_ResolveWorkoutImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkoutServiceServer is the server API for WorkoutService service.
+// All implementations must embed UnimplementedWorkoutServiceServer
+// for forward compatibility.
+type WorkoutServiceServer interface {
+	// Метод для начала новой тренировки
+	StartWorkout(context.Context, *StartWorkoutRequest) (*WorkoutResponse, error)
+	// Метод для записи прошедшей тренировки с явным временем начала и окончания,
+	// упражнениями и подходами. Тренировка не должна пересекаться с другими тренировками
+	CreatePastWorkout(context.Context, *CreatePastWorkoutRequest) (*GetWorkoutResponse, error)
+	// Метод для получения состояния тренировки
+	GetWorkout(context.Context, *GetWorkoutRequest) (*GetWorkoutResponse, error)
+	// Удалить тренировку
+	DeleteWorkout(context.Context, *DeleteWorkoutRequest) (*emptypb.Empty, error)
+	// Метод для получения списка активных тренировок
+	GetActiveWorkouts(context.Context, *emptypb.Empty) (*WorkoutsListResponse, error)
+	// Метод для получения списка всех тренировок
+	GetWorkouts(context.Context, *GetWorkoutsRequest) (*GetWorkoutsResponse, error)
+	// Метод для создания записи о выполнении упражнения
+	LogExercise(context.Context, *LogExerciseRequest) (*ExerciseLog, error)
+	// Метод для получения записи о выполнении упражнения
+	GetExerciseLogDetails(context.Context, *GetExerciseLogDetailRequest) (*ExerciseLogResponse, error)
+	// Удалить запись о выполнении упражнения
+	DeleteExerciseLog(context.Context, *DeleteExerciseLogRequest) (*emptypb.Empty, error)
+	// Добавить оценку усилий при выполнении упражнения
+	AddPowerRatingToExerciseLog(context.Context, *AddPowerRatingToExerciseLogRequest) (*emptypb.Empty, error)
+	// Добавление заметки к выполнению упражнения
+	AddNotesToExerciseLog(context.Context, *AddNotesToExerciseLogRequest) (*emptypb.Empty, error)
+	// Метод для установки порядка подходов упражнения в тренировке
+	SetSetLogOrder(context.Context, *SetSetLogOrderRequest) (*emptypb.Empty, error)
+	// Метод для установки порядка упражнений в тренировке
+	SetExerciseLogOrder(context.Context, *SetExerciseLogOrderRequest) (*emptypb.Empty, error)
+	// Метод для объединения упражнений тренировки в суперсет, гигантский сет или круг
+	GroupExerciseLogs(context.Context, *GroupExerciseLogsRequest) (*GroupExerciseLogsResponse, error)
+	// Метод для расформирования группы упражнений тренировки
+	UngroupExerciseLogs(context.Context, *UngroupExerciseLogsRequest) (*emptypb.Empty, error)
+	// Метод для создания записи о выполнении подхода
+	LogSet(context.Context, *LogSetRequest) (*SetLogResponse, error)
+	// Метод для изменения записи о выполнении подхода
+	UpdateSetLog(context.Context, *UpdateSetLogRequest) (*SetLogResponse, error)
+	// Удалить запись о выполнении подхода
+	DeleteSetLog(context.Context, *DeleteSetLogRequest) (*emptypb.Empty, error)
+	// Метод для завершения тренировки
+	CompleteWorkout(context.Context, *CompleteWorkoutRequest) (*emptypb.Empty, error)
+	// Метод для получения отчета о тренировке
+	GetWorkoutReport(context.Context, *GetWorkoutReportRequest) (*WorkoutReportResponse, error)
+	// Метод для получения фактического и планируемого отдыха между подходами тренировки
+	GetWorkoutRestAnalytics(context.Context, *GetWorkoutRestAnalyticsRequest) (*GetWorkoutRestAnalyticsResponse, error)
+	// Метод для получения объема нагрузки по группам мышц
+	GetMuscleGroupVolume(context.Context, *GetMuscleGroupVolumeRequest) (*GetMuscleGroupVolumeResponse, error)
+	// Метод для получения календаря тренировок по дням в часовом поясе пользователя
+	GetWorkoutCalendar(context.Context, *GetWorkoutCalendarRequest) (*GetWorkoutCalendarResponse, error)
+	// Метод для получения серий тренировок по дням и неделям
+	GetWorkoutStreak(context.Context, *emptypb.Empty) (*GetWorkoutStreakResponse, error)
+	// Метод для расчета дисков, которые нужно повесить на штангу для целевого веса
+	CalculatePlates(context.Context, *CalculatePlatesRequest) (*PlateLoadout, error)
+	// Метод для установки оценки тренировки
+	RateWorkout(context.Context, *RateWorkoutRequest) (*WorkoutResponse, error)
+	// Метод для добавления комментария к тренировке
+	AddCommentToWorkout(context.Context, *AddCommentToWorkoutRequest) (*WorkoutResponse, error)
+	// Метод для получения истории правок завершённой тренировки
+	GetWorkoutAmendments(context.Context, *GetWorkoutAmendmentsRequest) (*GetWorkoutAmendmentsResponse, error)
+	// Метод для импорта истории тренировок из CSV-выгрузки Strong, Hevy или FitNotes.
+	// Названия упражнений сопоставляются с каталогом, импорт ждёт проверки
+	CreateWorkoutImport(context.Context, *CreateWorkoutImportRequest) (*WorkoutImportResponse, error)
+	// Метод для получения списка импортов истории тренировок
+	GetWorkoutImports(context.Context, *emptypb.Empty) (*GetWorkoutImportsResponse, error)
+	// Метод для получения импорта истории тренировок
+	GetWorkoutImport(context.Context, *GetWorkoutImportRequest) (*WorkoutImportResponse, error)
+	// Метод для сопоставления несопоставленных упражнений импорта с каталогом или их пропуска.
+	// С confirm импорт запускается, когда все упражнения сопоставлены или пропущены
+	ResolveWorkoutImport(context.Context, *ResolveWorkoutImportRequest) (*WorkoutImportResponse, error)
+	mustEmbedUnimplementedWorkoutServiceServer()
+}
+
+// UnimplementedWorkoutServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWorkoutServiceServer struct{}
+
+func (UnimplementedWorkoutServiceServer) StartWorkout(context.Context, *StartWorkoutRequest) (*WorkoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartWorkout not implemented")
+}
+func (UnimplementedWorkoutServiceServer) CreatePastWorkout(context.Context, *CreatePastWorkoutRequest) (*GetWorkoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePastWorkout not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetWorkout(context.Context, *GetWorkoutRequest) (*GetWorkoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkout not implemented")
+}
+func (UnimplementedWorkoutServiceServer) DeleteWorkout(context.Context, *DeleteWorkoutRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkout not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetActiveWorkouts(context.Context, *emptypb.Empty) (*WorkoutsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveWorkouts not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetWorkouts(context.Context, *GetWorkoutsRequest) (*GetWorkoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkouts not implemented")
+}
+func (UnimplementedWorkoutServiceServer) LogExercise(context.Context, *LogExerciseRequest) (*ExerciseLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogExercise not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetExerciseLogDetails(context.Context, *GetExerciseLogDetailRequest) (*ExerciseLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExerciseLogDetails not implemented")
+}
+func (UnimplementedWorkoutServiceServer) DeleteExerciseLog(context.Context, *DeleteExerciseLogRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExerciseLog not implemented")
+}
+func (UnimplementedWorkoutServiceServer) AddPowerRatingToExerciseLog(context.Context, *AddPowerRatingToExerciseLogRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPowerRatingToExerciseLog not implemented")
+}
+func (UnimplementedWorkoutServiceServer) AddNotesToExerciseLog(context.Context, *AddNotesToExerciseLogRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNotesToExerciseLog not implemented")
+}
+func (UnimplementedWorkoutServiceServer) SetSetLogOrder(context.Context, *SetSetLogOrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSetLogOrder not implemented")
+}
+func (UnimplementedWorkoutServiceServer) SetExerciseLogOrder(context.Context, *SetExerciseLogOrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExerciseLogOrder not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GroupExerciseLogs(context.Context, *GroupExerciseLogsRequest) (*GroupExerciseLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupExerciseLogs not implemented")
+}
+func (UnimplementedWorkoutServiceServer) UngroupExerciseLogs(context.Context, *UngroupExerciseLogsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UngroupExerciseLogs not implemented")
+}
+func (UnimplementedWorkoutServiceServer) LogSet(context.Context, *LogSetRequest) (*SetLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogSet not implemented")
+}
+func (UnimplementedWorkoutServiceServer) UpdateSetLog(context.Context, *UpdateSetLogRequest) (*SetLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSetLog not implemented")
+}
+func (UnimplementedWorkoutServiceServer) DeleteSetLog(context.Context, *DeleteSetLogRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSetLog not implemented")
+}
+func (UnimplementedWorkoutServiceServer) CompleteWorkout(context.Context, *CompleteWorkoutRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteWorkout not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetWorkoutReport(context.Context, *GetWorkoutReportRequest) (*WorkoutReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkoutReport not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetWorkoutRestAnalytics(context.Context, *GetWorkoutRestAnalyticsRequest) (*GetWorkoutRestAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkoutRestAnalytics not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetMuscleGroupVolume(context.Context, *GetMuscleGroupVolumeRequest) (*GetMuscleGroupVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMuscleGroupVolume not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetWorkoutCalendar(context.Context, *GetWorkoutCalendarRequest) (*GetWorkoutCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkoutCalendar not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetWorkoutStreak(context.Context, *emptypb.Empty) (*GetWorkoutStreakResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkoutStreak not implemented")
+}
+func (UnimplementedWorkoutServiceServer) CalculatePlates(context.Context, *CalculatePlatesRequest) (*PlateLoadout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculatePlates not implemented")
+}
+func (UnimplementedWorkoutServiceServer) RateWorkout(context.Context, *RateWorkoutRequest) (*WorkoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateWorkout not implemented")
+}
+func (UnimplementedWorkoutServiceServer) AddCommentToWorkout(context.Context, *AddCommentToWorkoutRequest) (*WorkoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCommentToWorkout not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetWorkoutAmendments(context.Context, *GetWorkoutAmendmentsRequest) (*GetWorkoutAmendmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkoutAmendments not implemented")
+}
+func (UnimplementedWorkoutServiceServer) CreateWorkoutImport(context.Context, *CreateWorkoutImportRequest) (*WorkoutImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkoutImport not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetWorkoutImports(context.Context, *emptypb.Empty) (*GetWorkoutImportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkoutImports not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetWorkoutImport(context.Context, *GetWorkoutImportRequest) (*WorkoutImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkoutImport not implemented")
+}
+func (UnimplementedWorkoutServiceServer) ResolveWorkoutImport(context.Context, *ResolveWorkoutImportRequest) (*WorkoutImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveWorkoutImport not implemented")
+}
+func (UnimplementedWorkoutServiceServer) mustEmbedUnimplementedWorkoutServiceServer() {}
+func (UnimplementedWorkoutServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeWorkoutServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkoutServiceServer will
+// result in compilation errors.
+type UnsafeWorkoutServiceServer interface {
+	mustEmbedUnimplementedWorkoutServiceServer()
+}
+
+func RegisterWorkoutServiceServer(s grpc.ServiceRegistrar, srv WorkoutServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWorkoutServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WorkoutService_ServiceDesc, srv)
+}
+
+func _WorkoutService_StartWorkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartWorkoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).StartWorkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_StartWorkout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).StartWorkout(ctx, req.(*StartWorkoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_CreatePastWorkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePastWorkoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).CreatePastWorkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_CreatePastWorkout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).CreatePastWorkout(ctx, req.(*CreatePastWorkoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetWorkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetWorkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetWorkout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetWorkout(ctx, req.(*GetWorkoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_DeleteWorkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).DeleteWorkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_DeleteWorkout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).DeleteWorkout(ctx, req.(*DeleteWorkoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetActiveWorkouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetActiveWorkouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetActiveWorkouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetActiveWorkouts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetWorkouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetWorkouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetWorkouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetWorkouts(ctx, req.(*GetWorkoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_LogExercise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogExerciseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).LogExercise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_LogExercise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).LogExercise(ctx, req.(*LogExerciseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetExerciseLogDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExerciseLogDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetExerciseLogDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetExerciseLogDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetExerciseLogDetails(ctx, req.(*GetExerciseLogDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_DeleteExerciseLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExerciseLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).DeleteExerciseLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_DeleteExerciseLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).DeleteExerciseLog(ctx, req.(*DeleteExerciseLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_AddPowerRatingToExerciseLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPowerRatingToExerciseLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).AddPowerRatingToExerciseLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_AddPowerRatingToExerciseLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).AddPowerRatingToExerciseLog(ctx, req.(*AddPowerRatingToExerciseLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_AddNotesToExerciseLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNotesToExerciseLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).AddNotesToExerciseLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_AddNotesToExerciseLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).AddNotesToExerciseLog(ctx, req.(*AddNotesToExerciseLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_SetSetLogOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSetLogOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).SetSetLogOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_SetSetLogOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).SetSetLogOrder(ctx, req.(*SetSetLogOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_SetExerciseLogOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExerciseLogOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).SetExerciseLogOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_SetExerciseLogOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).SetExerciseLogOrder(ctx, req.(*SetExerciseLogOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GroupExerciseLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupExerciseLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GroupExerciseLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GroupExerciseLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GroupExerciseLogs(ctx, req.(*GroupExerciseLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_UngroupExerciseLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UngroupExerciseLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).UngroupExerciseLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_UngroupExerciseLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).UngroupExerciseLogs(ctx, req.(*UngroupExerciseLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_LogSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).LogSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_LogSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).LogSet(ctx, req.(*LogSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_UpdateSetLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSetLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).UpdateSetLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_UpdateSetLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).UpdateSetLog(ctx, req.(*UpdateSetLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_DeleteSetLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSetLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).DeleteSetLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_DeleteSetLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).DeleteSetLog(ctx, req.(*DeleteSetLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_CompleteWorkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteWorkoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).CompleteWorkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_CompleteWorkout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).CompleteWorkout(ctx, req.(*CompleteWorkoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetWorkoutReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkoutReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetWorkoutReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetWorkoutReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetWorkoutReport(ctx, req.(*GetWorkoutReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetWorkoutRestAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkoutRestAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetWorkoutRestAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetWorkoutRestAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetWorkoutRestAnalytics(ctx, req.(*GetWorkoutRestAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetMuscleGroupVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMuscleGroupVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetMuscleGroupVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetMuscleGroupVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetMuscleGroupVolume(ctx, req.(*GetMuscleGroupVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetWorkoutCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkoutCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetWorkoutCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetWorkoutCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetWorkoutCalendar(ctx, req.(*GetWorkoutCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetWorkoutStreak_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetWorkoutStreak(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetWorkoutStreak_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetWorkoutStreak(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_CalculatePlates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculatePlatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).CalculatePlates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_CalculatePlates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).CalculatePlates(ctx, req.(*CalculatePlatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_RateWorkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateWorkoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).RateWorkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_RateWorkout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).RateWorkout(ctx, req.(*RateWorkoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_AddCommentToWorkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentToWorkoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).AddCommentToWorkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_AddCommentToWorkout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).AddCommentToWorkout(ctx, req.(*AddCommentToWorkoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetWorkoutAmendments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkoutAmendmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetWorkoutAmendments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetWorkoutAmendments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetWorkoutAmendments(ctx, req.(*GetWorkoutAmendmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_CreateWorkoutImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkoutImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).CreateWorkoutImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_CreateWorkoutImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).CreateWorkoutImport(ctx, req.(*CreateWorkoutImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetWorkoutImports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetWorkoutImports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetWorkoutImports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetWorkoutImports(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetWorkoutImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkoutImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetWorkoutImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetWorkoutImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetWorkoutImport(ctx, req.(*GetWorkoutImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_ResolveWorkoutImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveWorkoutImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).ResolveWorkoutImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_ResolveWorkoutImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).ResolveWorkoutImport(ctx, req.(*ResolveWorkoutImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkoutService_ServiceDesc is the grpc.ServiceDesc for WorkoutService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkoutService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_trainer.api.workout.WorkoutService",
+	HandlerType: (*WorkoutServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartWorkout",
+			Handler:    _WorkoutService_StartWorkout_Handler,
+		},
+		{
+			MethodName: "CreatePastWorkout",
+			Handler:    _WorkoutService_CreatePastWorkout_Handler,
+		},
+		{
+			MethodName: "GetWorkout",
+			Handler:    _WorkoutService_GetWorkout_Handler,
+		},
+		{
+			MethodName: "DeleteWorkout",
+			Handler:    _WorkoutService_DeleteWorkout_Handler,
+		},
+		{
+			MethodName: "GetActiveWorkouts",
+			Handler:    _WorkoutService_GetActiveWorkouts_Handler,
+		},
+		{
+			MethodName: "GetWorkouts",
+			Handler:    _WorkoutService_GetWorkouts_Handler,
+		},
+		{
+			MethodName: "LogExercise",
+			Handler:    _WorkoutService_LogExercise_Handler,
+		},
+		{
+			MethodName: "GetExerciseLogDetails",
+			Handler:    _WorkoutService_GetExerciseLogDetails_Handler,
+		},
+		{
+			MethodName: "DeleteExerciseLog",
+			Handler:    _WorkoutService_DeleteExerciseLog_Handler,
+		},
+		{
+			MethodName: "AddPowerRatingToExerciseLog",
+			Handler:    _WorkoutService_AddPowerRatingToExerciseLog_Handler,
+		},
+		{
+			MethodName: "AddNotesToExerciseLog",
+			Handler:    _WorkoutService_AddNotesToExerciseLog_Handler,
+		},
+		{
+			MethodName: "SetSetLogOrder",
+			Handler:    _WorkoutService_SetSetLogOrder_Handler,
+		},
+		{
+			MethodName: "SetExerciseLogOrder",
+			Handler:    _WorkoutService_SetExerciseLogOrder_Handler,
+		},
+		{
+			MethodName: "GroupExerciseLogs",
+			Handler:    _WorkoutService_GroupExerciseLogs_Handler,
+		},
+		{
+			MethodName: "UngroupExerciseLogs",
+			Handler:    _WorkoutService_UngroupExerciseLogs_Handler,
+		},
+		{
+			MethodName: "LogSet",
+			Handler:    _WorkoutService_LogSet_Handler,
+		},
+		{
+			MethodName: "UpdateSetLog",
+			Handler:    _WorkoutService_UpdateSetLog_Handler,
+		},
+		{
+			MethodName: "DeleteSetLog",
+			Handler:    _WorkoutService_DeleteSetLog_Handler,
+		},
+		{
+			MethodName: "CompleteWorkout",
+			Handler:    _WorkoutService_CompleteWorkout_Handler,
+		},
+		{
+			MethodName: "GetWorkoutReport",
+			Handler:    _WorkoutService_GetWorkoutReport_Handler,
+		},
+		{
+			MethodName: "GetWorkoutRestAnalytics",
+			Handler:    _WorkoutService_GetWorkoutRestAnalytics_Handler,
+		},
+		{
+			MethodName: "GetMuscleGroupVolume",
+			Handler:    _WorkoutService_GetMuscleGroupVolume_Handler,
+		},
+		{
+			MethodName: "GetWorkoutCalendar",
+			Handler:    _WorkoutService_GetWorkoutCalendar_Handler,
+		},
+		{
+			MethodName: "GetWorkoutStreak",
+			Handler:    _WorkoutService_GetWorkoutStreak_Handler,
+		},
+		{
+			MethodName: "CalculatePlates",
+			Handler:    _WorkoutService_CalculatePlates_Handler,
+		},
+		{
+			MethodName: "RateWorkout",
+			Handler:    _WorkoutService_RateWorkout_Handler,
+		},
+		{
+			MethodName: "AddCommentToWorkout",
+			Handler:    _WorkoutService_AddCommentToWorkout_Handler,
+		},
+		{
+			MethodName: "GetWorkoutAmendments",
+			Handler:    _WorkoutService_GetWorkoutAmendments_Handler,
+		},
+		{
+			MethodName: "CreateWorkoutImport",
+			Handler:    _WorkoutService_CreateWorkoutImport_Handler,
+		},
+		{
+			MethodName: "GetWorkoutImports",
+			Handler:    _WorkoutService_GetWorkoutImports_Handler,
+		},
+		{
+			MethodName: "GetWorkoutImport",
+			Handler:    _WorkoutService_GetWorkoutImport_Handler,
+		},
+		{
+			MethodName: "ResolveWorkoutImport",
+			Handler:    _WorkoutService_ResolveWorkoutImport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "workouts/workouts.proto",
+}
+
+const (
+	UserService_CreateUser_FullMethodName                      = "/fitness_trainer.api.workout.UserService/CreateUser"
+	UserService_GetUser_FullMethodName                         = "/fitness_trainer.api.workout.UserService/GetUser"
+	UserService_GetMe_FullMethodName                           = "/fitness_trainer.api.workout.UserService/GetMe"
+	UserService_UpdateUser_FullMethodName                      = "/fitness_trainer.api.workout.UserService/UpdateUser"
+	UserService_UpdateWorkoutGenerationSettings_FullMethodName = "/fitness_trainer.api.workout.UserService/UpdateWorkoutGenerationSettings"
+	UserService_GetWorkoutGenerationSettings_FullMethodName    = "/fitness_trainer.api.workout.UserService/GetWorkoutGenerationSettings"
+	UserService_CreateMeasurement_FullMethodName               = "/fitness_trainer.api.workout.UserService/CreateMeasurement"
+	UserService_GetMeasurements_FullMethodName                 = "/fitness_trainer.api.workout.UserService/GetMeasurements"
+	UserService_UpdateMeasurement_FullMethodName               = "/fitness_trainer.api.workout.UserService/UpdateMeasurement"
+	UserService_DeleteMeasurement_FullMethodName               = "/fitness_trainer.api.workout.UserService/DeleteMeasurement"
+	UserService_GetMeasurementTrend_FullMethodName             = "/fitness_trainer.api.workout.UserService/GetMeasurementTrend"
+	UserService_CreateProgressPhoto_FullMethodName             = "/fitness_trainer.api.workout.UserService/CreateProgressPhoto"
+	UserService_GetProgressPhotos_FullMethodName               = "/fitness_trainer.api.workout.UserService/GetProgressPhotos"
+	UserService_UpdateProgressPhoto_FullMethodName             = "/fitness_trainer.api.workout.UserService/UpdateProgressPhoto"
+	UserService_DeleteProgressPhoto_FullMethodName             = "/fitness_trainer.api.workout.UserService/DeleteProgressPhoto"
+	UserService_CompareProgressPhotos_FullMethodName           = "/fitness_trainer.api.workout.UserService/CompareProgressPhotos"
+	UserService_CreateGymProfile_FullMethodName                = "/fitness_trainer.api.workout.UserService/CreateGymProfile"
+	UserService_GetGymProfiles_FullMethodName                  = "/fitness_trainer.api.workout.UserService/GetGymProfiles"
+	UserService_UpdateGymProfile_FullMethodName                = "/fitness_trainer.api.workout.UserService/UpdateGymProfile"
+	UserService_DeleteGymProfile_FullMethodName                = "/fitness_trainer.api.workout.UserService/DeleteGymProfile"
+	UserService_GetUserPreferences_FullMethodName              = "/fitness_trainer.api.workout.UserService/GetUserPreferences"
+	UserService_UpdateUserPreferences_FullMethodName           = "/fitness_trainer.api.workout.UserService/UpdateUserPreferences"
+	UserService_RequestDataExport_FullMethodName               = "/fitness_trainer.api.workout.UserService/RequestDataExport"
+	UserService_GetDataExports_FullMethodName                  = "/fitness_trainer.api.workout.UserService/GetDataExports"
+	UserService_GetDataExport_FullMethodName                   = "/fitness_trainer.api.workout.UserService/GetDataExport"
+)
+
+// UserServiceClient is the client API for UserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserServiceClient interface {
+	// Метод для создания пользователя
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	// Метод для получения пользователя по ID
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	// Метод для получения текущего пользователя
+	GetMe(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserResponse, error)
+	// Метод для обновления данных пользователя
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	// Метод для обновления настроек генерации тренировок
+	UpdateWorkoutGenerationSettings(ctx context.Context, in *UpdateWorkoutGenerationSettingsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для получения настроек генерации тренировок
+	GetWorkoutGenerationSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WorkoutGenerationSettingsResponse, error)
+	// Метод для добавления замера тела
+	CreateMeasurement(ctx context.Context, in *CreateMeasurementRequest, opts ...grpc.CallOption) (*MeasurementResponse, error)
+	// Метод для получения истории замеров тела
+	GetMeasurements(ctx context.Context, in *GetMeasurementsRequest, opts ...grpc.CallOption) (*GetMeasurementsResponse, error)
+	// Метод для изменения замера тела
+	UpdateMeasurement(ctx context.Context, in *UpdateMeasurementRequest, opts ...grpc.CallOption) (*MeasurementResponse, error)
+	// Метод для удаления замера тела
+	DeleteMeasurement(ctx context.Context, in *DeleteMeasurementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для получения динамики замеров со скользящим средним
+	GetMeasurementTrend(ctx context.Context, in *GetMeasurementTrendRequest, opts ...grpc.CallOption) (*GetMeasurementTrendResponse, error)
+	// Метод для добавления фото прогресса из подтвержденной загрузки
+	CreateProgressPhoto(ctx context.Context, in *CreateProgressPhotoRequest, opts ...grpc.CallOption) (*ProgressPhotoResponse, error)
+	// Метод для получения ленты фото прогресса
+	GetProgressPhotos(ctx context.Context, in *GetProgressPhotosRequest, opts ...grpc.CallOption) (*GetProgressPhotosResponse, error)
+	// Метод для изменения фото прогресса
+	UpdateProgressPhoto(ctx context.Context, in *UpdateProgressPhotoRequest, opts ...grpc.CallOption) (*ProgressPhotoResponse, error)
+	// Метод для удаления фото прогресса
+	DeleteProgressPhoto(ctx context.Context, in *DeleteProgressPhotoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для сравнения фото прогресса на две даты
+	CompareProgressPhotos(ctx context.Context, in *CompareProgressPhotosRequest, opts ...grpc.CallOption) (*CompareProgressPhotosResponse, error)
+	// Метод для добавления профиля зала с грифом и набором дисков
+	CreateGymProfile(ctx context.Context, in *CreateGymProfileRequest, opts ...grpc.CallOption) (*GymProfileResponse, error)
+	// Метод для получения профилей залов пользователя
+	GetGymProfiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetGymProfilesResponse, error)
+	// Метод для изменения профиля зала
+	UpdateGymProfile(ctx context.Context, in *UpdateGymProfileRequest, opts ...grpc.CallOption) (*GymProfileResponse, error)
+	// Метод для удаления профиля зала
+	DeleteGymProfile(ctx context.Context, in *DeleteGymProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Метод для получения единиц измерения и региональных настроек пользователя
+	GetUserPreferences(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserPreferencesResponse, error)
+	// Метод для изменения единиц измерения и региональных настроек пользователя
+	UpdateUserPreferences(ctx context.Context, in *UpdateUserPreferencesRequest, opts ...grpc.CallOption) (*UserPreferencesResponse, error)
+	// Метод для запроса архива со всеми данными пользователя, архив собирается в фоне
+	RequestDataExport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DataExportResponse, error)
+	// Метод для получения списка выгрузок данных пользователя
+	GetDataExports(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDataExportsResponse, error)
+	// Метод для получения состояния выгрузки и ссылки на скачивание архива
+	GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*DataExportResponse, error)
+}
+
+type userServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
+	return &userServiceClient{cc}
+}
+
+func (c *userServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetMe(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, UserService_GetMe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateWorkoutGenerationSettings(ctx context.Context, in *UpdateWorkoutGenerationSettingsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_UpdateWorkoutGenerationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetWorkoutGenerationSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WorkoutGenerationSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkoutGenerationSettingsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetWorkoutGenerationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateMeasurement(ctx context.Context, in *CreateMeasurementRequest, opts ...grpc.CallOption) (*MeasurementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MeasurementResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateMeasurement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetMeasurements(ctx context.Context, in *GetMeasurementsRequest, opts ...grpc.CallOption) (*GetMeasurementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMeasurementsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetMeasurements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateMeasurement(ctx context.Context, in *UpdateMeasurementRequest, opts ...grpc.CallOption) (*MeasurementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MeasurementResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateMeasurement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteMeasurement(ctx context.Context, in *DeleteMeasurementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteMeasurement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetMeasurementTrend(ctx context.Context, in *GetMeasurementTrendRequest, opts ...grpc.CallOption) (*GetMeasurementTrendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMeasurementTrendResponse)
+	err := c.cc.Invoke(ctx, UserService_GetMeasurementTrend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateProgressPhoto(ctx context.Context, in *CreateProgressPhotoRequest, opts ...grpc.CallOption) (*ProgressPhotoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProgressPhotoResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateProgressPhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetProgressPhotos(ctx context.Context, in *GetProgressPhotosRequest, opts ...grpc.CallOption) (*GetProgressPhotosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProgressPhotosResponse)
+	err := c.cc.Invoke(ctx, UserService_GetProgressPhotos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateProgressPhoto(ctx context.Context, in *UpdateProgressPhotoRequest, opts ...grpc.CallOption) (*ProgressPhotoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProgressPhotoResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateProgressPhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteProgressPhoto(ctx context.Context, in *DeleteProgressPhotoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteProgressPhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CompareProgressPhotos(ctx context.Context, in *CompareProgressPhotosRequest, opts ...grpc.CallOption) (*CompareProgressPhotosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareProgressPhotosResponse)
+	err := c.cc.Invoke(ctx, UserService_CompareProgressPhotos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateGymProfile(ctx context.Context, in *CreateGymProfileRequest, opts ...grpc.CallOption) (*GymProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GymProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateGymProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetGymProfiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetGymProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGymProfilesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetGymProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateGymProfile(ctx context.Context, in *UpdateGymProfileRequest, opts ...grpc.CallOption) (*GymProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GymProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateGymProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteGymProfile(ctx context.Context, in *DeleteGymProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteGymProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserPreferences(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserPreferencesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateUserPreferences(ctx context.Context, in *UpdateUserPreferencesRequest, opts ...grpc.CallOption) (*UserPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserPreferencesResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateUserPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequestDataExport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DataExportResponse)
+	err := c.cc.Invoke(ctx, UserService_RequestDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetDataExports(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDataExportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataExportsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetDataExports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*DataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DataExportResponse)
+	err := c.cc.Invoke(ctx, UserService_GetDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+// All implementations must embed UnimplementedUserServiceServer
+// for forward compatibility.
+type UserServiceServer interface {
+	// Метод для создания пользователя
+	CreateUser(context.Context, *CreateUserRequest) (*UserResponse, error)
+	// Метод для получения пользователя по ID
+	GetUser(context.Context, *GetUserRequest) (*UserResponse, error)
+	// Метод для получения текущего пользователя
+	GetMe(context.Context, *emptypb.Empty) (*UserResponse, error)
+	// Метод для обновления данных пользователя
+	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
+	// Метод для обновления настроек генерации тренировок
+	UpdateWorkoutGenerationSettings(context.Context, *UpdateWorkoutGenerationSettingsRequest) (*emptypb.Empty, error)
+	// Метод для получения настроек генерации тренировок
+	GetWorkoutGenerationSettings(context.Context, *emptypb.Empty) (*WorkoutGenerationSettingsResponse, error)
+	// Метод для добавления замера тела
+	CreateMeasurement(context.Context, *CreateMeasurementRequest) (*MeasurementResponse, error)
+	// Метод для получения истории замеров тела
+	GetMeasurements(context.Context, *GetMeasurementsRequest) (*GetMeasurementsResponse, error)
+	// Метод для изменения замера тела
+	UpdateMeasurement(context.Context, *UpdateMeasurementRequest) (*MeasurementResponse, error)
+	// Метод для удаления замера тела
+	DeleteMeasurement(context.Context, *DeleteMeasurementRequest) (*emptypb.Empty, error)
+	// Метод для получения динамики замеров со скользящим средним
+	GetMeasurementTrend(context.Context, *GetMeasurementTrendRequest) (*GetMeasurementTrendResponse, error)
+	// Метод для добавления фото прогресса из подтвержденной загрузки
+	CreateProgressPhoto(context.Context, *CreateProgressPhotoRequest) (*ProgressPhotoResponse, error)
+	// Метод для получения ленты фото прогресса
+	GetProgressPhotos(context.Context, *GetProgressPhotosRequest) (*GetProgressPhotosResponse, error)
+	// Метод для изменения фото прогресса
+	UpdateProgressPhoto(context.Context, *UpdateProgressPhotoRequest) (*ProgressPhotoResponse, error)
+	// Метод для удаления фото прогресса
+	DeleteProgressPhoto(context.Context, *DeleteProgressPhotoRequest) (*emptypb.Empty, error)
+	// Метод для сравнения фото прогресса на две даты
+	CompareProgressPhotos(context.Context, *CompareProgressPhotosRequest) (*CompareProgressPhotosResponse, error)
+	// Метод для добавления профиля зала с грифом и набором дисков
+	CreateGymProfile(context.Context, *CreateGymProfileRequest) (*GymProfileResponse, error)
+	// Метод для получения профилей залов пользователя
+	GetGymProfiles(context.Context, *emptypb.Empty) (*GetGymProfilesResponse, error)
+	// Метод для изменения профиля зала
+	UpdateGymProfile(context.Context, *UpdateGymProfileRequest) (*GymProfileResponse, error)
+	// Метод для удаления профиля зала
+	DeleteGymProfile(context.Context, *DeleteGymProfileRequest) (*emptypb.Empty, error)
+	// Метод для получения единиц измерения и региональных настроек пользователя
+	GetUserPreferences(context.Context, *emptypb.Empty) (*UserPreferencesResponse, error)
+	// Метод для изменения единиц измерения и региональных настроек пользователя
+	UpdateUserPreferences(context.Context, *UpdateUserPreferencesRequest) (*UserPreferencesResponse, error)
+	// Метод для запроса архива со всеми данными пользователя, архив собирается в фоне
+	RequestDataExport(context.Context, *emptypb.Empty) (*DataExportResponse, error)
+	// Метод для получения списка выгрузок данных пользователя
+	GetDataExports(context.Context, *emptypb.Empty) (*GetDataExportsResponse, error)
+	// Метод для получения состояния выгрузки и ссылки на скачивание архива
+	GetDataExport(context.Context, *GetDataExportRequest) (*DataExportResponse, error)
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+// UnimplementedUserServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserServiceServer struct{}
+
+func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedUserServiceServer) GetMe(context.Context, *emptypb.Empty) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMe not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateWorkoutGenerationSettings(context.Context, *UpdateWorkoutGenerationSettingsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkoutGenerationSettings not implemented")
+}
+func (UnimplementedUserServiceServer) GetWorkoutGenerationSettings(context.Context, *emptypb.Empty) (*WorkoutGenerationSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkoutGenerationSettings not implemented")
+}
+func (UnimplementedUserServiceServer) CreateMeasurement(context.Context, *CreateMeasurementRequest) (*MeasurementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMeasurement not implemented")
+}
+func (UnimplementedUserServiceServer) GetMeasurements(context.Context, *GetMeasurementsRequest) (*GetMeasurementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeasurements not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateMeasurement(context.Context, *UpdateMeasurementRequest) (*MeasurementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMeasurement not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteMeasurement(context.Context, *DeleteMeasurementRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMeasurement not implemented")
+}
+func (UnimplementedUserServiceServer) GetMeasurementTrend(context.Context, *GetMeasurementTrendRequest) (*GetMeasurementTrendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeasurementTrend not implemented")
+}
+func (UnimplementedUserServiceServer) CreateProgressPhoto(context.Context, *CreateProgressPhotoRequest) (*ProgressPhotoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProgressPhoto not implemented")
+}
+func (UnimplementedUserServiceServer) GetProgressPhotos(context.Context, *GetProgressPhotosRequest) (*GetProgressPhotosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProgressPhotos not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateProgressPhoto(context.Context, *UpdateProgressPhotoRequest) (*ProgressPhotoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProgressPhoto not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteProgressPhoto(context.Context, *DeleteProgressPhotoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProgressPhoto not implemented")
+}
+func (UnimplementedUserServiceServer) CompareProgressPhotos(context.Context, *CompareProgressPhotosRequest) (*CompareProgressPhotosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareProgressPhotos not implemented")
+}
+func (UnimplementedUserServiceServer) CreateGymProfile(context.Context, *CreateGymProfileRequest) (*GymProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGymProfile not implemented")
+}
+func (UnimplementedUserServiceServer) GetGymProfiles(context.Context, *emptypb.Empty) (*GetGymProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGymProfiles not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateGymProfile(context.Context, *UpdateGymProfileRequest) (*GymProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGymProfile not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteGymProfile(context.Context, *DeleteGymProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGymProfile not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserPreferences(context.Context, *emptypb.Empty) (*UserPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUserPreferences(context.Context, *UpdateUserPreferencesRequest) (*UserPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) RequestDataExport(context.Context, *emptypb.Empty) (*DataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDataExport not implemented")
+}
+func (UnimplementedUserServiceServer) GetDataExports(context.Context, *emptypb.Empty) (*GetDataExportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataExports not implemented")
+}
+func (UnimplementedUserServiceServer) GetDataExport(context.Context, *GetDataExportRequest) (*DataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataExport not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
 	}
-	return interceptor(ctx, in, info, handler)
+	s.RegisterService(&UserService_ServiceDesc, srv)
 }
 
-func _WorkoutService_GetExerciseLogDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExerciseLogDetailRequest)
+func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).GetExerciseLogDetails(ctx, in)
+		return srv.(UserServiceServer).CreateUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_GetExerciseLogDetails_FullMethodName,
+		FullMethod: UserService_CreateUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).GetExerciseLogDetails(ctx, req.(*GetExerciseLogDetailRequest))
+		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkoutService_DeleteExerciseLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteExerciseLogRequest)
+func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).DeleteExerciseLog(ctx, in)
+		return srv.(UserServiceServer).GetUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_DeleteExerciseLog_FullMethodName,
+		FullMethod: UserService_GetUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).DeleteExerciseLog(ctx, req.(*DeleteExerciseLogRequest))
+		return srv.(UserServiceServer).GetUser(ctx, req.(*GetUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkoutService_AddPowerRatingToExerciseLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPowerRatingToExerciseLogRequest)
+func _UserService_GetMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).AddPowerRatingToExerciseLog(ctx, in)
+		return srv.(UserServiceServer).GetMe(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_AddPowerRatingToExerciseLog_FullMethodName,
+		FullMethod: UserService_GetMe_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).AddPowerRatingToExerciseLog(ctx, req.(*AddPowerRatingToExerciseLogRequest))
+		return srv.(UserServiceServer).GetMe(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkoutService_AddNotesToExerciseLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddNotesToExerciseLogRequest)
+func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).AddNotesToExerciseLog(ctx, in)
+		return srv.(UserServiceServer).UpdateUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_AddNotesToExerciseLog_FullMethodName,
+		FullMethod: UserService_UpdateUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).AddNotesToExerciseLog(ctx, req.(*AddNotesToExerciseLogRequest))
+		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkoutService_LogSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogSetRequest)
+func _UserService_UpdateWorkoutGenerationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkoutGenerationSettingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).LogSet(ctx, in)
+		return srv.(UserServiceServer).UpdateWorkoutGenerationSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_LogSet_FullMethodName,
+		FullMethod: UserService_UpdateWorkoutGenerationSettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).LogSet(ctx, req.(*LogSetRequest))
+		return srv.(UserServiceServer).UpdateWorkoutGenerationSettings(ctx, req.(*UpdateWorkoutGenerationSettingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkoutService_UpdateSetLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSetLogRequest)
+func _UserService_GetWorkoutGenerationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).UpdateSetLog(ctx, in)
+		return srv.(UserServiceServer).GetWorkoutGenerationSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_UpdateSetLog_FullMethodName,
+		FullMethod: UserService_GetWorkoutGenerationSettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).UpdateSetLog(ctx, req.(*UpdateSetLogRequest))
+		return srv.(UserServiceServer).GetWorkoutGenerationSettings(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkoutService_DeleteSetLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteSetLogRequest)
+func _UserService_CreateMeasurement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMeasurementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).DeleteSetLog(ctx, in)
+		return srv.(UserServiceServer).CreateMeasurement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_DeleteSetLog_FullMethodName,
+		FullMethod: UserService_CreateMeasurement_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).DeleteSetLog(ctx, req.(*DeleteSetLogRequest))
+		return srv.(UserServiceServer).CreateMeasurement(ctx, req.(*CreateMeasurementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkoutService_CompleteWorkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CompleteWorkoutRequest)
+func _UserService_GetMeasurements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeasurementsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).CompleteWorkout(ctx, in)
+		return srv.(UserServiceServer).GetMeasurements(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_CompleteWorkout_FullMethodName,
+		FullMethod: UserService_GetMeasurements_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).CompleteWorkout(ctx, req.(*CompleteWorkoutRequest))
+		return srv.(UserServiceServer).GetMeasurements(ctx, req.(*GetMeasurementsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkoutService_GetWorkoutReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkoutReportRequest)
+func _UserService_UpdateMeasurement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMeasurementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).GetWorkoutReport(ctx, in)
+		return srv.(UserServiceServer).UpdateMeasurement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_GetWorkoutReport_FullMethodName,
+		FullMethod: UserService_UpdateMeasurement_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).GetWorkoutReport(ctx, req.(*GetWorkoutReportRequest))
+		return srv.(UserServiceServer).UpdateMeasurement(ctx, req.(*UpdateMeasurementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkoutService_RateWorkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RateWorkoutRequest)
+func _UserService_DeleteMeasurement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMeasurementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).RateWorkout(ctx, in)
+		return srv.(UserServiceServer).DeleteMeasurement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_RateWorkout_FullMethodName,
+		FullMethod: UserService_DeleteMeasurement_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).RateWorkout(ctx, req.(*RateWorkoutRequest))
+		return srv.(UserServiceServer).DeleteMeasurement(ctx, req.(*DeleteMeasurementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkoutService_AddCommentToWorkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddCommentToWorkoutRequest)
+func _UserService_GetMeasurementTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeasurementTrendRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkoutServiceServer).AddCommentToWorkout(ctx, in)
+		return srv.(UserServiceServer).GetMeasurementTrend(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WorkoutService_AddCommentToWorkout_FullMethodName,
+		FullMethod: UserService_GetMeasurementTrend_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkoutServiceServer).AddCommentToWorkout(ctx, req.(*AddCommentToWorkoutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// WorkoutService_ServiceDesc is the grpc.ServiceDesc for WorkoutService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var WorkoutService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "fitness_trainer.api.workout.WorkoutService",
-	HandlerType: (*WorkoutServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "StartWorkout",
-			Handler:    _WorkoutService_StartWorkout_Handler,
-		},
-		{
-			MethodName: "GetWorkout",
-			Handler:    _WorkoutService_GetWorkout_Handler,
-		},
-		{
-			MethodName: "DeleteWorkout",
-			Handler:    _WorkoutService_DeleteWorkout_Handler,
-		},
-		{
-			MethodName: "GetActiveWorkouts",
-			Handler:    _WorkoutService_GetActiveWorkouts_Handler,
-		},
-		{
-			MethodName: "GetWorkouts",
-			Handler:    _WorkoutService_GetWorkouts_Handler,
-		},
-		{
-			MethodName: "LogExercise",
-			Handler:    _WorkoutService_LogExercise_Handler,
-		},
-		{
-			MethodName: "GetExerciseLogDetails",
-			Handler:    _WorkoutService_GetExerciseLogDetails_Handler,
-		},
-		{
-			MethodName: "DeleteExerciseLog",
-			Handler:    _WorkoutService_DeleteExerciseLog_Handler,
-		},
-		{
-			MethodName: "AddPowerRatingToExerciseLog",
-			Handler:    _WorkoutService_AddPowerRatingToExerciseLog_Handler,
-		},
-		{
-			MethodName: "AddNotesToExerciseLog",
-			Handler:    _WorkoutService_AddNotesToExerciseLog_Handler,
-		},
-		{
-			MethodName: "LogSet",
-			Handler:    _WorkoutService_LogSet_Handler,
-		},
-		{
-			MethodName: "UpdateSetLog",
-			Handler:    _WorkoutService_UpdateSetLog_Handler,
-		},
-		{
-			MethodName: "DeleteSetLog",
-			Handler:    _WorkoutService_DeleteSetLog_Handler,
-		},
-		{
-			MethodName: "CompleteWorkout",
-			Handler:    _WorkoutService_CompleteWorkout_Handler,
-		},
-		{
-			MethodName: "GetWorkoutReport",
-			Handler:    _WorkoutService_GetWorkoutReport_Handler,
-		},
-		{
-			MethodName: "RateWorkout",
-			Handler:    _WorkoutService_RateWorkout_Handler,
-		},
-		{
-			MethodName: "AddCommentToWorkout",
-			Handler:    _WorkoutService_AddCommentToWorkout_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "workouts/workouts.proto",
-}
-
-const (
-	UserService_CreateUser_FullMethodName                      = "/fitness_trainer.api.workout.UserService/CreateUser"
-	UserService_GetUser_FullMethodName                         = "/fitness_trainer.api.workout.UserService/GetUser"
-	UserService_GetMe_FullMethodName                           = "/fitness_trainer.api.workout.UserService/GetMe"
-	UserService_UpdateUser_FullMethodName                      = "/fitness_trainer.api.workout.UserService/UpdateUser"
-	UserService_UpdateWorkoutGenerationSettings_FullMethodName = "/fitness_trainer.api.workout.UserService/UpdateWorkoutGenerationSettings"
-	UserService_GetWorkoutGenerationSettings_FullMethodName    = "/fitness_trainer.api.workout.UserService/GetWorkoutGenerationSettings"
-)
-
-// UserServiceClient is the client API for UserService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type UserServiceClient interface {
-	// Метод для создания пользователя
-	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
-	// Метод для получения пользователя по ID
-	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
-	// Метод для получения текущего пользователя
-	GetMe(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserResponse, error)
-	// Метод для обновления данных пользователя
-	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
-	// Метод для обновления настроек генерации тренировок
-	UpdateWorkoutGenerationSettings(ctx context.Context, in *UpdateWorkoutGenerationSettingsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Метод для получения настроек генерации тренировок
-	GetWorkoutGenerationSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WorkoutGenerationSettingsResponse, error)
-}
-
-type userServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
-	return &userServiceClient{cc}
-}
-
-func (c *userServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UserResponse)
-	err := c.cc.Invoke(ctx, UserService_CreateUser_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *userServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UserResponse)
-	err := c.cc.Invoke(ctx, UserService_GetUser_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
+		return srv.(UserServiceServer).GetMeasurementTrend(ctx, req.(*GetMeasurementTrendRequest))
 	}
-	return out, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userServiceClient) GetMe(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UserResponse)
-	err := c.cc.Invoke(ctx, UserService_GetMe_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _UserService_CreateProgressPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProgressPhotoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateProgressPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateProgressPhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateProgressPhoto(ctx, req.(*CreateProgressPhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UserResponse)
-	err := c.cc.Invoke(ctx, UserService_UpdateUser_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _UserService_GetProgressPhotos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProgressPhotosRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetProgressPhotos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetProgressPhotos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetProgressPhotos(ctx, req.(*GetProgressPhotosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userServiceClient) UpdateWorkoutGenerationSettings(ctx context.Context, in *UpdateWorkoutGenerationSettingsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, UserService_UpdateWorkoutGenerationSettings_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _UserService_UpdateProgressPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProgressPhotoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateProgressPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateProgressPhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateProgressPhoto(ctx, req.(*UpdateProgressPhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userServiceClient) GetWorkoutGenerationSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WorkoutGenerationSettingsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(WorkoutGenerationSettingsResponse)
-	err := c.cc.Invoke(ctx, UserService_GetWorkoutGenerationSettings_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _UserService_DeleteProgressPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProgressPhotoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// UserServiceServer is the server API for UserService service.
-// All implementations must embed UnimplementedUserServiceServer
-// for forward compatibility.
-type UserServiceServer interface {
-	// Метод для создания пользователя
-	CreateUser(context.Context, *CreateUserRequest) (*UserResponse, error)
-	// Метод для получения пользователя по ID
-	GetUser(context.Context, *GetUserRequest) (*UserResponse, error)
-	// Метод для получения текущего пользователя
-	GetMe(context.Context, *emptypb.Empty) (*UserResponse, error)
-	// Метод для обновления данных пользователя
-	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
-	// Метод для обновления настроек генерации тренировок
-	UpdateWorkoutGenerationSettings(context.Context, *UpdateWorkoutGenerationSettingsRequest) (*emptypb.Empty, error)
-	// Метод для получения настроек генерации тренировок
-	GetWorkoutGenerationSettings(context.Context, *emptypb.Empty) (*WorkoutGenerationSettingsResponse, error)
-	mustEmbedUnimplementedUserServiceServer()
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteProgressPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteProgressPhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteProgressPhoto(ctx, req.(*DeleteProgressPhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedUserServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedUserServiceServer struct{}
-
-func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
-}
-func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
-}
-func (UnimplementedUserServiceServer) GetMe(context.Context, *emptypb.Empty) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMe not implemented")
-}
-func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
-}
-func (UnimplementedUserServiceServer) UpdateWorkoutGenerationSettings(context.Context, *UpdateWorkoutGenerationSettingsRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkoutGenerationSettings not implemented")
-}
-func (UnimplementedUserServiceServer) GetWorkoutGenerationSettings(context.Context, *emptypb.Empty) (*WorkoutGenerationSettingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkoutGenerationSettings not implemented")
+func _UserService_CompareProgressPhotos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareProgressPhotosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CompareProgressPhotos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CompareProgressPhotos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CompareProgressPhotos(ctx, req.(*CompareProgressPhotosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
-func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to UserServiceServer will
-// result in compilation errors.
-type UnsafeUserServiceServer interface {
-	mustEmbedUnimplementedUserServiceServer()
+func _UserService_CreateGymProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGymProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateGymProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateGymProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateGymProfile(ctx, req.(*CreateGymProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
-	// If the following call pancis, it indicates UnimplementedUserServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _UserService_GetGymProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&UserService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetGymProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetGymProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetGymProfiles(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _UserService_UpdateGymProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGymProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).CreateUser(ctx, in)
+		return srv.(UserServiceServer).UpdateGymProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_CreateUser_FullMethodName,
+		FullMethod: UserService_UpdateGymProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(UserServiceServer).UpdateGymProfile(ctx, req.(*UpdateGymProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _UserService_DeleteGymProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGymProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUser(ctx, in)
+		return srv.(UserServiceServer).DeleteGymProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUser_FullMethodName,
+		FullMethod: UserService_DeleteGymProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(UserServiceServer).DeleteGymProfile(ctx, req.(*DeleteGymProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _UserService_GetUserPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetMe(ctx, in)
+		return srv.(UserServiceServer).GetUserPreferences(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetMe_FullMethodName,
+		FullMethod: UserService_GetUserPreferences_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetMe(ctx, req.(*emptypb.Empty))
+		return srv.(UserServiceServer).GetUserPreferences(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _UserService_UpdateUserPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserPreferencesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).UpdateUser(ctx, in)
+		return srv.(UserServiceServer).UpdateUserPreferences(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_UpdateUser_FullMethodName,
+		FullMethod: UserService_UpdateUserPreferences_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(UserServiceServer).UpdateUserPreferences(ctx, req.(*UpdateUserPreferencesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_UpdateWorkoutGenerationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateWorkoutGenerationSettingsRequest)
+func _UserService_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).UpdateWorkoutGenerationSettings(ctx, in)
+		return srv.(UserServiceServer).RequestDataExport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_UpdateWorkoutGenerationSettings_FullMethodName,
+		FullMethod: UserService_RequestDataExport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdateWorkoutGenerationSettings(ctx, req.(*UpdateWorkoutGenerationSettingsRequest))
+		return srv.(UserServiceServer).RequestDataExport(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetWorkoutGenerationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _UserService_GetDataExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetWorkoutGenerationSettings(ctx, in)
+		return srv.(UserServiceServer).GetDataExports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetWorkoutGenerationSettings_FullMethodName,
+		FullMethod: UserService_GetDataExports_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetWorkoutGenerationSettings(ctx, req.(*emptypb.Empty))
+		return srv.(UserServiceServer).GetDataExports(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetDataExport(ctx, req.(*GetDataExportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1910,6 +4360,82 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkoutGenerationSettings",
 			Handler:    _UserService_GetWorkoutGenerationSettings_Handler,
 		},
+		{
+			MethodName: "CreateMeasurement",
+			Handler:    _UserService_CreateMeasurement_Handler,
+		},
+		{
+			MethodName: "GetMeasurements",
+			Handler:    _UserService_GetMeasurements_Handler,
+		},
+		{
+			MethodName: "UpdateMeasurement",
+			Handler:    _UserService_UpdateMeasurement_Handler,
+		},
+		{
+			MethodName: "DeleteMeasurement",
+			Handler:    _UserService_DeleteMeasurement_Handler,
+		},
+		{
+			MethodName: "GetMeasurementTrend",
+			Handler:    _UserService_GetMeasurementTrend_Handler,
+		},
+		{
+			MethodName: "CreateProgressPhoto",
+			Handler:    _UserService_CreateProgressPhoto_Handler,
+		},
+		{
+			MethodName: "GetProgressPhotos",
+			Handler:    _UserService_GetProgressPhotos_Handler,
+		},
+		{
+			MethodName: "UpdateProgressPhoto",
+			Handler:    _UserService_UpdateProgressPhoto_Handler,
+		},
+		{
+			MethodName: "DeleteProgressPhoto",
+			Handler:    _UserService_DeleteProgressPhoto_Handler,
+		},
+		{
+			MethodName: "CompareProgressPhotos",
+			Handler:    _UserService_CompareProgressPhotos_Handler,
+		},
+		{
+			MethodName: "CreateGymProfile",
+			Handler:    _UserService_CreateGymProfile_Handler,
+		},
+		{
+			MethodName: "GetGymProfiles",
+			Handler:    _UserService_GetGymProfiles_Handler,
+		},
+		{
+			MethodName: "UpdateGymProfile",
+			Handler:    _UserService_UpdateGymProfile_Handler,
+		},
+		{
+			MethodName: "DeleteGymProfile",
+			Handler:    _UserService_DeleteGymProfile_Handler,
+		},
+		{
+			MethodName: "GetUserPreferences",
+			Handler:    _UserService_GetUserPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateUserPreferences",
+			Handler:    _UserService_UpdateUserPreferences_Handler,
+		},
+		{
+			MethodName: "RequestDataExport",
+			Handler:    _UserService_RequestDataExport_Handler,
+		},
+		{
+			MethodName: "GetDataExports",
+			Handler:    _UserService_GetDataExports_Handler,
+		},
+		{
+			MethodName: "GetDataExport",
+			Handler:    _UserService_GetDataExport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "workouts/workouts.proto",
@@ -2095,13 +4621,17 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	FileService_PresignUpload_FullMethodName = "/fitness_trainer.api.workout.FileService/PresignUpload"
+	FileService_ConfirmUpload_FullMethodName = "/fitness_trainer.api.workout.FileService/ConfirmUpload"
 )
 
 // FileServiceClient is the client API for FileService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type FileServiceClient interface {
+	// Метод для получения ссылки на загрузку файла
 	PresignUpload(ctx context.Context, in *PresignUploadRequest, opts ...grpc.CallOption) (*PresignUploadResponse, error)
+	// Метод для подтверждения загрузки файла
+	ConfirmUpload(ctx context.Context, in *ConfirmUploadRequest, opts ...grpc.CallOption) (*FileResponse, error)
 }
 
 type fileServiceClient struct {
@@ -2122,11 +4652,24 @@ func (c *fileServiceClient) PresignUpload(ctx context.Context, in *PresignUpload
 	return out, nil
 }
 
+func (c *fileServiceClient) ConfirmUpload(ctx context.Context, in *ConfirmUploadRequest, opts ...grpc.CallOption) (*FileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileResponse)
+	err := c.cc.Invoke(ctx, FileService_ConfirmUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FileServiceServer is the server API for FileService service.
 // All implementations must embed UnimplementedFileServiceServer
 // for forward compatibility.
 type FileServiceServer interface {
+	// Метод для получения ссылки на загрузку файла
 	PresignUpload(context.Context, *PresignUploadRequest) (*PresignUploadResponse, error)
+	// Метод для подтверждения загрузки файла
+	ConfirmUpload(context.Context, *ConfirmUploadRequest) (*FileResponse, error)
 	mustEmbedUnimplementedFileServiceServer()
 }
 
@@ -2140,6 +4683,9 @@ type UnimplementedFileServiceServer struct{}
 func (UnimplementedFileServiceServer) PresignUpload(context.Context, *PresignUploadRequest) (*PresignUploadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PresignUpload not implemented")
 }
+func (UnimplementedFileServiceServer) ConfirmUpload(context.Context, *ConfirmUploadRequest) (*FileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmUpload not implemented")
+}
 func (UnimplementedFileServiceServer) mustEmbedUnimplementedFileServiceServer() {}
 func (UnimplementedFileServiceServer) testEmbeddedByValue()                     {}
 
@@ -2179,6 +4725,24 @@ func _FileService_PresignUpload_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileService_ConfirmUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ConfirmUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ConfirmUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ConfirmUpload(ctx, req.(*ConfirmUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FileService_ServiceDesc is the grpc.ServiceDesc for FileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2190,6 +4754,10 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PresignUpload",
 			Handler:    _FileService_PresignUpload_Handler,
 		},
+		{
+			MethodName: "ConfirmUpload",
+			Handler:    _FileService_ConfirmUpload_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "workouts/workouts.proto",
@@ -9,11 +9,95 @@
  * ---------------------------------------------------------------
  */
 
+export interface CreatePastWorkoutRequestPastExercise {
+  exerciseId: string;
+  notes?: string;
+  sets?: CreatePastWorkoutRequestPastSet[];
+}
+
+export interface CreatePastWorkoutRequestPastSet {
+  /** @format int32 */
+  reps?: number;
+  /** @format float */
+  weight?: number;
+  time?: string;
+  /**
+   * Время завершения подхода; по умолчанию подходы равномерно распределяются по тренировке
+   * @format date-time
+   */
+  completedAt?: string;
+}
+
+export type ExerciseServiceArchiveExerciseBody = object;
+
+export type ExerciseServiceConfirmExerciseMediaBody = object;
+
+export interface ExerciseServiceCreateExerciseMediaUploadBody {
+  /** image/jpeg, image/png, image/webp, image/gif, video/mp4, video/webm или video/quicktime */
+  contentType: string;
+  caption?: string;
+  /**
+   * Точный размер файла; ссылка на загрузку принимает только файл этого размера
+   * @format int64
+   */
+  sizeBytes: string;
+}
+
+export interface ExerciseServiceMergeExercisesBody {
+  duplicateExerciseId: string;
+}
+
+export interface ExerciseServiceModerateExerciseBody {
+  approve?: boolean;
+  comment?: string;
+}
+
+export type ExerciseServiceRequestExercisePromotionBody = object;
+
+export type ExerciseServiceRestoreExerciseBody = object;
+
+export interface ExerciseServiceSetExerciseMediaOrderBody {
+  mediaIds?: string[];
+}
+
+export interface ExerciseServiceSetExerciseVisibilityBody {
+  /**
+   * - EXERCISE_VISIBILITY_GLOBAL: Упражнение из общего каталога
+   *  - EXERCISE_VISIBILITY_PRIVATE: Упражнение видно только владельцу
+   *  - EXERCISE_VISIBILITY_SHARED: Упражнение доступно по ссылке
+   */
+  visibility: WorkoutExerciseVisibility;
+}
+
+export interface ExerciseServiceUpdateExerciseBody {
+  name?: string;
+  description?: string;
+  videoUrl?: string;
+  /** Если указаны, полностью заменяют группы мышц упражнения */
+  targetMuscleGroupIds?: string[];
+  muscleGroups?: WorkoutMuscleGroupTarget[];
+  equipment?: WorkoutEquipment;
+  /** Если указаны, полностью заменяют альтернативные названия */
+  aliases?: WorkoutExerciseAlias[];
+}
+
+export interface ExerciseServiceUpdateExerciseMediaBody {
+  caption?: string;
+}
+
+export type FileServiceConfirmUploadBody = object;
+
 export interface GetWorkoutsResponseWorkoutDetails {
   workout?: WorkoutWorkout;
   exerciseLogs?: WorkoutExerciseLog[];
 }
 
+export interface ResolveWorkoutImportRequestMapping {
+  name?: string;
+  exerciseId?: string;
+  skip?: boolean;
+}
+
 export interface RoutineServiceAddExerciseToRoutineBody {
   exerciseId: string;
 }
@@ -25,15 +109,59 @@ export interface RoutineServiceAddSetToExerciseInstanceBody {
   /** @format float */
   weight?: number;
   time?: string;
+  /** Планируемый отдых после подхода */
+  rest?: string;
+  /**
+   * Индекс, на который вставляется подход; по умолчанию - в конец
+   * @format int32
+   */
+  position?: number;
+}
+
+export interface RoutineServiceCloneRoutineTemplateBody {
+  /** Название рутины, по умолчанию используется название шаблона */
+  name?: string;
+}
+
+export interface RoutineServiceCreateRoutineShareLinkBody {
+  /**
+   * Время истечения ссылки, по умолчанию ссылка бессрочная
+   * @format date-time
+   */
+  expiresAt?: string;
+}
+
+export interface RoutineServiceDuplicateRoutineBody {
+  /** Название копии, по умолчанию сохраняется название рутины */
+  name?: string;
+  /**
+   * Версия для копирования, по умолчанию копируется текущее состояние рутины
+   * @format int32
+   */
+  version?: number;
+}
+
+export interface RoutineServiceGroupExerciseInstancesBody {
+  /** Упражнения выполняются в порядке рутины */
+  exerciseInstanceIds?: string[];
+  type?: WorkoutExerciseGroupType;
+  /** @format int32 */
+  rounds?: number;
 }
 
 export interface RoutineServiceSetExerciseOrderBody {
   exerciseInstanceIds?: string[];
 }
 
+export interface RoutineServiceSetSetOrderBody {
+  /** Все сеты упражнения в новом порядке */
+  setIds?: string[];
+}
+
 export interface RoutineServiceUpdateRoutineBody {
   name?: string;
   description?: string;
+  warmupScheme?: WorkoutWarmupScheme;
 }
 
 export interface RoutineServiceUpdateSetInExerciseInstanceBody {
@@ -43,6 +171,37 @@ export interface RoutineServiceUpdateSetInExerciseInstanceBody {
   /** @format float */
   weight?: number;
   time?: string;
+  rest?: string;
+}
+
+export interface UserServiceUpdateGymProfileBody {
+  name?: string;
+  /** @format float */
+  barWeight?: number;
+  /** Заменяет набор дисков целиком, если update_plates = true */
+  plates?: WorkoutPlateInventory[];
+  updatePlates?: boolean;
+  /** Сделать профиль основным; снять признак можно только назначив основным другой профиль */
+  isDefault?: boolean;
+}
+
+export interface UserServiceUpdateMeasurementBody {
+  /** @format double */
+  value?: number;
+  unit?: WorkoutMeasurementUnit;
+  /** @format date-time */
+  measuredAt?: string;
+  note?: string;
+}
+
+export interface UserServiceUpdateProgressPhotoBody {
+  pose?: WorkoutProgressPhotoPose;
+  /** @format date-time */
+  takenAt?: string;
+  /** Пустая строка отвязывает замер */
+  measurementId?: string;
+  isPrivate?: boolean;
+  note?: string;
 }
 
 export interface WorkoutReportResponseAdditionalInfo {
@@ -50,17 +209,36 @@ export interface WorkoutReportResponseAdditionalInfo {
   totalSets?: number;
   /** @format int32 */
   totalReps?: number;
-  /** @format float */
+  /**
+   * Сумма веса, умноженного на повторения, по всем подходам
+   * @format float
+   */
   totalWeight?: number;
   totalTime?: string;
 }
 
+export interface WorkoutReportResponseGroupSummary {
+  group?: WorkoutExerciseGroup;
+  /** Упражнения группы в порядке выполнения */
+  exerciseLogIds?: string[];
+  /**
+   * Круги, в которых выполнены подходы всех упражнений группы
+   * @format int32
+   */
+  completedRounds?: number;
+}
+
 export interface WorkoutServiceAddCommentToWorkoutBody {
   comment?: string;
 }
 
 export interface WorkoutServiceAddNotesToExerciseLogBody {
   notes?: string;
+  /**
+   * Изменить завершённую тренировку после окончания окна редактирования.
+   * Изменения завершённых тренировок записываются в историю правок
+   */
+  amend?: boolean;
 }
 
 export interface WorkoutServiceAddPowerRatingToExerciseLogBody {
@@ -70,6 +248,14 @@ export interface WorkoutServiceAddPowerRatingToExerciseLogBody {
 
 export type WorkoutServiceCompleteWorkoutBody = object;
 
+export interface WorkoutServiceGroupExerciseLogsBody {
+  /** Упражнения выполняются в порядке тренировки */
+  exerciseLogIds?: string[];
+  type?: WorkoutExerciseGroupType;
+  /** @format int32 */
+  rounds?: number;
+}
+
 export interface WorkoutServiceLogExerciseBody {
   exerciseId: string;
 }
@@ -80,6 +266,21 @@ export interface WorkoutServiceLogSetBody {
   /** @format float */
   weight?: number;
   time?: string;
+  /**
+   * Время начала подхода; время завершения записывается сервером
+   * @format date-time
+   */
+  startedAt?: string;
+  /**
+   * Индекс, на который вставляется подход; по умолчанию - в конец
+   * @format int32
+   */
+  position?: number;
+  /**
+   * Изменить завершённую тренировку после окончания окна редактирования.
+   * Изменения завершённых тренировок записываются в историю правок
+   */
+  amend?: boolean;
 }
 
 export interface WorkoutServiceRateWorkoutBody {
@@ -87,6 +288,21 @@ export interface WorkoutServiceRateWorkoutBody {
   rating?: number;
 }
 
+export interface WorkoutServiceResolveWorkoutImportBody {
+  mappings?: ResolveWorkoutImportRequestMapping[];
+  confirm?: boolean;
+}
+
+export interface WorkoutServiceSetExerciseLogOrderBody {
+  /** Все упражнения тренировки в новом порядке */
+  exerciseLogIds?: string[];
+}
+
+export interface WorkoutServiceSetSetLogOrderBody {
+  /** Все подходы упражнения в новом порядке */
+  setLogIds?: string[];
+}
+
 export interface WorkoutServiceUpdateSetLogBody {
   setType?: WorkoutSetType;
   /** @format int32 */
@@ -94,6 +310,11 @@ export interface WorkoutServiceUpdateSetLogBody {
   /** @format float */
   weight?: number;
   time?: string;
+  /**
+   * Изменить завершённую тренировку после окончания окна редактирования.
+   * Изменения завершённых тренировок записываются в историю правок
+   */
+  amend?: boolean;
 }
 
 export interface ProtobufAny {
@@ -108,17 +329,91 @@ export interface RpcStatus {
   details?: ProtobufAny[];
 }
 
+export interface WorkoutAddSharedExerciseRequest {
+  shareToken: string;
+}
+
+export interface WorkoutCompareProgressPhotosResponse {
+  comparisons?: WorkoutProgressPhotoComparison[];
+}
+
+export interface WorkoutCreateExerciseMediaUploadResponse {
+  media?: WorkoutExerciseMedia;
+  /** Ссылка для загрузки файла методом PUT с указанным content_type */
+  uploadUrl?: string;
+}
+
 export interface WorkoutCreateExerciseRequest {
   name: string;
   description?: string;
   videoUrl?: string;
+  /** Основные группы мышц */
   targetMuscleGroupIds?: string[];
+  muscleGroups?: WorkoutMuscleGroupTarget[];
+  /**
+   * По умолчанию упражнение видно только создателю
+   * - EXERCISE_VISIBILITY_GLOBAL: Упражнение из общего каталога
+   *  - EXERCISE_VISIBILITY_PRIVATE: Упражнение видно только владельцу
+   *  - EXERCISE_VISIBILITY_SHARED: Упражнение доступно по ссылке
+   */
+  visibility?: WorkoutExerciseVisibility;
+  equipment?: WorkoutEquipment;
+  aliases?: WorkoutExerciseAlias[];
+}
+
+export interface WorkoutCreateGymProfileRequest {
+  name: string;
+  /** @format float */
+  barWeight?: number;
+  plates?: WorkoutPlateInventory[];
+  /** Первый профиль всегда становится основным */
+  isDefault?: boolean;
+}
+
+export interface WorkoutCreateMeasurementRequest {
+  kind: WorkoutMeasurementKind;
+  /** @format double */
+  value: number;
+  unit: WorkoutMeasurementUnit;
+  /**
+   * По умолчанию текущее время
+   * @format date-time
+   */
+  measuredAt?: string;
+  note?: string;
+}
+
+export interface WorkoutCreatePastWorkoutRequest {
+  /** @format date-time */
+  startedAt: string;
+  /** @format date-time */
+  finishedAt: string;
+  notes?: string;
+  /** @format int32 */
+  rating?: number;
+  exercises?: CreatePastWorkoutRequestPastExercise[];
+}
+
+export interface WorkoutCreateProgressPhotoRequest {
+  /** Файл, загруженный с FILE_PURPOSE_PROGRESS_PHOTO */
+  fileId: string;
+  pose: WorkoutProgressPhotoPose;
+  /**
+   * По умолчанию текущее время
+   * @format date-time
+   */
+  takenAt?: string;
+  measurementId?: string;
+  /** По умолчанию фото приватное */
+  isPrivate?: boolean;
+  note?: string;
 }
 
 export interface WorkoutCreateRoutineRequest {
   workoutId?: string;
   name: string;
   description?: string;
+  warmupScheme?: WorkoutWarmupScheme;
 }
 
 export interface WorkoutCreateUserRequest {
@@ -134,6 +429,78 @@ export interface WorkoutCreateUserRequest {
   weight?: number;
 }
 
+export interface WorkoutCreateWorkoutImportRequest {
+  source: WorkoutWorkoutImportSource;
+  /** Файл, загруженный с назначением FILE_PURPOSE_WORKOUT_IMPORT */
+  fileId: string;
+  /** Единица веса в выгрузке Strong, по умолчанию из настроек пользователя */
+  weightUnit?: WorkoutMeasurementUnit;
+}
+
+/** Выгрузка данных: zip-архив с export.json, CSV-файлами и описанием формата */
+export interface WorkoutDataExport {
+  id?: string;
+  status?: WorkoutDataExportStatus;
+  /**
+   * Размер архива в байтах, 0 пока архив не готов
+   * @format int64
+   */
+  size?: string;
+  /**
+   * Время, после которого архив удаляется; пусто, пока архив не готов
+   * @format date-time
+   */
+  expiresAt?: string;
+  /** Временная ссылка на скачивание архива; пусто, если архив не готов или удалён */
+  downloadUrl?: string;
+  /** @format date-time */
+  createdAt?: string;
+}
+
+export interface WorkoutDataExportResponse {
+  export?: WorkoutDataExport;
+}
+
+/** @default "DATA_EXPORT_STATUS_UNSPECIFIED" */
+export enum WorkoutDataExportStatus {
+  DATA_EXPORT_STATUS_UNSPECIFIED = "DATA_EXPORT_STATUS_UNSPECIFIED",
+  DATA_EXPORT_STATUS_PENDING = "DATA_EXPORT_STATUS_PENDING",
+  DATA_EXPORT_STATUS_RUNNING = "DATA_EXPORT_STATUS_RUNNING",
+  DATA_EXPORT_STATUS_DONE = "DATA_EXPORT_STATUS_DONE",
+  DATA_EXPORT_STATUS_FAILED = "DATA_EXPORT_STATUS_FAILED",
+}
+
+/** @default "DAY_OF_WEEK_UNSPECIFIED" */
+export enum WorkoutDayOfWeek {
+  DAY_OF_WEEK_UNSPECIFIED = "DAY_OF_WEEK_UNSPECIFIED",
+  DAY_OF_WEEK_MONDAY = "DAY_OF_WEEK_MONDAY",
+  DAY_OF_WEEK_TUESDAY = "DAY_OF_WEEK_TUESDAY",
+  DAY_OF_WEEK_WEDNESDAY = "DAY_OF_WEEK_WEDNESDAY",
+  DAY_OF_WEEK_THURSDAY = "DAY_OF_WEEK_THURSDAY",
+  DAY_OF_WEEK_FRIDAY = "DAY_OF_WEEK_FRIDAY",
+  DAY_OF_WEEK_SATURDAY = "DAY_OF_WEEK_SATURDAY",
+  DAY_OF_WEEK_SUNDAY = "DAY_OF_WEEK_SUNDAY",
+}
+
+export interface WorkoutDiffRoutineVersionsResponse {
+  /** Изменённые поля рутины: name, description, warmup_steps */
+  changes?: Record<string, WorkoutFieldChange>;
+  exercises?: WorkoutExerciseInstanceChange[];
+}
+
+/** @default "EQUIPMENT_UNSPECIFIED" */
+export enum WorkoutEquipment {
+  EQUIPMENT_UNSPECIFIED = "EQUIPMENT_UNSPECIFIED",
+  EQUIPMENT_BARBELL = "EQUIPMENT_BARBELL",
+  EQUIPMENT_DUMBBELL = "EQUIPMENT_DUMBBELL",
+  EQUIPMENT_KETTLEBELL = "EQUIPMENT_KETTLEBELL",
+  EQUIPMENT_MACHINE = "EQUIPMENT_MACHINE",
+  EQUIPMENT_CABLE = "EQUIPMENT_CABLE",
+  EQUIPMENT_BODYWEIGHT = "EQUIPMENT_BODYWEIGHT",
+  EQUIPMENT_BAND = "EQUIPMENT_BAND",
+  EQUIPMENT_OTHER = "EQUIPMENT_OTHER",
+}
+
 export interface WorkoutExercise {
   id?: string;
   /** @format date-time */
@@ -144,6 +511,70 @@ export interface WorkoutExercise {
   targetMuscleGroups?: string[];
   /** @format date-time */
   updatedAt?: string;
+  muscleGroups?: WorkoutExerciseMuscleGroup[];
+  ownerId?: string;
+  /**
+   * - EXERCISE_VISIBILITY_GLOBAL: Упражнение из общего каталога
+   *  - EXERCISE_VISIBILITY_PRIVATE: Упражнение видно только владельцу
+   *  - EXERCISE_VISIBILITY_SHARED: Упражнение доступно по ссылке
+   */
+  visibility?: WorkoutExerciseVisibility;
+  /** Токен ссылки для упражнений с видимостью EXERCISE_VISIBILITY_SHARED */
+  shareToken?: string;
+  moderationStatus?: WorkoutModerationStatus;
+  moderationComment?: string;
+  /**
+   * Время архивации; архивные упражнения скрыты из каталога
+   * @format date-time
+   */
+  archivedAt?: string;
+  /** Упражнение, с которым было объединено данное (дубликат) */
+  mergedIntoId?: string;
+  equipment?: WorkoutEquipment;
+  aliases?: WorkoutExerciseAlias[];
+  media?: WorkoutExerciseMedia[];
+}
+
+/** Альтернативное название упражнения, используемое в поиске */
+export interface WorkoutExerciseAlias {
+  /** Код языка, например "ru" или "en" */
+  language?: string;
+  alias?: string;
+}
+
+/** Запись истории изменений упражнения */
+export interface WorkoutExerciseAuditEntry {
+  id?: string;
+  exerciseId?: string;
+  userId?: string;
+  action?: string;
+  changes?: Record<string, WorkoutFieldChange>;
+  /** @format date-time */
+  createdAt?: string;
+}
+
+/** Группа упражнений, выполняемых друг за другом */
+export interface WorkoutExerciseGroup {
+  id?: string;
+  type?: WorkoutExerciseGroupType;
+  /**
+   * Количество кругов
+   * @format int32
+   */
+  rounds?: number;
+}
+
+/**
+ * - EXERCISE_GROUP_TYPE_SUPERSET: Два упражнения подряд без отдыха
+ *  - EXERCISE_GROUP_TYPE_GIANT_SET: Три и более упражнения подряд без отдыха
+ *  - EXERCISE_GROUP_TYPE_CIRCUIT: Круговая тренировка
+ * @default "EXERCISE_GROUP_TYPE_UNSPECIFIED"
+ */
+export enum WorkoutExerciseGroupType {
+  EXERCISE_GROUP_TYPE_UNSPECIFIED = "EXERCISE_GROUP_TYPE_UNSPECIFIED",
+  EXERCISE_GROUP_TYPE_SUPERSET = "EXERCISE_GROUP_TYPE_SUPERSET",
+  EXERCISE_GROUP_TYPE_GIANT_SET = "EXERCISE_GROUP_TYPE_GIANT_SET",
+  EXERCISE_GROUP_TYPE_CIRCUIT = "EXERCISE_GROUP_TYPE_CIRCUIT",
 }
 
 export interface WorkoutExerciseHistoryResponse {
@@ -159,6 +590,18 @@ export interface WorkoutExerciseInstance {
   routineId?: string;
   /** @format date-time */
   updatedAt?: string;
+  /** Не задана, если упражнение не входит в группу */
+  group?: WorkoutExerciseGroup;
+}
+
+/** Изменение упражнения между версиями рутины */
+export interface WorkoutExerciseInstanceChange {
+  exerciseInstanceId?: string;
+  exerciseId?: string;
+  action?: WorkoutRoutineChangeAction;
+  /** Изменённые поля: position, group */
+  changes?: Record<string, WorkoutFieldChange>;
+  sets?: WorkoutSetChange[];
 }
 
 export interface WorkoutExerciseInstanceDetails {
@@ -179,6 +622,13 @@ export interface WorkoutExerciseLog {
   powerRating?: number;
   /** @format date-time */
   updatedAt?: string;
+  /** Не задана, если упражнение не входит в группу */
+  group?: WorkoutExerciseGroup;
+  /**
+   * Порядок упражнения в тренировке
+   * @format int32
+   */
+  position?: number;
 }
 
 export interface WorkoutExerciseLogDetails {
@@ -192,10 +642,67 @@ export interface WorkoutExerciseLogResponse {
   exerciseLogDetails?: WorkoutExerciseLogDetails;
 }
 
+/** Медиафайл упражнения */
+export interface WorkoutExerciseMedia {
+  id?: string;
+  kind?: WorkoutExerciseMediaKind;
+  url?: string;
+  contentType?: string;
+  /** @format int64 */
+  sizeBytes?: string;
+  caption?: string;
+  /** @format int32 */
+  position?: number;
+  /** false, пока загрузка не подтверждена */
+  ready?: boolean;
+  variants?: WorkoutImageVariant[];
+}
+
+/**
+ * - EXERCISE_MEDIA_KIND_GIF: Анимированная демонстрация упражнения
+ * @default "EXERCISE_MEDIA_KIND_UNSPECIFIED"
+ */
+export enum WorkoutExerciseMediaKind {
+  EXERCISE_MEDIA_KIND_UNSPECIFIED = "EXERCISE_MEDIA_KIND_UNSPECIFIED",
+  EXERCISE_MEDIA_KIND_IMAGE = "EXERCISE_MEDIA_KIND_IMAGE",
+  EXERCISE_MEDIA_KIND_GIF = "EXERCISE_MEDIA_KIND_GIF",
+  EXERCISE_MEDIA_KIND_VIDEO = "EXERCISE_MEDIA_KIND_VIDEO",
+}
+
+export interface WorkoutExerciseMediaResponse {
+  media?: WorkoutExerciseMedia;
+}
+
+/** Группа мышц, задействованная в упражнении */
+export interface WorkoutExerciseMuscleGroup {
+  muscleGroupId?: string;
+  name?: string;
+  role?: WorkoutMuscleGroupRole;
+  /**
+   * Доля подхода, засчитываемая группе мышц, от 0 до 1
+   * @format float
+   */
+  activation?: number;
+}
+
 export interface WorkoutExerciseResponse {
   exercise?: WorkoutExercise;
 }
 
+/**
+ * Видимость упражнения
+ * - EXERCISE_VISIBILITY_GLOBAL: Упражнение из общего каталога
+ *  - EXERCISE_VISIBILITY_PRIVATE: Упражнение видно только владельцу
+ *  - EXERCISE_VISIBILITY_SHARED: Упражнение доступно по ссылке
+ * @default "EXERCISE_VISIBILITY_UNSPECIFIED"
+ */
+export enum WorkoutExerciseVisibility {
+  EXERCISE_VISIBILITY_UNSPECIFIED = "EXERCISE_VISIBILITY_UNSPECIFIED",
+  EXERCISE_VISIBILITY_GLOBAL = "EXERCISE_VISIBILITY_GLOBAL",
+  EXERCISE_VISIBILITY_PRIVATE = "EXERCISE_VISIBILITY_PRIVATE",
+  EXERCISE_VISIBILITY_SHARED = "EXERCISE_VISIBILITY_SHARED",
+}
+
 /** Ожидаемый сет */
 export interface WorkoutExpectedSet {
   id?: string;
@@ -209,96 +716,522 @@ export interface WorkoutExpectedSet {
   createdAt?: string;
   /** @format date-time */
   updatedAt?: string;
+  /** Диски для штанги; только для упражнений со штангой в активной тренировке */
+  loadout?: WorkoutPlateLoadout;
+  isWarmup?: boolean;
+  /** Планируемый отдых после подхода */
+  rest?: string;
+  /** @format int32 */
+  position?: number;
+}
+
+export interface WorkoutFieldChange {
+  from?: string;
+  to?: string;
+}
+
+/** Загруженный пользователем файл */
+export interface WorkoutFile {
+  id?: string;
+  /**
+   * - FILE_PURPOSE_PROGRESS_PHOTO: Хранится приватно, ссылки всегда подписаны
+   *  - FILE_PURPOSE_WORKOUT_IMPORT: CSV-выгрузка тренировок из другого приложения, хранится приватно
+   */
+  purpose?: WorkoutFilePurpose;
+  url?: string;
+  contentType?: string;
+  /** @format int64 */
+  sizeBytes?: string;
+  /** false, пока загрузка не подтверждена */
+  confirmed?: boolean;
+  /** @format date-time */
+  createdAt?: string;
+  variants?: WorkoutImageVariant[];
+}
+
+/**
+ * Назначение загружаемого файла
+ * - FILE_PURPOSE_PROGRESS_PHOTO: Хранится приватно, ссылки всегда подписаны
+ *  - FILE_PURPOSE_WORKOUT_IMPORT: CSV-выгрузка тренировок из другого приложения, хранится приватно
+ * @default "FILE_PURPOSE_UNSPECIFIED"
+ */
+export enum WorkoutFilePurpose {
+  FILE_PURPOSE_UNSPECIFIED = "FILE_PURPOSE_UNSPECIFIED",
+  FILE_PURPOSE_PROFILE_PICTURE = "FILE_PURPOSE_PROFILE_PICTURE",
+  FILE_PURPOSE_PROGRESS_PHOTO = "FILE_PURPOSE_PROGRESS_PHOTO",
+  FILE_PURPOSE_WORKOUT_IMPORT = "FILE_PURPOSE_WORKOUT_IMPORT",
+}
+
+export interface WorkoutFileResponse {
+  file?: WorkoutFile;
+}
+
+export interface WorkoutGetDataExportsResponse {
+  exports?: WorkoutDataExport[];
 }
 
 export interface WorkoutGetExerciseAlternativesResponse {
   alternatives?: WorkoutExercise[];
 }
 
+export interface WorkoutGetExerciseAuditLogResponse {
+  entries?: WorkoutExerciseAuditEntry[];
+}
+
 export interface WorkoutGetExerciseInstanceDetailsResponse {
   exerciseInstanceDetails?: WorkoutExerciseInstanceDetails;
 }
 
 export interface WorkoutGetExercisesResponse {
   exercises?: WorkoutExercise[];
+  /** Пустой, если страница последняя */
+  nextCursor?: string;
+}
+
+export interface WorkoutGetGymProfilesResponse {
+  gymProfiles?: WorkoutGymProfile[];
+}
+
+export interface WorkoutGetMeasurementTrendResponse {
+  kind?: WorkoutMeasurementKind;
+  /** Значения приведены к этой единице измерения */
+  unit?: WorkoutMeasurementUnit;
+  points?: WorkoutMeasurementTrendPoint[];
+  /** @format double */
+  change?: number;
+}
+
+export interface WorkoutGetMeasurementsResponse {
+  measurements?: WorkoutMeasurement[];
+}
+
+export interface WorkoutGetMuscleGroupVolumeResponse {
+  muscleGroups?: WorkoutMuscleGroupVolume[];
 }
 
 export interface WorkoutGetMuscleGroupsResponse {
   muscleGroups?: WorkoutMuscleGroup[];
 }
 
+export interface WorkoutGetProgressPhotosResponse {
+  photos?: WorkoutProgressPhoto[];
+}
+
+export interface WorkoutGetRoutineShareLinksResponse {
+  shareLinks?: WorkoutRoutineShareLink[];
+}
+
+export interface WorkoutGetRoutineTemplatesResponse {
+  templates?: WorkoutRoutineTemplate[];
+}
+
+export interface WorkoutGetRoutineVersionResponse {
+  version?: WorkoutRoutineVersion;
+  description?: string;
+  warmupSteps?: WorkoutWarmupStep[];
+  exercises?: WorkoutRoutineVersionExercise[];
+}
+
+export interface WorkoutGetRoutineVersionsResponse {
+  versions?: WorkoutRoutineVersion[];
+}
+
+export interface WorkoutGetWorkoutAmendmentsResponse {
+  amendments?: WorkoutWorkoutAmendment[];
+}
+
+export interface WorkoutGetWorkoutCalendarResponse {
+  /** Дни без тренировок не возвращаются */
+  days?: WorkoutWorkoutCalendarDay[];
+  timezone?: string;
+}
+
+export interface WorkoutGetWorkoutImportsResponse {
+  imports?: WorkoutWorkoutImport[];
+}
+
 export interface WorkoutGetWorkoutResponse {
   workout?: WorkoutWorkout;
   exerciseLogs?: WorkoutExerciseLogDetails[];
 }
 
-export interface WorkoutGetWorkoutsResponse {
-  workouts?: GetWorkoutsResponseWorkoutDetails[];
+export interface WorkoutGetWorkoutRestAnalyticsResponse {
+  intervals?: WorkoutRestInterval[];
+  totalRest?: string;
+  averageRest?: string;
+  averageTargetRest?: string;
+  /** @format int32 */
+  longGaps?: number;
 }
 
-export interface WorkoutLoginRequest {
-  email: string;
-  password: string;
+export interface WorkoutGetWorkoutStreakResponse {
+  /**
+   * Серия дней подряд с тренировками, заканчивающаяся сегодня или вчера
+   * @format int32
+   */
+  currentDays?: number;
+  /** @format int32 */
+  longestDays?: number;
+  /**
+   * Серия недель подряд с тренировками, заканчивающаяся на текущей или прошлой неделе
+   * @format int32
+   */
+  currentWeeks?: number;
+  /** @format int32 */
+  longestWeeks?: number;
+  /** @format date-time */
+  lastWorkoutAt?: string;
 }
 
-export interface WorkoutLoginResponse {
-  tokens?: WorkoutTokensPair;
+export interface WorkoutGetWorkoutsResponse {
+  workouts?: GetWorkoutsResponseWorkoutDetails[];
 }
 
-export interface WorkoutLogoutRequest {
-  refreshToken: string;
+export interface WorkoutGroupExerciseInstancesResponse {
+  exerciseInstances?: WorkoutExerciseInstance[];
 }
 
-export interface WorkoutMuscleGroup {
+export interface WorkoutGroupExerciseLogsResponse {
+  exerciseLogs?: WorkoutExerciseLog[];
+}
+
+export interface WorkoutGymProfile {
   id?: string;
   name?: string;
+  /** @format float */
+  barWeight?: number;
+  plates?: WorkoutPlateInventory[];
+  /** Основной профиль используется для раскладки дисков в тренировках */
+  isDefault?: boolean;
+  /** @format date-time */
+  createdAt?: string;
+  /** @format date-time */
+  updatedAt?: string;
 }
 
-export interface WorkoutPresignUploadRequest {
-  filename: string;
-  contentType: string;
+export interface WorkoutGymProfileResponse {
+  gymProfile?: WorkoutGymProfile;
 }
 
-export interface WorkoutPresignUploadResponse {
-  uploadUrl?: string;
-  getUrl?: string;
+export interface WorkoutImageVariant {
+  /**
+   * Сторона квадратного изображения в пикселях
+   * @format int32
+   */
+  size?: number;
+  url?: string;
 }
 
-export interface WorkoutRefreshRequest {
-  tokens?: WorkoutTokensPair;
+export interface WorkoutImportRoutineRequest {
+  shareToken: string;
 }
 
-export interface WorkoutRefreshResponse {
+export interface WorkoutLoginRequest {
+  email: string;
+  password: string;
+}
+
+export interface WorkoutLoginResponse {
   tokens?: WorkoutTokensPair;
 }
 
-/** Структура плана тренировки */
-export interface WorkoutRoutine {
+export interface WorkoutLogoutRequest {
+  refreshToken: string;
+}
+
+export interface WorkoutMeasurement {
   id?: string;
+  kind?: WorkoutMeasurementKind;
+  /** @format double */
+  value?: number;
+  unit?: WorkoutMeasurementUnit;
   /** @format date-time */
-  createdAt?: string;
-  userId?: string;
-  name?: string;
-  description?: string;
+  measuredAt?: string;
+  note?: string;
   /** @format date-time */
-  updatedAt?: string;
+  createdAt?: string;
 }
 
-export interface WorkoutRoutineDetailResponse {
-  routine?: WorkoutRoutine;
-  exerciseInstances?: WorkoutExerciseInstanceDetails[];
+/** @default "MEASUREMENT_KIND_UNSPECIFIED" */
+export enum WorkoutMeasurementKind {
+  MEASUREMENT_KIND_UNSPECIFIED = "MEASUREMENT_KIND_UNSPECIFIED",
+  MEASUREMENT_KIND_BODYWEIGHT = "MEASUREMENT_KIND_BODYWEIGHT",
+  MEASUREMENT_KIND_BODY_FAT = "MEASUREMENT_KIND_BODY_FAT",
+  MEASUREMENT_KIND_NECK = "MEASUREMENT_KIND_NECK",
+  MEASUREMENT_KIND_SHOULDERS = "MEASUREMENT_KIND_SHOULDERS",
+  MEASUREMENT_KIND_CHEST = "MEASUREMENT_KIND_CHEST",
+  MEASUREMENT_KIND_WAIST = "MEASUREMENT_KIND_WAIST",
+  MEASUREMENT_KIND_HIPS = "MEASUREMENT_KIND_HIPS",
+  MEASUREMENT_KIND_ARM = "MEASUREMENT_KIND_ARM",
+  MEASUREMENT_KIND_FOREARM = "MEASUREMENT_KIND_FOREARM",
+  MEASUREMENT_KIND_THIGH = "MEASUREMENT_KIND_THIGH",
+  MEASUREMENT_KIND_CALF = "MEASUREMENT_KIND_CALF",
 }
 
-export interface WorkoutRoutineInstanceResponse {
-  exerciseInstance?: WorkoutExerciseInstance;
+export interface WorkoutMeasurementResponse {
+  measurement?: WorkoutMeasurement;
 }
 
-export interface WorkoutRoutineListResponse {
-  routines?: WorkoutRoutine[];
+export interface WorkoutMeasurementTrendPoint {
+  /** @format date-time */
+  date?: string;
+  /**
+   * Среднее значение за день
+   * @format double
+   */
+  value?: number;
+  /** @format double */
+  movingAverage?: number;
 }
 
-export interface WorkoutRoutineResponse {
-  routine?: WorkoutRoutine;
+/** @default "MEASUREMENT_UNIT_UNSPECIFIED" */
+export enum WorkoutMeasurementUnit {
+  MEASUREMENT_UNIT_UNSPECIFIED = "MEASUREMENT_UNIT_UNSPECIFIED",
+  MEASUREMENT_UNIT_KG = "MEASUREMENT_UNIT_KG",
+  MEASUREMENT_UNIT_LB = "MEASUREMENT_UNIT_LB",
+  MEASUREMENT_UNIT_PERCENT = "MEASUREMENT_UNIT_PERCENT",
+  MEASUREMENT_UNIT_CM = "MEASUREMENT_UNIT_CM",
+  MEASUREMENT_UNIT_IN = "MEASUREMENT_UNIT_IN",
+}
+
+/**
+ * Статус модерации упражнения
+ * @default "MODERATION_STATUS_UNSPECIFIED"
+ */
+export enum WorkoutModerationStatus {
+  MODERATION_STATUS_UNSPECIFIED = "MODERATION_STATUS_UNSPECIFIED",
+  MODERATION_STATUS_NONE = "MODERATION_STATUS_NONE",
+  MODERATION_STATUS_PENDING = "MODERATION_STATUS_PENDING",
+  MODERATION_STATUS_APPROVED = "MODERATION_STATUS_APPROVED",
+  MODERATION_STATUS_REJECTED = "MODERATION_STATUS_REJECTED",
+}
+
+export interface WorkoutMuscleGroup {
+  id?: string;
+  name?: string;
+}
+
+/**
+ * Роль группы мышц в упражнении
+ * @default "MUSCLE_GROUP_ROLE_UNSPECIFIED"
+ */
+export enum WorkoutMuscleGroupRole {
+  MUSCLE_GROUP_ROLE_UNSPECIFIED = "MUSCLE_GROUP_ROLE_UNSPECIFIED",
+  MUSCLE_GROUP_ROLE_PRIMARY = "MUSCLE_GROUP_ROLE_PRIMARY",
+  MUSCLE_GROUP_ROLE_SECONDARY = "MUSCLE_GROUP_ROLE_SECONDARY",
+  MUSCLE_GROUP_ROLE_STABILIZER = "MUSCLE_GROUP_ROLE_STABILIZER",
+}
+
+export interface WorkoutMuscleGroupTarget {
+  muscleGroupId: string;
+  role: WorkoutMuscleGroupRole;
+  /** @format float */
+  activation?: number;
+}
+
+export interface WorkoutMuscleGroupVolume {
+  muscleGroupId?: string;
+  name?: string;
+  /**
+   * Подходы, в которых группа мышц была основной
+   * @format int32
+   */
+  directSets?: number;
+  /**
+   * Подходы с учетом доли участия группы мышц
+   * @format float
+   */
+  fractionalSets?: number;
+}
+
+export interface WorkoutPlateInventory {
+  /** @format float */
+  weight?: number;
+  /**
+   * Количество дисков этого веса на обе стороны
+   * @format int32
+   */
+  count?: number;
+}
+
+/** Раскладка дисков на штанге */
+export interface WorkoutPlateLoadout {
+  /** Диски на одну сторону, начиная с самого тяжелого */
+  platesPerSide?: number[];
+  /** @format float */
+  barWeight?: number;
+  /**
+   * Итоговый вес штанги; ближайший к целевому, который можно собрать
+   * @format float
+   */
+  weight?: number;
+}
+
+export interface WorkoutPresignUploadRequest {
+  /** Исходное имя файла; ключ в хранилище генерируется сервером */
+  filename: string;
+  contentType: string;
+  /**
+   * По умолчанию FILE_PURPOSE_PROFILE_PICTURE
+   * - FILE_PURPOSE_PROGRESS_PHOTO: Хранится приватно, ссылки всегда подписаны
+   *  - FILE_PURPOSE_WORKOUT_IMPORT: CSV-выгрузка тренировок из другого приложения, хранится приватно
+   */
+  purpose?: WorkoutFilePurpose;
+  /**
+   * Точный размер файла; ссылка на загрузку принимает только файл этого размера
+   * @format int64
+   */
+  sizeBytes: string;
+}
+
+export interface WorkoutPresignUploadResponse {
+  /** Ссылка для загрузки методом PUT с заголовками Content-Type и Content-Length */
+  uploadUrl?: string;
+  getUrl?: string;
+  fileId?: string;
+}
+
+export interface WorkoutProgressPhoto {
+  id?: string;
+  pose?: WorkoutProgressPhotoPose;
+  /** @format date-time */
+  takenAt?: string;
+  /** Подписанная ссылка с ограниченным сроком действия */
+  url?: string;
+  variants?: WorkoutImageVariant[];
+  measurementId?: string;
+  /** Приватные фото не попадают в сравнения, если их не запросить явно */
+  isPrivate?: boolean;
+  note?: string;
+  /** @format date-time */
+  createdAt?: string;
+}
+
+/** Фото одного ракурса, снятые ближе всего к сравниваемым датам */
+export interface WorkoutProgressPhotoComparison {
+  pose?: WorkoutProgressPhotoPose;
+  before?: WorkoutProgressPhoto;
+  after?: WorkoutProgressPhoto;
+}
+
+/** @default "PROGRESS_PHOTO_POSE_UNSPECIFIED" */
+export enum WorkoutProgressPhotoPose {
+  PROGRESS_PHOTO_POSE_UNSPECIFIED = "PROGRESS_PHOTO_POSE_UNSPECIFIED",
+  PROGRESS_PHOTO_POSE_FRONT = "PROGRESS_PHOTO_POSE_FRONT",
+  PROGRESS_PHOTO_POSE_SIDE = "PROGRESS_PHOTO_POSE_SIDE",
+  PROGRESS_PHOTO_POSE_BACK = "PROGRESS_PHOTO_POSE_BACK",
+}
+
+export interface WorkoutProgressPhotoResponse {
+  photo?: WorkoutProgressPhoto;
+}
+
+export interface WorkoutRefreshRequest {
+  tokens?: WorkoutTokensPair;
+}
+
+export interface WorkoutRefreshResponse {
+  tokens?: WorkoutTokensPair;
+}
+
+/** Отдых между двумя последовательными подходами */
+export interface WorkoutRestInterval {
+  /** Упражнение подхода, после которого был отдых */
+  exerciseLogId?: string;
+  previousSetLogId?: string;
+  setLogId?: string;
+  actual?: string;
+  /** Не задан, если отдых не планировался */
+  target?: string;
+  /** Отдых значительно дольше планируемого или дольше 5 минут без плана */
+  isLong?: boolean;
+}
+
+/** Структура плана тренировки */
+export interface WorkoutRoutine {
+  id?: string;
+  /** @format date-time */
+  createdAt?: string;
+  userId?: string;
+  name?: string;
+  description?: string;
+  /** @format date-time */
+  updatedAt?: string;
+  /** Разминочные подходы перед первым рабочим подходом каждого упражнения; пусто, если разминки нет */
+  warmupSteps?: WorkoutWarmupStep[];
+}
+
+/** @default "ROUTINE_CHANGE_ACTION_UNSPECIFIED" */
+export enum WorkoutRoutineChangeAction {
+  ROUTINE_CHANGE_ACTION_UNSPECIFIED = "ROUTINE_CHANGE_ACTION_UNSPECIFIED",
+  ROUTINE_CHANGE_ACTION_ADDED = "ROUTINE_CHANGE_ACTION_ADDED",
+  ROUTINE_CHANGE_ACTION_REMOVED = "ROUTINE_CHANGE_ACTION_REMOVED",
+  ROUTINE_CHANGE_ACTION_MODIFIED = "ROUTINE_CHANGE_ACTION_MODIFIED",
+}
+
+export interface WorkoutRoutineDetailResponse {
+  routine?: WorkoutRoutine;
+  exerciseInstances?: WorkoutExerciseInstanceDetails[];
+}
+
+export interface WorkoutRoutineInstanceResponse {
+  exerciseInstance?: WorkoutExerciseInstance;
+}
+
+export interface WorkoutRoutineListResponse {
+  routines?: WorkoutRoutine[];
+}
+
+export interface WorkoutRoutineResponse {
+  routine?: WorkoutRoutine;
+}
+
+/** Ссылка на просмотр рутины без авторизации */
+export interface WorkoutRoutineShareLink {
+  id?: string;
+  routineId?: string;
+  shareToken?: string;
+  /**
+   * Пусто, если ссылка бессрочная
+   * @format date-time
+   */
+  expiresAt?: string;
+  /** @format date-time */
+  createdAt?: string;
+}
+
+export interface WorkoutRoutineShareLinkResponse {
+  shareLink?: WorkoutRoutineShareLink;
+}
+
+/** Шаблон рутины из библиотеки, содержимое доступно через GetRoutine */
+export interface WorkoutRoutineTemplate {
+  routine?: WorkoutRoutine;
+  /** Программа, к которой относится шаблон, например 5x5 */
+  program?: string;
+}
+
+/** Неизменяемая версия рутины, создаётся при каждом изменении */
+export interface WorkoutRoutineVersion {
+  id?: string;
+  /** Не задан, если программа удалена */
+  routineId?: string;
+  /** @format int32 */
+  version?: number;
+  name?: string;
+  /** @format int32 */
+  exerciseCount?: number;
+  /** @format date-time */
+  createdAt?: string;
+}
+
+/** Упражнение рутины в версии с подходами по порядку */
+export interface WorkoutRoutineVersionExercise {
+  exerciseInstanceId?: string;
+  exerciseId?: string;
+  /** Не задана, если упражнение не входило в группу */
+  group?: WorkoutExerciseGroup;
+  sets?: WorkoutSet[];
 }
 
 /** Структура сета (подхода) */
@@ -315,6 +1248,21 @@ export interface WorkoutSet {
   time?: string;
   /** @format date-time */
   updatedAt?: string;
+  /** Планируемый отдых после подхода */
+  rest?: string;
+  /**
+   * Порядок подхода в упражнении
+   * @format int32
+   */
+  position?: number;
+}
+
+/** Изменение подхода между версиями рутины; before пуст для добавленных, after — для удалённых */
+export interface WorkoutSetChange {
+  setId?: string;
+  action?: WorkoutRoutineChangeAction;
+  before?: WorkoutSet;
+  after?: WorkoutSet;
 }
 
 /** Лог выполнения подхода */
@@ -330,6 +1278,18 @@ export interface WorkoutSetLog {
   time?: string;
   /** @format date-time */
   updatedAt?: string;
+  /**
+   * Начало подхода по данным клиента, не задано, если неизвестно
+   * @format date-time
+   */
+  startedAt?: string;
+  /**
+   * Время записи подхода на сервере
+   * @format date-time
+   */
+  completedAt?: string;
+  /** @format int32 */
+  position?: number;
 }
 
 export interface WorkoutSetLogResponse {
@@ -362,6 +1322,14 @@ export interface WorkoutTokensPair {
   refreshToken: string;
 }
 
+export interface WorkoutUpdateUserPreferencesRequest {
+  weightUnit?: WorkoutMeasurementUnit;
+  lengthUnit?: WorkoutMeasurementUnit;
+  locale?: string;
+  firstDayOfWeek?: WorkoutDayOfWeek;
+  timezone?: string;
+}
+
 export interface WorkoutUpdateUserRequest {
   firstName?: string;
   lastName?: string;
@@ -371,7 +1339,10 @@ export interface WorkoutUpdateUserRequest {
   height?: number;
   /** @format float */
   weight?: number;
+  /** Должна указывать на подтвержденную загрузку пользователя; пустая строка удаляет аватар */
   profilePictureUrl?: string;
+  /** Подтвержденная загрузка с назначением FILE_PURPOSE_PROFILE_PICTURE */
+  profilePictureFileId?: string;
 }
 
 export interface WorkoutUpdateWorkoutGenerationSettingsRequest {
@@ -396,12 +1367,45 @@ export interface WorkoutUser {
   /** @format date-time */
   updatedAt?: string;
   profilePictureUrl?: string;
+  isAdmin?: boolean;
+  /** Уменьшенные копии аватара, пусто пока изображение обрабатывается */
+  profilePictureVariants?: WorkoutImageVariant[];
+}
+
+export interface WorkoutUserPreferences {
+  /** Единица веса в запросах и ответах: килограммы или фунты */
+  weightUnit?: WorkoutMeasurementUnit;
+  /** Единица длины в запросах и ответах: сантиметры или дюймы */
+  lengthUnit?: WorkoutMeasurementUnit;
+  locale?: string;
+  firstDayOfWeek?: WorkoutDayOfWeek;
+  /** Часовой пояс IANA, например Europe/Moscow */
+  timezone?: string;
+}
+
+export interface WorkoutUserPreferencesResponse {
+  preferences?: WorkoutUserPreferences;
 }
 
 export interface WorkoutUserResponse {
   user?: WorkoutUser;
 }
 
+export interface WorkoutWarmupScheme {
+  /** Проценты должны возрастать; пустой список отключает разминку */
+  steps?: WorkoutWarmupStep[];
+  /** Использовать схему по умолчанию: 40% x 5, 60% x 3, 80% x 2 */
+  useDefaultSteps?: boolean;
+}
+
+/** Шаг разминки: процент от рабочего веса и количество повторений */
+export interface WorkoutWarmupStep {
+  /** @format int32 */
+  percent?: number;
+  /** @format int32 */
+  reps?: number;
+}
+
 /** Структура тренировки */
 export interface WorkoutWorkout {
   id?: string;
@@ -418,6 +1422,33 @@ export interface WorkoutWorkout {
   updatedAt?: string;
   isAiGenerated?: boolean;
   reasoning?: string;
+  /** Версия рутины, из которой была начата тренировка */
+  routineVersionId?: string;
+}
+
+/** Запись истории правок завершённой тренировки */
+export interface WorkoutWorkoutAmendment {
+  id?: string;
+  workoutId?: string;
+  userId?: string;
+  exerciseLogId?: string;
+  /** Пусто для правок самого упражнения, например заметок */
+  setLogId?: string;
+  /** set_logged, set_updated, set_deleted или notes_changed */
+  action?: string;
+  changes?: Record<string, WorkoutFieldChange>;
+  /** @format date-time */
+  createdAt?: string;
+}
+
+export interface WorkoutWorkoutCalendarDay {
+  /**
+   * Начало дня в часовом поясе пользователя
+   * @format date-time
+   */
+  date?: string;
+  /** @format int32 */
+  workoutCount?: number;
 }
 
 /** Настройки генерации тренировок */
@@ -431,10 +1462,71 @@ export interface WorkoutWorkoutGenerationSettingsResponse {
   settings?: WorkoutWorkoutGenerationSettings;
 }
 
+export interface WorkoutWorkoutImport {
+  id?: string;
+  source?: WorkoutWorkoutImportSource;
+  status?: WorkoutWorkoutImportStatus;
+  mappings?: WorkoutWorkoutImportMapping[];
+  /** @format int32 */
+  createdCount?: number;
+  /**
+   * Тренировки, импортированные ранее или без сопоставленных упражнений
+   * @format int32
+   */
+  skippedCount?: number;
+  lastError?: string;
+  /** @format date-time */
+  createdAt?: string;
+}
+
+/** Сопоставление названия упражнения из выгрузки с упражнением каталога */
+export interface WorkoutWorkoutImportMapping {
+  /** Название упражнения в выгрузке */
+  name?: string;
+  /** Пусто, пока упражнение не сопоставлено */
+  exerciseId?: string;
+  exerciseName?: string;
+  /**
+   * Похожесть названий от 0 до 1, 1 для выбранных пользователем упражнений
+   * @format double
+   */
+  similarity?: number;
+  /** Подходы пропущенного упражнения не импортируются */
+  skip?: boolean;
+  /** @format int32 */
+  setCount?: number;
+}
+
+export interface WorkoutWorkoutImportResponse {
+  import?: WorkoutWorkoutImport;
+}
+
+/** @default "WORKOUT_IMPORT_SOURCE_UNSPECIFIED" */
+export enum WorkoutWorkoutImportSource {
+  WORKOUT_IMPORT_SOURCE_UNSPECIFIED = "WORKOUT_IMPORT_SOURCE_UNSPECIFIED",
+  WORKOUT_IMPORT_SOURCE_STRONG = "WORKOUT_IMPORT_SOURCE_STRONG",
+  WORKOUT_IMPORT_SOURCE_HEVY = "WORKOUT_IMPORT_SOURCE_HEVY",
+  WORKOUT_IMPORT_SOURCE_FITNOTES = "WORKOUT_IMPORT_SOURCE_FITNOTES",
+}
+
+/**
+ * - WORKOUT_IMPORT_STATUS_REVIEW: Ждёт сопоставления упражнений пользователем
+ * @default "WORKOUT_IMPORT_STATUS_UNSPECIFIED"
+ */
+export enum WorkoutWorkoutImportStatus {
+  WORKOUT_IMPORT_STATUS_UNSPECIFIED = "WORKOUT_IMPORT_STATUS_UNSPECIFIED",
+  WORKOUT_IMPORT_STATUS_REVIEW = "WORKOUT_IMPORT_STATUS_REVIEW",
+  WORKOUT_IMPORT_STATUS_PENDING = "WORKOUT_IMPORT_STATUS_PENDING",
+  WORKOUT_IMPORT_STATUS_RUNNING = "WORKOUT_IMPORT_STATUS_RUNNING",
+  WORKOUT_IMPORT_STATUS_DONE = "WORKOUT_IMPORT_STATUS_DONE",
+  WORKOUT_IMPORT_STATUS_FAILED = "WORKOUT_IMPORT_STATUS_FAILED",
+}
+
 export interface WorkoutWorkoutReportResponse {
   workout?: WorkoutWorkout;
   exerciseLogs?: WorkoutExerciseLog[];
   additionalInfo?: WorkoutReportResponseAdditionalInfo;
+  groups?: WorkoutReportResponseGroupSummary[];
 }
 
 export interface WorkoutWorkoutResponse {
@@ -616,7 +1708,7 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
      * @secure
      */
     authServiceLogout: (body: WorkoutLogoutRequest, params: RequestParams = {}) =>
-      this.request<WorkoutServiceCompleteWorkoutBody, RpcStatus>({
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
         path: `/v1/auth/logout`,
         method: "POST",
         body: body,
@@ -658,6 +1750,32 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
       query?: {
         muscleGroupIds?: string[];
         excludeExerciseIds?: string[];
+        /** Учитывать совпадение групп мышц только с указанными ролями */
+        muscleGroupRoles?: (
+          | "MUSCLE_GROUP_ROLE_UNSPECIFIED"
+          | "MUSCLE_GROUP_ROLE_PRIMARY"
+          | "MUSCLE_GROUP_ROLE_SECONDARY"
+          | "MUSCLE_GROUP_ROLE_STABILIZER"
+        )[];
+        /** Поисковый запрос по названию, описанию и альтернативным названиям */
+        query?: string;
+        equipment?: (
+          | "EQUIPMENT_UNSPECIFIED"
+          | "EQUIPMENT_BARBELL"
+          | "EQUIPMENT_DUMBBELL"
+          | "EQUIPMENT_KETTLEBELL"
+          | "EQUIPMENT_MACHINE"
+          | "EQUIPMENT_CABLE"
+          | "EQUIPMENT_BODYWEIGHT"
+          | "EQUIPMENT_BAND"
+          | "EQUIPMENT_OTHER"
+        )[];
+        /** Только упражнения, которые пользователь выполнял за последние 30 дней */
+        recentlyUsed?: boolean;
+        /** Курсор из next_cursor предыдущей страницы */
+        cursor?: string;
+        /** @format int32 */
+        limit?: number;
       },
       params: RequestParams = {},
     ) =>
@@ -673,19 +1791,913 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
     /**
      * No description
      *
-     * @tags ExerciseService
-     * @name ExerciseServiceCreateExercise
-     * @summary Метод для создания нового упражнения
-     * @request POST:/v1/exercises
+     * @tags ExerciseService
+     * @name ExerciseServiceCreateExercise
+     * @summary Метод для создания нового упражнения
+     * @request POST:/v1/exercises
+     * @secure
+     */
+    exerciseServiceCreateExercise: (body: WorkoutCreateExerciseRequest, params: RequestParams = {}) =>
+      this.request<WorkoutExerciseResponse, RpcStatus>({
+        path: `/v1/exercises`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceAddSharedExercise
+     * @summary Метод для добавления упражнения по ссылке
+     * @request POST:/v1/exercises/shared
+     * @secure
+     */
+    exerciseServiceAddSharedExercise: (body: WorkoutAddSharedExerciseRequest, params: RequestParams = {}) =>
+      this.request<WorkoutExerciseResponse, RpcStatus>({
+        path: `/v1/exercises/shared`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceMergeExercises
+     * @summary Метод для объединения дубликата с основным упражнением
+     * @request POST:/v1/exercises/{canonicalExerciseId}/merge
+     * @secure
+     */
+    exerciseServiceMergeExercises: (
+      canonicalExerciseId: string,
+      body: ExerciseServiceMergeExercisesBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutExerciseResponse, RpcStatus>({
+        path: `/v1/exercises/${canonicalExerciseId}/merge`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceGetExerciseDetail
+     * @summary Метод для получения деталей об упражнении
+     * @request GET:/v1/exercises/{exerciseId}
+     * @secure
+     */
+    exerciseServiceGetExerciseDetail: (exerciseId: string, params: RequestParams = {}) =>
+      this.request<WorkoutExerciseResponse, RpcStatus>({
+        path: `/v1/exercises/${exerciseId}`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceUpdateExercise
+     * @summary Метод для редактирования упражнения (владелец или администратор)
+     * @request PUT:/v1/exercises/{exerciseId}
+     * @secure
+     */
+    exerciseServiceUpdateExercise: (
+      exerciseId: string,
+      body: ExerciseServiceUpdateExerciseBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutExerciseResponse, RpcStatus>({
+        path: `/v1/exercises/${exerciseId}`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceGetExerciseAlternatives
+     * @summary Метод для получения альтернативных упражнений по exercise_id
+     * @request GET:/v1/exercises/{exerciseId}/alternatives
+     * @secure
+     */
+    exerciseServiceGetExerciseAlternatives: (exerciseId: string, params: RequestParams = {}) =>
+      this.request<WorkoutGetExerciseAlternativesResponse, RpcStatus>({
+        path: `/v1/exercises/${exerciseId}/alternatives`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceArchiveExercise
+     * @summary Метод для архивации упражнения; история тренировок сохраняется
+     * @request POST:/v1/exercises/{exerciseId}/archive
+     * @secure
+     */
+    exerciseServiceArchiveExercise: (
+      exerciseId: string,
+      body: ExerciseServiceArchiveExerciseBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutExerciseResponse, RpcStatus>({
+        path: `/v1/exercises/${exerciseId}/archive`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceGetExerciseAuditLog
+     * @summary Метод для получения истории изменений упражнения
+     * @request GET:/v1/exercises/{exerciseId}/audit_log
+     * @secure
+     */
+    exerciseServiceGetExerciseAuditLog: (
+      exerciseId: string,
+      query?: {
+        /** @format int32 */
+        offset?: number;
+        /** @format int32 */
+        limit?: number;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutGetExerciseAuditLogResponse, RpcStatus>({
+        path: `/v1/exercises/${exerciseId}/audit_log`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceGetExerciseHistory
+     * @summary Метод для получения истории выполнения упражнения
+     * @request GET:/v1/exercises/{exerciseId}/history
+     * @secure
+     */
+    exerciseServiceGetExerciseHistory: (
+      exerciseId: string,
+      query?: {
+        /** @format int32 */
+        offset?: number;
+        /** @format int32 */
+        limit?: number;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutExerciseHistoryResponse, RpcStatus>({
+        path: `/v1/exercises/${exerciseId}/history`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceCreateExerciseMediaUpload
+     * @summary Метод для получения ссылки на загрузку медиафайла упражнения
+     * @request POST:/v1/exercises/{exerciseId}/media
+     * @secure
+     */
+    exerciseServiceCreateExerciseMediaUpload: (
+      exerciseId: string,
+      body: ExerciseServiceCreateExerciseMediaUploadBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutCreateExerciseMediaUploadResponse, RpcStatus>({
+        path: `/v1/exercises/${exerciseId}/media`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceSetExerciseMediaOrder
+     * @summary Метод для изменения порядка медиафайлов упражнения
+     * @request POST:/v1/exercises/{exerciseId}/media/order
+     * @secure
+     */
+    exerciseServiceSetExerciseMediaOrder: (
+      exerciseId: string,
+      body: ExerciseServiceSetExerciseMediaOrderBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutExerciseResponse, RpcStatus>({
+        path: `/v1/exercises/${exerciseId}/media/order`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceDeleteExerciseMedia
+     * @summary Метод для удаления медиафайла упражнения
+     * @request DELETE:/v1/exercises/{exerciseId}/media/{mediaId}
+     * @secure
+     */
+    exerciseServiceDeleteExerciseMedia: (exerciseId: string, mediaId: string, params: RequestParams = {}) =>
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
+        path: `/v1/exercises/${exerciseId}/media/${mediaId}`,
+        method: "DELETE",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceUpdateExerciseMedia
+     * @summary Метод для изменения подписи к медиафайлу
+     * @request PATCH:/v1/exercises/{exerciseId}/media/{mediaId}
+     * @secure
+     */
+    exerciseServiceUpdateExerciseMedia: (
+      exerciseId: string,
+      mediaId: string,
+      body: ExerciseServiceUpdateExerciseMediaBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutExerciseMediaResponse, RpcStatus>({
+        path: `/v1/exercises/${exerciseId}/media/${mediaId}`,
+        method: "PATCH",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceConfirmExerciseMedia
+     * @summary Метод для подтверждения загрузки медиафайла и прикрепления его к упражнению
+     * @request POST:/v1/exercises/{exerciseId}/media/{mediaId}/confirm
+     * @secure
+     */
+    exerciseServiceConfirmExerciseMedia: (
+      exerciseId: string,
+      mediaId: string,
+      body: ExerciseServiceConfirmExerciseMediaBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutExerciseMediaResponse, RpcStatus>({
+        path: `/v1/exercises/${exerciseId}/media/${mediaId}/confirm`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceRequestExercisePromotion
+     * @summary Метод для отправки собственного упражнения на модерацию в общий каталог
+     * @request POST:/v1/exercises/{exerciseId}/promotion
+     * @secure
+     */
+    exerciseServiceRequestExercisePromotion: (
+      exerciseId: string,
+      body: ExerciseServiceRequestExercisePromotionBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutExerciseResponse, RpcStatus>({
+        path: `/v1/exercises/${exerciseId}/promotion`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceRestoreExercise
+     * @summary Метод для восстановления упражнения из архива
+     * @request POST:/v1/exercises/{exerciseId}/restore
+     * @secure
+     */
+    exerciseServiceRestoreExercise: (
+      exerciseId: string,
+      body: ExerciseServiceRestoreExerciseBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutExerciseResponse, RpcStatus>({
+        path: `/v1/exercises/${exerciseId}/restore`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceSetExerciseVisibility
+     * @summary Метод для изменения видимости собственного упражнения
+     * @request POST:/v1/exercises/{exerciseId}/visibility
+     * @secure
+     */
+    exerciseServiceSetExerciseVisibility: (
+      exerciseId: string,
+      body: ExerciseServiceSetExerciseVisibilityBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutExerciseResponse, RpcStatus>({
+        path: `/v1/exercises/${exerciseId}/visibility`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceGetDataExports
+     * @summary Метод для получения списка выгрузок данных пользователя
+     * @request GET:/v1/exports
+     * @secure
+     */
+    userServiceGetDataExports: (params: RequestParams = {}) =>
+      this.request<WorkoutGetDataExportsResponse, RpcStatus>({
+        path: `/v1/exports`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceRequestDataExport
+     * @summary Метод для запроса архива со всеми данными пользователя, архив собирается в фоне
+     * @request POST:/v1/exports
+     * @secure
+     */
+    userServiceRequestDataExport: (body: ExerciseServiceArchiveExerciseBody, params: RequestParams = {}) =>
+      this.request<WorkoutDataExportResponse, RpcStatus>({
+        path: `/v1/exports`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceGetDataExport
+     * @summary Метод для получения состояния выгрузки и ссылки на скачивание архива
+     * @request GET:/v1/exports/{exportId}
+     * @secure
+     */
+    userServiceGetDataExport: (exportId: string, params: RequestParams = {}) =>
+      this.request<WorkoutDataExportResponse, RpcStatus>({
+        path: `/v1/exports/${exportId}`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags FileService
+     * @name FileServicePresignUpload
+     * @summary Метод для получения ссылки на загрузку файла
+     * @request POST:/v1/files/presign
+     * @secure
+     */
+    fileServicePresignUpload: (body: WorkoutPresignUploadRequest, params: RequestParams = {}) =>
+      this.request<WorkoutPresignUploadResponse, RpcStatus>({
+        path: `/v1/files/presign`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags FileService
+     * @name FileServiceConfirmUpload
+     * @summary Метод для подтверждения загрузки файла
+     * @request POST:/v1/files/{fileId}/confirm
+     * @secure
+     */
+    fileServiceConfirmUpload: (fileId: string, body: FileServiceConfirmUploadBody, params: RequestParams = {}) =>
+      this.request<WorkoutFileResponse, RpcStatus>({
+        path: `/v1/files/${fileId}/confirm`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceGetGymProfiles
+     * @summary Метод для получения профилей залов пользователя
+     * @request GET:/v1/gym_profiles
+     * @secure
+     */
+    userServiceGetGymProfiles: (params: RequestParams = {}) =>
+      this.request<WorkoutGetGymProfilesResponse, RpcStatus>({
+        path: `/v1/gym_profiles`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceCreateGymProfile
+     * @summary Метод для добавления профиля зала с грифом и набором дисков
+     * @request POST:/v1/gym_profiles
+     * @secure
+     */
+    userServiceCreateGymProfile: (body: WorkoutCreateGymProfileRequest, params: RequestParams = {}) =>
+      this.request<WorkoutGymProfileResponse, RpcStatus>({
+        path: `/v1/gym_profiles`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceDeleteGymProfile
+     * @summary Метод для удаления профиля зала
+     * @request DELETE:/v1/gym_profiles/{gymProfileId}
+     * @secure
+     */
+    userServiceDeleteGymProfile: (gymProfileId: string, params: RequestParams = {}) =>
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
+        path: `/v1/gym_profiles/${gymProfileId}`,
+        method: "DELETE",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceUpdateGymProfile
+     * @summary Метод для изменения профиля зала
+     * @request PATCH:/v1/gym_profiles/{gymProfileId}
+     * @secure
+     */
+    userServiceUpdateGymProfile: (
+      gymProfileId: string,
+      body: UserServiceUpdateGymProfileBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutGymProfileResponse, RpcStatus>({
+        path: `/v1/gym_profiles/${gymProfileId}`,
+        method: "PATCH",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceGetMeasurements
+     * @summary Метод для получения истории замеров тела
+     * @request GET:/v1/measurements
+     * @secure
+     */
+    userServiceGetMeasurements: (
+      query?: {
+        /**
+         * Если не указан, возвращаются замеры всех видов
+         * @default "MEASUREMENT_KIND_UNSPECIFIED"
+         */
+        kind?:
+          | "MEASUREMENT_KIND_UNSPECIFIED"
+          | "MEASUREMENT_KIND_BODYWEIGHT"
+          | "MEASUREMENT_KIND_BODY_FAT"
+          | "MEASUREMENT_KIND_NECK"
+          | "MEASUREMENT_KIND_SHOULDERS"
+          | "MEASUREMENT_KIND_CHEST"
+          | "MEASUREMENT_KIND_WAIST"
+          | "MEASUREMENT_KIND_HIPS"
+          | "MEASUREMENT_KIND_ARM"
+          | "MEASUREMENT_KIND_FOREARM"
+          | "MEASUREMENT_KIND_THIGH"
+          | "MEASUREMENT_KIND_CALF";
+        /** @format date-time */
+        from?: string;
+        /** @format date-time */
+        to?: string;
+        /** @format int32 */
+        offset?: number;
+        /** @format int32 */
+        limit?: number;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutGetMeasurementsResponse, RpcStatus>({
+        path: `/v1/measurements`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceCreateMeasurement
+     * @summary Метод для добавления замера тела
+     * @request POST:/v1/measurements
+     * @secure
+     */
+    userServiceCreateMeasurement: (body: WorkoutCreateMeasurementRequest, params: RequestParams = {}) =>
+      this.request<WorkoutMeasurementResponse, RpcStatus>({
+        path: `/v1/measurements`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceGetMeasurementTrend
+     * @summary Метод для получения динамики замеров со скользящим средним
+     * @request GET:/v1/measurements/trend
+     * @secure
+     */
+    userServiceGetMeasurementTrend: (
+      query: {
+        /** @default "MEASUREMENT_KIND_UNSPECIFIED" */
+        kind:
+          | "MEASUREMENT_KIND_UNSPECIFIED"
+          | "MEASUREMENT_KIND_BODYWEIGHT"
+          | "MEASUREMENT_KIND_BODY_FAT"
+          | "MEASUREMENT_KIND_NECK"
+          | "MEASUREMENT_KIND_SHOULDERS"
+          | "MEASUREMENT_KIND_CHEST"
+          | "MEASUREMENT_KIND_WAIST"
+          | "MEASUREMENT_KIND_HIPS"
+          | "MEASUREMENT_KIND_ARM"
+          | "MEASUREMENT_KIND_FOREARM"
+          | "MEASUREMENT_KIND_THIGH"
+          | "MEASUREMENT_KIND_CALF";
+        /** @format date-time */
+        from?: string;
+        /**
+         * По умолчанию текущее время
+         * @format date-time
+         */
+        to?: string;
+        /**
+         * Окно скользящего среднего в днях, по умолчанию 7
+         * @format int32
+         */
+        windowDays?: number;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutGetMeasurementTrendResponse, RpcStatus>({
+        path: `/v1/measurements/trend`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceDeleteMeasurement
+     * @summary Метод для удаления замера тела
+     * @request DELETE:/v1/measurements/{measurementId}
+     * @secure
+     */
+    userServiceDeleteMeasurement: (measurementId: string, params: RequestParams = {}) =>
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
+        path: `/v1/measurements/${measurementId}`,
+        method: "DELETE",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceUpdateMeasurement
+     * @summary Метод для изменения замера тела
+     * @request PUT:/v1/measurements/{measurementId}
+     * @secure
+     */
+    userServiceUpdateMeasurement: (
+      measurementId: string,
+      body: UserServiceUpdateMeasurementBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutMeasurementResponse, RpcStatus>({
+        path: `/v1/measurements/${measurementId}`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceGetExercisesForModeration
+     * @summary Метод для получения упражнений, ожидающих модерации (только для администраторов)
+     * @request GET:/v1/moderation/exercises
+     * @secure
+     */
+    exerciseServiceGetExercisesForModeration: (params: RequestParams = {}) =>
+      this.request<WorkoutGetExercisesResponse, RpcStatus>({
+        path: `/v1/moderation/exercises`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceModerateExercise
+     * @summary Метод для принятия решения по модерации упражнения (только для администраторов)
+     * @request POST:/v1/moderation/exercises/{exerciseId}
+     * @secure
+     */
+    exerciseServiceModerateExercise: (
+      exerciseId: string,
+      body: ExerciseServiceModerateExerciseBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutExerciseResponse, RpcStatus>({
+        path: `/v1/moderation/exercises/${exerciseId}`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags ExerciseService
+     * @name ExerciseServiceGetMuscleGroups
+     * @summary Метод для получения списка групп мышц
+     * @request GET:/v1/muscle_groups
+     * @secure
+     */
+    exerciseServiceGetMuscleGroups: (params: RequestParams = {}) =>
+      this.request<WorkoutGetMuscleGroupsResponse, RpcStatus>({
+        path: `/v1/muscle_groups`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceGetUserPreferences
+     * @summary Метод для получения единиц измерения и региональных настроек пользователя
+     * @request GET:/v1/preferences
+     * @secure
+     */
+    userServiceGetUserPreferences: (params: RequestParams = {}) =>
+      this.request<WorkoutUserPreferencesResponse, RpcStatus>({
+        path: `/v1/preferences`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceUpdateUserPreferences
+     * @summary Метод для изменения единиц измерения и региональных настроек пользователя
+     * @request PATCH:/v1/preferences
+     * @secure
+     */
+    userServiceUpdateUserPreferences: (body: WorkoutUpdateUserPreferencesRequest, params: RequestParams = {}) =>
+      this.request<WorkoutUserPreferencesResponse, RpcStatus>({
+        path: `/v1/preferences`,
+        method: "PATCH",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceGetProgressPhotos
+     * @summary Метод для получения ленты фото прогресса
+     * @request GET:/v1/progress_photos
+     * @secure
+     */
+    userServiceGetProgressPhotos: (
+      query?: {
+        /**
+         * Если не указана, возвращаются фото всех ракурсов
+         * @default "PROGRESS_PHOTO_POSE_UNSPECIFIED"
+         */
+        pose?:
+          | "PROGRESS_PHOTO_POSE_UNSPECIFIED"
+          | "PROGRESS_PHOTO_POSE_FRONT"
+          | "PROGRESS_PHOTO_POSE_SIDE"
+          | "PROGRESS_PHOTO_POSE_BACK";
+        /** @format date-time */
+        from?: string;
+        /** @format date-time */
+        to?: string;
+        /** @format int32 */
+        offset?: number;
+        /** @format int32 */
+        limit?: number;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutGetProgressPhotosResponse, RpcStatus>({
+        path: `/v1/progress_photos`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceCreateProgressPhoto
+     * @summary Метод для добавления фото прогресса из подтвержденной загрузки
+     * @request POST:/v1/progress_photos
+     * @secure
+     */
+    userServiceCreateProgressPhoto: (body: WorkoutCreateProgressPhotoRequest, params: RequestParams = {}) =>
+      this.request<WorkoutProgressPhotoResponse, RpcStatus>({
+        path: `/v1/progress_photos`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags UserService
+     * @name UserServiceCompareProgressPhotos
+     * @summary Метод для сравнения фото прогресса на две даты
+     * @request GET:/v1/progress_photos/compare
      * @secure
      */
-    exerciseServiceCreateExercise: (body: WorkoutCreateExerciseRequest, params: RequestParams = {}) =>
-      this.request<WorkoutExerciseResponse, RpcStatus>({
-        path: `/v1/exercises`,
-        method: "POST",
-        body: body,
+    userServiceCompareProgressPhotos: (
+      query: {
+        /** @format date-time */
+        before: string;
+        /** @format date-time */
+        after: string;
+        /** Приватные фото участвуют в сравнении, только если это явно запрошено */
+        includePrivate?: boolean;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutCompareProgressPhotosResponse, RpcStatus>({
+        path: `/v1/progress_photos/compare`,
+        method: "GET",
+        query: query,
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -693,16 +2705,16 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
     /**
      * No description
      *
-     * @tags ExerciseService
-     * @name ExerciseServiceGetExerciseDetail
-     * @summary Метод для получения деталей об упражнении
-     * @request GET:/v1/exercises/{exerciseId}
+     * @tags UserService
+     * @name UserServiceDeleteProgressPhoto
+     * @summary Метод для удаления фото прогресса
+     * @request DELETE:/v1/progress_photos/{photoId}
      * @secure
      */
-    exerciseServiceGetExerciseDetail: (exerciseId: string, params: RequestParams = {}) =>
-      this.request<WorkoutExerciseResponse, RpcStatus>({
-        path: `/v1/exercises/${exerciseId}`,
-        method: "GET",
+    userServiceDeleteProgressPhoto: (photoId: string, params: RequestParams = {}) =>
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
+        path: `/v1/progress_photos/${photoId}`,
+        method: "DELETE",
         secure: true,
         format: "json",
         ...params,
@@ -711,17 +2723,23 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
     /**
      * No description
      *
-     * @tags ExerciseService
-     * @name ExerciseServiceGetExerciseAlternatives
-     * @summary Метод для получения альтернативных упражнений по exercise_id
-     * @request GET:/v1/exercises/{exerciseId}/alternatives
+     * @tags UserService
+     * @name UserServiceUpdateProgressPhoto
+     * @summary Метод для изменения фото прогресса
+     * @request PATCH:/v1/progress_photos/{photoId}
      * @secure
      */
-    exerciseServiceGetExerciseAlternatives: (exerciseId: string, params: RequestParams = {}) =>
-      this.request<WorkoutGetExerciseAlternativesResponse, RpcStatus>({
-        path: `/v1/exercises/${exerciseId}/alternatives`,
-        method: "GET",
+    userServiceUpdateProgressPhoto: (
+      photoId: string,
+      body: UserServiceUpdateProgressPhotoBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutProgressPhotoResponse, RpcStatus>({
+        path: `/v1/progress_photos/${photoId}`,
+        method: "PATCH",
+        body: body,
         secure: true,
+        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -729,24 +2747,23 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
     /**
      * No description
      *
-     * @tags ExerciseService
-     * @name ExerciseServiceGetExerciseHistory
-     * @summary Метод для получения истории выполнения упражнения
-     * @request GET:/v1/exercises/{exerciseId}/history
+     * @tags RoutineService
+     * @name RoutineServiceGetRoutineTemplates
+     * @summary Метод для поиска по библиотеке шаблонов рутин
+     * @request GET:/v1/routine_templates
      * @secure
      */
-    exerciseServiceGetExerciseHistory: (
-      exerciseId: string,
+    routineServiceGetRoutineTemplates: (
       query?: {
-        /** @format int32 */
-        offset?: number;
-        /** @format int32 */
-        limit?: number;
+        /** Поиск по названию, описанию и программе */
+        query?: string;
+        /** Точное название программы */
+        program?: string;
       },
       params: RequestParams = {},
     ) =>
-      this.request<WorkoutExerciseHistoryResponse, RpcStatus>({
-        path: `/v1/exercises/${exerciseId}/history`,
+      this.request<WorkoutGetRoutineTemplatesResponse, RpcStatus>({
+        path: `/v1/routine_templates`,
         method: "GET",
         query: query,
         secure: true,
@@ -757,14 +2774,19 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
     /**
      * No description
      *
-     * @tags FileService
-     * @name FileServicePresignUpload
-     * @request POST:/v1/files/presign
+     * @tags RoutineService
+     * @name RoutineServiceCloneRoutineTemplate
+     * @summary Копирование шаблона в рутины пользователя
+     * @request POST:/v1/routine_templates/{templateId}/clone
      * @secure
      */
-    fileServicePresignUpload: (body: WorkoutPresignUploadRequest, params: RequestParams = {}) =>
-      this.request<WorkoutPresignUploadResponse, RpcStatus>({
-        path: `/v1/files/presign`,
+    routineServiceCloneRoutineTemplate: (
+      templateId: string,
+      body: RoutineServiceCloneRoutineTemplateBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutRoutineResponse, RpcStatus>({
+        path: `/v1/routine_templates/${templateId}/clone`,
         method: "POST",
         body: body,
         secure: true,
@@ -776,15 +2798,15 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
     /**
      * No description
      *
-     * @tags ExerciseService
-     * @name ExerciseServiceGetMuscleGroups
-     * @summary Метод для получения списка групп мышц
-     * @request GET:/v1/muscle_groups
+     * @tags RoutineService
+     * @name RoutineServiceGetRoutineVersion
+     * @summary Метод для получения содержимого версии рутины, в том числе удалённой
+     * @request GET:/v1/routine_versions/{versionId}
      * @secure
      */
-    exerciseServiceGetMuscleGroups: (params: RequestParams = {}) =>
-      this.request<WorkoutGetMuscleGroupsResponse, RpcStatus>({
-        path: `/v1/muscle_groups`,
+    routineServiceGetRoutineVersion: (versionId: string, params: RequestParams = {}) =>
+      this.request<WorkoutGetRoutineVersionResponse, RpcStatus>({
+        path: `/v1/routine_versions/${versionId}`,
         method: "GET",
         secure: true,
         format: "json",
@@ -829,6 +2851,44 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags RoutineService
+     * @name RoutineServiceImportRoutine
+     * @summary Метод для копирования рутины по ссылке в свой аккаунт
+     * @request POST:/v1/routines/shared
+     * @secure
+     */
+    routineServiceImportRoutine: (body: WorkoutImportRoutineRequest, params: RequestParams = {}) =>
+      this.request<WorkoutRoutineResponse, RpcStatus>({
+        path: `/v1/routines/shared`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags RoutineService
+     * @name RoutineServiceGetSharedRoutine
+     * @summary Метод для просмотра рутины по ссылке, не требует авторизации
+     * @request GET:/v1/routines/shared/{shareToken}
+     * @secure
+     */
+    routineServiceGetSharedRoutine: (shareToken: string, params: RequestParams = {}) =>
+      this.request<WorkoutRoutineDetailResponse, RpcStatus>({
+        path: `/v1/routines/shared/${shareToken}`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -857,7 +2917,7 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
      * @secure
      */
     routineServiceDeleteRoutine: (routineId: string, params: RequestParams = {}) =>
-      this.request<WorkoutServiceCompleteWorkoutBody, RpcStatus>({
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
         path: `/v1/routines/${routineId}`,
         method: "DELETE",
         secure: true,
@@ -889,6 +2949,30 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags RoutineService
+     * @name RoutineServiceDuplicateRoutine
+     * @summary Метод для создания копии рутины или одной из её версий
+     * @request POST:/v1/routines/{routineId}/duplicate
+     * @secure
+     */
+    routineServiceDuplicateRoutine: (
+      routineId: string,
+      body: RoutineServiceDuplicateRoutineBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutRoutineResponse, RpcStatus>({
+        path: `/v1/routines/${routineId}/duplicate`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -903,7 +2987,7 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
       body: RoutineServiceSetExerciseOrderBody,
       params: RequestParams = {},
     ) =>
-      this.request<WorkoutServiceCompleteWorkoutBody, RpcStatus>({
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
         path: `/v1/routines/${routineId}/exercise_instances/order`,
         method: "POST",
         body: body,
@@ -949,7 +3033,7 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
       exerciseInstanceId: string,
       params: RequestParams = {},
     ) =>
-      this.request<WorkoutServiceCompleteWorkoutBody, RpcStatus>({
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
         path: `/v1/routines/${routineId}/exercise_instances/${exerciseInstanceId}`,
         method: "DELETE",
         secure: true,
@@ -982,6 +3066,31 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags RoutineService
+     * @name RoutineServiceSetSetOrder
+     * @summary Метод для установки порядка сетов упражнения в рутине
+     * @request POST:/v1/routines/{routineId}/exercise_instances/{exerciseInstanceId}/sets/order
+     * @secure
+     */
+    routineServiceSetSetOrder: (
+      routineId: string,
+      exerciseInstanceId: string,
+      body: RoutineServiceSetSetOrderBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
+        path: `/v1/routines/${routineId}/exercise_instances/${exerciseInstanceId}/sets/order`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -997,7 +3106,7 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
       setId: string,
       params: RequestParams = {},
     ) =>
-      this.request<WorkoutServiceCompleteWorkoutBody, RpcStatus>({
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
         path: `/v1/routines/${routineId}/exercise_instances/${exerciseInstanceId}/sets/${setId}`,
         method: "DELETE",
         secure: true,
@@ -1014,19 +3123,163 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
      * @request PUT:/v1/routines/{routineId}/exercise_instances/{exerciseInstanceId}/sets/{setId}
      * @secure
      */
-    routineServiceUpdateSetInExerciseInstance: (
-      routineId: string,
-      exerciseInstanceId: string,
-      setId: string,
-      body: RoutineServiceUpdateSetInExerciseInstanceBody,
-      params: RequestParams = {},
-    ) =>
-      this.request<WorkoutSetResponse, RpcStatus>({
-        path: `/v1/routines/${routineId}/exercise_instances/${exerciseInstanceId}/sets/${setId}`,
-        method: "PUT",
-        body: body,
+    routineServiceUpdateSetInExerciseInstance: (
+      routineId: string,
+      exerciseInstanceId: string,
+      setId: string,
+      body: RoutineServiceUpdateSetInExerciseInstanceBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutSetResponse, RpcStatus>({
+        path: `/v1/routines/${routineId}/exercise_instances/${exerciseInstanceId}/sets/${setId}`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags RoutineService
+     * @name RoutineServiceAddExerciseToRoutine
+     * @summary Добавление упражнения в рутину
+     * @request POST:/v1/routines/{routineId}/exercises
+     * @secure
+     */
+    routineServiceAddExerciseToRoutine: (
+      routineId: string,
+      body: RoutineServiceAddExerciseToRoutineBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutRoutineInstanceResponse, RpcStatus>({
+        path: `/v1/routines/${routineId}/exercises`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags RoutineService
+     * @name RoutineServiceGroupExerciseInstances
+     * @summary Метод для объединения упражнений рутины в суперсет, гигантский сет или круг
+     * @request POST:/v1/routines/{routineId}/groups
+     * @secure
+     */
+    routineServiceGroupExerciseInstances: (
+      routineId: string,
+      body: RoutineServiceGroupExerciseInstancesBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutGroupExerciseInstancesResponse, RpcStatus>({
+        path: `/v1/routines/${routineId}/groups`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags RoutineService
+     * @name RoutineServiceUngroupExerciseInstances
+     * @summary Метод для расформирования группы упражнений рутины
+     * @request DELETE:/v1/routines/{routineId}/groups/{groupId}
+     * @secure
+     */
+    routineServiceUngroupExerciseInstances: (routineId: string, groupId: string, params: RequestParams = {}) =>
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
+        path: `/v1/routines/${routineId}/groups/${groupId}`,
+        method: "DELETE",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags RoutineService
+     * @name RoutineServiceGetRoutineShareLinks
+     * @summary Метод для получения ссылок на рутину
+     * @request GET:/v1/routines/{routineId}/share_links
+     * @secure
+     */
+    routineServiceGetRoutineShareLinks: (routineId: string, params: RequestParams = {}) =>
+      this.request<WorkoutGetRoutineShareLinksResponse, RpcStatus>({
+        path: `/v1/routines/${routineId}/share_links`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags RoutineService
+     * @name RoutineServiceCreateRoutineShareLink
+     * @summary Метод для создания ссылки на просмотр рутины без авторизации
+     * @request POST:/v1/routines/{routineId}/share_links
+     * @secure
+     */
+    routineServiceCreateRoutineShareLink: (
+      routineId: string,
+      body: RoutineServiceCreateRoutineShareLinkBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutRoutineShareLinkResponse, RpcStatus>({
+        path: `/v1/routines/${routineId}/share_links`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags RoutineService
+     * @name RoutineServiceDeleteRoutineShareLink
+     * @summary Метод для отзыва ссылки на рутину
+     * @request DELETE:/v1/routines/{routineId}/share_links/{shareLinkId}
+     * @secure
+     */
+    routineServiceDeleteRoutineShareLink: (routineId: string, shareLinkId: string, params: RequestParams = {}) =>
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
+        path: `/v1/routines/${routineId}/share_links/${shareLinkId}`,
+        method: "DELETE",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags RoutineService
+     * @name RoutineServiceGetRoutineVersions
+     * @summary Метод для получения истории версий рутины
+     * @request GET:/v1/routines/{routineId}/versions
+     * @secure
+     */
+    routineServiceGetRoutineVersions: (routineId: string, params: RequestParams = {}) =>
+      this.request<WorkoutGetRoutineVersionsResponse, RpcStatus>({
+        path: `/v1/routines/${routineId}/versions`,
+        method: "GET",
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -1035,22 +3288,26 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
      * No description
      *
      * @tags RoutineService
-     * @name RoutineServiceAddExerciseToRoutine
-     * @summary Добавление упражнения в рутину
-     * @request POST:/v1/routines/{routineId}/exercises
+     * @name RoutineServiceDiffRoutineVersions
+     * @summary Метод для сравнения двух версий рутины
+     * @request GET:/v1/routines/{routineId}/versions/diff
      * @secure
      */
-    routineServiceAddExerciseToRoutine: (
+    routineServiceDiffRoutineVersions: (
       routineId: string,
-      body: RoutineServiceAddExerciseToRoutineBody,
+      query?: {
+        /** @format int32 */
+        fromVersion?: number;
+        /** @format int32 */
+        toVersion?: number;
+      },
       params: RequestParams = {},
     ) =>
-      this.request<WorkoutRoutineInstanceResponse, RpcStatus>({
-        path: `/v1/routines/${routineId}/exercises`,
-        method: "POST",
-        body: body,
+      this.request<WorkoutDiffRoutineVersionsResponse, RpcStatus>({
+        path: `/v1/routines/${routineId}/versions/diff`,
+        method: "GET",
+        query: query,
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -1144,7 +3401,7 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
       body: WorkoutUpdateWorkoutGenerationSettingsRequest,
       params: RequestParams = {},
     ) =>
-      this.request<WorkoutServiceCompleteWorkoutBody, RpcStatus>({
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
         path: `/v1/users/workout_generation_settings`,
         method: "PUT",
         body: body,
@@ -1172,6 +3429,86 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags WorkoutService
+     * @name WorkoutServiceGetWorkoutImports
+     * @summary Метод для получения списка импортов истории тренировок
+     * @request GET:/v1/workout_imports
+     * @secure
+     */
+    workoutServiceGetWorkoutImports: (params: RequestParams = {}) =>
+      this.request<WorkoutGetWorkoutImportsResponse, RpcStatus>({
+        path: `/v1/workout_imports`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags WorkoutService
+     * @name WorkoutServiceCreateWorkoutImport
+     * @summary Метод для импорта истории тренировок из CSV-выгрузки Strong, Hevy или FitNotes.
+     * @request POST:/v1/workout_imports
+     * @secure
+     */
+    workoutServiceCreateWorkoutImport: (body: WorkoutCreateWorkoutImportRequest, params: RequestParams = {}) =>
+      this.request<WorkoutWorkoutImportResponse, RpcStatus>({
+        path: `/v1/workout_imports`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags WorkoutService
+     * @name WorkoutServiceGetWorkoutImport
+     * @summary Метод для получения импорта истории тренировок
+     * @request GET:/v1/workout_imports/{importId}
+     * @secure
+     */
+    workoutServiceGetWorkoutImport: (importId: string, params: RequestParams = {}) =>
+      this.request<WorkoutWorkoutImportResponse, RpcStatus>({
+        path: `/v1/workout_imports/${importId}`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags WorkoutService
+     * @name WorkoutServiceResolveWorkoutImport
+     * @summary Метод для сопоставления несопоставленных упражнений импорта с каталогом или их пропуска.
+     * @request POST:/v1/workout_imports/{importId}/resolve
+     * @secure
+     */
+    workoutServiceResolveWorkoutImport: (
+      importId: string,
+      body: WorkoutServiceResolveWorkoutImportBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutWorkoutImportResponse, RpcStatus>({
+        path: `/v1/workout_imports/${importId}/resolve`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -1237,6 +3574,136 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags WorkoutService
+     * @name WorkoutServiceGetWorkoutCalendar
+     * @summary Метод для получения календаря тренировок по дням в часовом поясе пользователя
+     * @request GET:/v1/workouts/analytics/calendar
+     * @secure
+     */
+    workoutServiceGetWorkoutCalendar: (
+      query?: {
+        /**
+         * По умолчанию - текущий месяц в часовом поясе пользователя
+         * @format date-time
+         */
+        from?: string;
+        /** @format date-time */
+        to?: string;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutGetWorkoutCalendarResponse, RpcStatus>({
+        path: `/v1/workouts/analytics/calendar`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags WorkoutService
+     * @name WorkoutServiceGetMuscleGroupVolume
+     * @summary Метод для получения объема нагрузки по группам мышц
+     * @request GET:/v1/workouts/analytics/muscle_volume
+     * @secure
+     */
+    workoutServiceGetMuscleGroupVolume: (
+      query?: {
+        /**
+         * По умолчанию - последние 7 дней
+         * @format date-time
+         */
+        from?: string;
+        /** @format date-time */
+        to?: string;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutGetMuscleGroupVolumeResponse, RpcStatus>({
+        path: `/v1/workouts/analytics/muscle_volume`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags WorkoutService
+     * @name WorkoutServiceGetWorkoutStreak
+     * @summary Метод для получения серий тренировок по дням и неделям
+     * @request GET:/v1/workouts/analytics/streak
+     * @secure
+     */
+    workoutServiceGetWorkoutStreak: (params: RequestParams = {}) =>
+      this.request<WorkoutGetWorkoutStreakResponse, RpcStatus>({
+        path: `/v1/workouts/analytics/streak`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags WorkoutService
+     * @name WorkoutServiceCreatePastWorkout
+     * @summary Метод для записи прошедшей тренировки с явным временем начала и окончания,
+     * @request POST:/v1/workouts/past
+     * @secure
+     */
+    workoutServiceCreatePastWorkout: (body: WorkoutCreatePastWorkoutRequest, params: RequestParams = {}) =>
+      this.request<WorkoutGetWorkoutResponse, RpcStatus>({
+        path: `/v1/workouts/past`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags WorkoutService
+     * @name WorkoutServiceCalculatePlates
+     * @summary Метод для расчета дисков, которые нужно повесить на штангу для целевого веса
+     * @request GET:/v1/workouts/plates
+     * @secure
+     */
+    workoutServiceCalculatePlates: (
+      query: {
+        /** @format float */
+        targetWeight: number;
+        /** По умолчанию используется основной профиль зала */
+        gymProfileId?: string;
+        /**
+         * Заменяет вес грифа из профиля зала
+         * @format float
+         */
+        barWeight?: number;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutPlateLoadout, RpcStatus>({
+        path: `/v1/workouts/plates`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -1265,7 +3732,7 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
      * @secure
      */
     workoutServiceDeleteWorkout: (workoutId: string, params: RequestParams = {}) =>
-      this.request<WorkoutServiceCompleteWorkoutBody, RpcStatus>({
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
         path: `/v1/workouts/${workoutId}`,
         method: "DELETE",
         secure: true,
@@ -1273,6 +3740,42 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags WorkoutService
+     * @name WorkoutServiceGetWorkoutAmendments
+     * @summary Метод для получения истории правок завершённой тренировки
+     * @request GET:/v1/workouts/{workoutId}/amendments
+     * @secure
+     */
+    workoutServiceGetWorkoutAmendments: (workoutId: string, params: RequestParams = {}) =>
+      this.request<WorkoutGetWorkoutAmendmentsResponse, RpcStatus>({
+        path: `/v1/workouts/${workoutId}/amendments`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags WorkoutService
+     * @name WorkoutServiceGetWorkoutRestAnalytics
+     * @summary Метод для получения фактического и планируемого отдыха между подходами тренировки
+     * @request GET:/v1/workouts/{workoutId}/analytics/rest
+     * @secure
+     */
+    workoutServiceGetWorkoutRestAnalytics: (workoutId: string, params: RequestParams = {}) =>
+      this.request<WorkoutGetWorkoutRestAnalyticsResponse, RpcStatus>({
+        path: `/v1/workouts/${workoutId}/analytics/rest`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -1311,7 +3814,7 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
       body: WorkoutServiceCompleteWorkoutBody,
       params: RequestParams = {},
     ) =>
-      this.request<WorkoutServiceCompleteWorkoutBody, RpcStatus>({
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
         path: `/v1/workouts/${workoutId}/complete`,
         method: "POST",
         body: body,
@@ -1321,6 +3824,48 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags WorkoutService
+     * @name WorkoutServiceGroupExerciseLogs
+     * @summary Метод для объединения упражнений тренировки в суперсет, гигантский сет или круг
+     * @request POST:/v1/workouts/{workoutId}/groups
+     * @secure
+     */
+    workoutServiceGroupExerciseLogs: (
+      workoutId: string,
+      body: WorkoutServiceGroupExerciseLogsBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<WorkoutGroupExerciseLogsResponse, RpcStatus>({
+        path: `/v1/workouts/${workoutId}/groups`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags WorkoutService
+     * @name WorkoutServiceUngroupExerciseLogs
+     * @summary Метод для расформирования группы упражнений тренировки
+     * @request DELETE:/v1/workouts/{workoutId}/groups/{groupId}
+     * @secure
+     */
+    workoutServiceUngroupExerciseLogs: (workoutId: string, groupId: string, params: RequestParams = {}) =>
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
+        path: `/v1/workouts/${workoutId}/groups/${groupId}`,
+        method: "DELETE",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -1341,6 +3886,30 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags WorkoutService
+     * @name WorkoutServiceSetExerciseLogOrder
+     * @summary Метод для установки порядка упражнений в тренировке
+     * @request POST:/v1/workouts/{workoutId}/log/exercise/order
+     * @secure
+     */
+    workoutServiceSetExerciseLogOrder: (
+      workoutId: string,
+      body: WorkoutServiceSetExerciseLogOrderBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
+        path: `/v1/workouts/${workoutId}/log/exercise/order`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -1369,7 +3938,7 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
      * @secure
      */
     workoutServiceDeleteExerciseLog: (workoutId: string, exerciseLogId: string, params: RequestParams = {}) =>
-      this.request<WorkoutServiceCompleteWorkoutBody, RpcStatus>({
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
         path: `/v1/workouts/${workoutId}/log/exercise/${exerciseLogId}`,
         method: "DELETE",
         secure: true,
@@ -1392,7 +3961,7 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
       body: WorkoutServiceAddNotesToExerciseLogBody,
       params: RequestParams = {},
     ) =>
-      this.request<WorkoutServiceCompleteWorkoutBody, RpcStatus>({
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
         path: `/v1/workouts/${workoutId}/log/exercise/${exerciseLogId}/notes`,
         method: "POST",
         body: body,
@@ -1417,7 +3986,7 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
       body: WorkoutServiceAddPowerRatingToExerciseLogBody,
       params: RequestParams = {},
     ) =>
-      this.request<WorkoutServiceCompleteWorkoutBody, RpcStatus>({
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
         path: `/v1/workouts/${workoutId}/log/exercise/${exerciseLogId}/power_rating`,
         method: "POST",
         body: body,
@@ -1452,6 +4021,31 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags WorkoutService
+     * @name WorkoutServiceSetSetLogOrder
+     * @summary Метод для установки порядка подходов упражнения в тренировке
+     * @request POST:/v1/workouts/{workoutId}/log/exercise/{exerciseLogId}/set/order
+     * @secure
+     */
+    workoutServiceSetSetLogOrder: (
+      workoutId: string,
+      exerciseLogId: string,
+      body: WorkoutServiceSetSetLogOrderBody,
+      params: RequestParams = {},
+    ) =>
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
+        path: `/v1/workouts/${workoutId}/log/exercise/${exerciseLogId}/set/order`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -1461,10 +4055,23 @@ export class Api<SecurityDataType extends unknown> extends HttpClient<SecurityDa
      * @request DELETE:/v1/workouts/{workoutId}/log/exercise/{exerciseLogId}/set/{setId}
      * @secure
      */
-    workoutServiceDeleteSetLog: (workoutId: string, exerciseLogId: string, setId: string, params: RequestParams = {}) =>
-      this.request<WorkoutServiceCompleteWorkoutBody, RpcStatus>({
+    workoutServiceDeleteSetLog: (
+      workoutId: string,
+      exerciseLogId: string,
+      setId: string,
+      query?: {
+        /**
+         * Изменить завершённую тренировку после окончания окна редактирования.
+         * Изменения завершённых тренировок записываются в историю правок
+         */
+        amend?: boolean;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<ExerciseServiceArchiveExerciseBody, RpcStatus>({
         path: `/v1/workouts/${workoutId}/log/exercise/${exerciseLogId}/set/${setId}`,
         method: "DELETE",
+        query: query,
         secure: true,
         format: "json",
         ...params,
@@ -115,13 +115,11 @@ function AvatarSection({
 
     const file = e.target.files[0];
 
-    const filename =
-      (Math.random() + 1).toString(36).substring(2) + "-" + file.name;
-
     const data = await authApi.v1
       .fileServicePresignUpload({
-        filename: filename,
+        filename: file.name,
         contentType: file.type,
+        sizeBytes: String(file.size),
       })
       .then((response) => {
         return response.data;
@@ -135,11 +133,7 @@ function AvatarSection({
       return;
     }
 
-    const formData = new FormData();
-
-    formData.append("file", file);
-
-    const response = await fetch(data.uploadUrl!, {
+    const uploaded = await fetch(data.uploadUrl!, {
       method: "PUT",
       body: file,
       headers: {
@@ -149,33 +143,43 @@ function AvatarSection({
       .then((response) => {
         if (!response.ok) {
           throw new Error("Ошибка при загрузке файла");
-        } else {
-          toast.success("Фотография успешно загружена");
         }
 
-        return response;
+        return authApi.v1.fileServiceConfirmUpload(data.fileId!, {});
+      })
+      .then((response) => {
+        toast.success("Фотография успешно загружена");
+
+        return response.data;
       })
       .catch((error) => {
         console.log(error);
         toast.error("Ошибка при загрузке файла");
       });
 
-    if (!response) {
+    e.target.value = "";
+
+    if (!uploaded) {
       return;
     }
 
-    await authApi.v1
+    const user = await authApi.v1
       .userServiceUpdateUser({
-        profilePictureUrl: data.getUrl,
+        profilePictureFileId: data.fileId,
+      })
+      .then((response) => {
+        return response.data.user;
       })
       .catch((error) => {
         console.log(error);
         toast.error("Ошибка при обновлении аватара");
       });
 
-    e.target.value = "";
+    if (!user) {
+      return;
+    }
 
-    setProfilePictureURL(data.getUrl!);
+    setProfilePictureURL(user.profilePictureUrl!);
   }
 
   return (